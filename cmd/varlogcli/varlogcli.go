@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
@@ -10,6 +11,7 @@ import (
 	"github.com/kakao/varlog/internal/flags"
 	"github.com/kakao/varlog/internal/varlogcli"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
 )
 
 func main() {
@@ -44,6 +46,14 @@ const (
 
 var (
 	flagBatchSize = flags.FlagDesc{Name: "batch-size"}
+	flagFromTime  = flags.FlagDesc{
+		Name:  "from-time",
+		Usage: "subscribe to log entries committed at or after the time in RFC 3339 format, for example, 2006-01-02T15:04:05+09:00",
+	}
+	flagFrom = flags.FlagDesc{
+		Name:  "from",
+		Usage: "subscribe from the position: latest to receive only new log entries, or earliest to receive all log entries not trimmed",
+	}
 )
 
 func newAppend() *cli.Command {
@@ -62,7 +72,11 @@ func newSubscribe() *cli.Command {
 	return &cli.Command{
 		Name:   cmdSubscribe,
 		Action: commandAction,
-		Flags:  commonFlags(),
+		Flags: append(
+			commonFlags(),
+			flagFromTime.StringFlag(false, ""),
+			flagFrom.StringFlag(false, ""),
+		),
 	}
 }
 
@@ -83,7 +97,16 @@ func commandAction(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	topicID, err = types.ParseTopicID(c.String(flags.TopicID().Name))
+	switch {
+	case c.IsSet(flags.TopicID().Name) && c.IsSet(flags.TopicName().Name):
+		return errors.Errorf("%s cannot be used with %s", flags.TopicID().Name, flags.TopicName().Name)
+	case c.IsSet(flags.TopicID().Name):
+		topicID, err = types.ParseTopicID(c.String(flags.TopicID().Name))
+	case c.IsSet(flags.TopicName().Name):
+		topicID, err = varlogcli.LookupTopic(mrAddrs, clusterID, c.String(flags.TopicName().Name))
+	default:
+		err = errors.Errorf("either %s or %s is required", flags.TopicID().Name, flags.TopicName().Name)
+	}
 	if err != nil {
 		return err
 	}
@@ -106,6 +129,34 @@ func commandAction(c *cli.Context) error {
 		}
 		return varlogcli.Append(mrAddrs, clusterID, topicID, batchSize)
 	case cmdSubscribe:
+		if c.IsSet(flagFrom.Name) {
+			if c.IsSet(flagFromTime.Name) {
+				return errors.Errorf("%s cannot be used with %s", flagFrom.Name, flagFromTime.Name)
+			}
+			var pos varlog.StartPosition
+			switch from := c.String(flagFrom.Name); from {
+			case varlog.StartPositionLatest.String():
+				pos = varlog.StartPositionLatest
+			case varlog.StartPositionEarliest.String():
+				pos = varlog.StartPositionEarliest
+			default:
+				return errors.Errorf("invalid %s: %s", flagFrom.Name, from)
+			}
+			if c.IsSet(flags.LogStreamID().Name) {
+				return varlogcli.SubscribeTo(mrAddrs, clusterID, topicID, logStreamID, varlog.WithStartPosition(pos))
+			}
+			return varlogcli.SubscribeFromPosition(mrAddrs, clusterID, topicID, pos)
+		}
+		if c.IsSet(flagFromTime.Name) {
+			if c.IsSet(flags.LogStreamID().Name) {
+				return errors.Errorf("%s cannot be used with %s", flagFromTime.Name, flags.LogStreamID().Name)
+			}
+			fromTime, err := time.Parse(time.RFC3339, c.String(flagFromTime.Name))
+			if err != nil {
+				return errors.WithMessagef(err, "invalid %s", flagFromTime.Name)
+			}
+			return varlogcli.SubscribeFromTime(mrAddrs, clusterID, topicID, fromTime)
+		}
 		if c.IsSet(flags.LogStreamID().Name) {
 			return varlogcli.SubscribeTo(mrAddrs, clusterID, topicID, logStreamID)
 		}
@@ -118,7 +169,8 @@ func commonFlags() []cli.Flag {
 	return []cli.Flag{
 		flags.MetadataRepositoryAddress().StringSliceFlag(true, nil),
 		flags.ClusterID().StringFlag(false, types.ClusterID(1).String()),
-		flags.TopicID().StringFlag(true, ""),
+		flags.TopicID().StringFlag(false, ""),
+		flags.TopicName().StringFlag(false, ""),
 		flags.LogStreamID().StringFlag(false, ""),
 	}
 }
@@ -32,6 +32,8 @@ func newVarlogControllerApp() *cli.App {
 			newTopicCommand(),
 			newLogStreamCommand(),
 			newMetadataRepositoryCommand(),
+			newConsumerGroupCommand(),
+			newSchemaCommand(),
 		},
 	}
 	return app
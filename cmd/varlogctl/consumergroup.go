@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/consumergroup"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func newConsumerGroupCommand() *cli.Command {
+	const (
+		cmdDescribe = "get"
+		cmdReset    = "reset"
+		cmdRemove   = "remove"
+	)
+
+	action := func(c *cli.Context) error {
+		if c.NArg() > 0 {
+			return fmt.Errorf("consumer group command: unexpected args: %v", c.Args().Slice())
+		}
+
+		group := c.String(flagConsumerGroup.name)
+
+		var f varlogctl.ExecuteFunc
+		switch c.Command.Name {
+		case cmdDescribe:
+			if c.IsSet(flagConsumerGroup.name) {
+				f = consumergroup.Describe(group)
+			} else {
+				f = consumergroup.Describe()
+			}
+		case cmdReset:
+			offset, err := parseConsumerGroupOffset(c)
+			if err != nil {
+				return fmt.Errorf("consumer group command: %w", err)
+			}
+			f = consumergroup.Reset(group, offset)
+		case cmdRemove:
+			f = consumergroup.Remove(group)
+		default:
+			return fmt.Errorf("consumer group command: unknown command: %s", c.Command.Name)
+		}
+		return execute(c, f)
+	}
+
+	return &cli.Command{
+		Name:    "consumergroup",
+		Aliases: []string{"cg"},
+		Subcommands: []*cli.Command{
+			{
+				Name:    cmdDescribe,
+				Aliases: []string{"describe", "lag"},
+				Usage:   "describe consumer groups and lags of their offsets",
+				Action:  action,
+				Flags: commonFlags(
+					flagConsumerGroup.StringFlag(false, ""),
+				),
+			},
+			{
+				Name:   cmdReset,
+				Usage:  "reset the offset of a consumer group",
+				Action: action,
+				Flags: commonFlags(
+					flagConsumerGroup.StringFlag(true, ""),
+					flagTopicID.StringFlag(true, ""),
+					flagLogStreamID.StringFlag(false, ""),
+					flagGLSN.Uint64Flag(false, 0),
+					flagLLSN.Uint64Flag(false, 0),
+				),
+			},
+			{
+				Name:    cmdRemove,
+				Aliases: []string{"delete"},
+				Usage:   "remove a consumer group",
+				Action:  action,
+				Flags: commonFlags(
+					flagConsumerGroup.StringFlag(true, ""),
+				),
+			},
+		},
+	}
+}
+
+// parseConsumerGroupOffset makes a topic-level offset if the log stream ID is
+// not set; otherwise, it makes a log-stream-level offset.
+func parseConsumerGroupOffset(c *cli.Context) (offset varlogpb.ConsumerGroupOffset, err error) {
+	offset.TopicID, err = types.ParseTopicID(c.String(flagTopicID.name))
+	if err != nil {
+		return offset, err
+	}
+	if !c.IsSet(flagLogStreamID.name) {
+		if c.IsSet(flagLLSN.name) {
+			return offset, fmt.Errorf("topic-level offset should not have llsn")
+		}
+		offset.GLSN = types.GLSN(c.Uint64(flagGLSN.name))
+		return offset, nil
+	}
+	offset.LogStreamID, err = types.ParseLogStreamID(c.String(flagLogStreamID.name))
+	if err != nil {
+		return offset, err
+	}
+	if c.IsSet(flagGLSN.name) {
+		return offset, fmt.Errorf("log-stream-level offset should not have glsn")
+	}
+	offset.LLSN = types.LLSN(c.Uint64(flagLLSN.name))
+	return offset, nil
+}
@@ -38,6 +38,27 @@ var (
 		name:    "topic-id",
 		aliases: []string{"tpid"},
 	}
+	flagTopicName = flagDesc{
+		name:    "topic-name",
+		aliases: []string{"tpname"},
+		usage:   "name of the topic, which can be used instead of the topic id",
+	}
+	flagTopicReplicationFactor = flagDesc{
+		name:  "replication-factor",
+		usage: "replication factor of log streams in the topic, zero means the default of the cluster",
+	}
+	flagTopicRetention = flagDesc{
+		name:  "retention",
+		usage: "how long log entries in the topic should be kept, zero means unlimited",
+	}
+	flagTopicMaxLogStreams = flagDesc{
+		name:  "max-log-streams",
+		usage: "maximum number of log streams in the topic, zero means unlimited",
+	}
+	flagTopicDescription = flagDesc{
+		name:  "description",
+		usage: "description of the topic",
+	}
 
 	flagLogStreamID = flagDesc{
 		name:    "log-stream-id",
@@ -49,4 +70,33 @@ var (
 	flagSyncDst = flagDesc{
 		name: "dst",
 	}
+
+	flagConsumerGroup = flagDesc{
+		name:    "group",
+		aliases: []string{"consumer-group"},
+	}
+	flagGLSN = flagDesc{
+		name:  "glsn",
+		usage: "last processed GLSN of the topic",
+	}
+	flagLLSN = flagDesc{
+		name:  "llsn",
+		usage: "last processed LLSN of the log stream",
+	}
+
+	flagSchemaID = flagDesc{
+		name: "schema-id",
+	}
+	flagSchemaType = flagDesc{
+		name:  "type",
+		usage: "type of the schema: protobuf, json or avro",
+	}
+	flagSchemaDefinitionFile = flagDesc{
+		name:  "definition-file",
+		usage: "file having the definition of the schema; a serialized FileDescriptorSet for protobuf",
+	}
+	flagSchemaCompatibility = flagDesc{
+		name:  "compatibility",
+		usage: "compatibility with the latest version: backward, forward, full or none",
+	}
 )
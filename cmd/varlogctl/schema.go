@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/schema"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func newSchemaCommand() *cli.Command {
+	const (
+		cmdDescribe = "get"
+		cmdRegister = "register"
+	)
+
+	action := func(c *cli.Context) error {
+		if c.NArg() > 0 {
+			return fmt.Errorf("schema command: unexpected args: %v", c.Args().Slice())
+		}
+
+		var tpid types.TopicID
+		if c.IsSet(flagTopicID.name) {
+			var err error
+			tpid, err = types.ParseTopicID(c.String(flagTopicID.name))
+			if err != nil {
+				return fmt.Errorf("schema command: %w", err)
+			}
+		}
+
+		var f varlogctl.ExecuteFunc
+		switch c.Command.Name {
+		case cmdDescribe:
+			switch {
+			case c.IsSet(flagSchemaID.name):
+				schemaID, err := types.ParseSchemaID(c.String(flagSchemaID.name))
+				if err != nil {
+					return fmt.Errorf("schema command: %w", err)
+				}
+				f = schema.Describe(schemaID)
+			case c.IsSet(flagTopicID.name):
+				f = schema.List(tpid)
+			default:
+				return errors.New("schema command: either schema id or topic id is required")
+			}
+		case cmdRegister:
+			typ, err := parseSchemaType(c.String(flagSchemaType.name))
+			if err != nil {
+				return fmt.Errorf("schema command: %w", err)
+			}
+			compat, err := parseSchemaCompatibility(c.String(flagSchemaCompatibility.name))
+			if err != nil {
+				return fmt.Errorf("schema command: %w", err)
+			}
+			definition, err := os.ReadFile(c.String(flagSchemaDefinitionFile.name))
+			if err != nil {
+				return fmt.Errorf("schema command: %w", err)
+			}
+			f = schema.Register(tpid, typ, definition, compat)
+		default:
+			return fmt.Errorf("schema command: unknown command: %s", c.Command.Name)
+		}
+		return execute(c, f)
+	}
+
+	return &cli.Command{
+		Name: "schema",
+		Subcommands: []*cli.Command{
+			{
+				Name:    cmdDescribe,
+				Aliases: []string{"describe"},
+				Usage:   "describe a schema, or list all versions of the schema of a topic",
+				Action:  action,
+				Flags: commonFlags(
+					flagSchemaID.StringFlag(false, ""),
+					flagTopicID.StringFlag(false, ""),
+				),
+			},
+			{
+				Name:   cmdRegister,
+				Usage:  "register a new version of the schema of a topic",
+				Action: action,
+				Flags: commonFlags(
+					flagTopicID.StringFlag(true, ""),
+					flagSchemaType.StringFlag(true, ""),
+					flagSchemaDefinitionFile.StringFlag(true, ""),
+					flagSchemaCompatibility.StringFlag(false, "backward"),
+				),
+			},
+		},
+	}
+}
+
+func parseSchemaType(s string) (varlogpb.SchemaType, error) {
+	typ, ok := varlogpb.SchemaType_value["SCHEMA_TYPE_"+strings.ToUpper(s)]
+	if !ok || varlogpb.SchemaType(typ) == varlogpb.SchemaTypeUnspecified {
+		return varlogpb.SchemaTypeUnspecified, fmt.Errorf("unknown schema type %q", s)
+	}
+	return varlogpb.SchemaType(typ), nil
+}
+
+func parseSchemaCompatibility(s string) (varlogpb.SchemaCompatibility, error) {
+	compat, ok := varlogpb.SchemaCompatibility_value["SCHEMA_COMPATIBILITY_"+strings.ToUpper(s)]
+	if !ok {
+		return varlogpb.SchemaCompatibilityBackward, fmt.Errorf("unknown schema compatibility %q", s)
+	}
+	return varlogpb.SchemaCompatibility(compat), nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/urfave/cli/v2"
@@ -8,6 +9,7 @@ import (
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/internal/varlogctl/topic"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func newTopicCommand() *cli.Command {
@@ -21,6 +23,9 @@ func newTopicCommand() *cli.Command {
 		if c.NArg() > 0 {
 			return fmt.Errorf("topic command: unexpected args: %v", c.Args().Slice())
 		}
+		if c.IsSet(flagTopicID.name) && c.IsSet(flagTopicName.name) {
+			return fmt.Errorf("topic command: %s cannot be used with %s", flagTopicID.name, flagTopicName.name)
+		}
 
 		var tpid types.TopicID
 		if c.IsSet(flagTopicID.name) {
@@ -30,19 +35,43 @@ func newTopicCommand() *cli.Command {
 				return fmt.Errorf("topic command: %w", err)
 			}
 		}
+		name := c.String(flagTopicName.name)
 
 		var f varlogctl.ExecuteFunc
 		switch c.Command.Name {
 		case cmdDescribe:
-			if c.IsSet(flagTopicID.name) {
+			switch {
+			case c.IsSet(flagTopicID.name):
 				f = topic.Describe(tpid)
-			} else {
+			case c.IsSet(flagTopicName.name):
+				f = topic.DescribeByName(name)
+			default:
 				f = topic.Describe()
 			}
 		case cmdAdd:
-			f = topic.Add()
+			config := varlogpb.TopicConfig{
+				ReplicationFactor: int32(c.Int(flagTopicReplicationFactor.name)),
+				Retention:         c.Duration(flagTopicRetention.name),
+				MaxLogStreams:     int32(c.Int(flagTopicMaxLogStreams.name)),
+				Description:       c.String(flagTopicDescription.name),
+			}
+			switch {
+			case c.IsSet(flagTopicName.name):
+				f = topic.AddNamed(name, config)
+			case config.Equal(varlogpb.TopicConfig{}):
+				f = topic.Add()
+			default:
+				return fmt.Errorf("topic command: %s is required to configure the topic", flagTopicName.name)
+			}
 		case cmdRemove:
-			f = topic.Remove(tpid)
+			switch {
+			case c.IsSet(flagTopicID.name):
+				f = topic.Remove(tpid)
+			case c.IsSet(flagTopicName.name):
+				f = topic.RemoveByName(name)
+			default:
+				return errors.New("topic command: either topic id or topic name is required")
+			}
 		default:
 			return fmt.Errorf("topic command: unknown command: %s", c.Command.Name)
 		}
@@ -59,20 +88,28 @@ func newTopicCommand() *cli.Command {
 				Action:  action,
 				Flags: commonFlags(
 					flagTopicID.StringFlag(false, ""),
+					flagTopicName.StringFlag(false, ""),
 				),
 			},
 			{
 				Name:   cmdAdd,
 				Usage:  "add a new topic",
 				Action: action,
-				Flags:  commonFlags(),
+				Flags: commonFlags(
+					flagTopicName.StringFlag(false, ""),
+					flagTopicReplicationFactor.IntFlag(false, 0),
+					flagTopicRetention.DurationFlag(false, 0),
+					flagTopicMaxLogStreams.IntFlag(false, 0),
+					flagTopicDescription.StringFlag(false, ""),
+				),
 			},
 			{
 				Name:   cmdRemove,
 				Usage:  "remove a topic",
 				Action: action,
 				Flags: commonFlags(
-					flagTopicID.StringFlag(true, ""),
+					flagTopicID.StringFlag(false, ""),
+					flagTopicName.StringFlag(false, ""),
 				),
 			},
 		},
@@ -402,6 +402,35 @@ func (adm *Admin) getTopicInternal(ctx context.Context, tpid types.TopicID) (*va
 	return td, nil
 }
 
+func (adm *Admin) getTopicByName(ctx context.Context, name string) (*varlogpb.TopicDescriptor, error) {
+	if len(name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "get topic by name: empty name")
+	}
+
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	td, err, _ := adm.sfg.Do(sfgkey.GetTopicByNameKey(name), func() (interface{}, error) {
+		return adm.getTopicByNameInternal(ctx, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return td.(*varlogpb.TopicDescriptor), nil
+}
+
+func (adm *Admin) getTopicByNameInternal(ctx context.Context, name string) (*varlogpb.TopicDescriptor, error) {
+	md, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "get topic by name: %s", err.Error())
+	}
+	td := md.GetTopicByName(name)
+	if td == nil {
+		return nil, status.Errorf(codes.NotFound, "get topic by name: no such topic %q", name)
+	}
+	return td, nil
+}
+
 func (adm *Admin) listTopics(ctx context.Context) ([]varlogpb.TopicDescriptor, error) {
 	adm.mu.RLock()
 	defer adm.mu.RUnlock()
@@ -428,18 +457,37 @@ func (adm *Admin) listTopicsInternal(ctx context.Context) ([]varlogpb.TopicDescr
 	return tds, nil
 }
 
-func (adm *Admin) addTopic(ctx context.Context) (*varlogpb.TopicDescriptor, error) {
+func (adm *Admin) addTopic(ctx context.Context, name string, config varlogpb.TopicConfig) (*varlogpb.TopicDescriptor, error) {
+	if len(name) > 0 {
+		if err := varlogpb.ValidateTopicName(name); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "add topic: %s", err.Error())
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "add topic: %s", err.Error())
+	}
+	// The metadata repository commits a log stream only if it has as many
+	// replicas as the replication factor of the cluster.
+	if config.ReplicationFactor > 0 && uint(config.ReplicationFactor) < adm.replicationFactor {
+		return nil, status.Errorf(codes.InvalidArgument, "add topic: replication factor %d is less than that of the cluster %d", config.ReplicationFactor, adm.replicationFactor)
+	}
+
 	adm.mu.Lock()
 	defer adm.mu.Unlock()
 
-	topicID := adm.tpidGen.Generate()
+	td := &varlogpb.TopicDescriptor{
+		TopicID: adm.tpidGen.Generate(),
+		Name:    name,
+		Config:  config,
+	}
 	// Note that the metadata repository accepts redundant RegisterTopic
-	// RPC only if the topic has no log streams.
-	if err := adm.mrmgr.RegisterTopic(ctx, topicID); err != nil {
+	// RPC only if the topic has no log streams. It also rejects the topic
+	// whose name is used by another topic.
+	if err := adm.mrmgr.RegisterTopic(ctx, td); err != nil {
 		return nil, status.Errorf(status.Code(err), "add topic: %s", err.Error())
 	}
 
-	return &varlogpb.TopicDescriptor{TopicID: topicID}, nil
+	return td, nil
 }
 
 func (adm *Admin) unregisterTopic(ctx context.Context, tpid types.TopicID) error {
@@ -631,10 +679,30 @@ func (adm *Admin) addLogStreamInternal(ctx context.Context, tpid types.TopicID,
 	adm.mu.Lock()
 	defer adm.mu.Unlock()
 
-	var err error
+	clusmeta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "add log stream: %s", err.Error())
+	}
+
+	repfactor := adm.replicationFactor
+	if td := clusmeta.GetTopic(tpid); td != nil {
+		if maxLogStreams := int(td.Config.MaxLogStreams); maxLogStreams > 0 && len(td.LogStreams) >= maxLogStreams {
+			return nil, status.Errorf(codes.ResourceExhausted, "add log stream: too many log streams in topic %d, limits %d", tpid, maxLogStreams)
+		}
+		if td.Config.ReplicationFactor > 0 {
+			repfactor = uint(td.Config.ReplicationFactor)
+		}
+	}
 
 	if len(replicas) == 0 {
-		replicas, err = adm.snSelector.Select(ctx)
+		selector := adm.snSelector
+		if repfactor != adm.replicationFactor {
+			selector, err = NewReplicaSelector(adm.snSelector.Name(), adm.mrmgr.ClusterMetadataView(), int(repfactor))
+			if err != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "add log stream: replication factor %d of topic %d: %s", repfactor, tpid, err.Error())
+			}
+		}
+		replicas, err = selector.Select(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -649,11 +717,7 @@ func (adm *Admin) addLogStreamInternal(ctx context.Context, tpid types.TopicID,
 		Replicas:    replicas,
 	}
 
-	clusmeta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
-	if err != nil {
-		return nil, status.Errorf(status.Code(err), "add log stream: %s", err.Error())
-	}
-	if err := adm.verifyLogStream(clusmeta, lsd); err != nil {
+	if err := adm.verifyLogStream(clusmeta, lsd, repfactor); err != nil {
 		return nil, err
 	}
 
@@ -671,11 +735,11 @@ func (adm *Admin) addLogStreamInternal(ctx context.Context, tpid types.TopicID,
 	return lsd, nil
 }
 
-func (adm *Admin) verifyLogStream(clusmeta *varlogpb.MetadataDescriptor, lsdesc *varlogpb.LogStreamDescriptor) error {
+func (adm *Admin) verifyLogStream(clusmeta *varlogpb.MetadataDescriptor, lsdesc *varlogpb.LogStreamDescriptor, repfactor uint) error {
 	replicas := lsdesc.GetReplicas()
 	// the number of logstream replica
-	if uint(len(replicas)) != adm.replicationFactor {
-		return status.Errorf(codes.FailedPrecondition, "add log stream: invalid number of log stream replicas: expected %d, actual %d", adm.replicationFactor, len(replicas))
+	if uint(len(replicas)) != repfactor {
+		return status.Errorf(codes.FailedPrecondition, "add log stream: invalid number of log stream replicas: expected %d, actual %d", repfactor, len(replicas))
 	}
 	// storagenode existence
 	for _, replica := range replicas {
@@ -1126,6 +1190,157 @@ func (adm *Admin) removeMRPeer(ctx context.Context, raftURL string) error {
 	return nil
 }
 
+func (adm *Admin) listConsumerGroups(ctx context.Context) ([]admpb.ConsumerGroupMetadata, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	cgds, err := adm.mrmgr.ListConsumerGroups(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "list consumer groups: %s", err.Error())
+	}
+	hwms := adm.highWatermarks()
+	cgms := make([]admpb.ConsumerGroupMetadata, 0, len(cgds))
+	for i := range cgds {
+		cgms = append(cgms, hwms.consumerGroupMetadata(&cgds[i]))
+	}
+	return cgms, nil
+}
+
+func (adm *Admin) getConsumerGroup(ctx context.Context, group string) (*admpb.ConsumerGroupMetadata, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	cgd, err := adm.mrmgr.GetConsumerGroup(ctx, group)
+	if err != nil {
+		code := status.Code(err)
+		if code != codes.NotFound {
+			code = codes.Unavailable
+		}
+		return nil, status.Errorf(code, "get consumer group: %s", err.Error())
+	}
+	cgm := adm.highWatermarks().consumerGroupMetadata(cgd)
+	return &cgm, nil
+}
+
+// resetConsumerGroupOffset overwrites the offset of the consumer group, and
+// then returns the consumer group.
+func (adm *Admin) resetConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) (*admpb.ConsumerGroupMetadata, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	if err := adm.mrmgr.CommitConsumerGroupOffset(ctx, group, offset); err != nil {
+		return nil, status.Errorf(status.Code(err), "reset consumer group offset: %s", err.Error())
+	}
+	cgd, err := adm.mrmgr.GetConsumerGroup(ctx, group)
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "reset consumer group offset: %s", err.Error())
+	}
+	cgm := adm.highWatermarks().consumerGroupMetadata(cgd)
+	return &cgm, nil
+}
+
+func (adm *Admin) deleteConsumerGroup(ctx context.Context, group string) error {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	if err := adm.mrmgr.DeleteConsumerGroup(ctx, group); err != nil {
+		return status.Errorf(status.Code(err), "delete consumer group: %s", err.Error())
+	}
+	return nil
+}
+
+func (adm *Admin) registerSchema(ctx context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	sd, err := adm.mrmgr.RegisterSchema(ctx, tpid, typ, definition, compat)
+	if err != nil {
+		return nil, status.Errorf(status.Code(err), "register schema: %s", err.Error())
+	}
+	return sd, nil
+}
+
+func (adm *Admin) getSchema(ctx context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	sd, err := adm.mrmgr.GetSchema(ctx, schemaID)
+	if err != nil {
+		code := status.Code(err)
+		if code != codes.NotFound {
+			code = codes.Unavailable
+		}
+		return nil, status.Errorf(code, "get schema: %s", err.Error())
+	}
+	return sd, nil
+}
+
+func (adm *Admin) listSchemas(ctx context.Context, tpid types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	adm.mu.RLock()
+	defer adm.mu.RUnlock()
+
+	sds, err := adm.mrmgr.ListSchemas(ctx, tpid)
+	if err != nil {
+		code := status.Code(err)
+		if code != codes.NotFound {
+			code = codes.Unavailable
+		}
+		return nil, status.Errorf(code, "list schemas: %s", err.Error())
+	}
+	return sds, nil
+}
+
+// highWatermarks collects the high watermarks of topics and log streams from
+// the reports of storage nodes.
+func (adm *Admin) highWatermarks() highWatermarks {
+	hwms := highWatermarks{
+		topics:     make(map[types.TopicID]types.GLSN),
+		logStreams: make(map[types.LogStreamID]types.LLSN),
+	}
+	for _, snm := range adm.statRepository.ListStorageNodes() {
+		for _, lsrmd := range snm.LogStreamReplicas {
+			hwm := lsrmd.LocalHighWatermark
+			if hwm.GLSN > hwms.topics[lsrmd.TopicID] {
+				hwms.topics[lsrmd.TopicID] = hwm.GLSN
+			}
+			if hwm.LLSN > hwms.logStreams[lsrmd.LogStreamID] {
+				hwms.logStreams[lsrmd.LogStreamID] = hwm.LLSN
+			}
+		}
+	}
+	return hwms
+}
+
+type highWatermarks struct {
+	topics     map[types.TopicID]types.GLSN
+	logStreams map[types.LogStreamID]types.LLSN
+}
+
+func (hwms highWatermarks) consumerGroupMetadata(cgd *varlogpb.ConsumerGroupDescriptor) admpb.ConsumerGroupMetadata {
+	cgm := admpb.ConsumerGroupMetadata{
+		Name:    cgd.Name,
+		Offsets: make([]admpb.ConsumerGroupOffsetLag, 0, len(cgd.Offsets)),
+	}
+	for _, offset := range cgd.Offsets {
+		var hwm, committed uint64
+		if offset.TopicLevel() {
+			hwm, committed = uint64(hwms.topics[offset.TopicID]), uint64(offset.GLSN)
+		} else {
+			hwm, committed = uint64(hwms.logStreams[offset.LogStreamID]), uint64(offset.LLSN)
+		}
+		lag := uint64(0)
+		if hwm > committed {
+			lag = hwm - committed
+		}
+		cgm.Offsets = append(cgm.Offsets, admpb.ConsumerGroupOffsetLag{
+			Offset:        offset,
+			HighWatermark: hwm,
+			Lag:           lag,
+		})
+	}
+	return cgm
+}
+
 func (adm *Admin) HandleHeartbeatTimeout(ctx context.Context, snid types.StorageNodeID) {
 	meta, err := adm.mrmgr.ClusterMetadataView().ClusterMetadata(ctx)
 	if err != nil {
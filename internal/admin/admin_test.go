@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/internal/admin"
 	"github.com/kakao/varlog/internal/admin/mrmanager"
@@ -634,6 +636,111 @@ func TestAdmin_GetTopic(t *testing.T) {
 	}
 }
 
+func TestAdmin_GetTopicByName(t *testing.T) {
+	const name = "orders-events"
+
+	tcs := []struct {
+		name    string
+		topic   string
+		success bool
+		prepare func(mock *testMock)
+	}{
+		{
+			name:    "EmptyName",
+			topic:   "",
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "ClusterMetadataFetchError",
+			topic:   name,
+			success: false,
+			prepare: func(mock *testMock) {
+				// To create a new admin, ClusterMetadata should be called 3 times.
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{}, nil,
+				).Times(3)
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					nil, errors.New("error"),
+				)
+			},
+		},
+		{
+			name:    "NoSuchTopicName",
+			topic:   name,
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						Topics: []*varlogpb.TopicDescriptor{
+							{
+								TopicID: 1,
+								Status:  varlogpb.TopicStatusRunning,
+								Name:    name + "-dlq",
+							},
+						},
+					}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "Success",
+			topic:   name,
+			success: true,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						Topics: []*varlogpb.TopicDescriptor{
+							{
+								TopicID: 1,
+								Status:  varlogpb.TopicStatusRunning,
+								Name:    name,
+							},
+						},
+					}, nil,
+				).AnyTimes()
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := newTestMock(ctrl)
+			tc.prepare(mock)
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+					snwatcher.WithStatisticsRepository(mock.MockRepository),
+				),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			td, err := client.GetTopicByName(context.Background(), tc.topic)
+			if tc.success {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.topic, td.Name)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestAdmin_ListTopics(t *testing.T) {
 	const tpid = types.TopicID(1)
 
@@ -711,6 +818,8 @@ func TestAdmin_AddTopic(t *testing.T) {
 
 	tcs := []struct {
 		name    string
+		topic   string
+		config  varlogpb.TopicConfig
 		success bool
 		prepare func(mock *testMock)
 	}{
@@ -718,14 +827,50 @@ func TestAdmin_AddTopic(t *testing.T) {
 			name:    "RejectedByMetadataRepository",
 			success: false,
 			prepare: func(mock *testMock) {
-				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), tpid).Return(errors.New("error"))
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{TopicID: tpid}).Return(errors.New("error"))
 			},
 		},
 		{
 			name:    "Success",
 			success: true,
 			prepare: func(mock *testMock) {
-				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), tpid).Return(nil)
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{TopicID: tpid}).Return(nil)
+			},
+		},
+		{
+			name:    "InvalidName",
+			topic:   "orders events",
+			success: false,
+			prepare: func(*testMock) {},
+		},
+		{
+			name:    "InvalidConfig",
+			topic:   "orders-events",
+			config:  varlogpb.TopicConfig{MaxLogStreams: -1},
+			success: false,
+			prepare: func(*testMock) {},
+		},
+		{
+			name:    "DuplicatedName",
+			topic:   "orders-events",
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), gomock.Any()).Return(
+					grpcstatus.Error(codes.AlreadyExists, "topic name"),
+				)
+			},
+		},
+		{
+			name:    "NamedTopic",
+			topic:   "orders-events",
+			config:  varlogpb.TopicConfig{ReplicationFactor: 3, Retention: time.Hour, MaxLogStreams: 4, Description: "orders"},
+			success: true,
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().RegisterTopic(gomock.Any(), &varlogpb.TopicDescriptor{
+					TopicID: tpid,
+					Name:    "orders-events",
+					Config:  varlogpb.TopicConfig{ReplicationFactor: 3, Retention: time.Hour, MaxLogStreams: 4, Description: "orders"},
+				}).Return(nil)
 			},
 		},
 	}
@@ -750,7 +895,12 @@ func TestAdmin_AddTopic(t *testing.T) {
 			defer closer()
 
 			tc.prepare(mock)
-			_, err := client.AddTopic(context.Background())
+			var err error
+			if len(tc.topic) > 0 {
+				_, err = client.AddNamedTopic(context.Background(), tc.topic, tc.config)
+			} else {
+				_, err = client.AddTopic(context.Background())
+			}
 			if tc.success {
 				assert.NoError(t, err)
 			} else {
@@ -1243,6 +1393,43 @@ func TestAdmin_AddLogStream(t *testing.T) {
 				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(&varlogpb.MetadataDescriptor{}, nil).AnyTimes()
 			},
 		},
+		{
+			name:    "TooManyLogStreamsInTopic",
+			success: false,
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						Topics: []*varlogpb.TopicDescriptor{
+							{
+								TopicID:    tpid,
+								LogStreams: []types.LogStreamID{lsid + 1},
+								Config:     varlogpb.TopicConfig{MaxLogStreams: 1},
+							},
+						},
+					}, nil,
+				).AnyTimes()
+			},
+		},
+		{
+			name:    "WrongReplicationFactorOfTopic",
+			success: false,
+			replicas: []*varlogpb.ReplicaDescriptor{
+				{StorageNodeID: snid1, StorageNodePath: snpath1},
+				{StorageNodeID: snid2, StorageNodePath: snpath2},
+			},
+			prepare: func(mock *testMock) {
+				mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+					&varlogpb.MetadataDescriptor{
+						Topics: []*varlogpb.TopicDescriptor{
+							{
+								TopicID: tpid,
+								Config:  varlogpb.TopicConfig{ReplicationFactor: replicationFactor + 1},
+							},
+						},
+					}, nil,
+				).AnyTimes()
+			},
+		},
 		{
 			name:    "NoSuchStorageNode",
 			success: false,
@@ -2375,6 +2562,192 @@ func TestAdmin_Trim(t *testing.T) {
 	}
 }
 
+func TestAdmin_ConsumerGroup(t *testing.T) {
+	const (
+		snid  = types.StorageNodeID(1)
+		tpid  = types.TopicID(1)
+		lsid  = types.LogStreamID(1)
+		group = "cg"
+	)
+
+	cgd := &varlogpb.ConsumerGroupDescriptor{
+		Name: group,
+		Offsets: []varlogpb.ConsumerGroupOffset{
+			{TopicID: tpid, GLSN: 4},
+			{TopicID: tpid, LogStreamID: lsid, LLSN: 12},
+		},
+	}
+	reports := map[types.StorageNodeID]*admpb.StorageNodeMetadata{
+		snid: {
+			StorageNodeMetadataDescriptor: snpb.StorageNodeMetadataDescriptor{
+				StorageNode: varlogpb.StorageNode{StorageNodeID: snid},
+				LogStreamReplicas: []snpb.LogStreamReplicaMetadataDescriptor{
+					{
+						LogStreamReplica: varlogpb.LogStreamReplica{
+							TopicLogStream: varlogpb.TopicLogStream{
+								TopicID:     tpid,
+								LogStreamID: lsid,
+							},
+						},
+						LocalHighWatermark: varlogpb.LogSequenceNumber{
+							LLSN: 10,
+							GLSN: 10,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tcs := []struct {
+		name    string
+		prepare func(mock *testMock)
+		testf   func(t *testing.T, client varlog.Admin)
+	}{
+		{
+			name: "ListConsumerGroups",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().ListConsumerGroups(gomock.Any()).Return(
+					[]varlogpb.ConsumerGroupDescriptor{*cgd}, nil,
+				)
+				mock.MockRepository.EXPECT().ListStorageNodes().Return(reports)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				cgms, err := client.ListConsumerGroups(context.Background())
+				require.NoError(t, err)
+				require.Len(t, cgms, 1)
+				require.Equal(t, []admpb.ConsumerGroupOffsetLag{
+					{Offset: cgd.Offsets[0], HighWatermark: 10, Lag: 6},
+					{Offset: cgd.Offsets[1], HighWatermark: 10, Lag: 0},
+				}, cgms[0].Offsets)
+			},
+		},
+		{
+			name: "ListConsumerGroupsEmpty",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().ListConsumerGroups(gomock.Any()).Return(nil, nil)
+				mock.MockRepository.EXPECT().ListStorageNodes().Return(reports)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				cgms, err := client.ListConsumerGroups(context.Background())
+				require.NoError(t, err)
+				require.NotNil(t, cgms)
+				require.Empty(t, cgms)
+			},
+		},
+		{
+			name: "GetConsumerGroupNotFound",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().GetConsumerGroup(gomock.Any(), group).Return(
+					nil, grpcstatus.Error(codes.NotFound, "no such consumer group"),
+				)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				_, err := client.GetConsumerGroup(context.Background(), group)
+				require.ErrorIs(t, err, verrors.ErrNotExist)
+			},
+		},
+		{
+			name: "GetConsumerGroup",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().GetConsumerGroup(gomock.Any(), group).Return(cgd, nil)
+				mock.MockRepository.EXPECT().ListStorageNodes().Return(reports)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				cgm, err := client.GetConsumerGroup(context.Background(), group)
+				require.NoError(t, err)
+				require.Equal(t, group, cgm.Name)
+				require.EqualValues(t, 6, cgm.Offsets[0].Lag)
+			},
+		},
+		{
+			name: "ResetConsumerGroupOffsetInvalidArgument",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().CommitConsumerGroupOffset(gomock.Any(), group, gomock.Any()).Return(
+					grpcstatus.Error(codes.InvalidArgument, "invalid offset"),
+				)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				_, err := client.ResetConsumerGroupOffset(context.Background(), group, varlogpb.ConsumerGroupOffset{})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, grpcstatus.Code(errors.Unwrap(err)))
+			},
+		},
+		{
+			name: "ResetConsumerGroupOffset",
+			prepare: func(mock *testMock) {
+				offset := varlogpb.ConsumerGroupOffset{TopicID: tpid}
+				mock.MockMetadataRepositoryManager.EXPECT().CommitConsumerGroupOffset(gomock.Any(), group, offset).Return(nil)
+				mock.MockMetadataRepositoryManager.EXPECT().GetConsumerGroup(gomock.Any(), group).Return(
+					&varlogpb.ConsumerGroupDescriptor{
+						Name:    group,
+						Offsets: []varlogpb.ConsumerGroupOffset{offset},
+					}, nil,
+				)
+				mock.MockRepository.EXPECT().ListStorageNodes().Return(reports)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				cgm, err := client.ResetConsumerGroupOffset(context.Background(), group, varlogpb.ConsumerGroupOffset{TopicID: tpid})
+				require.NoError(t, err)
+				require.EqualValues(t, 10, cgm.Offsets[0].Lag)
+			},
+		},
+		{
+			name: "DeleteConsumerGroupNotFound",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().DeleteConsumerGroup(gomock.Any(), group).Return(
+					grpcstatus.Error(codes.NotFound, "no such consumer group"),
+				)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				err := client.DeleteConsumerGroup(context.Background(), group)
+				require.ErrorIs(t, err, verrors.ErrNotExist)
+			},
+		},
+		{
+			name: "DeleteConsumerGroup",
+			prepare: func(mock *testMock) {
+				mock.MockMetadataRepositoryManager.EXPECT().DeleteConsumerGroup(gomock.Any(), group).Return(nil)
+			},
+			testf: func(t *testing.T, client varlog.Admin) {
+				err := client.DeleteConsumerGroup(context.Background(), group)
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mock := newTestMock(ctrl)
+			mock.MockClusterMetadataView.EXPECT().ClusterMetadata(gomock.Any()).Return(
+				&varlogpb.MetadataDescriptor{}, nil,
+			).AnyTimes()
+			tc.prepare(mock)
+
+			tadm := admin.TestNewClusterManager(t,
+				admin.WithListenAddress("127.0.0.1:0"),
+				admin.WithMetadataRepositoryManager(mock.MockMetadataRepositoryManager),
+				admin.WithStorageNodeManager(mock.MockStorageNodeManager),
+				admin.WithStorageNodeWatcherOptions(
+					snwatcher.WithTick(time.Hour), // no heartbeat checking
+					snwatcher.WithStatisticsRepository(mock.MockRepository),
+				),
+				admin.WithStatisticsRepository(mock.MockRepository),
+			)
+			tadm.Serve(t)
+			defer tadm.Close(t)
+
+			client, closer := newTestClient(t, tadm.Address())
+			defer closer()
+
+			tc.testf(t, client)
+		})
+	}
+}
+
 func TestAdmin_GetMetadataRepositoryNode(t *testing.T) {
 	nid := types.NewNodeID("127.0.0.1:10000")
 
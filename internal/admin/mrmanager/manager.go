@@ -12,6 +12,8 @@ import (
 
 	"github.com/pkg/errors"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/kakao/varlog/pkg/mrc"
 	"github.com/kakao/varlog/pkg/mrc/mrconnector"
@@ -51,7 +53,7 @@ type MetadataRepositoryManager interface {
 
 	UnregisterStorageNode(ctx context.Context, storageNodeID types.StorageNodeID) error
 
-	RegisterTopic(ctx context.Context, topicID types.TopicID) error
+	RegisterTopic(ctx context.Context, topic *varlogpb.TopicDescriptor) error
 
 	UnregisterTopic(ctx context.Context, topicID types.TopicID) error
 
@@ -75,6 +77,29 @@ type MetadataRepositoryManager interface {
 	RemovePeer(ctx context.Context, nodeID types.NodeID) error
 
 	NumberOfMR() int
+
+	// CommitConsumerGroupOffset stores the offset of the consumer group in
+	// the metadata repository. It overwrites the existing offset even if the
+	// new one is behind it, thus it is also used to reset the offset.
+	CommitConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error
+
+	// GetConsumerGroup returns the consumer group whose name is group.
+	GetConsumerGroup(ctx context.Context, group string) (*varlogpb.ConsumerGroupDescriptor, error)
+
+	// ListConsumerGroups returns all consumer groups sorted by their names.
+	ListConsumerGroups(ctx context.Context) ([]varlogpb.ConsumerGroupDescriptor, error)
+
+	DeleteConsumerGroup(ctx context.Context, group string) error
+
+	// RegisterSchema registers a new version of the schema of the topic in
+	// the metadata repository.
+	RegisterSchema(ctx context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error)
+
+	GetSchema(ctx context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error)
+
+	// ListSchemas returns all versions of the schema of the topic sorted by
+	// their versions.
+	ListSchemas(ctx context.Context, tpid types.TopicID) ([]varlogpb.SchemaDescriptor, error)
 }
 
 var (
@@ -216,7 +241,7 @@ func (mrm *mrManager) UnregisterStorageNode(ctx context.Context, storageNodeID t
 	return err
 }
 
-func (mrm *mrManager) RegisterTopic(ctx context.Context, topicID types.TopicID) (err error) {
+func (mrm *mrManager) RegisterTopic(ctx context.Context, topic *varlogpb.TopicDescriptor) (err error) {
 	mrm.mu.Lock()
 	defer func() {
 		if err == nil {
@@ -230,7 +255,7 @@ func (mrm *mrManager) RegisterTopic(ctx context.Context, topicID types.TopicID)
 		return errors.WithMessage(err, "mrmanager: not accessible")
 	}
 
-	err = cli.RegisterTopic(ctx, topicID)
+	err = cli.RegisterTopic(ctx, topic)
 	if err != nil {
 		_ = cli.Close()
 		return err
@@ -428,6 +453,134 @@ func (mrm *mrManager) RemovePeer(ctx context.Context, nodeID types.NodeID) error
 	return nil
 }
 
+func (mrm *mrManager) CommitConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	if err := cli.CommitConsumerGroupOffset(ctx, group, offset); err != nil {
+		closeIfUnexpected(cli, err)
+		return err
+	}
+	return nil
+}
+
+func (mrm *mrManager) GetConsumerGroup(ctx context.Context, group string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	cgd, err := cli.GetConsumerGroup(ctx, group)
+	if err != nil {
+		closeIfUnexpected(cli, err)
+		return nil, err
+	}
+	return cgd, nil
+}
+
+func (mrm *mrManager) ListConsumerGroups(ctx context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	cgds, err := cli.ListConsumerGroups(ctx)
+	if err != nil {
+		_ = cli.Close()
+		return nil, err
+	}
+	return cgds, nil
+}
+
+func (mrm *mrManager) DeleteConsumerGroup(ctx context.Context, group string) error {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	if err := cli.DeleteConsumerGroup(ctx, group); err != nil {
+		closeIfUnexpected(cli, err)
+		return err
+	}
+	return nil
+}
+
+func (mrm *mrManager) RegisterSchema(ctx context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	sd, err := cli.RegisterSchema(ctx, tpid, typ, definition, compat)
+	if err != nil {
+		closeIfUnexpected(cli, err)
+		return nil, err
+	}
+	return sd, nil
+}
+
+func (mrm *mrManager) GetSchema(ctx context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	sd, err := cli.GetSchema(ctx, schemaID)
+	if err != nil {
+		closeIfUnexpected(cli, err)
+		return nil, err
+	}
+	return sd, nil
+}
+
+func (mrm *mrManager) ListSchemas(ctx context.Context, tpid types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	mrm.mu.RLock()
+	defer mrm.mu.RUnlock()
+
+	cli, err := mrm.c()
+	if err != nil {
+		return nil, errors.WithMessage(err, "mrmanager: not accessible")
+	}
+
+	sds, err := cli.ListSchemas(ctx, tpid)
+	if err != nil {
+		closeIfUnexpected(cli, err)
+		return nil, err
+	}
+	return sds, nil
+}
+
+// closeIfUnexpected closes the client unless the error is returned by the
+// metadata repository for an invalid request or a missing entity, which does
+// not indicate that the connection is broken.
+func closeIfUnexpected(cli mrc.MetadataRepositoryClient, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition:
+		return
+	}
+	_ = cli.Close()
+}
+
 func (mrm *mrManager) ClusterMetadata(ctx context.Context) (*varlogpb.MetadataDescriptor, error) {
 	// fail-fast
 	if err := ctx.Err(); err != nil {
@@ -9,7 +9,6 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-
 	types "github.com/kakao/varlog/pkg/types"
 	mrpb "github.com/kakao/varlog/proto/mrpb"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
@@ -133,6 +132,34 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) ClusterMetadataView() *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterMetadataView", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).ClusterMetadataView))
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockMetadataRepositoryManager) CommitConsumerGroupOffset(arg0 context.Context, arg1 string, arg2 varlogpb.ConsumerGroupOffset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockMetadataRepositoryManagerMockRecorder) CommitConsumerGroupOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).CommitConsumerGroupOffset), arg0, arg1, arg2)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryManager) DeleteConsumerGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryManagerMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
 // GetClusterInfo mocks base method.
 func (m *MockMetadataRepositoryManager) GetClusterInfo(arg0 context.Context) (*mrpb.ClusterInfo, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +175,66 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) GetClusterInfo(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterInfo", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).GetClusterInfo), arg0)
 }
 
+// GetConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryManager) GetConsumerGroup(arg0 context.Context, arg1 string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*varlogpb.ConsumerGroupDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockMetadataRepositoryManagerMockRecorder) GetConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).GetConsumerGroup), arg0, arg1)
+}
+
+// GetSchema mocks base method.
+func (m *MockMetadataRepositoryManager) GetSchema(arg0 context.Context, arg1 types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockMetadataRepositoryManagerMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).GetSchema), arg0, arg1)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockMetadataRepositoryManager) ListConsumerGroups(arg0 context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumerGroups", arg0)
+	ret0, _ := ret[0].([]varlogpb.ConsumerGroupDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockMetadataRepositoryManagerMockRecorder) ListConsumerGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).ListConsumerGroups), arg0)
+}
+
+// ListSchemas mocks base method.
+func (m *MockMetadataRepositoryManager) ListSchemas(arg0 context.Context, arg1 types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchemas", arg0, arg1)
+	ret0, _ := ret[0].([]varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockMetadataRepositoryManagerMockRecorder) ListSchemas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).ListSchemas), arg0, arg1)
+}
+
 // NumberOfMR mocks base method.
 func (m *MockMetadataRepositoryManager) NumberOfMR() int {
 	m.ctrl.T.Helper()
@@ -176,6 +263,21 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) RegisterLogStream(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLogStream", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).RegisterLogStream), arg0, arg1)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockMetadataRepositoryManager) RegisterSchema(arg0 context.Context, arg1 types.TopicID, arg2 varlogpb.SchemaType, arg3 []byte, arg4 varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSchema", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockMetadataRepositoryManagerMockRecorder) RegisterSchema(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockMetadataRepositoryManager)(nil).RegisterSchema), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RegisterStorageNode mocks base method.
 func (m *MockMetadataRepositoryManager) RegisterStorageNode(arg0 context.Context, arg1 *varlogpb.StorageNodeDescriptor) error {
 	m.ctrl.T.Helper()
@@ -191,7 +293,7 @@ func (mr *MockMetadataRepositoryManagerMockRecorder) RegisterStorageNode(arg0, a
 }
 
 // RegisterTopic mocks base method.
-func (m *MockMetadataRepositoryManager) RegisterTopic(arg0 context.Context, arg1 types.TopicID) error {
+func (m *MockMetadataRepositoryManager) RegisterTopic(arg0 context.Context, arg1 *varlogpb.TopicDescriptor) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterTopic", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -58,6 +58,14 @@ func (s *server) GetTopic(ctx context.Context, req *admpb.GetTopicRequest) (*adm
 	return &admpb.GetTopicResponse{Topic: td}, nil
 }
 
+func (s *server) GetTopicByName(ctx context.Context, req *admpb.GetTopicByNameRequest) (*admpb.GetTopicByNameResponse, error) {
+	td, err := s.admin.getTopicByName(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.GetTopicByNameResponse{Topic: td}, nil
+}
+
 func (s *server) ListTopics(ctx context.Context, req *admpb.ListTopicsRequest) (*admpb.ListTopicsResponse, error) {
 	tds, err := s.admin.listTopics(ctx)
 	if err != nil {
@@ -67,7 +75,7 @@ func (s *server) ListTopics(ctx context.Context, req *admpb.ListTopicsRequest) (
 }
 
 func (s *server) AddTopic(ctx context.Context, req *admpb.AddTopicRequest) (*admpb.AddTopicResponse, error) {
-	td, err := s.admin.addTopic(ctx)
+	td, err := s.admin.addTopic(ctx, req.Name, req.Config)
 	if err != nil {
 		return nil, err
 	}
@@ -199,3 +207,58 @@ func (s *server) Trim(ctx context.Context, req *admpb.TrimRequest) (*admpb.TrimR
 	res, err := s.admin.trim(ctx, req.TopicID, req.LastGLSN)
 	return &admpb.TrimResponse{Results: res}, verrors.ToStatusError(err)
 }
+
+func (s *server) ListConsumerGroups(ctx context.Context, _ *admpb.ListConsumerGroupsRequest) (*admpb.ListConsumerGroupsResponse, error) {
+	cgms, err := s.admin.listConsumerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.ListConsumerGroupsResponse{ConsumerGroups: cgms}, nil
+}
+
+func (s *server) GetConsumerGroup(ctx context.Context, req *admpb.GetConsumerGroupRequest) (*admpb.GetConsumerGroupResponse, error) {
+	cgm, err := s.admin.getConsumerGroup(ctx, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.GetConsumerGroupResponse{ConsumerGroup: cgm}, nil
+}
+
+func (s *server) ResetConsumerGroupOffset(ctx context.Context, req *admpb.ResetConsumerGroupOffsetRequest) (*admpb.ResetConsumerGroupOffsetResponse, error) {
+	cgm, err := s.admin.resetConsumerGroupOffset(ctx, req.Group, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.ResetConsumerGroupOffsetResponse{ConsumerGroup: cgm}, nil
+}
+
+func (s *server) DeleteConsumerGroup(ctx context.Context, req *admpb.DeleteConsumerGroupRequest) (*admpb.DeleteConsumerGroupResponse, error) {
+	if err := s.admin.deleteConsumerGroup(ctx, req.Group); err != nil {
+		return nil, err
+	}
+	return &admpb.DeleteConsumerGroupResponse{}, nil
+}
+
+func (s *server) RegisterSchema(ctx context.Context, req *admpb.RegisterSchemaRequest) (*admpb.RegisterSchemaResponse, error) {
+	sd, err := s.admin.registerSchema(ctx, req.TopicID, req.Type, req.Definition, req.Compatibility)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.RegisterSchemaResponse{Schema: sd}, nil
+}
+
+func (s *server) GetSchema(ctx context.Context, req *admpb.GetSchemaRequest) (*admpb.GetSchemaResponse, error) {
+	sd, err := s.admin.getSchema(ctx, req.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.GetSchemaResponse{Schema: sd}, nil
+}
+
+func (s *server) ListSchemas(ctx context.Context, req *admpb.ListSchemasRequest) (*admpb.ListSchemasResponse, error) {
+	sds, err := s.admin.listSchemas(ctx, req.TopicID)
+	if err != nil {
+		return nil, err
+	}
+	return &admpb.ListSchemasResponse{Schemas: sds}, nil
+}
@@ -9,6 +9,7 @@ const (
 	keyGetStorageNode   = "getsn"
 	keyListStorageNodes = "listsns"
 	keyGetTopic         = "gettp"
+	keyGetTopicByName   = "gettpbyname"
 	keyListTopics       = "listtps"
 	keyGetLogStream     = "getls"
 	keyListLogStreams   = "listlss"
@@ -27,6 +28,10 @@ func GetTopicKey(tpid types.TopicID) string {
 	return keyGetTopic + delimiter + tpid.String()
 }
 
+func GetTopicByNameKey(name string) string {
+	return keyGetTopicByName + delimiter + name
+}
+
 func ListTopicsKey() string {
 	return keyListTopics
 }
@@ -0,0 +1,138 @@
+// Package compress compresses and decompresses payloads of log entries with
+// the codecs defined by varlogpb.CompressionCodec.
+package compress
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// zstdCodec returns the zstd encoder and decoder shared by all callers. Both
+// of them are safe for concurrent use of EncodeAll and DecodeAll.
+func zstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdEncoder, zstdDecoder, zstdErr
+}
+
+// Compress returns the payload compressed by the codec. It returns the
+// payload itself if the codec is CompressionCodecNone.
+func Compress(codec varlogpb.CompressionCodec, src []byte) ([]byte, error) {
+	switch codec {
+	case varlogpb.CompressionCodecNone:
+		return src, nil
+	case varlogpb.CompressionCodecZstd:
+		enc, _, err := zstdCodec()
+		if err != nil {
+			return nil, fmt.Errorf("compress: zstd: %w", err)
+		}
+		return enc.EncodeAll(src, nil), nil
+	case varlogpb.CompressionCodecSnappy:
+		return snappy.Encode(nil, src), nil
+	case varlogpb.CompressionCodecLZ4:
+		return lz4Encode(src), nil
+	default:
+		return nil, fmt.Errorf("compress: unknown codec %d", codec)
+	}
+}
+
+// Decompress returns the payload decompressed by the codec. It returns the
+// payload itself if the codec is CompressionCodecNone.
+func Decompress(codec varlogpb.CompressionCodec, src []byte) ([]byte, error) {
+	switch codec {
+	case varlogpb.CompressionCodecNone:
+		return src, nil
+	case varlogpb.CompressionCodecZstd:
+		_, dec, err := zstdCodec()
+		if err != nil {
+			return nil, fmt.Errorf("compress: zstd: %w", err)
+		}
+		dst, err := dec.DecodeAll(src, nil)
+		if err != nil {
+			return nil, fmt.Errorf("compress: zstd: %w", err)
+		}
+		return dst, nil
+	case varlogpb.CompressionCodecSnappy:
+		dst, err := snappy.Decode(nil, src)
+		if err != nil {
+			return nil, fmt.Errorf("compress: snappy: %w", err)
+		}
+		return dst, nil
+	case varlogpb.CompressionCodecLZ4:
+		dst, err := lz4Decode(src)
+		if err != nil {
+			return nil, fmt.Errorf("compress: lz4: %w", err)
+		}
+		return dst, nil
+	default:
+		return nil, fmt.Errorf("compress: unknown codec %d", codec)
+	}
+}
+
+// CompressBatch compresses each payload of the batch by the codec and records
+// the codec in the attributes of each log entry. The argument attrs can be
+// empty; otherwise, it must have an attribute for each payload. The returned
+// attributes have an attribute for each payload, and the caller's attributes
+// are not modified.
+//
+// A payload is left uncompressed, and its codec is CompressionCodecNone, if
+// compression does not make it smaller. Since the codec is recorded for each
+// log entry, a batch can mix compressed and uncompressed payloads.
+func CompressBatch(codec varlogpb.CompressionCodec, data [][]byte, attrs []varlogpb.LogEntryAttributes) ([][]byte, []varlogpb.LogEntryAttributes, error) {
+	if codec == varlogpb.CompressionCodecNone {
+		return data, attrs, nil
+	}
+	if len(attrs) > 0 && len(attrs) != len(data) {
+		return nil, nil, fmt.Errorf("compress: unmatched attributes: %d payloads, %d attributes", len(data), len(attrs))
+	}
+
+	compressedData := make([][]byte, len(data))
+	compressedAttrs := make([]varlogpb.LogEntryAttributes, len(data))
+	copy(compressedAttrs, attrs)
+	for i := range data {
+		compressed, err := Compress(codec, data[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(compressed) >= len(data[i]) {
+			compressedData[i] = data[i]
+			compressedAttrs[i].CompressionCodec = varlogpb.CompressionCodecNone
+			continue
+		}
+		compressedData[i] = compressed
+		compressedAttrs[i].CompressionCodec = codec
+	}
+	return compressedData, compressedAttrs, nil
+}
+
+// DecompressLogEntry decompresses the payload of the log entry in place and
+// resets its codec to CompressionCodecNone.
+func DecompressLogEntry(logEntry *varlogpb.LogEntry) error {
+	if logEntry.CompressionCodec == varlogpb.CompressionCodecNone {
+		return nil
+	}
+	data, err := Decompress(logEntry.CompressionCodec, logEntry.Data)
+	if err != nil {
+		return err
+	}
+	logEntry.Data = data
+	logEntry.CompressionCodec = varlogpb.CompressionCodecNone
+	return nil
+}
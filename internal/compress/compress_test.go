@@ -0,0 +1,163 @@
+package compress
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+var codecs = []varlogpb.CompressionCodec{
+	varlogpb.CompressionCodecNone,
+	varlogpb.CompressionCodecZstd,
+	varlogpb.CompressionCodecSnappy,
+	varlogpb.CompressionCodecLZ4,
+}
+
+func testPayloads() map[string][]byte {
+	rng := rand.New(rand.NewSource(1))
+	random := make([]byte, 1<<16)
+	_, _ = rng.Read(random)
+
+	var json bytes.Buffer
+	for i := 0; json.Len() < 1<<17; i++ {
+		json.WriteString(`{"id":`)
+		json.WriteByte(byte('0' + i%10))
+		json.WriteString(`,"name":"varlog","tags":["log","stream"],"value":`)
+		json.WriteByte(byte('a' + rng.Intn(26)))
+		json.WriteString("}\n")
+	}
+
+	return map[string][]byte{
+		"Empty":    {},
+		"Short":    []byte("foo"),
+		"Repeated": bytes.Repeat([]byte{'a'}, 1<<12),
+		"JSON":     json.Bytes(),
+		"Random":   random,
+	}
+}
+
+func TestCompress(t *testing.T) {
+	for _, codec := range codecs {
+		codec := codec
+		for name, payload := range testPayloads() {
+			payload := payload
+			t.Run(codec.String()+"/"+name, func(t *testing.T) {
+				compressed, err := Compress(codec, payload)
+				require.NoError(t, err)
+				if codec != varlogpb.CompressionCodecNone && len(payload) > 1<<10 && name != "Random" {
+					require.Less(t, len(compressed), len(payload)/2)
+				}
+
+				decompressed, err := Decompress(codec, compressed)
+				require.NoError(t, err)
+				require.Equal(t, len(payload), len(decompressed))
+				require.True(t, bytes.Equal(payload, decompressed))
+			})
+		}
+	}
+}
+
+func TestCompress_UnknownCodec(t *testing.T) {
+	const codec = varlogpb.CompressionCodec(-1)
+	require.False(t, codec.Valid())
+
+	_, err := Compress(codec, []byte("foo"))
+	require.Error(t, err)
+
+	_, err = Decompress(codec, []byte("foo"))
+	require.Error(t, err)
+}
+
+func TestDecompress_Corrupted(t *testing.T) {
+	payload := testPayloads()["JSON"]
+	for _, codec := range codecs[1:] {
+		codec := codec
+		t.Run(codec.String(), func(t *testing.T) {
+			compressed, err := Compress(codec, payload)
+			require.NoError(t, err)
+
+			_, err = Decompress(codec, compressed[:len(compressed)/2])
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLZ4Decode_Corrupted(t *testing.T) {
+	tcs := []struct {
+		name string
+		src  []byte
+	}{
+		{name: "NoLength", src: nil},
+		{name: "TooLong", src: []byte{0xff, 0xff, 0x03, 0x00}},
+		{name: "ShortLiterals", src: []byte{0x04, 0x40, 'a', 'b'}},
+		{name: "ZeroOffset", src: []byte{0x08, 0x10, 'a', 0x00, 0x00}},
+		{name: "FarOffset", src: []byte{0x08, 0x10, 'a', 0x02, 0x00}},
+		{name: "LongMatch", src: []byte{0x04, 0x10, 'a', 0x01, 0x00}},
+		{name: "ShortBlock", src: []byte{0x05, 0x10, 'a'}},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := lz4Decode(tc.src)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCompressBatch(t *testing.T) {
+	compressible := bytes.Repeat([]byte("varlog"), 100)
+	incompressible := []byte("foo")
+	data := [][]byte{compressible, incompressible}
+
+	t.Run("None", func(t *testing.T) {
+		compressedData, compressedAttrs, err := CompressBatch(varlogpb.CompressionCodecNone, data, nil)
+		require.NoError(t, err)
+		require.Equal(t, data, compressedData)
+		require.Nil(t, compressedAttrs)
+	})
+
+	t.Run("UnmatchedAttributes", func(t *testing.T) {
+		_, _, err := CompressBatch(varlogpb.CompressionCodecZstd, data, make([]varlogpb.LogEntryAttributes, 1))
+		require.Error(t, err)
+	})
+
+	for _, codec := range codecs[1:] {
+		codec := codec
+		t.Run(codec.String(), func(t *testing.T) {
+			attrs := []varlogpb.LogEntryAttributes{
+				{Key: []byte("key")},
+				{},
+			}
+			compressedData, compressedAttrs, err := CompressBatch(codec, data, attrs)
+			require.NoError(t, err)
+			require.Len(t, compressedData, len(data))
+			require.Len(t, compressedAttrs, len(data))
+
+			// The attributes of the caller are not modified.
+			assert.Equal(t, varlogpb.CompressionCodecNone, attrs[0].CompressionCodec)
+
+			assert.Equal(t, codec, compressedAttrs[0].CompressionCodec)
+			assert.Equal(t, []byte("key"), compressedAttrs[0].Key)
+			assert.Less(t, len(compressedData[0]), len(compressible))
+
+			assert.Equal(t, varlogpb.CompressionCodecNone, compressedAttrs[1].CompressionCodec)
+			assert.True(t, compressedAttrs[1].Empty())
+			assert.Equal(t, incompressible, compressedData[1])
+
+			for i := range compressedData {
+				logEntry := varlogpb.LogEntry{
+					Data:               compressedData[i],
+					LogEntryAttributes: compressedAttrs[i],
+				}
+				require.NoError(t, DecompressLogEntry(&logEntry))
+				require.Equal(t, data[i], logEntry.Data)
+				require.Equal(t, varlogpb.CompressionCodecNone, logEntry.CompressionCodec)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package compress
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/kakao/varlog/pkg/util/mathutil"
+)
+
+// A payload compressed by lz4 is the length of the uncompressed payload as an
+// unsigned varint followed by a single LZ4 block. The block follows the LZ4
+// block format, thus, it can be decoded by any LZ4 block decoder given the
+// uncompressed length. The encoder is a simple greedy one that favors speed
+// over compression ratio.
+const (
+	lz4MinMatch     = 4
+	lz4LastLiterals = 5
+	// lz4MatchFindLimit is the minimum distance from the start of the last
+	// match to the end of the block.
+	lz4MatchFindLimit = 12
+	lz4MaxOffset      = 1<<16 - 1
+	lz4HashLog        = 14
+	lz4RunMask        = 1<<4 - 1
+)
+
+var errLZ4Corrupted = errors.New("corrupted block")
+
+func lz4Hash(seq uint32) uint32 {
+	return (seq * 2654435761) >> (32 - lz4HashLog)
+}
+
+func lz4Encode(src []byte) []byte {
+	dst := make([]byte, 0, binary.MaxVarintLen64+len(src)+len(src)/255+16)
+	dst = binary.AppendUvarint(dst, uint64(len(src)))
+
+	anchor := 0
+	if len(src) > lz4MatchFindLimit {
+		// The table keeps positions plus one so that zero means empty.
+		var table [1 << lz4HashLog]int32
+		limit := len(src) - lz4MatchFindLimit
+		for pos := 0; pos < limit; {
+			seq := binary.LittleEndian.Uint32(src[pos:])
+			h := lz4Hash(seq)
+			ref := int(table[h]) - 1
+			table[h] = int32(pos + 1)
+			if ref < 0 || pos-ref > lz4MaxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+				pos++
+				continue
+			}
+
+			for pos > anchor && ref > 0 && src[pos-1] == src[ref-1] {
+				pos--
+				ref--
+			}
+			end := pos + lz4MinMatch
+			for end < len(src)-lz4LastLiterals && src[end] == src[ref+end-pos] {
+				end++
+			}
+
+			dst = lz4AppendSequence(dst, src[anchor:pos], pos-ref, end-pos)
+			pos = end
+			anchor = pos
+		}
+	}
+
+	literals := src[anchor:]
+	if len(literals) < lz4RunMask {
+		return append(append(dst, byte(len(literals)<<4)), literals...)
+	}
+	dst = lz4AppendLength(append(dst, lz4RunMask<<4), len(literals)-lz4RunMask)
+	return append(dst, literals...)
+}
+
+func lz4AppendSequence(dst, literals []byte, offset, matchLen int) []byte {
+	litLen := len(literals)
+	matchLen -= lz4MinMatch
+	token := byte(mathutil.MinInt(litLen, lz4RunMask)<<4) | byte(mathutil.MinInt(matchLen, lz4RunMask))
+	dst = append(dst, token)
+	if litLen >= lz4RunMask {
+		dst = lz4AppendLength(dst, litLen-lz4RunMask)
+	}
+	dst = append(dst, literals...)
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if matchLen >= lz4RunMask {
+		dst = lz4AppendLength(dst, matchLen-lz4RunMask)
+	}
+	return dst
+}
+
+func lz4AppendLength(dst []byte, n int) []byte {
+	for ; n >= 0xff; n -= 0xff {
+		dst = append(dst, 0xff)
+	}
+	return append(dst, byte(n))
+}
+
+func lz4Decode(src []byte) ([]byte, error) {
+	size, n := binary.Uvarint(src)
+	// A byte of the block expands to at most 255 bytes.
+	if n <= 0 || size > uint64(len(src)-n)*0xff {
+		return nil, errLZ4Corrupted
+	}
+	src = src[n:]
+	dst := make([]byte, 0, size)
+
+	var err error
+	for pos := 0; pos < len(src); {
+		token := src[pos]
+		pos++
+
+		litLen := int(token >> 4)
+		if litLen == lz4RunMask {
+			if litLen, pos, err = lz4ReadLength(src, pos, litLen); err != nil {
+				return nil, err
+			}
+		}
+		if litLen > len(src)-pos || litLen > cap(dst)-len(dst) {
+			return nil, errLZ4Corrupted
+		}
+		dst = append(dst, src[pos:pos+litLen]...)
+		pos += litLen
+		if pos == len(src) {
+			break
+		}
+
+		if len(src)-pos < 2 {
+			return nil, errLZ4Corrupted
+		}
+		offset := int(src[pos]) | int(src[pos+1])<<8
+		pos += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, errLZ4Corrupted
+		}
+
+		matchLen := int(token & lz4RunMask)
+		if matchLen == lz4RunMask {
+			if matchLen, pos, err = lz4ReadLength(src, pos, matchLen); err != nil {
+				return nil, err
+			}
+		}
+		matchLen += lz4MinMatch
+		if matchLen > cap(dst)-len(dst) {
+			return nil, errLZ4Corrupted
+		}
+		// The match can overlap the bytes it produces, so it is copied byte
+		// by byte.
+		start := len(dst) - offset
+		for i := 0; i < matchLen; i++ {
+			dst = append(dst, dst[start+i])
+		}
+	}
+	if uint64(len(dst)) != size {
+		return nil, errLZ4Corrupted
+	}
+	return dst, nil
+}
+
+func lz4ReadLength(src []byte, pos, n int) (int, int, error) {
+	for {
+		if pos >= len(src) {
+			return 0, 0, errLZ4Corrupted
+		}
+		b := src[pos]
+		pos++
+		n += int(b)
+		if b != 0xff {
+			return n, pos, nil
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Package encrypt encrypts and decrypts payloads of log entries with data keys
+// by using AES-256-GCM.
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// KeySize is the size of keys in bytes.
+const KeySize = 32
+
+// NewKey returns a random key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	return key, nil
+}
+
+// AEAD returns the AEAD cipher using the key.
+func AEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("encrypt: invalid key size %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	return aead, nil
+}
+
+// Seal encrypts the plaintext by the AEAD cipher. The returned ciphertext is
+// prefixed with a random nonce.
+func Seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	dst := make([]byte, nonceSize, nonceSize+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(dst); err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	return aead.Seal(dst, dst, plaintext, nil), nil
+}
+
+// Open decrypts the ciphertext encrypted by Seal.
+func Open(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(ciphertext) < nonceSize+aead.Overhead() {
+		return nil, errors.New("encrypt: ciphertext too short")
+	}
+	plaintext, err := aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,51 @@
+package encrypt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSealOpen(t *testing.T) {
+	key, err := NewKey()
+	require.NoError(t, err)
+	require.Len(t, key, KeySize)
+	aead, err := AEAD(key)
+	require.NoError(t, err)
+
+	for _, plaintext := range [][]byte{{}, []byte("foo"), bytes.Repeat([]byte{'a'}, 1<<12)} {
+		ciphertext, err := Seal(aead, plaintext)
+		require.NoError(t, err)
+
+		// Nonces are random, thus, the same plaintext is sealed differently.
+		other, err := Seal(aead, plaintext)
+		require.NoError(t, err)
+		require.NotEqual(t, ciphertext, other)
+
+		decrypted, err := Open(aead, ciphertext)
+		require.NoError(t, err)
+		require.Equal(t, len(plaintext), len(decrypted))
+		require.True(t, bytes.Equal(plaintext, decrypted))
+
+		// Tampered ciphertexts cannot be opened.
+		ciphertext[len(ciphertext)-1] ^= 0xff
+		_, err = Open(aead, ciphertext)
+		require.Error(t, err)
+	}
+
+	otherKey, err := NewKey()
+	require.NoError(t, err)
+	otherAEAD, err := AEAD(otherKey)
+	require.NoError(t, err)
+	ciphertext, err := Seal(aead, []byte("foo"))
+	require.NoError(t, err)
+	_, err = Open(otherAEAD, ciphertext)
+	require.Error(t, err)
+
+	_, err = Open(aead, ciphertext[:aead.NonceSize()])
+	require.Error(t, err)
+
+	_, err = AEAD(key[:16])
+	require.Error(t, err)
+}
@@ -124,6 +124,13 @@ func TopicID() *FlagDesc {
 	}
 }
 
+func TopicName() *FlagDesc {
+	return &FlagDesc{
+		Name:    "topic-name",
+		Aliases: []string{"tpname"},
+	}
+}
+
 func LogStreamID() *FlagDesc {
 	return &FlagDesc{
 		Name:    "log-stream-id",
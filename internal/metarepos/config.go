@@ -40,6 +40,8 @@ const (
 	DefaultMaxTopicsCount             = -1
 	DefaultMaxLogStreamsCountPerTopic = -1
 
+	DefaultTransactionTTL = 30 * time.Second
+
 	UnusedRequestIndex uint64 = 0
 )
 
@@ -83,6 +85,7 @@ type config struct {
 	reportCommitterWriteBufferSize int
 	maxTopicsCount                 int32
 	maxLogStreamsCountPerTopic     int32
+	transactionTTL                 time.Duration
 	telemetryCollectorName         string
 	telemetryCollectorEndpoint     string
 	logger                         *zap.Logger
@@ -111,6 +114,7 @@ func newConfig(opts []Option) (config, error) {
 		reportCommitterWriteBufferSize: DefaultReportCommitterWriteBufferSize,
 		maxTopicsCount:                 DefaultMaxTopicsCount,
 		maxLogStreamsCountPerTopic:     DefaultMaxLogStreamsCountPerTopic,
+		transactionTTL:                 DefaultTransactionTTL,
 		telemetryCollectorName:         DefaultTelemetryCollectorName,
 		telemetryCollectorEndpoint:     DefaultTelmetryCollectorEndpoint,
 		logger:                         zap.NewNop(),
@@ -177,6 +181,10 @@ func (cfg *config) ensureDefault() {
 	if cfg.raftDir == "" {
 		cfg.raftDir = DefaultRaftDir
 	}
+
+	if cfg.transactionTTL == time.Duration(0) {
+		cfg.transactionTTL = DefaultTransactionTTL
+	}
 }
 
 func (cfg *config) ensurePeers() {
@@ -355,6 +363,15 @@ func WithMaxLogStreamsCountPerTopic(maxLogStreamsCountPerTopic int32) Option {
 	})
 }
 
+// WithTransactionTTL sets the duration for which the metadata repository
+// waits for a transaction to be ready. The transaction is discarded if it is
+// not committed in time.
+func WithTransactionTTL(transactionTTL time.Duration) Option {
+	return newFuncOption(func(cfg *config) {
+		cfg.transactionTTL = transactionTTL
+	})
+}
+
 func WithTelemetryCollectorName(telemetryCollectorName string) Option {
 	return newFuncOption(func(cfg *config) {
 		cfg.telemetryCollectorName = telemetryCollectorName
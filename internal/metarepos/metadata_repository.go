@@ -4,13 +4,14 @@ import (
 	"context"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/mrpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type MetadataRepository interface {
 	RegisterStorageNode(context.Context, *varlogpb.StorageNodeDescriptor) error
 	UnregisterStorageNode(context.Context, types.StorageNodeID) error
-	RegisterTopic(context.Context, types.TopicID) error
+	RegisterTopic(context.Context, *varlogpb.TopicDescriptor) error
 	UnregisterTopic(context.Context, types.TopicID) error
 	RegisterLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	UnregisterLogStream(context.Context, types.LogStreamID) error
@@ -18,5 +19,14 @@ type MetadataRepository interface {
 	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	CommitConsumerGroupOffset(context.Context, string, varlogpb.ConsumerGroupOffset) error
+	GetConsumerGroup(context.Context, string) (*varlogpb.ConsumerGroupDescriptor, error)
+	ListConsumerGroups(context.Context) ([]varlogpb.ConsumerGroupDescriptor, error)
+	DeleteConsumerGroup(context.Context, string) error
+	CommitTransaction(context.Context, uint64, []varlogpb.TopicLogStream) error
+	GetCommitResults(context.Context, types.TopicID) (*mrpb.LogStreamCommitResults, error)
+	RegisterSchema(context.Context, types.TopicID, varlogpb.SchemaType, []byte, varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error)
+	GetSchema(context.Context, types.SchemaID) (*varlogpb.SchemaDescriptor, error)
+	ListSchemas(context.Context, types.TopicID) ([]varlogpb.SchemaDescriptor, error)
 	Close() error
 }
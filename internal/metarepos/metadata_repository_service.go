@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/kakao/varlog/proto/mrpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type MetadataRepositoryService struct {
@@ -36,7 +37,11 @@ func (s *MetadataRepositoryService) UnregisterStorageNode(ctx context.Context, r
 }
 
 func (s *MetadataRepositoryService) RegisterTopic(ctx context.Context, req *mrpb.TopicRequest) (*types.Empty, error) {
-	err := s.metaRepos.RegisterTopic(ctx, req.TopicID)
+	err := s.metaRepos.RegisterTopic(ctx, &varlogpb.TopicDescriptor{
+		TopicID: req.TopicID,
+		Name:    req.Name,
+		Config:  req.Config,
+	})
 	return &types.Empty{}, err
 }
 
@@ -76,3 +81,63 @@ func (s *MetadataRepositoryService) Unseal(ctx context.Context, req *mrpb.Unseal
 	err := s.metaRepos.Unseal(ctx, req.GetLogStreamID())
 	return &mrpb.UnsealResponse{}, err
 }
+
+func (s *MetadataRepositoryService) CommitConsumerGroupOffset(ctx context.Context, req *mrpb.CommitConsumerGroupOffsetRequest) (*types.Empty, error) {
+	err := s.metaRepos.CommitConsumerGroupOffset(ctx, req.Group, req.Offset)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) GetConsumerGroup(ctx context.Context, req *mrpb.GetConsumerGroupRequest) (*mrpb.GetConsumerGroupResponse, error) {
+	cgd, err := s.metaRepos.GetConsumerGroup(ctx, req.Group)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.GetConsumerGroupResponse{ConsumerGroup: *cgd}, nil
+}
+
+func (s *MetadataRepositoryService) ListConsumerGroups(ctx context.Context, _ *mrpb.ListConsumerGroupsRequest) (*mrpb.ListConsumerGroupsResponse, error) {
+	cgds, err := s.metaRepos.ListConsumerGroups(ctx)
+	return &mrpb.ListConsumerGroupsResponse{ConsumerGroups: cgds}, err
+}
+
+func (s *MetadataRepositoryService) DeleteConsumerGroup(ctx context.Context, req *mrpb.DeleteConsumerGroupRequest) (*types.Empty, error) {
+	err := s.metaRepos.DeleteConsumerGroup(ctx, req.Group)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) GetCommitResults(ctx context.Context, req *mrpb.GetCommitResultsRequest) (*mrpb.GetCommitResultsResponse, error) {
+	crs, err := s.metaRepos.GetCommitResults(ctx, req.TopicID)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.GetCommitResultsResponse{
+		Version:       crs.Version,
+		CommitResults: crs.CommitResults,
+	}, nil
+}
+
+func (s *MetadataRepositoryService) CommitTransaction(ctx context.Context, req *mrpb.CommitTransactionRequest) (*types.Empty, error) {
+	err := s.metaRepos.CommitTransaction(ctx, req.TransactionID, req.Participants)
+	return &types.Empty{}, err
+}
+
+func (s *MetadataRepositoryService) RegisterSchema(ctx context.Context, req *mrpb.RegisterSchemaRequest) (*mrpb.RegisterSchemaResponse, error) {
+	sd, err := s.metaRepos.RegisterSchema(ctx, req.TopicID, req.Type, req.Definition, req.Compatibility)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.RegisterSchemaResponse{Schema: *sd}, nil
+}
+
+func (s *MetadataRepositoryService) GetSchema(ctx context.Context, req *mrpb.GetSchemaRequest) (*mrpb.GetSchemaResponse, error) {
+	sd, err := s.metaRepos.GetSchema(ctx, req.SchemaID)
+	if err != nil {
+		return nil, err
+	}
+	return &mrpb.GetSchemaResponse{Schema: *sd}, nil
+}
+
+func (s *MetadataRepositoryService) ListSchemas(ctx context.Context, req *mrpb.ListSchemasRequest) (*mrpb.ListSchemasResponse, error) {
+	sds, err := s.metaRepos.ListSchemas(ctx, req.TopicID)
+	return &mrpb.ListSchemasResponse{Schemas: sds}, err
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -23,6 +24,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/kakao/varlog/internal/reportcommitter"
+	"github.com/kakao/varlog/internal/schema"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/container/set"
 	"github.com/kakao/varlog/pkg/util/netutil"
@@ -586,6 +588,14 @@ func (mr *RaftMetadataRepository) apply(c *committedEntry) {
 			mr.applyRemovePeer(r, c.confState, e.AppliedIndex)
 		case *mrpb.Endpoint:
 			mr.applyEndpoint(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.CommitConsumerGroupOffset:
+			mr.applyCommitConsumerGroupOffset(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.DeleteConsumerGroup:
+			mr.applyDeleteConsumerGroup(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.CommitTransaction:
+			mr.applyCommitTransaction(r, e.NodeIndex, e.RequestIndex)
+		case *mrpb.RegisterSchema:
+			mr.applyRegisterSchema(r, e.NodeIndex, e.RequestIndex)
 		}
 
 		mr.storage.UpdateAppliedIndex(e.AppliedIndex)
@@ -619,6 +629,8 @@ func (mr *RaftMetadataRepository) applyUnregisterStorageNode(r *mrpb.UnregisterS
 func (mr *RaftMetadataRepository) applyRegisterTopic(r *mrpb.RegisterTopic, nodeIndex, requestIndex uint64) error {
 	topicDesc := &varlogpb.TopicDescriptor{
 		TopicID: r.TopicID,
+		Name:    r.Name,
+		Config:  r.Config,
 	}
 	err := mr.storage.RegisterTopic(topicDesc, nodeIndex, requestIndex)
 	if err != nil {
@@ -777,7 +789,7 @@ func (mr *RaftMetadataRepository) applyReport(reports *mrpb.Reports) error {
 			}
 
 			if (s.Version == u.Version &&
-				s.UncommittedLLSNEnd() < u.UncommittedLLSNEnd()) ||
+				(s.UncommittedLLSNEnd() < u.UncommittedLLSNEnd() || transactionChanged(s, u))) ||
 				s.Version < u.Version {
 				if s.UncommittedLLSNEnd() > u.UncommittedLLSNEnd() {
 					mr.logger.Error("unexpeted report",
@@ -801,6 +813,15 @@ func (mr *RaftMetadataRepository) applyReport(reports *mrpb.Reports) error {
 	return nil
 }
 
+// transactionChanged returns true if the staged transaction in the report cur
+// differs from the one in the report prev, for instance, the transaction is
+// newly staged or its last log entry is sequenced.
+func transactionChanged(prev, cur snpb.LogStreamUncommitReport) bool {
+	return prev.TransactionID != cur.TransactionID ||
+		prev.TransactionLLSNOffset != cur.TransactionLLSNOffset ||
+		prev.TransactionLLSNEnd != cur.TransactionLLSNEnd
+}
+
 func topicBoundary(topicLSIDs []TopicLSID, idx int) (begin bool, end bool) {
 	if idx == 0 {
 		begin = true
@@ -851,6 +872,19 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 
 			commitResultsMap := make(map[types.Version]*mrpb.LogStreamCommitResults)
 
+			var now time.Time
+			if r != nil {
+				now = r.CreatedTime
+			}
+			txnEnds := mr.decideTransactions(prevCommitResults, now)
+
+			// The commit time comes from the raft entry so that all
+			// replicas of the metadata repository agree on it.
+			var commitTime *time.Time
+			if !now.IsZero() {
+				commitTime = &now
+			}
+
 			committedOffset := types.InvalidGLSN
 
 			//TODO:: apply topic
@@ -926,6 +960,19 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 					committedLLSNOffset = prevCommitResult.CommittedLLSNOffset + types.LLSN(prevCommitResult.CommittedGLSNLength)
 				}
 
+				// Log entries of the staged transaction are held until
+				// the transaction is committed, and so are the following
+				// ones.
+				if txnID, txnBegin, _ := stagedTransaction(reports); txnID != 0 && txnBegin >= committedLLSNOffset {
+					limit := txnBegin
+					if end, ok := txnEnds[topicLSID.LogStreamID]; ok {
+						limit = end
+					}
+					if committedLLSNOffset+types.LLSN(nrUncommit) > limit {
+						nrUncommit = uint64(limit - committedLLSNOffset)
+					}
+				}
+
 				commit := snpb.LogStreamCommitResult{
 					TopicID:             topicLSID.TopicID,
 					LogStreamID:         topicLSID.LogStreamID,
@@ -936,6 +983,7 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 
 				if nrUncommit > 0 {
 					committedOffset += types.GLSN(commit.CommittedGLSNLength)
+					commit.CommitTime = commitTime
 				} else {
 					commit.CommittedGLSNOffset = mr.getLastCommitted(topicLSID.TopicID, topicLSID.LogStreamID, idx) + types.GLSN(1)
 					commit.CommittedGLSNLength = 0
@@ -974,6 +1022,100 @@ func (mr *RaftMetadataRepository) applyCommit(r *mrpb.Commit, appliedIndex uint6
 	return err
 }
 
+// decideTransactions returns the ends of log entries of transactions that are
+// committed by this commit keyed by their participants. A transaction is
+// committed once its log entries are replicated to all replicas of every
+// participant. It discards the transaction if any participant is sealed, or
+// it is not committed until the TTL expires; the argument now is the creation
+// time of the commit, and zero time disables the expiration. Both committed
+// and discarded transactions are removed from the storage.
+func (mr *RaftMetadataRepository) decideTransactions(prevCommitResults *mrpb.LogStreamCommitResults, now time.Time) map[types.LogStreamID]types.LLSN {
+	txns := mr.storage.GetTransactions()
+	if len(txns) == 0 {
+		return nil
+	}
+
+	txnEnds := make(map[types.LogStreamID]types.LLSN)
+	for _, txn := range txns {
+		ready, discard := true, false
+		if !now.IsZero() && now.Sub(txn.CreateTime) > mr.transactionTTL {
+			discard = true
+		}
+
+		ends := make(map[types.LogStreamID]types.LLSN, len(txn.Participants))
+		for _, p := range txn.Participants {
+			reports := mr.storage.LookupUncommitReports(p.LogStreamID)
+			if reports == nil || reports.Status.Sealed() || reports.Status.Deleted() {
+				discard = true
+				break
+			}
+
+			id, begin, end := stagedTransaction(reports)
+			if id != txn.TransactionID || end.Invalid() || len(reports.Replicas) < mr.replicationFactor {
+				ready = false
+				continue
+			}
+
+			committedLLSNEnd := types.MinLLSN
+			if cr, _, ok := prevCommitResults.LookupCommitResult(p.TopicID, p.LogStreamID, -1); ok {
+				committedLLSNEnd = cr.CommittedLLSNOffset + types.LLSN(cr.CommittedGLSNLength)
+			}
+			if begin < committedLLSNEnd {
+				discard = true
+				break
+			}
+
+			for _, replica := range reports.Replicas {
+				if replica.UncommittedLLSNEnd() < end {
+					ready = false
+					break
+				}
+			}
+			ends[p.LogStreamID] = end
+		}
+
+		switch {
+		case discard:
+			mr.logger.Info("discard transaction", zap.Uint64("transaction_id", txn.TransactionID))
+			mr.storage.DeleteTransaction(txn.TransactionID)
+		case ready:
+			for lsid, end := range ends {
+				txnEnds[lsid] = end
+			}
+			mr.storage.DeleteTransaction(txn.TransactionID)
+		}
+	}
+	return txnEnds
+}
+
+// stagedTransaction returns the transaction staged in the log stream. The
+// returned begin is the first LLSN of the transaction reported by replicas,
+// and the returned end is invalid unless the primary replica reports that all
+// log entries of the transaction are sequenced.
+func stagedTransaction(reports *mrpb.LogStreamUncommitReports) (id uint64, begin, end types.LLSN) {
+	if reports == nil {
+		return 0, types.InvalidLLSN, types.InvalidLLSN
+	}
+	for _, r := range reports.Replicas {
+		if r.TransactionID == 0 || r.TransactionLLSNOffset.Invalid() {
+			continue
+		}
+		if id == 0 {
+			id = r.TransactionID
+		}
+		if r.TransactionID != id {
+			continue
+		}
+		if begin.Invalid() || r.TransactionLLSNOffset < begin {
+			begin = r.TransactionLLSNOffset
+		}
+		if r.TransactionLLSNEnd > end {
+			end = r.TransactionLLSNEnd
+		}
+	}
+	return id, begin, end
+}
+
 func (mr *RaftMetadataRepository) applySeal(r *mrpb.Seal, nodeIndex, requestIndex, appliedIndex uint64) error {
 	mr.applyCommit(nil, appliedIndex) //nolint:errcheck,revive // TODO:: Handle an error returned.
 	err := mr.storage.SealingLogStream(r.LogStreamID, nodeIndex, requestIndex)
@@ -1022,6 +1164,26 @@ func (mr *RaftMetadataRepository) applyEndpoint(r *mrpb.Endpoint, nodeIndex, req
 	return nil
 }
 
+func (mr *RaftMetadataRepository) applyCommitConsumerGroupOffset(r *mrpb.CommitConsumerGroupOffset, nodeIndex, requestIndex uint64) error {
+	return mr.storage.CommitConsumerGroupOffset(r.Group, r.Offset, nodeIndex, requestIndex)
+}
+
+func (mr *RaftMetadataRepository) applyDeleteConsumerGroup(r *mrpb.DeleteConsumerGroup, nodeIndex, requestIndex uint64) error {
+	return mr.storage.DeleteConsumerGroup(r.Group, nodeIndex, requestIndex)
+}
+
+func (mr *RaftMetadataRepository) applyCommitTransaction(r *mrpb.CommitTransaction, nodeIndex, requestIndex uint64) error {
+	return mr.storage.RegisterTransaction(&mrpb.Transaction{
+		TransactionID: r.TransactionID,
+		Participants:  r.Participants,
+		CreateTime:    r.CreatedTime,
+	}, nodeIndex, requestIndex)
+}
+
+func (mr *RaftMetadataRepository) applyRegisterSchema(r *mrpb.RegisterSchema, nodeIndex, requestIndex uint64) error {
+	return mr.storage.RegisterSchema(&r.Schema, r.Compatibility, nodeIndex, requestIndex)
+}
+
 func (mr *RaftMetadataRepository) numCommitSince(topicID types.TopicID, lsID types.LogStreamID, base, latest *mrpb.LogStreamCommitResults, hintPos int) uint64 {
 	if latest == nil {
 		return 0
@@ -1247,9 +1409,11 @@ func (mr *RaftMetadataRepository) UnregisterStorageNode(ctx context.Context, snI
 	return nil
 }
 
-func (mr *RaftMetadataRepository) RegisterTopic(ctx context.Context, topicID types.TopicID) error {
+func (mr *RaftMetadataRepository) RegisterTopic(ctx context.Context, topic *varlogpb.TopicDescriptor) error {
 	r := &mrpb.RegisterTopic{
-		TopicID: topicID,
+		TopicID: topic.TopicID,
+		Name:    topic.Name,
+		Config:  topic.Config,
 	}
 
 	return mr.propose(ctx, r, true)
@@ -1351,6 +1515,140 @@ func (mr *RaftMetadataRepository) Unseal(ctx context.Context, lsID types.LogStre
 	return nil
 }
 
+func (mr *RaftMetadataRepository) CommitConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	// The update time is decided by the proposer so that all replicas
+	// apply the same value.
+	offset.UpdateTime = time.Now().UTC()
+	r := &mrpb.CommitConsumerGroupOffset{
+		Group:  group,
+		Offset: offset,
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+func (mr *RaftMetadataRepository) GetConsumerGroup(_ context.Context, group string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	cgd := mr.storage.LookupConsumerGroup(group)
+	if cgd == nil {
+		return nil, status.Errorf(codes.NotFound, "consumer group %s", group)
+	}
+	return cgd, nil
+}
+
+func (mr *RaftMetadataRepository) ListConsumerGroups(context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	return mr.storage.GetConsumerGroups(), nil
+}
+
+func (mr *RaftMetadataRepository) DeleteConsumerGroup(ctx context.Context, group string) error {
+	r := &mrpb.DeleteConsumerGroup{
+		Group: group,
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+func (mr *RaftMetadataRepository) CommitTransaction(ctx context.Context, transactionID uint64, participants []varlogpb.TopicLogStream) error {
+	r := &mrpb.CommitTransaction{
+		TransactionID: transactionID,
+		Participants:  participants,
+		// The creation time is decided by the proposer so that all
+		// replicas discard the transaction at the same commit.
+		CreatedTime: time.Now().UTC(),
+	}
+
+	return mr.propose(ctx, r, true)
+}
+
+// RegisterSchema registers a new version of the schema of the topic and
+// returns it. The schema ID and the version are decided while applying the
+// request; hence, the registered schema is looked up by its definition, which
+// is unique in the topic.
+func (mr *RaftMetadataRepository) RegisterSchema(ctx context.Context, topicID types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	if err := schema.Validate(typ, definition); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "topic %d: %s", topicID, err.Error())
+	}
+
+	r := &mrpb.RegisterSchema{
+		Schema: varlogpb.SchemaDescriptor{
+			TopicID:    topicID,
+			Type:       typ,
+			Definition: definition,
+			// The creation time is decided by the proposer so that all
+			// replicas apply the same value.
+			CreateTime: time.Now().UTC(),
+		},
+		Compatibility: compat,
+	}
+	if err := mr.propose(ctx, r, true); err != nil {
+		return nil, err
+	}
+
+	sd := mr.storage.LookupSchemaByDefinition(topicID, typ, definition)
+	if sd == nil {
+		return nil, status.Errorf(codes.Internal, "topic %d: registered schema not found", topicID)
+	}
+	return sd, nil
+}
+
+func (mr *RaftMetadataRepository) GetSchema(_ context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	sd := mr.storage.LookupSchema(schemaID)
+	if sd == nil {
+		return nil, status.Errorf(codes.NotFound, "schema %d", schemaID)
+	}
+	return sd, nil
+}
+
+func (mr *RaftMetadataRepository) ListSchemas(_ context.Context, topicID types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	if mr.storage.LookupTopic(topicID) == nil {
+		return nil, status.Errorf(codes.NotFound, "topic %d", topicID)
+	}
+	return mr.storage.GetSchemas(topicID), nil
+}
+
+// GetCommitResults returns the commit results of the log streams in the topic
+// at the last commit. Log streams that have never been committed are
+// omitted.
+func (mr *RaftMetadataRepository) GetCommitResults(_ context.Context, topicID types.TopicID) (*mrpb.LogStreamCommitResults, error) {
+	if !mr.IsMember() {
+		return nil, verrors.ErrNotMember
+	}
+
+	if mr.storage.LookupTopic(topicID) == nil {
+		return nil, status.Errorf(codes.NotFound, "topic %d", topicID)
+	}
+
+	ret := &mrpb.LogStreamCommitResults{}
+	crs := mr.storage.GetLastCommitResults()
+	if crs == nil {
+		return ret, nil
+	}
+	ret.Version = crs.Version
+	// Commit results are sorted by topic and log stream.
+	i := sort.Search(len(crs.CommitResults), func(i int) bool {
+		return crs.CommitResults[i].TopicID >= topicID
+	})
+	for ; i < len(crs.CommitResults) && crs.CommitResults[i].TopicID == topicID; i++ {
+		ret.CommitResults = append(ret.CommitResults, crs.CommitResults[i])
+	}
+	return ret, nil
+}
+
 func (mr *RaftMetadataRepository) AddPeer(ctx context.Context, _ types.ClusterID, nodeID types.NodeID, url string) error {
 	if mr.membership.IsMember(nodeID) ||
 		mr.membership.IsLearner(nodeID) {
@@ -306,7 +306,7 @@ func (clus *metadataRepoCluster) closeNoErrors(t *testing.T) {
 
 func (clus *metadataRepoCluster) initDummyStorageNode(nrSN, nrTopic int) error {
 	for i := 0; i < nrTopic; i++ {
-		if err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(i%nrTopic)); err != nil {
+		if err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(i % nrTopic)}); err != nil {
 			return err
 		}
 	}
@@ -901,7 +901,7 @@ func TestMRSimpleReportNCommit(t *testing.T) {
 			return clus.reporterClientFac.(*DummyStorageNodeClientFactory).lookupClient(snID) != nil
 		}), ShouldBeTrue)
 
-		err = clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err = clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs)
@@ -1466,7 +1466,7 @@ func TestMRUpdateLogStream(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1543,7 +1543,7 @@ func TestMRUpdateLogStreamExclusive(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1602,7 +1602,7 @@ func TestMRUpdateLogStreamUnsafe(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := mr.RegisterTopic(context.TODO(), types.TopicID(1))
+		err := mr.RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		ls := makeLogStream(types.TopicID(1), lsID, snIDs[0:rep])
@@ -1673,7 +1673,7 @@ func TestMRFailoverLeaderElection(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		lsID := types.MinLogStreamID
@@ -1742,7 +1742,7 @@ func TestMRFailoverJoinNewNode(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		lsID := types.MinLogStreamID
@@ -2406,7 +2406,7 @@ func TestMRFailoverRecoverReportCollector(t *testing.T) {
 			return clus.nodes[leader].reportCollector.NumExecutors() == nrStorageNode*nrRep
 		}), ShouldBeTrue)
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), types.TopicID(1))
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: types.TopicID(1)})
 		So(err, ShouldBeNil)
 
 		for i := 0; i < nrLogStream; i++ {
@@ -2613,7 +2613,7 @@ func TestMRUnregisterTopic(t *testing.T) {
 			So(err, ShouldBeNil)
 		}
 
-		err := clus.nodes[0].RegisterTopic(context.TODO(), topicID)
+		err := clus.nodes[0].RegisterTopic(context.TODO(), &varlogpb.TopicDescriptor{TopicID: topicID})
 		So(err, ShouldBeNil)
 
 		lsIDs := make([]types.LogStreamID, nrLS)
@@ -2658,32 +2658,72 @@ func TestMetadataRepository_MaxTopicsCount(t *testing.T) {
 
 		Convey("Limit is zero", func(C) {
 			mr.storage.limits.maxTopicsCount = 0
-			err := mr.RegisterTopic(context.Background(), 1)
+			err := mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldNotBeNil)
 		})
 
 		Convey("Limit is one", func(C) {
 			mr.storage.limits.maxTopicsCount = 1
 
-			err := mr.RegisterTopic(context.Background(), 1)
+			err := mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldBeNil)
 
-			err = mr.RegisterTopic(context.Background(), 2)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2})
 			So(err, ShouldNotBeNil)
 			So(status.Code(err), ShouldEqual, codes.ResourceExhausted)
 
-			err = mr.RegisterTopic(context.Background(), 1)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1})
 			So(err, ShouldBeNil)
 
 			err = mr.UnregisterTopic(context.TODO(), 1)
 			So(err, ShouldBeNil)
 
-			err = mr.RegisterTopic(context.Background(), 2)
+			err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2})
 			So(err, ShouldBeNil)
 		})
 	})
 }
 
+func TestMetadataRepository_TopicName(t *testing.T) {
+	const numNodes = 1
+	const repFactor = 1
+	const increaseUncommit = false
+
+	Convey("TopicName", t, func(C) {
+		clus := newMetadataRepoCluster(numNodes, repFactor, increaseUncommit)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+
+		config := varlogpb.TopicConfig{
+			ReplicationFactor: 2,
+			Retention:         time.Hour,
+			MaxLogStreams:     3,
+			Description:       "orders",
+		}
+		err := mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 1, Name: "foo", Config: config})
+		So(err, ShouldBeNil)
+
+		err = mr.RegisterTopic(context.Background(), &varlogpb.TopicDescriptor{TopicID: 2, Name: "foo"})
+		So(status.Code(err), ShouldEqual, codes.AlreadyExists)
+
+		md, err := mr.GetMetadata(context.Background())
+		So(err, ShouldBeNil)
+		td := md.GetTopicByName("foo")
+		So(td, ShouldNotBeNil)
+		So(td.TopicID, ShouldEqual, types.TopicID(1))
+		So(td.Config, ShouldResemble, config)
+		So(md.GetTopic(2), ShouldBeNil)
+	})
+}
+
 func TestMetadataRepository_MaxLogStreamsCountPerTopic(t *testing.T) {
 	const (
 		numNodes         = 1
@@ -2715,7 +2755,7 @@ func TestMetadataRepository_MaxLogStreamsCountPerTopic(t *testing.T) {
 		})
 		So(err, ShouldBeNil)
 
-		err = mr.RegisterTopic(ctx, tpid)
+		err = mr.RegisterTopic(ctx, &varlogpb.TopicDescriptor{TopicID: tpid})
 		So(err, ShouldBeNil)
 
 		Convey("Limit is zero", func(C) {
@@ -2737,6 +2777,291 @@ func TestMetadataRepository_MaxLogStreamsCountPerTopic(t *testing.T) {
 	})
 }
 
+func TestMetadataRepository_ConsumerGroup(t *testing.T) {
+	const (
+		numNodes         = 1
+		repFactor        = 1
+		increaseUncommit = false
+
+		snid  = types.StorageNodeID(1)
+		tpid  = types.TopicID(1)
+		lsid  = types.LogStreamID(1)
+		group = "cg"
+	)
+
+	Convey("ConsumerGroup", t, func(C) {
+		clus := newMetadataRepoCluster(numNodes, repFactor, increaseUncommit)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		ctx := context.Background()
+
+		err := mr.RegisterStorageNode(ctx, &varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snid,
+			},
+		})
+		So(err, ShouldBeNil)
+
+		err = mr.RegisterTopic(ctx, &varlogpb.TopicDescriptor{TopicID: tpid})
+		So(err, ShouldBeNil)
+
+		err = mr.RegisterLogStream(ctx, makeLogStream(tpid, lsid, []types.StorageNodeID{snid}))
+		So(err, ShouldBeNil)
+
+		_, err = mr.GetConsumerGroup(ctx, group)
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+
+		err = mr.CommitConsumerGroupOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: tpid + 1, GLSN: 1})
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+
+		err = mr.CommitConsumerGroupOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 10})
+		So(err, ShouldBeNil)
+		err = mr.CommitConsumerGroupOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid, LLSN: 5})
+		So(err, ShouldBeNil)
+
+		cgd, err := mr.GetConsumerGroup(ctx, group)
+		So(err, ShouldBeNil)
+		So(cgd.Offsets, ShouldHaveLength, 2)
+
+		cgds, err := mr.ListConsumerGroups(ctx)
+		So(err, ShouldBeNil)
+		So(cgds, ShouldHaveLength, 1)
+
+		Convey("Offsets should survive restart", func(C) {
+			So(clus.restart(0), ShouldBeNil)
+			So(testutil.CompareWaitN(50, func() bool {
+				return clus.healthCheckAll()
+			}), ShouldBeTrue)
+
+			mr := clus.nodes[0]
+			So(testutil.CompareWaitN(50, func() bool {
+				_, err := mr.GetConsumerGroup(ctx, group)
+				return err == nil
+			}), ShouldBeTrue)
+
+			cgd, err := mr.GetConsumerGroup(ctx, group)
+			So(err, ShouldBeNil)
+			offset, ok := cgd.GetOffset(tpid, 0)
+			So(ok, ShouldBeTrue)
+			So(offset.GLSN, ShouldEqual, types.GLSN(10))
+			So(offset.UpdateTime.IsZero(), ShouldBeFalse)
+			offset, ok = cgd.GetOffset(tpid, lsid)
+			So(ok, ShouldBeTrue)
+			So(offset.LLSN, ShouldEqual, types.LLSN(5))
+		})
+
+		Convey("Deleted consumer group should not be found", func(C) {
+			err := mr.DeleteConsumerGroup(ctx, group)
+			So(err, ShouldBeNil)
+
+			_, err = mr.GetConsumerGroup(ctx, group)
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+
+			err = mr.DeleteConsumerGroup(ctx, group)
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+	})
+}
+
+func TestMetadataRepository_GetCommitResults(t *testing.T) {
+	const (
+		numNodes         = 1
+		repFactor        = 1
+		increaseUncommit = false
+
+		tpid = types.TopicID(1)
+	)
+
+	Convey("GetCommitResults", t, func(C) {
+		clus := newMetadataRepoCluster(numNodes, repFactor, increaseUncommit)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		mr := clus.nodes[0]
+		ctx := context.Background()
+		fac := clus.reporterClientFac.(*DummyStorageNodeClientFactory)
+
+		// Each topic has a log stream whose ID is the same as its storage
+		// node, which is what the dummy reporter expects.
+		for i := 0; i < 2; i++ {
+			snid := types.StorageNodeID(i + 1)
+			err := mr.RegisterStorageNode(ctx, &varlogpb.StorageNodeDescriptor{
+				StorageNode: varlogpb.StorageNode{
+					StorageNodeID: snid,
+				},
+			})
+			So(err, ShouldBeNil)
+
+			So(testutil.CompareWaitN(50, func() bool {
+				return fac.lookupClient(snid) != nil
+			}), ShouldBeTrue)
+
+			err = mr.RegisterTopic(ctx, &varlogpb.TopicDescriptor{TopicID: tpid + types.TopicID(i)})
+			So(err, ShouldBeNil)
+
+			err = mr.RegisterLogStream(ctx, makeLogStream(tpid+types.TopicID(i), types.LogStreamID(snid), []types.StorageNodeID{snid}))
+			So(err, ShouldBeNil)
+		}
+
+		_, err := mr.GetCommitResults(ctx, tpid+2)
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+
+		reporterClient1 := fac.lookupClient(types.StorageNodeID(1))
+		reporterClient2 := fac.lookupClient(types.StorageNodeID(2))
+		reporterClient1.increaseUncommitted(0)
+		reporterClient1.increaseUncommitted(0)
+		reporterClient2.increaseUncommitted(0)
+
+		So(testutil.CompareWaitN(50, func() bool {
+			return reporterClient1.numUncommitted(0) == 0 && reporterClient2.numUncommitted(0) == 0
+		}), ShouldBeTrue)
+
+		crs, err := mr.GetCommitResults(ctx, tpid)
+		So(err, ShouldBeNil)
+		So(crs.Version, ShouldEqual, mr.GetLastCommitVersion())
+		So(crs.CommitResults, ShouldHaveLength, 1)
+		cr := crs.CommitResults[0]
+		So(cr.LogStreamID, ShouldEqual, types.LogStreamID(1))
+		So(cr.CommittedLLSNOffset+types.LLSN(cr.CommittedGLSNLength), ShouldEqual, types.LLSN(3))
+
+		crs, err = mr.GetCommitResults(ctx, tpid+1)
+		So(err, ShouldBeNil)
+		So(crs.CommitResults, ShouldHaveLength, 1)
+		cr = crs.CommitResults[0]
+		So(cr.LogStreamID, ShouldEqual, types.LogStreamID(2))
+		So(cr.CommittedLLSNOffset+types.LLSN(cr.CommittedGLSNLength), ShouldEqual, types.LLSN(2))
+	})
+}
+
+func TestMetadataRepository_CommitTransaction(t *testing.T) {
+	const (
+		numNodes         = 1
+		repFactor        = 1
+		increaseUncommit = false
+
+		snid  = types.StorageNodeID(1)
+		tpid  = types.TopicID(1)
+		lsid1 = types.LogStreamID(1)
+		lsid2 = types.LogStreamID(2)
+		txnID = uint64(7)
+	)
+
+	reportWithTransaction := func(lsid types.LogStreamID, length uint64, begin, end types.LLSN) *mrpb.Report {
+		report := makeUncommitReport(snid, types.InvalidVersion, types.InvalidGLSN, lsid, types.MinLLSN, length)
+		report.UncommitReport[0].TransactionID = txnID
+		report.UncommitReport[0].TransactionLLSNOffset = begin
+		report.UncommitReport[0].TransactionLLSNEnd = end
+		return report
+	}
+
+	Convey("CommitTransaction", t, func(C) {
+		clus := newMetadataRepoCluster(numNodes, repFactor, increaseUncommit)
+		Reset(func() {
+			clus.closeNoErrors(t)
+		})
+		mr := clus.nodes[0]
+
+		err := mr.storage.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: snid,
+			},
+		})
+		So(err, ShouldBeNil)
+		err = mr.storage.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid})
+		So(err, ShouldBeNil)
+		for _, lsid := range []types.LogStreamID{lsid1, lsid2} {
+			err = mr.storage.registerLogStream(makeLogStream(tpid, lsid, []types.StorageNodeID{snid}))
+			So(err, ShouldBeNil)
+		}
+
+		So(clus.Start(), ShouldBeNil)
+		So(testutil.CompareWaitN(10, func() bool {
+			return clus.healthCheckAll()
+		}), ShouldBeTrue)
+
+		ctx := context.Background()
+		participants := []varlogpb.TopicLogStream{
+			{TopicID: tpid, LogStreamID: lsid1},
+			{TopicID: tpid, LogStreamID: lsid2},
+		}
+
+		err = mr.CommitTransaction(ctx, txnID, nil)
+		So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+
+		err = mr.CommitTransaction(ctx, txnID, []varlogpb.TopicLogStream{{TopicID: tpid, LogStreamID: lsid2 + 1}})
+		So(status.Code(err), ShouldEqual, codes.NotFound)
+
+		err = mr.CommitTransaction(ctx, txnID, participants)
+		So(err, ShouldBeNil)
+		So(mr.storage.GetTransactions(), ShouldHaveLength, 1)
+
+		err = mr.CommitTransaction(ctx, txnID, participants)
+		So(status.Code(err), ShouldEqual, codes.AlreadyExists)
+
+		// LS1: [1, 4), the transaction is [2, 4).
+		// LS2: [1, 2), the transaction is not sequenced completely.
+		report := reportWithTransaction(lsid1, 3, 2, 4)
+		So(mr.proposeReport(report.StorageNodeID, report.UncommitReport), ShouldBeNil)
+		report = reportWithTransaction(lsid2, 1, 1, types.InvalidLLSN)
+		So(mr.proposeReport(report.StorageNodeID, report.UncommitReport), ShouldBeNil)
+
+		// Only the log entry preceding the transaction is committed.
+		So(testutil.CompareWaitN(10, func() bool {
+			hwm, _ := mr.GetLastCommitResults().LastHighWatermark(tpid, -1)
+			return hwm == types.GLSN(1)
+		}), ShouldBeTrue)
+		time.Sleep(vtesting.TimeoutUnitTimesFactor(1))
+		hwm, _ := mr.GetLastCommitResults().LastHighWatermark(tpid, -1)
+		So(hwm, ShouldEqual, types.GLSN(1))
+
+		Convey("Transaction should be committed in all participants at once", func(C) {
+			report := reportWithTransaction(lsid2, 2, 1, 3)
+			So(mr.proposeReport(report.StorageNodeID, report.UncommitReport), ShouldBeNil)
+
+			So(testutil.CompareWaitN(10, func() bool {
+				hwm, _ := mr.GetLastCommitResults().LastHighWatermark(tpid, -1)
+				return hwm == types.GLSN(5)
+			}), ShouldBeTrue)
+			So(mr.storage.GetTransactions(), ShouldBeEmpty)
+
+			crs := mr.GetLastCommitResults()
+			cr, _, ok := crs.LookupCommitResult(tpid, lsid1, -1)
+			So(ok, ShouldBeTrue)
+			So(cr.CommittedLLSNOffset, ShouldEqual, types.LLSN(2))
+			So(cr.CommittedGLSNLength, ShouldEqual, 2)
+			cr, _, ok = crs.LookupCommitResult(tpid, lsid2, -1)
+			So(ok, ShouldBeTrue)
+			So(cr.CommittedLLSNOffset, ShouldEqual, types.LLSN(1))
+			So(cr.CommittedGLSNLength, ShouldEqual, 2)
+		})
+
+		Convey("Transaction should be discarded if a participant is sealed", func(C) {
+			_, err := mr.Seal(ctx, lsid2)
+			So(err, ShouldBeNil)
+
+			So(testutil.CompareWaitN(10, func() bool {
+				return len(mr.storage.GetTransactions()) == 0
+			}), ShouldBeTrue)
+			hwm, _ := mr.GetLastCommitResults().LastHighWatermark(tpid, -1)
+			So(hwm, ShouldEqual, types.GLSN(1))
+		})
+	})
+}
+
 func TestMRTopicLastHighWatermark(t *testing.T) {
 	Convey("given metadata repository with multiple topics", t, func(ctx C) {
 		nrTopics := 3
@@ -689,7 +689,8 @@ func (rce *reportCollectExecutor) processReport(response *snpb.GetReportResponse
 			}
 
 			if cur.UncommittedLLSNOffset > prev.UncommittedLLSNOffset ||
-				cur.UncommittedLLSNEnd() > prev.UncommittedLLSNEnd() {
+				cur.UncommittedLLSNEnd() > prev.UncommittedLLSNEnd() ||
+				transactionChanged(prev, cur) {
 				diff.UncommitReports = append(diff.UncommitReports, cur)
 			}
 			i++
@@ -1,6 +1,7 @@
 package metarepos
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 
+	"github.com/kakao/varlog/internal/schema"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/mathutil"
 	"github.com/kakao/varlog/pkg/util/runner"
@@ -121,6 +123,9 @@ type MetadataStorage struct {
 	prMu sync.RWMutex // mutex for Peers
 	ssMu sync.RWMutex // mutex for Snapshot
 	mcMu sync.RWMutex // mutex for Metadata Cache
+	cgMu sync.RWMutex // mutex for Consumer Groups
+	txMu sync.RWMutex // mutex for Transactions
+	scMu sync.RWMutex // mutex for Schemas
 
 	// async job (snapshot, cache)
 	jobC chan *storageAsyncJob
@@ -166,6 +171,15 @@ func NewMetadataStorage(cb func(uint64, uint64, error), snapCount uint64, logger
 	ms.origStateMachine.Endpoints = make(map[types.NodeID]string)
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 
+	ms.origStateMachine.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+
+	ms.origStateMachine.Transactions = make(map[uint64]*mrpb.Transaction)
+	ms.diffStateMachine.Transactions = make(map[uint64]*mrpb.Transaction)
+
+	ms.origStateMachine.Schemas = make(map[types.SchemaID]*varlogpb.SchemaDescriptor)
+	ms.diffStateMachine.Schemas = make(map[types.SchemaID]*varlogpb.SchemaDescriptor)
+
 	ms.metaCache = &varlogpb.MetadataDescriptor{}
 
 	ms.jobC = make(chan *storageAsyncJob, 4096)
@@ -270,6 +284,13 @@ func (ms *MetadataStorage) LookupLogStream(lsID types.LogStreamID) *varlogpb.Log
 	return ms.lookupLogStream(lsID)
 }
 
+func (ms *MetadataStorage) LookupTopic(topicID types.TopicID) *varlogpb.TopicDescriptor {
+	ms.mtMu.RLock()
+	defer ms.mtMu.RUnlock()
+
+	return ms.lookupTopic(topicID)
+}
+
 func (ms *MetadataStorage) lookupTopic(topicID types.TopicID) *varlogpb.TopicDescriptor {
 	pre, cur := ms.getStateMachine()
 	topic := cur.Metadata.GetTopic(topicID)
@@ -452,6 +473,9 @@ func (ms *MetadataStorage) registerLogStream(ls *varlogpb.LogStreamDescriptor) e
 	if limit, curr := ms.limits.maxLogStreamsCountPerTopic, len(topic.LogStreams); limit >= 0 && curr >= int(limit) {
 		return status.Errorf(codes.ResourceExhausted, "too many log streams in topic %d: limits=%d actual=%d", ls.TopicID, limit, curr)
 	}
+	if limit, curr := topic.Config.MaxLogStreams, len(topic.LogStreams); limit > 0 && curr >= int(limit) {
+		return status.Errorf(codes.ResourceExhausted, "too many log streams in topic %d: topic limits=%d actual=%d", ls.TopicID, limit, curr)
+	}
 
 	if err := cur.Metadata.UpsertLogStream(ls); err != nil {
 		return status.Errorf(codes.ResourceExhausted, "upsert ls %d, %s", ls.LogStreamID, err.Error())
@@ -513,6 +537,8 @@ func (ms *MetadataStorage) unregisterLogStream(lsID types.LogStreamID) error {
 		return status.Errorf(codes.NotFound, "ls %d", lsID)
 	}
 
+	topic := ms.lookupTopic(ls.TopicID)
+
 	pre, cur := ms.getStateMachine()
 
 	ms.mtMu.Lock()
@@ -521,6 +547,12 @@ func (ms *MetadataStorage) unregisterLogStream(lsID types.LogStreamID) error {
 	cur.Metadata.DeleteLogStream(lsID) //nolint:errcheck,revive // TODO:: Handle an error returned.
 	delete(cur.LogStream.UncommitReports, lsID)
 
+	if topic.HasLogStream(lsID) {
+		topic = proto.Clone(topic).(*varlogpb.TopicDescriptor)
+		topic.DeleteLogStream(lsID)
+		cur.Metadata.UpsertTopic(topic) //nolint:errcheck,revive // TODO:: Handle an error returned.
+	}
+
 	if pre != cur {
 		deleted := &varlogpb.LogStreamDescriptor{
 			LogStreamID: lsID,
@@ -666,7 +698,8 @@ func (ms *MetadataStorage) registerTopic(topic *varlogpb.TopicDescriptor) error
 		return nil
 	}
 
-	tpids := make(map[types.TopicID]struct{})
+	// names of running topics
+	tpids := make(map[types.TopicID]string)
 	pre, cur := ms.getStateMachine()
 
 	ms.mtMu.Lock()
@@ -674,7 +707,7 @@ func (ms *MetadataStorage) registerTopic(topic *varlogpb.TopicDescriptor) error
 
 	for _, tp := range pre.Metadata.Topics {
 		if !tp.Status.Deleted() {
-			tpids[tp.TopicID] = struct{}{}
+			tpids[tp.TopicID] = tp.Name
 		}
 	}
 	if pre != cur {
@@ -683,7 +716,15 @@ func (ms *MetadataStorage) registerTopic(topic *varlogpb.TopicDescriptor) error
 				delete(tpids, tp.TopicID)
 				continue
 			}
-			tpids[tp.TopicID] = struct{}{}
+			tpids[tp.TopicID] = tp.Name
+		}
+	}
+
+	if len(topic.Name) > 0 {
+		for tpid, name := range tpids {
+			if name == topic.Name {
+				return status.Errorf(codes.AlreadyExists, "topic name %q is used by topic %d", name, tpid)
+			}
 		}
 	}
 
@@ -1087,6 +1128,377 @@ func (ms *MetadataStorage) LookupEndpoint(nodeID types.NodeID) string {
 	return ""
 }
 
+func (ms *MetadataStorage) CommitConsumerGroupOffset(group string, offset varlogpb.ConsumerGroupOffset, nodeIndex, requestIndex uint64) error {
+	err := ms.commitConsumerGroupOffset(group, offset)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+func (ms *MetadataStorage) commitConsumerGroupOffset(group string, offset varlogpb.ConsumerGroupOffset) error {
+	if group == "" {
+		return status.Error(codes.InvalidArgument, "empty consumer group name")
+	}
+	if err := offset.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "consumer group %s: %s", group, err.Error())
+	}
+
+	ms.mtMu.RLock()
+	topic := ms.lookupTopic(offset.TopicID)
+	if topic == nil {
+		ms.mtMu.RUnlock()
+		return status.Errorf(codes.NotFound, "consumer group %s: topic %d", group, offset.TopicID)
+	}
+	if !offset.TopicLevel() && !topic.HasLogStream(offset.LogStreamID) {
+		ms.mtMu.RUnlock()
+		return status.Errorf(codes.NotFound, "consumer group %s: log stream %d in topic %d", group, offset.LogStreamID, offset.TopicID)
+	}
+	ms.mtMu.RUnlock()
+
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	_, cur := ms.getStateMachine()
+	cgd := &varlogpb.ConsumerGroupDescriptor{Name: group}
+	if old := ms.lookupConsumerGroup(group); old != nil {
+		cgd.Offsets = append(cgd.Offsets, old.Offsets...)
+	}
+	cgd.UpsertOffset(offset)
+	cur.ConsumerGroups[group] = cgd
+
+	return nil
+}
+
+func (ms *MetadataStorage) DeleteConsumerGroup(group string, nodeIndex, requestIndex uint64) error {
+	err := ms.deleteConsumerGroup(group)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+func (ms *MetadataStorage) deleteConsumerGroup(group string) error {
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	if ms.lookupConsumerGroup(group) == nil {
+		return status.Errorf(codes.NotFound, "consumer group %s", group)
+	}
+
+	pre, cur := ms.getStateMachine()
+	if pre == cur {
+		delete(cur.ConsumerGroups, group)
+	} else {
+		// nil means that the consumer group is deleted in the diff.
+		cur.ConsumerGroups[group] = nil
+	}
+
+	return nil
+}
+
+func (ms *MetadataStorage) lookupConsumerGroup(group string) *varlogpb.ConsumerGroupDescriptor {
+	pre, cur := ms.getStateMachine()
+	if cgd, ok := cur.ConsumerGroups[group]; ok {
+		return cgd
+	}
+
+	if pre == cur {
+		return nil
+	}
+
+	return pre.ConsumerGroups[group]
+}
+
+// LookupConsumerGroup returns a copy of the consumer group, or nil if the
+// consumer group does not exist.
+func (ms *MetadataStorage) LookupConsumerGroup(group string) *varlogpb.ConsumerGroupDescriptor {
+	ms.cgMu.RLock()
+	defer ms.cgMu.RUnlock()
+
+	cgd := ms.lookupConsumerGroup(group)
+	if cgd == nil {
+		return nil
+	}
+	return proto.Clone(cgd).(*varlogpb.ConsumerGroupDescriptor)
+}
+
+// GetConsumerGroups returns copies of all consumer groups sorted by their
+// names.
+func (ms *MetadataStorage) GetConsumerGroups() []varlogpb.ConsumerGroupDescriptor {
+	ms.cgMu.RLock()
+	defer ms.cgMu.RUnlock()
+
+	pre, cur := ms.getStateMachine()
+	names := make(map[string]struct{}, len(pre.ConsumerGroups)+len(cur.ConsumerGroups))
+	for name := range pre.ConsumerGroups {
+		names[name] = struct{}{}
+	}
+	for name := range cur.ConsumerGroups {
+		names[name] = struct{}{}
+	}
+
+	cgds := make([]varlogpb.ConsumerGroupDescriptor, 0, len(names))
+	for name := range names {
+		cgd := ms.lookupConsumerGroup(name)
+		if cgd == nil {
+			continue
+		}
+		cgds = append(cgds, *proto.Clone(cgd).(*varlogpb.ConsumerGroupDescriptor))
+	}
+	sort.Slice(cgds, func(i, j int) bool {
+		return cgds[i].Name < cgds[j].Name
+	})
+	return cgds
+}
+
+func (ms *MetadataStorage) RegisterTransaction(txn *mrpb.Transaction, nodeIndex, requestIndex uint64) error {
+	err := ms.registerTransaction(txn)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+func (ms *MetadataStorage) registerTransaction(txn *mrpb.Transaction) error {
+	if txn.TransactionID == 0 {
+		return status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+	if len(txn.Participants) == 0 {
+		return status.Errorf(codes.InvalidArgument, "transaction %d: no participant", txn.TransactionID)
+	}
+
+	ms.mtMu.RLock()
+	for _, p := range txn.Participants {
+		topic := ms.lookupTopic(p.TopicID)
+		if topic == nil || !topic.HasLogStream(p.LogStreamID) {
+			ms.mtMu.RUnlock()
+			return status.Errorf(codes.NotFound, "transaction %d: log stream %d in topic %d", txn.TransactionID, p.LogStreamID, p.TopicID)
+		}
+	}
+	ms.mtMu.RUnlock()
+
+	ms.txMu.Lock()
+	defer ms.txMu.Unlock()
+
+	if ms.lookupTransaction(txn.TransactionID) != nil {
+		return status.Errorf(codes.AlreadyExists, "transaction %d", txn.TransactionID)
+	}
+
+	_, cur := ms.getStateMachine()
+	cur.Transactions[txn.TransactionID] = txn
+	// The transaction might be ready to be committed with the reports
+	// already received.
+	ms.nrUpdateSinceCommit++
+
+	return nil
+}
+
+// DeleteTransaction removes the transaction committed or discarded.
+func (ms *MetadataStorage) DeleteTransaction(transactionID uint64) {
+	ms.txMu.Lock()
+	defer ms.txMu.Unlock()
+
+	if ms.lookupTransaction(transactionID) == nil {
+		return
+	}
+
+	pre, cur := ms.getStateMachine()
+	if pre == cur {
+		delete(cur.Transactions, transactionID)
+	} else {
+		// nil means that the transaction is deleted in the diff.
+		cur.Transactions[transactionID] = nil
+	}
+}
+
+func (ms *MetadataStorage) lookupTransaction(transactionID uint64) *mrpb.Transaction {
+	pre, cur := ms.getStateMachine()
+	if txn, ok := cur.Transactions[transactionID]; ok {
+		return txn
+	}
+
+	if pre == cur {
+		return nil
+	}
+
+	return pre.Transactions[transactionID]
+}
+
+// GetTransactions returns the transactions waiting to be committed sorted by
+// their identifiers. The returned transactions must not be modified.
+func (ms *MetadataStorage) GetTransactions() []*mrpb.Transaction {
+	ms.txMu.RLock()
+	defer ms.txMu.RUnlock()
+
+	pre, cur := ms.getStateMachine()
+	ids := make(map[uint64]struct{}, len(pre.Transactions)+len(cur.Transactions))
+	for id := range pre.Transactions {
+		ids[id] = struct{}{}
+	}
+	for id := range cur.Transactions {
+		ids[id] = struct{}{}
+	}
+
+	txns := make([]*mrpb.Transaction, 0, len(ids))
+	for id := range ids {
+		txn := ms.lookupTransaction(id)
+		if txn == nil {
+			continue
+		}
+		txns = append(txns, txn)
+	}
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].TransactionID < txns[j].TransactionID
+	})
+	return txns
+}
+
+func (ms *MetadataStorage) RegisterSchema(sd *varlogpb.SchemaDescriptor, compat varlogpb.SchemaCompatibility, nodeIndex, requestIndex uint64) error {
+	err := ms.registerSchema(sd, compat)
+	if ms.cacheCompleteCB != nil {
+		ms.cacheCompleteCB(nodeIndex, requestIndex, err)
+	}
+	return err
+}
+
+// registerSchema assigns the schema ID and the version to the argument sd and
+// stores it. If the topic already has a schema of the same definition, it does
+// nothing.
+func (ms *MetadataStorage) registerSchema(sd *varlogpb.SchemaDescriptor, compat varlogpb.SchemaCompatibility) error {
+	if err := schema.Validate(sd.Type, sd.Definition); err != nil {
+		return status.Errorf(codes.InvalidArgument, "topic %d: %s", sd.TopicID, err.Error())
+	}
+
+	ms.mtMu.RLock()
+	topic := ms.lookupTopic(sd.TopicID)
+	ms.mtMu.RUnlock()
+	if topic == nil {
+		return status.Errorf(codes.NotFound, "topic %d", sd.TopicID)
+	}
+
+	ms.scMu.Lock()
+	defer ms.scMu.Unlock()
+
+	if ms.lookupSchemaByDefinition(sd.TopicID, sd.Type, sd.Definition) != nil {
+		return nil
+	}
+
+	var latest *varlogpb.SchemaDescriptor
+	maxID := types.SchemaID(0)
+	for _, old := range ms.getSchemas() {
+		if old.SchemaID > maxID {
+			maxID = old.SchemaID
+		}
+		if old.TopicID == sd.TopicID && (latest == nil || old.Version > latest.Version) {
+			latest = old
+		}
+	}
+	if latest != nil {
+		if err := schema.CheckCompatibility(compat, latest, sd); err != nil {
+			code := codes.InvalidArgument
+			if errors.Is(err, schema.ErrIncompatible) {
+				code = codes.FailedPrecondition
+			}
+			return status.Errorf(code, "topic %d: %s", sd.TopicID, err.Error())
+		}
+		sd.Version = latest.Version + 1
+	} else {
+		sd.Version = 1
+	}
+	sd.SchemaID = maxID + 1
+
+	_, cur := ms.getStateMachine()
+	cur.Schemas[sd.SchemaID] = sd
+
+	return nil
+}
+
+func (ms *MetadataStorage) lookupSchema(schemaID types.SchemaID) *varlogpb.SchemaDescriptor {
+	pre, cur := ms.getStateMachine()
+	if sd, ok := cur.Schemas[schemaID]; ok {
+		return sd
+	}
+
+	if pre == cur {
+		return nil
+	}
+
+	return pre.Schemas[schemaID]
+}
+
+func (ms *MetadataStorage) lookupSchemaByDefinition(topicID types.TopicID, typ varlogpb.SchemaType, definition []byte) *varlogpb.SchemaDescriptor {
+	for _, sd := range ms.getSchemas() {
+		if sd.TopicID == topicID && sd.Type == typ && bytes.Equal(sd.Definition, definition) {
+			return sd
+		}
+	}
+	return nil
+}
+
+// getSchemas returns all schemas in no particular order.
+func (ms *MetadataStorage) getSchemas() []*varlogpb.SchemaDescriptor {
+	pre, cur := ms.getStateMachine()
+	sds := make([]*varlogpb.SchemaDescriptor, 0, len(pre.Schemas)+len(cur.Schemas))
+	for _, sd := range cur.Schemas {
+		sds = append(sds, sd)
+	}
+	if pre == cur {
+		return sds
+	}
+	for id, sd := range pre.Schemas {
+		// Schemas are immutable, hence, the diff never overwrites them.
+		if _, ok := cur.Schemas[id]; !ok {
+			sds = append(sds, sd)
+		}
+	}
+	return sds
+}
+
+// LookupSchema returns a copy of the schema, or nil if the schema does not
+// exist.
+func (ms *MetadataStorage) LookupSchema(schemaID types.SchemaID) *varlogpb.SchemaDescriptor {
+	ms.scMu.RLock()
+	defer ms.scMu.RUnlock()
+
+	sd := ms.lookupSchema(schemaID)
+	if sd == nil {
+		return nil
+	}
+	return proto.Clone(sd).(*varlogpb.SchemaDescriptor)
+}
+
+// LookupSchemaByDefinition returns a copy of the schema of the topic that has
+// the same type and definition, or nil if there is no such schema.
+func (ms *MetadataStorage) LookupSchemaByDefinition(topicID types.TopicID, typ varlogpb.SchemaType, definition []byte) *varlogpb.SchemaDescriptor {
+	ms.scMu.RLock()
+	defer ms.scMu.RUnlock()
+
+	sd := ms.lookupSchemaByDefinition(topicID, typ, definition)
+	if sd == nil {
+		return nil
+	}
+	return proto.Clone(sd).(*varlogpb.SchemaDescriptor)
+}
+
+// GetSchemas returns copies of all schemas of the topic sorted by their
+// versions.
+func (ms *MetadataStorage) GetSchemas(topicID types.TopicID) []varlogpb.SchemaDescriptor {
+	ms.scMu.RLock()
+	defer ms.scMu.RUnlock()
+
+	var sds []varlogpb.SchemaDescriptor
+	for _, sd := range ms.getSchemas() {
+		if sd.TopicID == topicID {
+			sds = append(sds, *proto.Clone(sd).(*varlogpb.SchemaDescriptor))
+		}
+	}
+	sort.Slice(sds, func(i, j int) bool {
+		return sds[i].Version < sds[j].Version
+	})
+	return sds
+}
+
 func (ms *MetadataStorage) lookupNextCommitResultsNoLock(ver types.Version) *mrpb.LogStreamCommitResults {
 	pre, cur := ms.getStateMachine()
 	if pre != cur {
@@ -1414,6 +1826,18 @@ func (ms *MetadataStorage) ApplySnapshot(snap []byte, snapConfState *raftpb.Conf
 		stateMachine.Endpoints = make(map[types.NodeID]string)
 	}
 
+	if stateMachine.ConsumerGroups == nil {
+		stateMachine.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+	}
+
+	if stateMachine.Transactions == nil {
+		stateMachine.Transactions = make(map[uint64]*mrpb.Transaction)
+	}
+
+	if stateMachine.Schemas == nil {
+		stateMachine.Schemas = make(map[types.SchemaID]*varlogpb.SchemaDescriptor)
+	}
+
 	running := ms.running.Load()
 
 	ms.Close()
@@ -1455,9 +1879,21 @@ func (ms *MetadataStorage) RecoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.releaseCopyOnWrite()
 
 	ms.mergePeers()
+	ms.mergeConsumerGroups()
+	ms.mergeTransactions()
+	ms.mergeSchemas()
 
 	stateMachine.Endpoints = ms.origStateMachine.Endpoints
 	stateMachine.PeersMap = ms.origStateMachine.PeersMap
+	if stateMachine.ConsumerGroups == nil {
+		stateMachine.ConsumerGroups = ms.origStateMachine.ConsumerGroups
+	}
+	if stateMachine.Transactions == nil {
+		stateMachine.Transactions = ms.origStateMachine.Transactions
+	}
+	if stateMachine.Schemas == nil {
+		stateMachine.Schemas = ms.origStateMachine.Schemas
+	}
 
 	ms.recoverLogStreams(stateMachine)
 	ms.recoverCache(stateMachine, appliedIndex)
@@ -1532,6 +1968,9 @@ func (ms *MetadataStorage) recoverStateMachine(stateMachine *mrpb.MetadataReposi
 	ms.diffStateMachine.LogStream.UncommitReports = make(map[types.LogStreamID]*mrpb.LogStreamUncommitReports)
 	ms.diffStateMachine.PeersMap.Peers = make(map[types.NodeID]*mrpb.MetadataRepositoryDescriptor_PeerDescriptor)
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+	ms.diffStateMachine.Transactions = make(map[uint64]*mrpb.Transaction)
+	ms.diffStateMachine.Schemas = make(map[types.SchemaID]*varlogpb.SchemaDescriptor)
 
 	ms.metaAppliedIndex = appliedIndex
 	ms.appliedIndex = appliedIndex
@@ -1819,6 +2258,59 @@ func (ms *MetadataStorage) mergePeers() {
 	ms.diffStateMachine.Endpoints = make(map[types.NodeID]string)
 }
 
+func (ms *MetadataStorage) mergeConsumerGroups() {
+	if len(ms.diffStateMachine.ConsumerGroups) == 0 {
+		return
+	}
+
+	ms.cgMu.Lock()
+	defer ms.cgMu.Unlock()
+
+	for name, cgd := range ms.diffStateMachine.ConsumerGroups {
+		if cgd == nil {
+			delete(ms.origStateMachine.ConsumerGroups, name)
+		} else {
+			ms.origStateMachine.ConsumerGroups[name] = cgd
+		}
+	}
+
+	ms.diffStateMachine.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+}
+
+func (ms *MetadataStorage) mergeTransactions() {
+	if len(ms.diffStateMachine.Transactions) == 0 {
+		return
+	}
+
+	ms.txMu.Lock()
+	defer ms.txMu.Unlock()
+
+	for id, txn := range ms.diffStateMachine.Transactions {
+		if txn == nil {
+			delete(ms.origStateMachine.Transactions, id)
+		} else {
+			ms.origStateMachine.Transactions[id] = txn
+		}
+	}
+
+	ms.diffStateMachine.Transactions = make(map[uint64]*mrpb.Transaction)
+}
+
+func (ms *MetadataStorage) mergeSchemas() {
+	if len(ms.diffStateMachine.Schemas) == 0 {
+		return
+	}
+
+	ms.scMu.Lock()
+	defer ms.scMu.Unlock()
+
+	for id, sd := range ms.diffStateMachine.Schemas {
+		ms.origStateMachine.Schemas[id] = sd
+	}
+
+	ms.diffStateMachine.Schemas = make(map[types.SchemaID]*varlogpb.SchemaDescriptor)
+}
+
 func (ms *MetadataStorage) mergeConfState() {
 	if ms.diffConfState != nil {
 		ms.origConfState = ms.diffConfState
@@ -1860,6 +2352,9 @@ func (ms *MetadataStorage) mergeStateMachine() {
 	ms.mergeMetadata()
 	ms.mergeLogStream()
 	ms.mergePeers()
+	ms.mergeConsumerGroups()
+	ms.mergeTransactions()
+	ms.mergeSchemas()
 	ms.mergeConfState()
 
 	ms.releaseCopyOnWrite()
@@ -374,6 +374,7 @@ func TestStoragUnregisterLS(t *testing.T) {
 			So(ms.lookupLogStream(lsID), ShouldBeNil)
 			So(ms.LookupUncommitReports(lsID), ShouldBeNil)
 			So(len(ms.GetSortedTopicLogStreamIDs()), ShouldEqual, 0)
+			So(ms.lookupTopic(types.TopicID(1)).HasLogStream(lsID), ShouldBeFalse)
 
 			Convey("unregistered SN should not be found after merge", func(ctx C) {
 				ms.mergeMetadata()
@@ -384,6 +385,7 @@ func TestStoragUnregisterLS(t *testing.T) {
 				So(ms.lookupLogStream(lsID), ShouldBeNil)
 				So(ms.LookupUncommitReports(lsID), ShouldBeNil)
 				So(len(ms.GetSortedTopicLogStreamIDs()), ShouldEqual, 0)
+				So(ms.lookupTopic(types.TopicID(1)).HasLogStream(lsID), ShouldBeFalse)
 			})
 		})
 	})
@@ -1997,6 +1999,104 @@ func TestStorage_MaxLogStreamsCountPerTopic(t *testing.T) {
 	}
 }
 
+func TestStorage_TopicConfig(t *testing.T) {
+	const snid = types.StorageNodeID(1)
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, ms *MetadataStorage)
+	}{
+		{
+			name: "DuplicatedName",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 1, Name: "foo"})
+				require.NoError(t, err)
+
+				// redundant request
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 1, Name: "foo"})
+				require.NoError(t, err)
+
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 2, Name: "foo"})
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+				// Topics without names do not conflict with each other.
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 2})
+				require.NoError(t, err)
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 3})
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "DuplicatedNameUnderCOW",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 1, Name: "foo"})
+				require.NoError(t, err)
+
+				ms.setCopyOnWrite()
+
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 2, Name: "foo"})
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 2, Name: "bar"})
+				require.NoError(t, err)
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 3, Name: "bar"})
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name: "NameOfUnregisteredTopic",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 1, Name: "foo"})
+				require.NoError(t, err)
+
+				ms.setCopyOnWrite()
+
+				err = ms.unregisterTopic(1)
+				require.NoError(t, err)
+
+				err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: 2, Name: "foo"})
+				require.NoError(t, err)
+				require.Equal(t, "foo", ms.lookupTopic(2).Name)
+			},
+		},
+		{
+			name: "MaxLogStreams",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTopic(&varlogpb.TopicDescriptor{
+					TopicID: 1,
+					Name:    "foo",
+					Config:  varlogpb.TopicConfig{MaxLogStreams: 1},
+				})
+				require.NoError(t, err)
+
+				err = ms.registerLogStream(makeLogStream(1, types.LogStreamID(1), []types.StorageNodeID{snid}))
+				require.NoError(t, err)
+
+				err = ms.registerLogStream(makeLogStream(1, types.LogStreamID(2), []types.StorageNodeID{snid}))
+				require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+				td := ms.lookupTopic(1)
+				require.Equal(t, "foo", td.Name)
+				require.EqualValues(t, 1, td.Config.MaxLogStreams)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+			err := ms.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+				StorageNode: varlogpb.StorageNode{
+					StorageNodeID: snid,
+				},
+			})
+			require.NoError(t, err)
+
+			tc.testf(t, ms)
+		})
+	}
+}
+
 func TestStorageSortedTopicLogStreamIDs(t *testing.T) {
 	Convey("UncommitReport should be committed", t, func(ctx C) {
 		/*
@@ -2044,3 +2144,440 @@ func TestStorageSortedTopicLogStreamIDs(t *testing.T) {
 		}), ShouldBeTrue)
 	})
 }
+
+func TestStorage_ConsumerGroup(t *testing.T) {
+	const (
+		snid = types.StorageNodeID(1)
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+		cg1  = "cg1"
+		cg2  = "cg2"
+	)
+
+	newStorage := func(t *testing.T) *MetadataStorage {
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+		err := ms.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{StorageNodeID: snid},
+		})
+		require.NoError(t, err)
+		err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid})
+		require.NoError(t, err)
+		err = ms.registerLogStream(makeLogStream(tpid, lsid, []types.StorageNodeID{snid}))
+		require.NoError(t, err)
+		return ms
+	}
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, ms *MetadataStorage)
+	}{
+		{
+			name: "InvalidArgument",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.commitConsumerGroupOffset("", varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 1})
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				err = ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 1, LLSN: 1})
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid + 1, GLSN: 1})
+				require.Equal(t, codes.NotFound, status.Code(err))
+
+				err = ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid + 1, LLSN: 1})
+				require.Equal(t, codes.NotFound, status.Code(err))
+
+				err = ms.deleteConsumerGroup(cg1)
+				require.Equal(t, codes.NotFound, status.Code(err))
+
+				require.Nil(t, ms.LookupConsumerGroup(cg1))
+				require.Empty(t, ms.GetConsumerGroups())
+			},
+		},
+		{
+			name: "CommitAndDelete",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 10})
+				require.NoError(t, err)
+				err = ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid, LLSN: 5})
+				require.NoError(t, err)
+				err = ms.commitConsumerGroupOffset(cg2, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 3})
+				require.NoError(t, err)
+
+				cgd := ms.LookupConsumerGroup(cg1)
+				require.NotNil(t, cgd)
+				require.Equal(t, cg1, cgd.Name)
+				require.Len(t, cgd.Offsets, 2)
+
+				// Resetting to the earlier offset is allowed.
+				err = ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 1})
+				require.NoError(t, err)
+				offset, ok := ms.LookupConsumerGroup(cg1).GetOffset(tpid, 0)
+				require.True(t, ok)
+				require.Equal(t, types.GLSN(1), offset.GLSN)
+
+				cgds := ms.GetConsumerGroups()
+				require.Len(t, cgds, 2)
+				require.Equal(t, cg1, cgds[0].Name)
+				require.Equal(t, cg2, cgds[1].Name)
+
+				err = ms.deleteConsumerGroup(cg1)
+				require.NoError(t, err)
+				require.Nil(t, ms.LookupConsumerGroup(cg1))
+				require.Len(t, ms.GetConsumerGroups(), 1)
+			},
+		},
+		{
+			name: "CopyOnWrite",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 10})
+				require.NoError(t, err)
+				err = ms.commitConsumerGroupOffset(cg2, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 3})
+				require.NoError(t, err)
+
+				ms.setCopyOnWrite()
+
+				err = ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 20})
+				require.NoError(t, err)
+				err = ms.deleteConsumerGroup(cg2)
+				require.NoError(t, err)
+
+				// The original state machine is not changed.
+				pre, cur := ms.getStateMachine()
+				require.Equal(t, types.GLSN(10), pre.ConsumerGroups[cg1].Offsets[0].GLSN)
+				require.Contains(t, pre.ConsumerGroups, cg2)
+				require.Equal(t, types.GLSN(20), cur.ConsumerGroups[cg1].Offsets[0].GLSN)
+
+				offset, ok := ms.LookupConsumerGroup(cg1).GetOffset(tpid, 0)
+				require.True(t, ok)
+				require.Equal(t, types.GLSN(20), offset.GLSN)
+				require.Nil(t, ms.LookupConsumerGroup(cg2))
+				require.Len(t, ms.GetConsumerGroups(), 1)
+
+				ms.mergeStateMachine()
+				require.False(t, ms.isCopyOnWrite())
+
+				pre, _ = ms.getStateMachine()
+				require.Equal(t, types.GLSN(20), pre.ConsumerGroups[cg1].Offsets[0].GLSN)
+				require.NotContains(t, pre.ConsumerGroups, cg2)
+			},
+		},
+		{
+			name: "Snapshot",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.commitConsumerGroupOffset(cg1, varlogpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid, LLSN: 7})
+				require.NoError(t, err)
+
+				ms.appliedIndex = 1
+				ms.createSnapshot(&jobSnapshot{appliedIndex: 1})
+				snap, confState, snapIndex := ms.GetSnapshot()
+
+				restored := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+				err = restored.ApplySnapshot(snap, confState, snapIndex)
+				require.NoError(t, err)
+
+				offset, ok := restored.LookupConsumerGroup(cg1).GetOffset(tpid, lsid)
+				require.True(t, ok)
+				require.Equal(t, types.LLSN(7), offset.LLSN)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.testf(t, newStorage(t))
+		})
+	}
+}
+
+func TestStorage_Schema(t *testing.T) {
+	const tpid = types.TopicID(1)
+
+	var (
+		v1 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}}`)
+		v2 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}`)
+		v3 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}, "required": ["id"]}`)
+	)
+
+	newStorage := func(t *testing.T) *MetadataStorage {
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+		err := ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid})
+		require.NoError(t, err)
+		err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid + 1})
+		require.NoError(t, err)
+		return ms
+	}
+
+	register := func(ms *MetadataStorage, topicID types.TopicID, definition []byte, compat varlogpb.SchemaCompatibility) error {
+		return ms.registerSchema(&varlogpb.SchemaDescriptor{
+			TopicID:    topicID,
+			Type:       varlogpb.SchemaTypeJSON,
+			Definition: definition,
+		}, compat)
+	}
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, ms *MetadataStorage)
+	}{
+		{
+			name: "InvalidArgument",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid, []byte("malformed"), varlogpb.SchemaCompatibilityBackward)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				err = ms.registerSchema(&varlogpb.SchemaDescriptor{
+					TopicID:    tpid,
+					Type:       varlogpb.SchemaTypeUnspecified,
+					Definition: v1,
+				}, varlogpb.SchemaCompatibilityBackward)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid+2, v1, varlogpb.SchemaCompatibilityBackward)
+				require.Equal(t, codes.NotFound, status.Code(err))
+
+				require.Nil(t, ms.LookupSchema(types.MinSchemaID))
+				require.Empty(t, ms.GetSchemas(tpid))
+			},
+		},
+		{
+			name: "RegisterVersions",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+				err = register(ms, tpid+1, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+				err = register(ms, tpid, v2, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				// Registering the same definition again is no-op.
+				err = register(ms, tpid, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				sds := ms.GetSchemas(tpid)
+				require.Len(t, sds, 2)
+				require.Equal(t, types.SchemaID(1), sds[0].SchemaID)
+				require.EqualValues(t, 1, sds[0].Version)
+				require.Equal(t, types.SchemaID(3), sds[1].SchemaID)
+				require.EqualValues(t, 2, sds[1].Version)
+
+				sd := ms.LookupSchema(2)
+				require.NotNil(t, sd)
+				require.Equal(t, tpid+1, sd.TopicID)
+				require.EqualValues(t, 1, sd.Version)
+
+				sd = ms.LookupSchemaByDefinition(tpid, varlogpb.SchemaTypeJSON, v2)
+				require.NotNil(t, sd)
+				require.Equal(t, types.SchemaID(3), sd.SchemaID)
+			},
+		},
+		{
+			name: "Incompatible",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				// Adding a required property breaks the backward
+				// compatibility.
+				err = register(ms, tpid, v3, varlogpb.SchemaCompatibilityBackward)
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+				require.Len(t, ms.GetSchemas(tpid), 1)
+
+				err = register(ms, tpid, v3, varlogpb.SchemaCompatibilityNone)
+				require.NoError(t, err)
+				require.Len(t, ms.GetSchemas(tpid), 2)
+			},
+		},
+		{
+			name: "CopyOnWrite",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				ms.setCopyOnWrite()
+
+				err = register(ms, tpid, v2, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				// The original state machine is not changed.
+				pre, cur := ms.getStateMachine()
+				require.Len(t, pre.Schemas, 1)
+				require.Len(t, cur.Schemas, 1)
+				require.Contains(t, cur.Schemas, types.SchemaID(2))
+				require.Len(t, ms.GetSchemas(tpid), 2)
+
+				ms.mergeStateMachine()
+				require.False(t, ms.isCopyOnWrite())
+
+				pre, _ = ms.getStateMachine()
+				require.Len(t, pre.Schemas, 2)
+			},
+		},
+		{
+			name: "Snapshot",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := register(ms, tpid, v1, varlogpb.SchemaCompatibilityBackward)
+				require.NoError(t, err)
+
+				ms.appliedIndex = 1
+				ms.createSnapshot(&jobSnapshot{appliedIndex: 1})
+				snap, confState, snapIndex := ms.GetSnapshot()
+
+				restored := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+				err = restored.ApplySnapshot(snap, confState, snapIndex)
+				require.NoError(t, err)
+
+				sd := restored.LookupSchema(types.MinSchemaID)
+				require.NotNil(t, sd)
+				require.Equal(t, v1, sd.Definition)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.testf(t, newStorage(t))
+		})
+	}
+}
+
+func TestStorage_Transaction(t *testing.T) {
+	const (
+		snid = types.StorageNodeID(1)
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+
+	newStorage := func(t *testing.T) *MetadataStorage {
+		ms := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+		err := ms.registerStorageNode(&varlogpb.StorageNodeDescriptor{
+			StorageNode: varlogpb.StorageNode{StorageNodeID: snid},
+		})
+		require.NoError(t, err)
+		err = ms.registerTopic(&varlogpb.TopicDescriptor{TopicID: tpid})
+		require.NoError(t, err)
+		err = ms.registerLogStream(makeLogStream(tpid, lsid, []types.StorageNodeID{snid}))
+		require.NoError(t, err)
+		return ms
+	}
+
+	newTransaction := func(id uint64) *mrpb.Transaction {
+		return &mrpb.Transaction{
+			TransactionID: id,
+			Participants:  []varlogpb.TopicLogStream{{TopicID: tpid, LogStreamID: lsid}},
+			CreateTime:    time.Now().UTC(),
+		}
+	}
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, ms *MetadataStorage)
+	}{
+		{
+			name: "InvalidArgument",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTransaction(newTransaction(0))
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				err = ms.registerTransaction(&mrpb.Transaction{TransactionID: 1})
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NotFound",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				txn := newTransaction(1)
+				txn.Participants = append(txn.Participants, varlogpb.TopicLogStream{TopicID: tpid, LogStreamID: lsid + 1})
+				err := ms.registerTransaction(txn)
+				require.Equal(t, codes.NotFound, status.Code(err))
+				require.Empty(t, ms.GetTransactions())
+			},
+		},
+		{
+			name: "RegisterAndDelete",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				nrUpdate := ms.NumUpdateSinceCommit()
+				err := ms.registerTransaction(newTransaction(2))
+				require.NoError(t, err)
+				require.Greater(t, ms.NumUpdateSinceCommit(), nrUpdate)
+				err = ms.registerTransaction(newTransaction(1))
+				require.NoError(t, err)
+
+				err = ms.registerTransaction(newTransaction(1))
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+				txns := ms.GetTransactions()
+				require.Len(t, txns, 2)
+				require.Equal(t, uint64(1), txns[0].TransactionID)
+				require.Equal(t, uint64(2), txns[1].TransactionID)
+
+				ms.DeleteTransaction(1)
+				ms.DeleteTransaction(3)
+				txns = ms.GetTransactions()
+				require.Len(t, txns, 1)
+				require.Equal(t, uint64(2), txns[0].TransactionID)
+			},
+		},
+		{
+			name: "CopyOnWrite",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTransaction(newTransaction(1))
+				require.NoError(t, err)
+
+				ms.setCopyOnWrite()
+
+				err = ms.registerTransaction(newTransaction(2))
+				require.NoError(t, err)
+				ms.DeleteTransaction(1)
+
+				// The original state machine is not changed.
+				pre, _ := ms.getStateMachine()
+				require.Contains(t, pre.Transactions, uint64(1))
+				require.NotContains(t, pre.Transactions, uint64(2))
+
+				txns := ms.GetTransactions()
+				require.Len(t, txns, 1)
+				require.Equal(t, uint64(2), txns[0].TransactionID)
+
+				ms.mergeStateMachine()
+				require.False(t, ms.isCopyOnWrite())
+
+				pre, _ = ms.getStateMachine()
+				require.NotContains(t, pre.Transactions, uint64(1))
+				require.Contains(t, pre.Transactions, uint64(2))
+			},
+		},
+		{
+			name: "Snapshot",
+			testf: func(t *testing.T, ms *MetadataStorage) {
+				err := ms.registerTransaction(newTransaction(1))
+				require.NoError(t, err)
+
+				ms.appliedIndex = 1
+				ms.createSnapshot(&jobSnapshot{appliedIndex: 1})
+				snap, confState, snapIndex := ms.GetSnapshot()
+
+				restored := NewMetadataStorage(nil, DefaultSnapshotCount, zaptest.NewLogger(t))
+				err = restored.ApplySnapshot(snap, confState, snapIndex)
+				require.NoError(t, err)
+
+				txns := restored.GetTransactions()
+				require.Len(t, txns, 1)
+				require.Equal(t, uint64(1), txns[0].TransactionID)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.testf(t, newStorage(t))
+		})
+	}
+}
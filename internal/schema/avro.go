@@ -0,0 +1,395 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// avroSchema is a parsed Avro schema. Named types are resolved through names,
+// which maps full names to their definitions.
+type avroSchema struct {
+	root  any
+	names map[string]map[string]any
+}
+
+var avroPrimitives = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"int":     true,
+	"long":    true,
+	"float":   true,
+	"double":  true,
+	"bytes":   true,
+	"string":  true,
+}
+
+// avroPromotions lists the writer types that each reader type can read in
+// addition to itself.
+var avroPromotions = map[string][]string{
+	"long":   {"int"},
+	"float":  {"int", "long"},
+	"double": {"int", "long", "float"},
+	"string": {"bytes"},
+	"bytes":  {"string"},
+}
+
+// parseAvroSchema parses an Avro schema in its JSON form.
+func parseAvroSchema(definition []byte) (*avroSchema, error) {
+	var root any
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	s := &avroSchema{
+		root:  root,
+		names: make(map[string]map[string]any),
+	}
+	if err := s.collect(root, ""); err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	if err := s.checkRefs(root, ""); err != nil {
+		return nil, fmt.Errorf("avro schema: %w", err)
+	}
+	return s, nil
+}
+
+// fullName qualifies the name with the namespace unless it is already
+// qualified.
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// collect registers named types and validates the structure of the schema.
+func (s *avroSchema) collect(schema any, namespace string) error {
+	var t map[string]any
+	switch v := schema.(type) {
+	case string:
+		return nil
+	case []any:
+		if len(v) == 0 {
+			return errors.New("empty union")
+		}
+		for _, branch := range v {
+			if _, ok := branch.([]any); ok {
+				return errors.New("union directly contains union")
+			}
+			if err := s.collect(branch, namespace); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]any:
+		t = v
+	default:
+		return fmt.Errorf("unexpected schema %v", v)
+	}
+
+	typ, ok := t["type"].(string)
+	if !ok {
+		// The type is a nested schema, e.g., {"type": {"type": "array", ...}}.
+		if _, ok := t["type"]; !ok {
+			return errors.New("schema without type")
+		}
+		return s.collect(t["type"], namespace)
+	}
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		name, _ := t["name"].(string)
+		if name == "" {
+			return fmt.Errorf("%s without name", typ)
+		}
+		if ns, ok := t["namespace"].(string); ok {
+			namespace = ns
+		}
+		fullName := avroFullName(name, namespace)
+		if _, ok := s.names[fullName]; ok {
+			return fmt.Errorf("duplicate name %s", fullName)
+		}
+		s.names[fullName] = t
+		if i := strings.LastIndex(fullName, "."); i >= 0 {
+			namespace = fullName[:i]
+		}
+	}
+
+	switch typ {
+	case "record", "error":
+		fields, ok := t["fields"].([]any)
+		if !ok {
+			return fmt.Errorf("record %v without fields", t["name"])
+		}
+		seen := make(map[string]bool, len(fields))
+		for _, f := range fields {
+			field, ok := f.(map[string]any)
+			if !ok {
+				return fmt.Errorf("record %v: unexpected field %v", t["name"], f)
+			}
+			name, _ := field["name"].(string)
+			if name == "" || seen[name] {
+				return fmt.Errorf("record %v: invalid or duplicate field name %q", t["name"], name)
+			}
+			seen[name] = true
+			if _, ok := field["type"]; !ok {
+				return fmt.Errorf("record %v: field %s without type", t["name"], name)
+			}
+			if err := s.collect(field["type"], namespace); err != nil {
+				return err
+			}
+		}
+	case "enum":
+		if _, ok := t["symbols"].([]any); !ok {
+			return fmt.Errorf("enum %v without symbols", t["name"])
+		}
+	case "fixed":
+		if _, ok := t["size"].(float64); !ok {
+			return fmt.Errorf("fixed %v without size", t["name"])
+		}
+	case "array":
+		if _, ok := t["items"]; !ok {
+			return errors.New("array without items")
+		}
+		return s.collect(t["items"], namespace)
+	case "map":
+		if _, ok := t["values"]; !ok {
+			return errors.New("map without values")
+		}
+		return s.collect(t["values"], namespace)
+	}
+	// Other types are either primitives or references to named types, which
+	// are checked by checkRefs.
+	return nil
+}
+
+// checkRefs checks whether all references to named types are defined.
+func (s *avroSchema) checkRefs(t any, namespace string) error {
+	switch t := t.(type) {
+	case string:
+		if avroPrimitives[t] {
+			return nil
+		}
+		if s.lookup(t, namespace) == nil {
+			return fmt.Errorf("undefined type %s", t)
+		}
+	case []any:
+		for _, branch := range t {
+			if err := s.checkRefs(branch, namespace); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		if ns, ok := t["namespace"].(string); ok {
+			namespace = ns
+		}
+		if name, ok := t["name"].(string); ok && strings.Contains(name, ".") {
+			namespace = name[:strings.LastIndex(name, ".")]
+		}
+		typ, ok := t["type"].(string)
+		if !ok {
+			return s.checkRefs(t["type"], namespace)
+		}
+		switch typ {
+		case "record", "error":
+			for _, f := range t["fields"].([]any) {
+				if err := s.checkRefs(f.(map[string]any)["type"], namespace); err != nil {
+					return err
+				}
+			}
+		case "array":
+			return s.checkRefs(t["items"], namespace)
+		case "map":
+			return s.checkRefs(t["values"], namespace)
+		case "enum", "fixed":
+		default:
+			return s.checkRefs(typ, namespace)
+		}
+	}
+	return nil
+}
+
+func (s *avroSchema) lookup(name, namespace string) map[string]any {
+	if t, ok := s.names[avroFullName(name, namespace)]; ok {
+		return t
+	}
+	return s.names[name]
+}
+
+// avroType is a schema with its enclosing namespace, which is necessary to
+// resolve references.
+type avroType struct {
+	schema    *avroSchema
+	t         any
+	namespace string
+}
+
+// unwrap returns the nested schema if the type is an object whose type is a
+// schema rather than a name, e.g., {"type": ["null", "string"]}.
+func (at avroType) unwrap() avroType {
+	for {
+		t, ok := at.t.(map[string]any)
+		if !ok {
+			return at
+		}
+		if _, ok := t["type"].(string); ok {
+			return at
+		}
+		at.t = t["type"]
+	}
+}
+
+// resolve dereferences named types and normalizes primitives into their
+// object form.
+func (at avroType) resolve() (map[string]any, string) {
+	switch t := at.t.(type) {
+	case string:
+		if avroPrimitives[t] {
+			return map[string]any{"type": t}, at.namespace
+		}
+		named := at.schema.lookup(t, at.namespace)
+		fullName := avroFullName(t, at.namespace)
+		if at.schema.names[fullName] == nil {
+			fullName = t
+		}
+		ns := ""
+		if i := strings.LastIndex(fullName, "."); i >= 0 {
+			ns = fullName[:i]
+		}
+		return named, ns
+	case map[string]any:
+		ns := at.namespace
+		if v, ok := t["namespace"].(string); ok {
+			ns = v
+		}
+		if name, ok := t["name"].(string); ok && strings.Contains(name, ".") {
+			ns = name[:strings.LastIndex(name, ".")]
+		}
+		if typ, ok := t["type"].(string); ok && !avroPrimitives[typ] {
+			switch typ {
+			case "record", "error", "enum", "fixed", "array", "map":
+			default:
+				// A type that refers to a named type, e.g., {"type": "Foo"}.
+				return avroType{schema: at.schema, t: typ, namespace: ns}.resolve()
+			}
+		}
+		return t, ns
+	}
+	return nil, at.namespace
+}
+
+func avroShortName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// avroReads checks whether data written with the writer schema can be read
+// with the reader schema according to the schema resolution of Avro.
+func avroReads(reader, writer *avroSchema) error {
+	r := &avroResolver{visited: make(map[[2]string]bool)}
+	return r.reads(avroType{schema: reader, t: reader.root}, avroType{schema: writer, t: writer.root}, "$")
+}
+
+type avroResolver struct {
+	// visited breaks cycles of recursive records.
+	visited map[[2]string]bool
+}
+
+func (r *avroResolver) reads(reader, writer avroType, path string) error {
+	reader, writer = reader.unwrap(), writer.unwrap()
+	if writerUnion, ok := writer.t.([]any); ok {
+		for _, branch := range writerUnion {
+			wt := avroType{schema: writer.schema, t: branch, namespace: writer.namespace}
+			if err := r.reads(reader, wt, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if readerUnion, ok := reader.t.([]any); ok {
+		for _, branch := range readerUnion {
+			rt := avroType{schema: reader.schema, t: branch, namespace: reader.namespace}
+			if r.reads(rt, writer, path) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: no branch of the union can read %v", path, writer.t)
+	}
+
+	rt, rns := reader.resolve()
+	wt, wns := writer.resolve()
+	readerType, _ := rt["type"].(string)
+	writerType, _ := wt["type"].(string)
+	if readerType != writerType {
+		for _, promotable := range avroPromotions[readerType] {
+			if promotable == writerType {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %s cannot be read as %s", path, writerType, readerType)
+	}
+
+	switch readerType {
+	case "record", "error":
+		readerName, _ := rt["name"].(string)
+		writerName, _ := wt["name"].(string)
+		if avroShortName(readerName) != avroShortName(writerName) {
+			return fmt.Errorf("%s: record %s cannot be read as %s", path, writerName, readerName)
+		}
+		key := [2]string{avroFullName(readerName, rns), avroFullName(writerName, wns)}
+		if r.visited[key] {
+			return nil
+		}
+		r.visited[key] = true
+
+		writerFields := make(map[string]map[string]any)
+		for _, f := range wt["fields"].([]any) {
+			field := f.(map[string]any)
+			writerFields[field["name"].(string)] = field
+		}
+		for _, f := range rt["fields"].([]any) {
+			field := f.(map[string]any)
+			name := field["name"].(string)
+			wf, ok := writerFields[name]
+			if !ok {
+				for _, alias := range jsonStrings(field["aliases"]) {
+					if wf, ok = writerFields[alias.(string)]; ok {
+						break
+					}
+				}
+			}
+			if !ok {
+				if _, ok := field["default"]; !ok {
+					return fmt.Errorf("%s: field %s without default is added", path, name)
+				}
+				continue
+			}
+			ft := avroType{schema: reader.schema, t: field["type"], namespace: rns}
+			wft := avroType{schema: writer.schema, t: wf["type"], namespace: wns}
+			if err := r.reads(ft, wft, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case "enum":
+		if _, ok := rt["default"]; ok {
+			return nil
+		}
+		readerSymbols, _ := rt["symbols"].([]any)
+		for _, symbol := range wt["symbols"].([]any) {
+			if !jsonContains(readerSymbols, symbol) {
+				return fmt.Errorf("%s: enum symbol %v is removed", path, symbol)
+			}
+		}
+	case "fixed":
+		if rt["size"] != wt["size"] {
+			return fmt.Errorf("%s: fixed size %v cannot be read as %v", path, wt["size"], rt["size"])
+		}
+	case "array":
+		return r.reads(avroType{schema: reader.schema, t: rt["items"], namespace: rns}, avroType{schema: writer.schema, t: wt["items"], namespace: wns}, path+"[]")
+	case "map":
+		return r.reads(avroType{schema: reader.schema, t: rt["values"], namespace: rns}, avroType{schema: writer.schema, t: wt["values"], namespace: wns}, path+"{}")
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// parseJSONSchema parses a JSON Schema, which should be a JSON object.
+func parseJSONSchema(definition []byte) (map[string]any, error) {
+	var s map[string]any
+	if err := json.Unmarshal(definition, &s); err != nil {
+		return nil, fmt.Errorf("json schema: %w", err)
+	}
+	if s == nil {
+		return nil, errors.New("json schema: not an object")
+	}
+	return s, nil
+}
+
+// jsonSchemaReads checks whether every document valid for the writer schema is
+// also valid for the reader schema. It understands only the keywords that
+// matter to the evolution of records: type, properties, required,
+// additionalProperties, items and enum. The argument path locates the schemas
+// in error messages.
+func jsonSchemaReads(reader, writer map[string]any, path string) error {
+	if err := jsonSchemaReadsType(reader, writer, path); err != nil {
+		return err
+	}
+
+	if readerEnum, ok := reader["enum"].([]any); ok {
+		writerEnum, ok := writer["enum"].([]any)
+		if !ok {
+			return fmt.Errorf("%s: enum is added", path)
+		}
+		for _, value := range writerEnum {
+			if !jsonContains(readerEnum, value) {
+				return fmt.Errorf("%s: enum value %v is removed", path, value)
+			}
+		}
+	}
+
+	readerProps, _ := reader["properties"].(map[string]any)
+	writerProps, _ := writer["properties"].(map[string]any)
+	for name, wp := range writerProps {
+		rp, ok := readerProps[name]
+		if !ok {
+			if additional, ok := reader["additionalProperties"].(bool); ok && !additional {
+				return fmt.Errorf("%s: property %q is removed while additional properties are not allowed", path, name)
+			}
+			continue
+		}
+		rps, rok := rp.(map[string]any)
+		wps, wok := wp.(map[string]any)
+		if rok && wok {
+			if err := jsonSchemaReads(rps, wps, path+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	writerRequired := jsonStrings(writer["required"])
+	for _, name := range jsonStrings(reader["required"]) {
+		if !jsonContains(writerRequired, name) {
+			return fmt.Errorf("%s: property %q is newly required", path, name)
+		}
+	}
+
+	readerItems, rok := reader["items"].(map[string]any)
+	writerItems, wok := writer["items"].(map[string]any)
+	if rok && wok {
+		if err := jsonSchemaReads(readerItems, writerItems, path+"[]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonSchemaReadsType checks whether the reader accepts all types of the
+// writer. A schema without type accepts any type, and a number accepts an
+// integer.
+func jsonSchemaReadsType(reader, writer map[string]any, path string) error {
+	readerTypes := jsonStrings(reader["type"])
+	if len(readerTypes) == 0 {
+		return nil
+	}
+	writerTypes := jsonStrings(writer["type"])
+	if len(writerTypes) == 0 {
+		return fmt.Errorf("%s: type is restricted to %v", path, readerTypes)
+	}
+	for _, typ := range writerTypes {
+		if jsonContains(readerTypes, typ) {
+			continue
+		}
+		if typ == "integer" && jsonContains(readerTypes, "number") {
+			continue
+		}
+		return fmt.Errorf("%s: type %v is not readable as %v", path, typ, readerTypes)
+	}
+	return nil
+}
+
+// jsonStrings returns a keyword that is either a string or an array of
+// strings as a slice.
+func jsonStrings(v any) []any {
+	switch v := v.(type) {
+	case string:
+		return []any{v}
+	case []any:
+		return v
+	default:
+		return nil
+	}
+}
+
+func jsonContains(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
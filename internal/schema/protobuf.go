@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protobufSchema has all messages of a Protobuf schema keyed by their full
+// names.
+type protobufSchema map[protoreflect.FullName]protoreflect.MessageDescriptor
+
+// parseProtobufSchema parses a Protobuf schema, which is a serialized
+// FileDescriptorSet. The set should contain all dependencies of its files.
+func parseProtobufSchema(definition []byte) (protobufSchema, error) {
+	var fds descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(definition, &fds); err != nil {
+		return nil, fmt.Errorf("protobuf schema: %w", err)
+	}
+	if len(fds.File) == 0 {
+		return nil, fmt.Errorf("protobuf schema: no file")
+	}
+	files, err := protodesc.NewFiles(&fds)
+	if err != nil {
+		return nil, fmt.Errorf("protobuf schema: %w", err)
+	}
+
+	messages := make(protobufSchema)
+	var collect func(protoreflect.MessageDescriptors)
+	collect = func(mds protoreflect.MessageDescriptors) {
+		for i := 0; i < mds.Len(); i++ {
+			md := mds.Get(i)
+			if md.IsMapEntry() {
+				continue
+			}
+			messages[md.FullName()] = md
+			collect(md.Messages())
+		}
+	}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		collect(fd.Messages())
+		return true
+	})
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("protobuf schema: no message")
+	}
+	return messages, nil
+}
+
+// protobufWireGroups groups kinds whose values are encoded identically on the
+// wire, and thus, can be read as each other.
+var protobufWireGroups = map[protoreflect.Kind]int{
+	protoreflect.Int32Kind:    1,
+	protoreflect.Int64Kind:    1,
+	protoreflect.Uint32Kind:   1,
+	protoreflect.Uint64Kind:   1,
+	protoreflect.BoolKind:     1,
+	protoreflect.EnumKind:     1,
+	protoreflect.Sint32Kind:   2,
+	protoreflect.Sint64Kind:   2,
+	protoreflect.Fixed32Kind:  3,
+	protoreflect.Sfixed32Kind: 3,
+	protoreflect.FloatKind:    4,
+	protoreflect.Fixed64Kind:  5,
+	protoreflect.Sfixed64Kind: 5,
+	protoreflect.DoubleKind:   6,
+	protoreflect.StringKind:   7,
+	protoreflect.BytesKind:    7,
+	protoreflect.MessageKind:  8,
+	protoreflect.GroupKind:    9,
+}
+
+// protobufReads checks whether messages written with the writer schema can be
+// read with the reader schema. Messages are matched by their full names, and
+// fields by their numbers since names are not encoded.
+func protobufReads(reader, writer protobufSchema) error {
+	for name, wmd := range writer {
+		rmd, ok := reader[name]
+		if !ok {
+			return fmt.Errorf("message %s is removed", name)
+		}
+		if err := protobufMessageReads(rmd, wmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func protobufMessageReads(reader, writer protoreflect.MessageDescriptor) error {
+	rfields, wfields := reader.Fields(), writer.Fields()
+	for i := 0; i < rfields.Len(); i++ {
+		rfd := rfields.Get(i)
+		wfd := wfields.ByNumber(rfd.Number())
+		if wfd == nil {
+			if rfd.Cardinality() == protoreflect.Required {
+				return fmt.Errorf("%s: required field %d is added", reader.FullName(), rfd.Number())
+			}
+			continue
+		}
+		if err := protobufFieldReads(rfd, wfd); err != nil {
+			return fmt.Errorf("%s: field %d: %w", reader.FullName(), rfd.Number(), err)
+		}
+	}
+	return nil
+}
+
+func protobufFieldReads(reader, writer protoreflect.FieldDescriptor) error {
+	if reader.IsMap() != writer.IsMap() {
+		return fmt.Errorf("map and non-map are not interchangeable")
+	}
+	if reader.IsList() != writer.IsList() {
+		return fmt.Errorf("repeated and singular are not interchangeable")
+	}
+	if reader.IsMap() {
+		if err := protobufFieldReads(reader.MapKey(), writer.MapKey()); err != nil {
+			return fmt.Errorf("map key: %w", err)
+		}
+		if err := protobufFieldReads(reader.MapValue(), writer.MapValue()); err != nil {
+			return fmt.Errorf("map value: %w", err)
+		}
+		return nil
+	}
+	if protobufWireGroups[reader.Kind()] != protobufWireGroups[writer.Kind()] {
+		return fmt.Errorf("%s cannot be read as %s", writer.Kind(), reader.Kind())
+	}
+	switch reader.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if writer.Kind() == reader.Kind() && reader.Message().FullName() != writer.Message().FullName() {
+			return fmt.Errorf("message %s cannot be read as %s", writer.Message().FullName(), reader.Message().FullName())
+		}
+	case protoreflect.EnumKind:
+		if writer.Kind() == protoreflect.EnumKind && reader.Enum().FullName() != writer.Enum().FullName() {
+			return fmt.Errorf("enum %s cannot be read as %s", writer.Enum().FullName(), reader.Enum().FullName())
+		}
+	}
+	return nil
+}
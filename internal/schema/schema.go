@@ -0,0 +1,91 @@
+// Package schema validates schemas registered to topics and checks the
+// compatibility between their versions.
+package schema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// ErrIncompatible is returned by CheckCompatibility if the new version of a
+// schema violates the compatibility.
+var ErrIncompatible = errors.New("schema: incompatible")
+
+// Validate checks whether the definition is a well-formed schema of the given
+// type.
+func Validate(typ varlogpb.SchemaType, definition []byte) error {
+	_, err := parse(typ, definition)
+	return err
+}
+
+// CheckCompatibility checks whether the new version of a schema, next, is
+// compatible with the latest one according to the argument compat. Schemas of
+// different types are compatible only if compat is
+// varlogpb.SchemaCompatibilityNone. It returns an error wrapping
+// ErrIncompatible if the check fails.
+func CheckCompatibility(compat varlogpb.SchemaCompatibility, latest, next *varlogpb.SchemaDescriptor) error {
+	switch compat {
+	case varlogpb.SchemaCompatibilityNone:
+		return nil
+	case varlogpb.SchemaCompatibilityBackward, varlogpb.SchemaCompatibilityForward, varlogpb.SchemaCompatibilityFull:
+	default:
+		return fmt.Errorf("schema: unknown compatibility %v", compat)
+	}
+
+	if latest.Type != next.Type {
+		return fmt.Errorf("%w: type %v cannot be changed to %v", ErrIncompatible, latest.Type, next.Type)
+	}
+	latestSchema, err := parse(latest.Type, latest.Definition)
+	if err != nil {
+		return err
+	}
+	nextSchema, err := parse(next.Type, next.Definition)
+	if err != nil {
+		return err
+	}
+
+	if compat == varlogpb.SchemaCompatibilityBackward || compat == varlogpb.SchemaCompatibilityFull {
+		if err := reads(next.Type, nextSchema, latestSchema); err != nil {
+			return fmt.Errorf("%w: version %d cannot read data written with version %d: %w", ErrIncompatible, latest.Version+1, latest.Version, err)
+		}
+	}
+	if compat == varlogpb.SchemaCompatibilityForward || compat == varlogpb.SchemaCompatibilityFull {
+		if err := reads(next.Type, latestSchema, nextSchema); err != nil {
+			return fmt.Errorf("%w: version %d cannot read data written with version %d: %w", ErrIncompatible, latest.Version, latest.Version+1, err)
+		}
+	}
+	return nil
+}
+
+func parse(typ varlogpb.SchemaType, definition []byte) (any, error) {
+	if len(definition) == 0 {
+		return nil, errors.New("schema: empty definition")
+	}
+	switch typ {
+	case varlogpb.SchemaTypeProtobuf:
+		return parseProtobufSchema(definition)
+	case varlogpb.SchemaTypeJSON:
+		return parseJSONSchema(definition)
+	case varlogpb.SchemaTypeAvro:
+		return parseAvroSchema(definition)
+	default:
+		return nil, fmt.Errorf("schema: unknown type %v", typ)
+	}
+}
+
+// reads checks whether data written with the writer schema can be read with
+// the reader schema. Both schemas should be of the given type.
+func reads(typ varlogpb.SchemaType, reader, writer any) error {
+	switch typ {
+	case varlogpb.SchemaTypeProtobuf:
+		return protobufReads(reader.(protobufSchema), writer.(protobufSchema))
+	case varlogpb.SchemaTypeJSON:
+		return jsonSchemaReads(reader.(map[string]any), writer.(map[string]any), "$")
+	case varlogpb.SchemaTypeAvro:
+		return avroReads(reader.(*avroSchema), writer.(*avroSchema))
+	default:
+		return fmt.Errorf("schema: unknown type %v", typ)
+	}
+}
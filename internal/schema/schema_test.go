@@ -0,0 +1,260 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func protobufDefinition(t *testing.T, fields ...*descriptorpb.FieldDescriptorProto) []byte {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("test.proto"),
+			Package: proto.String("test"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name:  proto.String("Event"),
+				Field: fields,
+			}},
+		}},
+	}
+	definition, err := proto.Marshal(fds)
+	require.NoError(t, err)
+	return definition
+}
+
+func protobufField(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     typ.Enum(),
+		Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tcs := []struct {
+		name       string
+		typ        varlogpb.SchemaType
+		definition []byte
+		wantErr    bool
+	}{
+		{
+			name:       "UnknownType",
+			typ:        varlogpb.SchemaTypeUnspecified,
+			definition: []byte(`{}`),
+			wantErr:    true,
+		},
+		{
+			name:    "EmptyDefinition",
+			typ:     varlogpb.SchemaTypeJSON,
+			wantErr: true,
+		},
+		{
+			name:       "JSON",
+			typ:        varlogpb.SchemaTypeJSON,
+			definition: []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}}`),
+		},
+		{
+			name:       "JSONNotObject",
+			typ:        varlogpb.SchemaTypeJSON,
+			definition: []byte(`[1, 2]`),
+			wantErr:    true,
+		},
+		{
+			name:       "Avro",
+			typ:        varlogpb.SchemaTypeAvro,
+			definition: []byte(`{"type": "record", "name": "Event", "namespace": "test", "fields": [{"name": "id", "type": "long"}, {"name": "next", "type": ["null", "Event"]}]}`),
+		},
+		{
+			name:       "AvroUndefinedType",
+			typ:        varlogpb.SchemaTypeAvro,
+			definition: []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "Unknown"}]}`),
+			wantErr:    true,
+		},
+		{
+			name:       "AvroRecordWithoutFields",
+			typ:        varlogpb.SchemaTypeAvro,
+			definition: []byte(`{"type": "record", "name": "Event"}`),
+			wantErr:    true,
+		},
+		{
+			name:       "Protobuf",
+			typ:        varlogpb.SchemaTypeProtobuf,
+			definition: protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+		},
+		{
+			name:       "ProtobufMalformed",
+			typ:        varlogpb.SchemaTypeProtobuf,
+			definition: []byte("malformed"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.typ, tc.definition)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	type result struct {
+		backward bool
+		forward  bool
+	}
+
+	tcs := []struct {
+		name   string
+		typ    varlogpb.SchemaType
+		latest []byte
+		next   []byte
+		want   result
+	}{
+		{
+			name:   "JSONOptionalPropertyAdded",
+			typ:    varlogpb.SchemaTypeJSON,
+			latest: []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}, "required": ["id"]}`),
+			next:   []byte(`{"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}, "required": ["id"]}`),
+			want:   result{backward: true, forward: true},
+		},
+		{
+			name:   "JSONRequiredPropertyAdded",
+			typ:    varlogpb.SchemaTypeJSON,
+			latest: []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}}`),
+			next:   []byte(`{"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}, "required": ["name"]}`),
+			want:   result{backward: false, forward: true},
+		},
+		{
+			name:   "JSONTypeWidened",
+			typ:    varlogpb.SchemaTypeJSON,
+			latest: []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}}`),
+			next:   []byte(`{"type": "object", "properties": {"id": {"type": "number"}}}`),
+			want:   result{backward: true, forward: false},
+		},
+		{
+			name:   "JSONEnumValueRemoved",
+			typ:    varlogpb.SchemaTypeJSON,
+			latest: []byte(`{"enum": ["a", "b"]}`),
+			next:   []byte(`{"enum": ["a"]}`),
+			want:   result{backward: false, forward: true},
+		},
+		{
+			name:   "AvroFieldWithDefaultAdded",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}]}`),
+			next:   []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}, {"name": "name", "type": "string", "default": ""}]}`),
+			want:   result{backward: true, forward: true},
+		},
+		{
+			name:   "AvroFieldWithoutDefaultAdded",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}]}`),
+			next:   []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}, {"name": "name", "type": "string"}]}`),
+			want:   result{backward: false, forward: true},
+		},
+		{
+			name:   "AvroPromotion",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "int"}]}`),
+			next:   []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}]}`),
+			want:   result{backward: true, forward: false},
+		},
+		{
+			name:   "AvroFieldRenamedWithAlias",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`{"type": "record", "name": "Event", "fields": [{"name": "id", "type": "long"}]}`),
+			next:   []byte(`{"type": "record", "name": "Event", "fields": [{"name": "key", "type": "long", "aliases": ["id"]}]}`),
+			want:   result{backward: true, forward: false},
+		},
+		{
+			name:   "AvroUnionBranchAdded",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`["null", "string"]`),
+			next:   []byte(`["null", "string", "long"]`),
+			want:   result{backward: true, forward: false},
+		},
+		{
+			name:   "AvroRecursiveRecord",
+			typ:    varlogpb.SchemaTypeAvro,
+			latest: []byte(`{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"]}]}`),
+			next:   []byte(`{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"]}, {"name": "value", "type": "long", "default": 0}]}`),
+			want:   result{backward: true, forward: true},
+		},
+		{
+			name:   "ProtobufFieldAdded",
+			typ:    varlogpb.SchemaTypeProtobuf,
+			latest: protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			next: protobufDefinition(t,
+				protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+				protobufField("name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			),
+			want: result{backward: true, forward: true},
+		},
+		{
+			name:   "ProtobufFieldRenamed",
+			typ:    varlogpb.SchemaTypeProtobuf,
+			latest: protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			next:   protobufDefinition(t, protobufField("key", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			want:   result{backward: true, forward: true},
+		},
+		{
+			name:   "ProtobufWireCompatibleTypeChanged",
+			typ:    varlogpb.SchemaTypeProtobuf,
+			latest: protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT32)),
+			next:   protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			want:   result{backward: true, forward: true},
+		},
+		{
+			name:   "ProtobufFieldTypeChanged",
+			typ:    varlogpb.SchemaTypeProtobuf,
+			latest: protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			next:   protobufDefinition(t, protobufField("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			want:   result{backward: false, forward: false},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			latest := &varlogpb.SchemaDescriptor{Version: 1, Type: tc.typ, Definition: tc.latest}
+			next := &varlogpb.SchemaDescriptor{Version: 2, Type: tc.typ, Definition: tc.next}
+
+			check := func(compat varlogpb.SchemaCompatibility, want bool) {
+				err := CheckCompatibility(compat, latest, next)
+				if want {
+					require.NoError(t, err)
+					return
+				}
+				require.ErrorIs(t, err, ErrIncompatible)
+			}
+			check(varlogpb.SchemaCompatibilityBackward, tc.want.backward)
+			check(varlogpb.SchemaCompatibilityForward, tc.want.forward)
+			check(varlogpb.SchemaCompatibilityFull, tc.want.backward && tc.want.forward)
+			check(varlogpb.SchemaCompatibilityNone, true)
+		})
+	}
+}
+
+func TestCheckCompatibility_TypeChanged(t *testing.T) {
+	latest := &varlogpb.SchemaDescriptor{Version: 1, Type: varlogpb.SchemaTypeJSON, Definition: []byte(`{}`)}
+	next := &varlogpb.SchemaDescriptor{Version: 2, Type: varlogpb.SchemaTypeAvro, Definition: []byte(`"string"`)}
+
+	err := CheckCompatibility(varlogpb.SchemaCompatibilityBackward, latest, next)
+	require.ErrorIs(t, err, ErrIncompatible)
+
+	err = CheckCompatibility(varlogpb.SchemaCompatibilityNone, latest, next)
+	require.NoError(t, err)
+}
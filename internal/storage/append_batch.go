@@ -3,10 +3,12 @@ package storage
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var appendBatchPool = sync.Pool{
@@ -15,6 +17,8 @@ var appendBatchPool = sync.Pool{
 			dk: make([]byte, dataKeyLength),
 			ck: make([]byte, commitKeyLength),
 			cc: make([]byte, commitContextLength),
+			tk: make([]byte, timeIndexKeyLength),
+			tv: make([]byte, timeIndexValueLength),
 		}
 	},
 }
@@ -27,6 +31,8 @@ type AppendBatch struct {
 	dk          []byte
 	ck          []byte
 	cc          []byte
+	tk          []byte
+	tv          []byte
 }
 
 func newAppendBatch(dataBatch, commitBatch *pebble.Batch, writeOpts *pebble.WriteOptions) *AppendBatch {
@@ -44,19 +50,26 @@ func (ab *AppendBatch) release() {
 	appendBatchPool.Put(ab)
 }
 
-// SetLogEntry inserts a log entry.
-func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte) error {
-	dk := encodeDataKeyInternal(llsn, ab.dk)
-	ck := encodeCommitKeyInternal(glsn, ab.ck)
-	if err := ab.dataBatch.Set(dk, data, nil); err != nil {
+// SetLogEntry inserts a log entry. The argument attrs can be nil if the log
+// entry has no attributes.
+func (ab *AppendBatch) SetLogEntry(llsn types.LLSN, glsn types.GLSN, data []byte, attrs *varlogpb.LogEntryAttributes) error {
+	if err := setDataInternal(ab.dataBatch, llsn, data, attrs, nil, 0); err != nil {
 		return err
 	}
+	dk := encodeDataKeyInternal(llsn, ab.dk)
+	ck := encodeCommitKeyInternal(glsn, ab.ck)
 	if err := ab.commitBatch.Set(ck, dk, nil); err != nil {
 		return err
 	}
 	return nil
 }
 
+// SetCommitTime inserts the commit time of the commit whose first log entry
+// has the argument llsn and glsn into the time index.
+func (ab *AppendBatch) SetCommitTime(commitTime time.Time, llsn types.LLSN, glsn types.GLSN) error {
+	return ab.commitBatch.Set(encodeTimeIndexKeyInternal(glsn, ab.tk), encodeTimeIndexValueInternal(commitTime, llsn, ab.tv), nil)
+}
+
 // SetCommitContext inserts a commit context.
 func (ab *AppendBatch) SetCommitContext(cc CommitContext) error {
 	return ab.commitBatch.Set(commitContextKey, encodeCommitContext(cc, ab.cc), nil)
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 
@@ -14,6 +15,8 @@ var commitBatchPool = sync.Pool{
 			cc: make([]byte, commitContextLength),
 			ck: make([]byte, commitKeyLength),
 			dk: make([]byte, dataKeyLength),
+			tk: make([]byte, timeIndexKeyLength),
+			tv: make([]byte, timeIndexValueLength),
 		}
 	},
 }
@@ -24,6 +27,8 @@ type CommitBatch struct {
 	cc        []byte
 	ck        []byte
 	dk        []byte
+	tk        []byte
+	tv        []byte
 }
 
 func newCommitBatch(batch *pebble.Batch, writeOpts *pebble.WriteOptions) *CommitBatch {
@@ -43,6 +48,13 @@ func (cb *CommitBatch) Set(llsn types.LLSN, glsn types.GLSN) error {
 	return cb.batch.Set(encodeCommitKeyInternal(glsn, cb.ck), encodeDataKeyInternal(llsn, cb.dk), nil)
 }
 
+// SetCommitTime records the commit time of the commit whose first log entry
+// has the argument llsn and glsn in the time index. It should be called once
+// for each commit that has log entries.
+func (cb *CommitBatch) SetCommitTime(commitTime time.Time, llsn types.LLSN, glsn types.GLSN) error {
+	return cb.batch.Set(encodeTimeIndexKeyInternal(glsn, cb.tk), encodeTimeIndexValueInternal(commitTime, llsn, cb.tv), nil)
+}
+
 func (cb *CommitBatch) Apply() error {
 	return cb.batch.Commit(cb.writeOpts)
 }
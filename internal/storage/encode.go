@@ -2,9 +2,15 @@ package storage
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
 	"unsafe"
 
+	"github.com/cockroachdb/pebble"
+
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 const (
@@ -12,10 +18,34 @@ const (
 	dataKeySentinelPrefix = byte(0x41)
 	dataKeyLength         = 9 // prefix(1) + LLSN(8)
 
+	// A data value starts with its type. The plain data value is followed by
+	// the data only, whereas the data value with attributes is followed by
+	// the length of encoded attributes as an unsigned varint, the encoded
+	// attributes and the data. The data value appended by an idempotent
+	// producer is followed by the producer ID, the sequence number and
+	// either the plain data value or the data value with attributes. The
+	// data value staged by a transaction is followed by the transaction ID
+	// and one of the other data values.
+	dataValueTypePlain           = byte(0x00)
+	dataValueTypeWithAttributes  = byte(0x01)
+	dataValueTypeWithProducer    = byte(0x02)
+	dataValueTypeWithTransaction = byte(0x03)
+	dataValueTypeLength          = 1
+	producerSequenceLength       = 16 // ProducerID(8) + Sequence(8)
+	transactionIDLength          = 8
+
 	commitKeyPrefix         = byte(0x80)
 	commitKeySentinelPrefix = byte(0x81)
 	commitKeyLength         = 9 // prefix(1) + GLSN(8)
 
+	// A time index key has the GLSN of the first log entry of a commit, and
+	// its value is the commit time in nanoseconds since the Unix epoch
+	// followed by the LLSN of the log entry.
+	timeIndexKeyPrefix         = byte(0xa0)
+	timeIndexKeySentinelPrefix = byte(0xa1)
+	timeIndexKeyLength         = 9  // prefix(1) + GLSN(8)
+	timeIndexValueLength       = 16 // CommitTime(8) + LLSN(8)
+
 	commitContextKeyMarker = byte(0xc0)
 	commitContextLength    = 40
 )
@@ -35,6 +65,131 @@ func decodeDataKey(k []byte) types.LLSN {
 	return types.LLSN(binary.BigEndian.Uint64(k[1:]))
 }
 
+// setDataInternal puts the data with its attributes into the batch. The
+// argument attrs can be nil if the log entry has no attributes, and the
+// argument producer can be nil if the log entry is not appended by an
+// idempotent producer. The argument transactionID is zero unless the log entry
+// is staged by a transaction. Since the value is encoded in place, it does not
+// allocate an intermediate buffer.
+func setDataInternal(batch *pebble.Batch, llsn types.LLSN, data []byte, attrs *varlogpb.LogEntryAttributes, producer *ProducerSequence, transactionID uint64) error {
+	prefixLen := 0
+	if transactionID != 0 {
+		prefixLen += dataValueTypeLength + transactionIDLength
+	}
+	if producer != nil {
+		prefixLen += dataValueTypeLength + producerSequenceLength
+	}
+
+	if attrs == nil || attrs.Empty() {
+		op := batch.SetDeferred(dataKeyLength, prefixLen+dataValueTypeLength+len(data))
+		encodeDataKeyInternal(llsn, op.Key)
+		value := encodeProducerSequence(encodeTransactionID(op.Value, transactionID), producer)
+		value[0] = dataValueTypePlain
+		copy(value[dataValueTypeLength:], data)
+		return op.Finish()
+	}
+
+	attrsSize := attrs.ProtoSize()
+	var sizeBuf [binary.MaxVarintLen64]byte
+	sizeLen := binary.PutUvarint(sizeBuf[:], uint64(attrsSize))
+	op := batch.SetDeferred(dataKeyLength, prefixLen+dataValueTypeLength+sizeLen+attrsSize+len(data))
+	encodeDataKeyInternal(llsn, op.Key)
+	value := encodeProducerSequence(encodeTransactionID(op.Value, transactionID), producer)
+	value[0] = dataValueTypeWithAttributes
+	offset := dataValueTypeLength
+	offset += copy(value[offset:], sizeBuf[:sizeLen])
+	if _, err := attrs.MarshalTo(value[offset : offset+attrsSize]); err != nil {
+		return err
+	}
+	offset += attrsSize
+	copy(value[offset:], data)
+	return op.Finish()
+}
+
+// encodeTransactionID writes the transaction ID at the front of the value if
+// it is not zero, and returns the rest of the value.
+func encodeTransactionID(value []byte, transactionID uint64) []byte {
+	if transactionID == 0 {
+		return value
+	}
+	value[0] = dataValueTypeWithTransaction
+	binary.BigEndian.PutUint64(value[dataValueTypeLength:], transactionID)
+	return value[dataValueTypeLength+transactionIDLength:]
+}
+
+// decodeTransactionID returns the transaction ID of a data value encoded by
+// setDataInternal and the rest of the value. The transaction ID is zero if
+// the log entry is not staged by a transaction.
+func decodeTransactionID(buf []byte) (transactionID uint64, rest []byte) {
+	if len(buf) < dataValueTypeLength+transactionIDLength || buf[0] != dataValueTypeWithTransaction {
+		return 0, buf
+	}
+	return binary.BigEndian.Uint64(buf[dataValueTypeLength:]), buf[dataValueTypeLength+transactionIDLength:]
+}
+
+// encodeProducerSequence writes the producer sequence at the front of the
+// value if it is not nil, and returns the rest of the value.
+func encodeProducerSequence(value []byte, producer *ProducerSequence) []byte {
+	if producer == nil {
+		return value
+	}
+	value[0] = dataValueTypeWithProducer
+	binary.BigEndian.PutUint64(value[dataValueTypeLength:], producer.ProducerID)
+	binary.BigEndian.PutUint64(value[dataValueTypeLength+8:], producer.Sequence)
+	return value[dataValueTypeLength+producerSequenceLength:]
+}
+
+// decodeProducerSequence returns the producer sequence of a data value
+// encoded by setDataInternal. The second return value is false if the log
+// entry is not appended by an idempotent producer.
+func decodeProducerSequence(buf []byte) (producer ProducerSequence, ok bool) {
+	_, buf = decodeTransactionID(buf)
+	if len(buf) < dataValueTypeLength+producerSequenceLength || buf[0] != dataValueTypeWithProducer {
+		return producer, false
+	}
+	producer.ProducerID = binary.BigEndian.Uint64(buf[dataValueTypeLength:])
+	producer.Sequence = binary.BigEndian.Uint64(buf[dataValueTypeLength+8:])
+	return producer, true
+}
+
+// decodeDataValue deserializes data and its attributes from a data value
+// encoded by setDataInternal. The returned data shares memory with the
+// argument buf, however, the attributes do not.
+func decodeDataValue(buf []byte) (data []byte, attrs varlogpb.LogEntryAttributes, err error) {
+	if len(buf) < dataValueTypeLength {
+		return nil, attrs, errors.New("storage: invalid data value")
+	}
+	switch buf[0] {
+	case dataValueTypePlain:
+		return buf[dataValueTypeLength:], attrs, nil
+	case dataValueTypeWithAttributes:
+		buf = buf[dataValueTypeLength:]
+		attrsSize, n := binary.Uvarint(buf)
+		if n <= 0 || uint64(len(buf)-n) < attrsSize {
+			return nil, attrs, errors.New("storage: invalid data value")
+		}
+		offset := n + int(attrsSize)
+		if err := attrs.Unmarshal(buf[n:offset]); err != nil {
+			return nil, attrs, err
+		}
+		return buf[offset:], attrs, nil
+	case dataValueTypeWithProducer:
+		prefixLen := dataValueTypeLength + producerSequenceLength
+		if len(buf) <= prefixLen || buf[prefixLen] == dataValueTypeWithProducer {
+			return nil, attrs, errors.New("storage: invalid data value")
+		}
+		return decodeDataValue(buf[prefixLen:])
+	case dataValueTypeWithTransaction:
+		prefixLen := dataValueTypeLength + transactionIDLength
+		if len(buf) <= prefixLen || buf[prefixLen] == dataValueTypeWithTransaction {
+			return nil, attrs, errors.New("storage: invalid data value")
+		}
+		return decodeDataValue(buf[prefixLen:])
+	default:
+		return nil, attrs, fmt.Errorf("storage: invalid data value type %d", buf[0])
+	}
+}
+
 func encodeCommitKeyInternal(glsn types.GLSN, key []byte) []byte {
 	key[0] = commitKeyPrefix
 	binary.BigEndian.PutUint64(key[1:], uint64(glsn))
@@ -113,3 +268,26 @@ func decodeCommitContextUnsafe(buf []byte) (cc CommitContext) {
 	cc = *(*CommitContext)(unsafe.Pointer(&buf[0]))
 	return cc
 }
+
+func encodeTimeIndexKeyInternal(glsn types.GLSN, key []byte) []byte {
+	key[0] = timeIndexKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], uint64(glsn))
+	return key
+}
+
+func encodeTimeIndexValueInternal(commitTime time.Time, llsn types.LLSN, value []byte) []byte {
+	binary.BigEndian.PutUint64(value[0:8], uint64(commitTime.UnixNano()))
+	binary.BigEndian.PutUint64(value[8:16], uint64(llsn))
+	return value
+}
+
+func decodeTimeIndex(k, v []byte) TimeIndexEntry {
+	if k[0] != timeIndexKeyPrefix || len(k) != timeIndexKeyLength || len(v) != timeIndexValueLength {
+		panic("storage: invalid time index")
+	}
+	return TimeIndexEntry{
+		CommitTime: time.Unix(0, int64(binary.BigEndian.Uint64(v[0:8]))),
+		LLSN:       types.LLSN(binary.BigEndian.Uint64(v[8:16])),
+		GLSN:       types.GLSN(binary.BigEndian.Uint64(k[1:])),
+	}
+}
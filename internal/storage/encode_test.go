@@ -3,7 +3,11 @@ package storage
 import (
 	"testing"
 
+	"github.com/cockroachdb/pebble"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 func TestEncodeCommitContextUnsafe(t *testing.T) {
@@ -19,6 +23,102 @@ func TestEncodeCommitContextUnsafe(t *testing.T) {
 	require.Equal(t, expected, actual)
 }
 
+func TestEncodeDataValue(t *testing.T) {
+	tcs := []struct {
+		name          string
+		data          []byte
+		attrs         *varlogpb.LogEntryAttributes
+		producer      *ProducerSequence
+		transactionID uint64
+	}{
+		{
+			name: "Plain",
+			data: []byte("data"),
+		},
+		{
+			name:  "EmptyAttributes",
+			data:  []byte("data"),
+			attrs: &varlogpb.LogEntryAttributes{},
+		},
+		{
+			name:  "KeyOnly",
+			data:  []byte("data"),
+			attrs: &varlogpb.LogEntryAttributes{Key: []byte("key")},
+		},
+		{
+			name: "NoData",
+			attrs: &varlogpb.LogEntryAttributes{
+				Headers: []varlogpb.LogEntryHeader{{Key: "foo", Value: []byte("bar")}},
+			},
+		},
+		{
+			name:     "PlainWithProducer",
+			data:     []byte("data"),
+			producer: &ProducerSequence{ProducerID: 1, Sequence: 2},
+		},
+		{
+			name:     "KeyWithProducer",
+			data:     []byte("data"),
+			attrs:    &varlogpb.LogEntryAttributes{Key: []byte("key")},
+			producer: &ProducerSequence{ProducerID: 1, Sequence: 2},
+		},
+		{
+			name:          "PlainInTransaction",
+			data:          []byte("data"),
+			transactionID: 3,
+		},
+		{
+			name:          "KeyWithProducerInTransaction",
+			data:          []byte("data"),
+			attrs:         &varlogpb.LogEntryAttributes{Key: []byte("key")},
+			producer:      &ProducerSequence{ProducerID: 1, Sequence: 2},
+			transactionID: 3,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			batch := new(pebble.Batch)
+			require.NoError(t, setDataInternal(batch, 10, tc.data, tc.attrs, tc.producer, tc.transactionID))
+
+			reader := batch.Reader()
+			kind, key, value, ok := reader.Next()
+			require.True(t, ok)
+			require.Equal(t, pebble.InternalKeyKindSet, kind)
+			require.Equal(t, types.LLSN(10), decodeDataKey(key))
+
+			data, attrs, err := decodeDataValue(value)
+			require.NoError(t, err)
+			require.Equal(t, string(tc.data), string(data))
+			if tc.attrs == nil {
+				require.True(t, attrs.Empty())
+			} else {
+				require.True(t, tc.attrs.Equal(attrs))
+			}
+
+			producer, ok := decodeProducerSequence(value)
+			require.Equal(t, tc.producer != nil, ok)
+			if tc.producer != nil {
+				require.Equal(t, *tc.producer, producer)
+			}
+
+			transactionID, _ := decodeTransactionID(value)
+			require.Equal(t, tc.transactionID, transactionID)
+		})
+	}
+
+	_, _, err := decodeDataValue(nil)
+	require.Error(t, err)
+	_, _, err = decodeDataValue([]byte{dataValueTypeWithAttributes, 0x10})
+	require.Error(t, err)
+	_, _, err = decodeDataValue([]byte{0xff})
+	require.Error(t, err)
+	_, _, err = decodeDataValue([]byte{dataValueTypeWithProducer, 0x01})
+	require.Error(t, err)
+	_, _, err = decodeDataValue([]byte{dataValueTypeWithTransaction, 0x01})
+	require.Error(t, err)
+}
+
 func BenchmarkCommitContext_Decode(b *testing.B) {
 	tcs := []struct {
 		name   string
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// ProducerSequence is the sequence number given to a log entry by an
+// idempotent producer.
+type ProducerSequence struct {
+	ProducerID uint64
+	Sequence   uint64
+}
+
+// LastProducerSequence is the sequence number of the last log entry appended
+// by an idempotent producer and its LLSN.
+type LastProducerSequence struct {
+	Sequence uint64
+	LLSN     types.LLSN
+}
+
+// ReadProducerSequences returns the last sequence number of each idempotent
+// producer among log entries whose LLSNs are in the range [begin, end). Both
+// committed and uncommitted log entries are read.
+func (s *Storage) ReadProducerSequences(begin, end types.LLSN) (map[uint64]LastProducerSequence, error) {
+	ret := make(map[uint64]LastProducerSequence)
+	if begin >= end {
+		return ret, nil
+	}
+
+	lower := make([]byte, dataKeyLength)
+	upper := make([]byte, dataKeyLength)
+	it := s.dataDB.NewIter(&pebble.IterOptions{
+		LowerBound: encodeDataKeyInternal(begin, lower),
+		UpperBound: encodeDataKeyInternal(end, upper),
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	for it.First(); it.Valid(); it.Next() {
+		producer, ok := decodeProducerSequence(it.Value())
+		if !ok {
+			continue
+		}
+		last, ok := ret[producer.ProducerID]
+		if ok && last.Sequence >= producer.Sequence {
+			continue
+		}
+		ret[producer.ProducerID] = LastProducerSequence{
+			Sequence: producer.Sequence,
+			LLSN:     decodeDataKey(it.Key()),
+		}
+	}
+	return ret, it.Error()
+}
@@ -75,23 +75,34 @@ func (s *Scanner) valueByGLSN() (le varlogpb.LogEntry, err error) {
 		}
 		return le, err
 	}
+	defer func() {
+		_ = closer.Close()
+	}()
 	le.GLSN = decodeCommitKey(ck)
 	le.LLSN = decodeDataKey(dk)
-	if len(data) > 0 {
-		le.Data = make([]byte, len(data))
-		copy(le.Data, data)
-	}
-	_ = closer.Close()
-	return le, nil
+	err = setLogEntryValue(&le, data)
+	return le, err
 }
 
 func (s *Scanner) valueByLLSN() (le varlogpb.LogEntry, err error) {
 	le.LLSN = decodeDataKey(s.it.Key())
-	if len(s.it.Value()) > 0 {
-		le.Data = make([]byte, len(s.it.Value()))
-		copy(le.Data, s.it.Value())
+	err = setLogEntryValue(&le, s.it.Value())
+	return le, err
+}
+
+// setLogEntryValue copies the data and the attributes decoded from the value
+// to the log entry.
+func setLogEntryValue(le *varlogpb.LogEntry, value []byte) error {
+	data, attrs, err := decodeDataValue(value)
+	if err != nil {
+		return err
+	}
+	le.LogEntryAttributes = attrs
+	if len(data) > 0 {
+		le.Data = make([]byte, len(data))
+		copy(le.Data, data)
 	}
-	return le, nil
+	return nil
 }
 
 func (s *Scanner) release() {
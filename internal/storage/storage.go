@@ -263,8 +263,8 @@ func (s *Storage) ReadCommitContext() (cc CommitContext, err error) {
 }
 
 // Trim deletes log entries whose GLSNs are less than or equal to the argument
-// glsn. Internally, it removes records for both data and commits but does not
-// remove the commit context.
+// glsn. Internally, it removes records for data, commits and the time index
+// but does not remove the commit context.
 // It returns the ErrNoLogEntry if there are no logs to delete.
 func (s *Storage) Trim(glsn types.GLSN) error {
 	lem, err := s.findLTE(glsn)
@@ -288,6 +288,11 @@ func (s *Storage) Trim(glsn types.GLSN) error {
 	ckEnd = encodeCommitKeyInternal(trimGLSN+1, ckEnd)
 	_ = commitBatch.DeleteRange(ckBegin, ckEnd, nil)
 
+	// time index
+	if err := s.trimTimeIndex(commitBatch, trimGLSN); err != nil {
+		return err
+	}
+
 	// data
 	dkBegin := make([]byte, dataKeyLength)
 	dkBegin = encodeDataKeyInternal(types.MinLLSN, dkBegin)
@@ -245,6 +245,256 @@ func TestStorage_WriteBatch(t *testing.T) {
 	})
 }
 
+func TestStorage_WriteBatchWithAttributes(t *testing.T) {
+	ts := time.Unix(1690000000, 0).UTC()
+	attrs := varlogpb.LogEntryAttributes{
+		Key:       []byte("key"),
+		Timestamp: &ts,
+		Headers: []varlogpb.LogEntryHeader{
+			{Key: "trace-id", Value: []byte("1234")},
+		},
+	}
+
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		wb := stg.NewWriteBatch()
+		require.NoError(t, wb.SetWithAttributes(1, []byte("1"), &attrs))
+		require.NoError(t, wb.SetWithAttributes(2, []byte("2"), nil))
+		require.NoError(t, wb.SetWithAttributes(3, []byte("3"), &varlogpb.LogEntryAttributes{}))
+		require.NoError(t, wb.Apply())
+		require.NoError(t, wb.Close())
+
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      3,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   4,
+			CommittedLLSNBegin: 1,
+		})
+		require.NoError(t, err)
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, cb.Set(types.LLSN(i), types.GLSN(i)))
+		}
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+
+		expected := []varlogpb.LogEntry{
+			{
+				LogEntryMeta:       varlogpb.LogEntryMeta{GLSN: 1, LLSN: 1},
+				Data:               []byte("1"),
+				LogEntryAttributes: attrs,
+			},
+			{
+				LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 2, LLSN: 2},
+				Data:         []byte("2"),
+			},
+			{
+				LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 3, LLSN: 3},
+				Data:         []byte("3"),
+			},
+		}
+
+		for _, opt := range []ScanOption{WithLLSN(1, 4), WithGLSN(1, 4)} {
+			scanner := stg.NewScanner(opt)
+			for i := range expected {
+				require.True(t, scanner.Valid())
+				le, err := scanner.Value()
+				require.NoError(t, err)
+				require.Equal(t, expected[i].LLSN, le.LLSN)
+				require.Equal(t, expected[i].Data, le.Data)
+				require.True(t, expected[i].LogEntryAttributes.Equal(le.LogEntryAttributes))
+				scanner.Next()
+			}
+			require.False(t, scanner.Valid())
+			require.NoError(t, scanner.Close())
+		}
+
+		le, err := stg.Read(AtGLSN(1))
+		require.NoError(t, err)
+		require.True(t, expected[0].Equal(le))
+
+		le, err = stg.Read(AtLLSN(1))
+		require.NoError(t, err)
+		require.True(t, expected[0].Equal(le))
+
+		require.NoError(t, stg.Trim(1))
+		_, err = stg.Read(AtGLSN(1))
+		require.ErrorIs(t, err, ErrNoLogEntry)
+		le, err = stg.Read(AtGLSN(2))
+		require.NoError(t, err)
+		require.True(t, expected[1].Equal(le))
+	})
+}
+
+func TestStorage_OverwriteLogEntryWithAttributes(t *testing.T) {
+	attrs := &varlogpb.LogEntryAttributes{Key: []byte("key")}
+
+	tcs := []struct {
+		name     string
+		oldAttrs *varlogpb.LogEntryAttributes
+		newAttrs *varlogpb.LogEntryAttributes
+	}{
+		{
+			name:     "PlainToAttributes",
+			oldAttrs: nil,
+			newAttrs: attrs,
+		},
+		{
+			name:     "AttributesToPlain",
+			oldAttrs: attrs,
+			newAttrs: nil,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			testStorage(t, func(t testing.TB, stg *Storage) {
+				// An uncommitted log entry is overwritten by the one having the
+				// same LLSN, for instance, after the log stream replica is
+				// sealed and unsealed.
+				for _, e := range []struct {
+					data  []byte
+					attrs *varlogpb.LogEntryAttributes
+				}{
+					{data: []byte("old"), attrs: tc.oldAttrs},
+					{data: []byte("new"), attrs: tc.newAttrs},
+				} {
+					wb := stg.NewWriteBatch()
+					require.NoError(t, wb.SetWithAttributes(1, e.data, e.attrs))
+					require.NoError(t, wb.Apply())
+					require.NoError(t, wb.Close())
+				}
+
+				cb, err := stg.NewCommitBatch(CommitContext{
+					Version:            1,
+					HighWatermark:      1,
+					CommittedGLSNBegin: 1,
+					CommittedGLSNEnd:   2,
+					CommittedLLSNBegin: 1,
+				})
+				require.NoError(t, err)
+				require.NoError(t, cb.Set(1, 1))
+				require.NoError(t, cb.Apply())
+				require.NoError(t, cb.Close())
+
+				var expectedAttrs varlogpb.LogEntryAttributes
+				if tc.newAttrs != nil {
+					expectedAttrs = *tc.newAttrs
+				}
+				for _, opt := range []ScanOption{WithLLSN(1, 2), WithGLSN(1, 2)} {
+					scanner := stg.NewScanner(opt)
+					require.True(t, scanner.Valid())
+					le, err := scanner.Value()
+					require.NoError(t, err)
+					require.Equal(t, []byte("new"), le.Data)
+					require.True(t, expectedAttrs.Equal(le.LogEntryAttributes))
+					require.False(t, scanner.Next())
+					require.NoError(t, scanner.Close())
+				}
+
+				for _, opt := range []ReadOption{AtGLSN(1), AtLLSN(1)} {
+					le, err := stg.Read(opt)
+					require.NoError(t, err)
+					require.Equal(t, []byte("new"), le.Data)
+					require.True(t, expectedAttrs.Equal(le.LogEntryAttributes))
+				}
+			})
+		})
+	}
+}
+
+func TestStorage_ReadProducerSequences(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		attrs := &varlogpb.LogEntryAttributes{Key: []byte("key")}
+
+		wb := stg.NewWriteBatch()
+		require.NoError(t, wb.SetWithProducerSequence(1, []byte("1"), nil, ProducerSequence{ProducerID: 1, Sequence: 1}))
+		require.NoError(t, wb.SetWithProducerSequence(2, []byte("2"), attrs, ProducerSequence{ProducerID: 2, Sequence: 10}))
+		require.NoError(t, wb.Set(3, []byte("3")))
+		require.NoError(t, wb.SetWithProducerSequence(4, []byte("4"), nil, ProducerSequence{ProducerID: 1, Sequence: 2}))
+		require.NoError(t, wb.Apply())
+		require.NoError(t, wb.Close())
+
+		lasts, err := stg.ReadProducerSequences(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, map[uint64]LastProducerSequence{
+			1: {Sequence: 2, LLSN: 4},
+			2: {Sequence: 10, LLSN: 2},
+		}, lasts)
+
+		lasts, err = stg.ReadProducerSequences(1, 4)
+		require.NoError(t, err)
+		require.Equal(t, map[uint64]LastProducerSequence{
+			1: {Sequence: 1, LLSN: 1},
+			2: {Sequence: 10, LLSN: 2},
+		}, lasts)
+
+		lasts, err = stg.ReadProducerSequences(3, 4)
+		require.NoError(t, err)
+		require.Empty(t, lasts)
+
+		lasts, err = stg.ReadProducerSequences(4, 4)
+		require.NoError(t, err)
+		require.Empty(t, lasts)
+
+		// Log entries appended by producers are read as usual.
+		cb, err := stg.NewCommitBatch(CommitContext{
+			Version:            1,
+			HighWatermark:      2,
+			CommittedGLSNBegin: 1,
+			CommittedGLSNEnd:   3,
+			CommittedLLSNBegin: 1,
+		})
+		require.NoError(t, err)
+		require.NoError(t, cb.Set(1, 1))
+		require.NoError(t, cb.Set(2, 2))
+		require.NoError(t, cb.Apply())
+		require.NoError(t, cb.Close())
+
+		le, err := stg.Read(AtGLSN(1))
+		require.NoError(t, err)
+		require.Equal(t, []byte("1"), le.Data)
+		le, err = stg.Read(AtLLSN(2))
+		require.NoError(t, err)
+		require.Equal(t, []byte("2"), le.Data)
+		require.True(t, attrs.Equal(le.LogEntryAttributes))
+	})
+}
+
+func TestStorage_ReadFirstStagedLLSN(t *testing.T) {
+	testStorage(t, func(t testing.TB, stg *Storage) {
+		wb := stg.NewWriteBatch()
+		require.NoError(t, wb.Set(1, []byte("1")))
+		require.NoError(t, wb.SetInTransaction(2, []byte("2"), nil, ProducerSequence{}, 1))
+		require.NoError(t, wb.SetInTransaction(3, []byte("3"), nil, ProducerSequence{ProducerID: 1, Sequence: 1}, 1))
+		require.NoError(t, wb.Set(4, []byte("4")))
+		require.NoError(t, wb.Apply())
+		require.NoError(t, wb.Close())
+
+		llsn, err := stg.ReadFirstStagedLLSN(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, types.LLSN(2), llsn)
+
+		llsn, err = stg.ReadFirstStagedLLSN(3, 5)
+		require.NoError(t, err)
+		require.Equal(t, types.LLSN(3), llsn)
+
+		llsn, err = stg.ReadFirstStagedLLSN(4, 5)
+		require.NoError(t, err)
+		require.Equal(t, types.InvalidLLSN, llsn)
+
+		llsn, err = stg.ReadFirstStagedLLSN(5, 5)
+		require.NoError(t, err)
+		require.Equal(t, types.InvalidLLSN, llsn)
+
+		lasts, err := stg.ReadProducerSequences(1, 5)
+		require.NoError(t, err)
+		require.Equal(t, map[uint64]LastProducerSequence{
+			1: {Sequence: 1, LLSN: 3},
+		}, lasts)
+	})
+}
+
 func TestStorage_EmptyWriteBatch(t *testing.T) {
 	testStorage(t, func(t testing.TB, stg *Storage) {
 		wb := stg.NewWriteBatch()
@@ -276,7 +526,7 @@ func TestStorage_AppendBatch(t *testing.T) {
 			name: "LogEntry",
 			testf: func(t testing.TB, stg *Storage) {
 				batch := stg.NewAppendBatch()
-				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one")))
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), nil))
 				require.NoError(t, batch.Apply())
 				require.NoError(t, batch.Close())
 
@@ -287,6 +537,23 @@ func TestStorage_AppendBatch(t *testing.T) {
 				require.Equal(t, []byte("one"), entry.Data)
 			},
 		},
+		{
+			name: "LogEntryWithAttributes",
+			testf: func(t testing.TB, stg *Storage) {
+				attrs := &varlogpb.LogEntryAttributes{Key: []byte("key")}
+				batch := stg.NewAppendBatch()
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), attrs))
+				require.NoError(t, batch.Apply())
+				require.NoError(t, batch.Close())
+
+				entry, err := stg.Read(AtGLSN(1))
+				require.NoError(t, err)
+				require.Equal(t, types.LLSN(1), entry.LLSN)
+				require.Equal(t, types.GLSN(1), entry.GLSN)
+				require.Equal(t, []byte("one"), entry.Data)
+				require.Equal(t, []byte("key"), entry.Key)
+			},
+		},
 		{
 			name: "CommitContext",
 			testf: func(t testing.TB, stg *Storage) {
@@ -304,7 +571,7 @@ func TestStorage_AppendBatch(t *testing.T) {
 			name: "Combined",
 			testf: func(t testing.TB, stg *Storage) {
 				batch := stg.NewAppendBatch()
-				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one")))
+				require.NoError(t, batch.SetLogEntry(1, 1, []byte("one"), nil))
 				require.NoError(t, batch.SetCommitContext(cc))
 				require.NoError(t, batch.Apply())
 				require.NoError(t, batch.Close())
@@ -941,3 +1208,138 @@ func TestStorage_Trim(t *testing.T) {
 		})
 	}
 }
+
+func TestStorage_TimeIndex(t *testing.T) {
+	base := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// Time: 1s      2s  3s          5s
+	// LLSN: 1  2    3   4  5  6     7
+	// GLSN: 1  2    5   10 11 12    20
+	commits := []struct {
+		commitTime time.Time
+		llsn       types.LLSN
+		glsn       types.GLSN
+		num        int
+	}{
+		{commitTime: base.Add(1 * time.Second), llsn: 1, glsn: 1, num: 2},
+		{commitTime: base.Add(2 * time.Second), llsn: 3, glsn: 5, num: 1},
+		{commitTime: base.Add(3 * time.Second), llsn: 4, glsn: 10, num: 3},
+		{commitTime: base.Add(5 * time.Second), llsn: 7, glsn: 20, num: 1},
+	}
+
+	setup := func(t testing.TB, stg *Storage) {
+		for i, commit := range commits {
+			wb := stg.NewWriteBatch()
+			for j := 0; j < commit.num; j++ {
+				require.NoError(t, wb.Set(commit.llsn+types.LLSN(j), nil))
+			}
+			require.NoError(t, wb.Apply())
+			require.NoError(t, wb.Close())
+
+			cb, err := stg.NewCommitBatch(CommitContext{
+				Version:            types.Version(i + 1),
+				HighWatermark:      commit.glsn + types.GLSN(commit.num) - 1,
+				CommittedGLSNBegin: commit.glsn,
+				CommittedGLSNEnd:   commit.glsn + types.GLSN(commit.num),
+				CommittedLLSNBegin: commit.llsn,
+			})
+			require.NoError(t, err)
+			for j := 0; j < commit.num; j++ {
+				require.NoError(t, cb.Set(commit.llsn+types.LLSN(j), commit.glsn+types.GLSN(j)))
+			}
+			require.NoError(t, cb.SetCommitTime(commit.commitTime, commit.llsn, commit.glsn))
+			require.NoError(t, cb.Apply())
+			require.NoError(t, cb.Close())
+		}
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		testStorage(t, func(t testing.TB, stg *Storage) {
+			_, err := stg.LookupTime(base)
+			require.ErrorIs(t, err, ErrNoLogEntry)
+
+			_, err = stg.ReadCommitTime(types.MaxGLSN)
+			require.ErrorIs(t, err, ErrNoLogEntry)
+		})
+	})
+
+	t.Run("LookupTime", func(t *testing.T) {
+		testStorage(t, func(t testing.TB, stg *Storage) {
+			setup(t, stg)
+
+			tcs := []struct {
+				time time.Time
+				llsn types.LLSN
+				glsn types.GLSN
+			}{
+				{time: base, llsn: 1, glsn: 1},
+				{time: base.Add(1 * time.Second), llsn: 1, glsn: 1},
+				{time: base.Add(1500 * time.Millisecond), llsn: 3, glsn: 5},
+				{time: base.Add(2 * time.Second), llsn: 3, glsn: 5},
+				{time: base.Add(2*time.Second + 1), llsn: 4, glsn: 10},
+				{time: base.Add(4 * time.Second), llsn: 7, glsn: 20},
+				{time: base.Add(5 * time.Second), llsn: 7, glsn: 20},
+			}
+			for _, tc := range tcs {
+				lem, err := stg.LookupTime(tc.time)
+				require.NoError(t, err)
+				require.Equal(t, tc.llsn, lem.LLSN, tc.time)
+				require.Equal(t, tc.glsn, lem.GLSN, tc.time)
+			}
+
+			_, err := stg.LookupTime(base.Add(6 * time.Second))
+			require.ErrorIs(t, err, ErrNoLogEntry)
+		})
+	})
+
+	t.Run("ReadCommitTime", func(t *testing.T) {
+		testStorage(t, func(t testing.TB, stg *Storage) {
+			setup(t, stg)
+
+			_, err := stg.ReadCommitTime(types.InvalidGLSN)
+			require.ErrorIs(t, err, ErrNoLogEntry)
+
+			tie, err := stg.ReadCommitTime(11)
+			require.NoError(t, err)
+			require.Equal(t, types.GLSN(10), tie.GLSN)
+			require.Equal(t, types.LLSN(4), tie.LLSN)
+			require.True(t, base.Add(3*time.Second).Equal(tie.CommitTime))
+
+			tie, err = stg.ReadCommitTime(types.MaxGLSN)
+			require.NoError(t, err)
+			require.Equal(t, types.GLSN(20), tie.GLSN)
+		})
+	})
+
+	t.Run("Trim", func(t *testing.T) {
+		testStorage(t, func(t testing.TB, stg *Storage) {
+			setup(t, stg)
+
+			// The commit at 1s has the remaining log entry.
+			require.NoError(t, stg.Trim(1))
+			lem, err := stg.LookupTime(base)
+			require.NoError(t, err)
+			require.Equal(t, types.LLSN(2), lem.LLSN)
+			require.Equal(t, types.GLSN(2), lem.GLSN)
+
+			// The commit at 1s has no remaining log entry.
+			require.NoError(t, stg.Trim(2))
+			lem, err = stg.LookupTime(base)
+			require.NoError(t, err)
+			require.Equal(t, types.GLSN(5), lem.GLSN)
+
+			require.NoError(t, stg.Trim(11))
+			lem, err = stg.LookupTime(base)
+			require.NoError(t, err)
+			require.Equal(t, types.LLSN(6), lem.LLSN)
+			require.Equal(t, types.GLSN(12), lem.GLSN)
+			tie, err := stg.ReadCommitTime(12)
+			require.NoError(t, err)
+			require.True(t, base.Add(3*time.Second).Equal(tie.CommitTime))
+
+			require.NoError(t, stg.Trim(20))
+			_, err = stg.LookupTime(base)
+			require.ErrorIs(t, err, ErrNoLogEntry)
+		})
+	})
+}
@@ -44,7 +44,7 @@ func TestWriteLogEntry(tb testing.TB, stg *Storage, llsn types.LLSN, data []byte
 // context.
 func TestAppendLogEntryWithoutCommitContext(tb testing.TB, stg *Storage, llsn types.LLSN, glsn types.GLSN, data []byte) {
 	batch := stg.NewAppendBatch()
-	require.NoError(tb, batch.SetLogEntry(llsn, glsn, data))
+	require.NoError(tb, batch.SetLogEntry(llsn, glsn, data, nil))
 	require.NoError(tb, batch.Apply())
 	require.NoError(tb, batch.Close())
 }
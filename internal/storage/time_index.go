@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// TimeIndexEntry is an entry of the time index. The time index has an entry
+// for each commit that has log entries rather than each log entry; thus, it
+// is sparse. The entry points to the first log entry of the commit.
+type TimeIndexEntry struct {
+	CommitTime time.Time
+	LLSN       types.LLSN
+	GLSN       types.GLSN
+}
+
+func (s *Storage) newTimeIndexIter() *pebble.Iterator {
+	return s.commitDB.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{timeIndexKeyPrefix},
+		UpperBound: []byte{timeIndexKeySentinelPrefix},
+	})
+}
+
+// LookupTime returns the first log entry committed at or after the argument
+// t. Since the time index has an entry for each commit, the result is the
+// first log entry of the commit. It returns ErrNoLogEntry if there is no such
+// log entry.
+//
+// Commit times are issued by the metadata repository and increase along with
+// GLSNs. LookupTime relies on it to find the result by binary search over
+// GLSNs.
+func (s *Storage) LookupTime(t time.Time) (lem varlogpb.LogEntryMeta, err error) {
+	it := s.newTimeIndexIter()
+	defer func() {
+		_ = it.Close()
+	}()
+
+	if !it.Last() {
+		return lem, ErrNoLogEntry
+	}
+	found := decodeTimeIndex(it.Key(), it.Value())
+	if found.CommitTime.Before(t) {
+		return lem, ErrNoLogEntry
+	}
+	_ = it.First()
+	lo := decodeTimeIndex(it.Key(), it.Value())
+	if !lo.CommitTime.Before(t) {
+		lem.GLSN = lo.GLSN
+		lem.LLSN = lo.LLSN
+		return lem, nil
+	}
+
+	// The commit time of lo is before t, whereas that of found is not. Entries
+	// between them, if any, are in the GLSN range (lo.GLSN, hi).
+	key := make([]byte, timeIndexKeyLength)
+	hi := found.GLSN
+	for lo.GLSN+1 < hi {
+		mid := lo.GLSN + (hi-lo.GLSN)/2
+		if !it.SeekGE(encodeTimeIndexKeyInternal(mid, key)) {
+			break
+		}
+		e := decodeTimeIndex(it.Key(), it.Value())
+		switch {
+		case e.GLSN >= hi:
+			hi = mid
+		case e.CommitTime.Before(t):
+			lo = e
+		default:
+			found = e
+			hi = e.GLSN
+		}
+	}
+	lem.GLSN = found.GLSN
+	lem.LLSN = found.LLSN
+	return lem, nil
+}
+
+// ReadCommitTime returns the entry of the time index for the commit that
+// includes the log entry at the argument glsn, that is, the last entry whose
+// GLSN is less than or equal to the argument glsn. It returns ErrNoLogEntry if
+// there is no such entry.
+func (s *Storage) ReadCommitTime(glsn types.GLSN) (TimeIndexEntry, error) {
+	it := s.newTimeIndexIter()
+	defer func() {
+		_ = it.Close()
+	}()
+	return readCommitTime(it, glsn)
+}
+
+func readCommitTime(it *pebble.Iterator, glsn types.GLSN) (TimeIndexEntry, error) {
+	var valid bool
+	if glsn < types.MaxGLSN {
+		valid = it.SeekLT(encodeTimeIndexKeyInternal(glsn+1, make([]byte, timeIndexKeyLength)))
+	} else {
+		valid = it.Last()
+	}
+	if !valid {
+		return TimeIndexEntry{}, ErrNoLogEntry
+	}
+	return decodeTimeIndex(it.Key(), it.Value()), nil
+}
+
+// trimTimeIndex deletes entries of the time index for log entries whose GLSNs
+// are less than or equal to the argument trimGLSN. If the commit including the
+// log entry at the trimGLSN has remaining log entries, its entry moves to the
+// first remaining log entry.
+func (s *Storage) trimTimeIndex(batch *pebble.Batch, trimGLSN types.GLSN) error {
+	it := s.newTimeIndexIter()
+	defer func() {
+		_ = it.Close()
+	}()
+
+	tie, err := readCommitTime(it, trimGLSN)
+	if err == ErrNoLogEntry {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	begin := encodeTimeIndexKeyInternal(types.MinGLSN, make([]byte, timeIndexKeyLength))
+	end := encodeTimeIndexKeyInternal(trimGLSN+1, make([]byte, timeIndexKeyLength))
+	if err := batch.DeleteRange(begin, end, nil); err != nil {
+		return err
+	}
+
+	cit := s.commitDB.NewIter(&pebble.IterOptions{
+		LowerBound: encodeCommitKeyInternal(trimGLSN+1, make([]byte, commitKeyLength)),
+		UpperBound: []byte{commitKeySentinelPrefix},
+	})
+	defer func() {
+		_ = cit.Close()
+	}()
+	if !cit.First() {
+		return nil
+	}
+	nextGLSN := decodeCommitKey(cit.Key())
+	nextLLSN := decodeDataKey(cit.Value())
+	if it.SeekGE(end) && decodeTimeIndex(it.Key(), it.Value()).GLSN <= nextGLSN {
+		// The next log entry belongs to another commit.
+		return nil
+	}
+	return batch.Set(
+		encodeTimeIndexKeyInternal(nextGLSN, make([]byte, timeIndexKeyLength)),
+		encodeTimeIndexValueInternal(tie.CommitTime, nextLLSN, make([]byte, timeIndexValueLength)),
+		nil,
+	)
+}
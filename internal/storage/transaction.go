@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// ReadFirstStagedLLSN returns the LLSN of the first log entry staged by a
+// transaction among log entries whose LLSNs are in the range [begin, end). It
+// returns types.InvalidLLSN if there is no such log entry.
+func (s *Storage) ReadFirstStagedLLSN(begin, end types.LLSN) (types.LLSN, error) {
+	if begin >= end {
+		return types.InvalidLLSN, nil
+	}
+
+	lower := make([]byte, dataKeyLength)
+	upper := make([]byte, dataKeyLength)
+	it := s.dataDB.NewIter(&pebble.IterOptions{
+		LowerBound: encodeDataKeyInternal(begin, lower),
+		UpperBound: encodeDataKeyInternal(end, upper),
+	})
+	defer func() {
+		_ = it.Close()
+	}()
+
+	for it.First(); it.Valid(); it.Next() {
+		if transactionID, _ := decodeTransactionID(it.Value()); transactionID != 0 {
+			return decodeDataKey(it.Key()), nil
+		}
+	}
+	return types.InvalidLLSN, it.Error()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cockroachdb/pebble"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var writeBatchPool = sync.Pool{
@@ -58,7 +59,31 @@ func (wb *WriteBatch) Deferred(poolIdx int) *DeferredWriteBatch {
 
 // Set writes the given LLSN and data to the batch.
 func (wb *WriteBatch) Set(llsn types.LLSN, data []byte) error {
-	return wb.batch.Set(encodeDataKeyInternal(llsn, wb.dk), data, nil)
+	return setDataInternal(wb.batch, llsn, data, nil, nil, 0)
+}
+
+// SetWithAttributes writes the given LLSN, data and its attributes to the
+// batch. If the attributes are nil or empty, it is the same as Set.
+func (wb *WriteBatch) SetWithAttributes(llsn types.LLSN, data []byte, attrs *varlogpb.LogEntryAttributes) error {
+	return setDataInternal(wb.batch, llsn, data, attrs, nil, 0)
+}
+
+// SetWithProducerSequence writes the given LLSN, data, its attributes and
+// the sequence number given by an idempotent producer to the batch. The
+// attributes can be nil.
+func (wb *WriteBatch) SetWithProducerSequence(llsn types.LLSN, data []byte, attrs *varlogpb.LogEntryAttributes, producer ProducerSequence) error {
+	return setDataInternal(wb.batch, llsn, data, attrs, &producer, 0)
+}
+
+// SetInTransaction writes the given LLSN, data and its attributes staged by
+// the transaction to the batch. The attributes can be nil, and the producer
+// can be zero if the log entry is not appended by an idempotent producer.
+func (wb *WriteBatch) SetInTransaction(llsn types.LLSN, data []byte, attrs *varlogpb.LogEntryAttributes, producer ProducerSequence, transactionID uint64) error {
+	var ps *ProducerSequence
+	if producer.ProducerID != 0 {
+		ps = &producer
+	}
+	return setDataInternal(wb.batch, llsn, data, attrs, ps, transactionID)
 }
 
 // SetDeferred writes the given LLSN and data to the batch.
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/kakao/varlog/internal/compress"
 	"github.com/kakao/varlog/pkg/rpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
@@ -14,7 +16,10 @@ import (
 
 type SubscribeResult struct {
 	varlogpb.LogEntry
-	Error error
+	// Filtered is true if the log entry does not match the filter of the
+	// subscription. Such a log entry has only its metadata.
+	Filtered bool
+	Error    error
 }
 
 var InvalidSubscribeResult = SubscribeResult{
@@ -40,8 +45,48 @@ func (c *LogClient) reset(rpcConn *rpc.Conn, _ types.ClusterID, target varlogpb.
 
 // Append stores data to the log stream specified with the topicID and the logStreamID.
 // The backup indicates the storage nodes that have backup replicas of that log stream.
+// The optional argument attrs should be either empty or as many as data.
 // It returns valid GLSN if the append completes successfully.
-func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte) ([]snpb.AppendResult, error) {
+func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, attrs ...varlogpb.LogEntryAttributes) ([]snpb.AppendResult, error) {
+	return c.append(ctx, &snpb.AppendRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Payload:     data,
+		Attributes:  attrs,
+	})
+}
+
+// AppendWithProducer is the same as Append, but it attaches the producer ID
+// and the sequence number of the first log entry to the request. The log
+// stream rejects the batch with the error code AlreadyExists if any of its
+// sequence numbers have already been appended by the producer.
+func (c *LogClient) AppendWithProducer(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, producerID, sequence uint64, data [][]byte, attrs ...varlogpb.LogEntryAttributes) ([]snpb.AppendResult, error) {
+	return c.append(ctx, &snpb.AppendRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Payload:     data,
+		Attributes:  attrs,
+		ProducerID:  producerID,
+		Sequence:    sequence,
+	})
+}
+
+// AppendInTransaction is the same as Append, but it stages the log entries in
+// the transaction identified by the argument transactionID. The log entries
+// become visible once the metadata repository commits the transaction. The
+// log stream rejects the batch with the error code Aborted if it has staged
+// another transaction.
+func (c *LogClient) AppendInTransaction(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, transactionID uint64, data [][]byte, attrs ...varlogpb.LogEntryAttributes) ([]snpb.AppendResult, error) {
+	return c.append(ctx, &snpb.AppendRequest{
+		TopicID:       tpid,
+		LogStreamID:   lsid,
+		Payload:       data,
+		Attributes:    attrs,
+		TransactionID: transactionID,
+	})
+}
+
+func (c *LogClient) append(ctx context.Context, req *snpb.AppendRequest) ([]snpb.AppendResult, error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -53,11 +98,6 @@ func (c *LogClient) Append(ctx context.Context, tpid types.TopicID, lsid types.L
 		_ = stream.CloseSend()
 	}()
 
-	req := &snpb.AppendRequest{
-		TopicID:     tpid,
-		LogStreamID: lsid,
-		Payload:     data,
-	}
 	err = stream.Send(req)
 	if err != nil {
 		return nil, err
@@ -77,6 +117,13 @@ func (c *LogClient) AppendStream(ctx context.Context) (snpb.LogIO_AppendClient,
 // Subscribe gets log entries continuously from the storage node. It guarantees that LLSNs of log
 // entries taken are sequential.
 func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN) (<-chan SubscribeResult, error) {
+	return c.SubscribeWithFilter(ctx, tpid, lsid, begin, end, nil)
+}
+
+// SubscribeWithFilter is the same as Subscribe, but the storage node strips
+// log entries that do not match the argument filter. They are delivered as
+// results whose Filtered is true so that the caller can advance its position.
+func (c *LogClient) SubscribeWithFilter(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN, filter *varlogpb.LogEntryFilter) (<-chan SubscribeResult, error) {
 	if begin >= end {
 		return nil, errors.New("logclient: invalid argument")
 	}
@@ -86,6 +133,7 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 		LogStreamID: lsid,
 		GLSNBegin:   begin,
 		GLSNEnd:     end,
+		Filter:      filter,
 	}
 	stream, err := c.rpcClient.Subscribe(ctx, req)
 	if err != nil {
@@ -109,6 +157,13 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 					},
 					Data: rsp.GetPayload(),
 				}
+				result.Filtered = rsp.GetFiltered()
+				if attrs := rsp.GetAttributes(); attrs != nil {
+					result.LogEntry.LogEntryAttributes = *attrs
+				}
+				if err = decompressLogEntry(&result.LogEntry); err != nil {
+					result = SubscribeResult{Error: err}
+				}
 			}
 			select {
 			case out <- result:
@@ -124,16 +179,44 @@ func (c *LogClient) Subscribe(ctx context.Context, tpid types.TopicID, lsid type
 }
 
 func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.LLSN) (<-chan SubscribeResult, error) {
+	return c.SubscribeToWithFilter(ctx, tpid, lsid, begin, end, nil)
+}
+
+// SubscribeToWithFilter is the same as SubscribeTo, but the storage node
+// strips log entries that do not match the argument filter as
+// SubscribeWithFilter does.
+func (c *LogClient) SubscribeToWithFilter(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.LLSN, filter *varlogpb.LogEntryFilter) (<-chan SubscribeResult, error) {
 	if begin >= end {
 		return nil, errors.New("logclient: invalid argument")
 	}
 
-	req := &snpb.SubscribeToRequest{
+	return c.subscribeTo(ctx, &snpb.SubscribeToRequest{
 		TopicID:     tpid,
 		LogStreamID: lsid,
 		LLSNBegin:   begin,
 		LLSNEnd:     end,
+		Filter:      filter,
+	})
+}
+
+// SubscribeToFrom is the same as SubscribeToWithFilter, but the storage node
+// decides the first LLSN to subscribe to by resolving the argument pos against
+// its commit context.
+func (c *LogClient) SubscribeToFrom(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, pos snpb.StartPosition, end types.LLSN, filter *varlogpb.LogEntryFilter) (<-chan SubscribeResult, error) {
+	if pos == snpb.StartPositionUnspecified || end.Invalid() {
+		return nil, errors.New("logclient: invalid argument")
 	}
+
+	return c.subscribeTo(ctx, &snpb.SubscribeToRequest{
+		TopicID:       tpid,
+		LogStreamID:   lsid,
+		LLSNEnd:       end,
+		Filter:        filter,
+		StartPosition: pos,
+	})
+}
+
+func (c *LogClient) subscribeTo(ctx context.Context, req *snpb.SubscribeToRequest) (<-chan SubscribeResult, error) {
 	stream, err := c.rpcClient.SubscribeTo(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
@@ -149,6 +232,10 @@ func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid ty
 			result := SubscribeResult{Error: err}
 			if err == nil {
 				result.LogEntry = rsp.LogEntry
+				result.Filtered = rsp.Filtered
+				if err = decompressLogEntry(&result.LogEntry); err != nil {
+					result = SubscribeResult{Error: err}
+				}
 			}
 			select {
 			case out <- result:
@@ -163,6 +250,84 @@ func (c *LogClient) SubscribeTo(ctx context.Context, tpid types.TopicID, lsid ty
 	return out, nil
 }
 
+// Read reads the committed log entry at the GLSN from the log stream.
+func (c *LogClient) Read(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	return c.read(ctx, &snpb.ReadRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		GLSN:        glsn,
+	})
+}
+
+// ReadWithLLSN reads the committed log entry at the LLSN from the log stream.
+func (c *LogClient) ReadWithLLSN(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, llsn types.LLSN) (varlogpb.LogEntry, error) {
+	return c.read(ctx, &snpb.ReadRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		LLSN:        llsn,
+	})
+}
+
+func (c *LogClient) read(ctx context.Context, req *snpb.ReadRequest) (varlogpb.LogEntry, error) {
+	rsp, err := c.rpcClient.Read(ctx, req)
+	if err != nil {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	if err := decompressLogEntry(&rsp.LogEntry); err != nil {
+		return varlogpb.InvalidLogEntry(), err
+	}
+	return rsp.LogEntry, nil
+}
+
+// ReadRange reads committed log entries in the range of GLSNs [begin, end)
+// from the log stream. The argument maxEntries limits the number of log
+// entries; if it is zero, the storage node decides the limit. It does not wait
+// for log entries to be committed; hence, the result can be shorter than the
+// range.
+func (c *LogClient) ReadRange(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.GLSN, maxEntries int32) ([]varlogpb.LogEntry, error) {
+	return c.readRange(ctx, &snpb.ReadRangeRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		GLSNBegin:   begin,
+		GLSNEnd:     end,
+		MaxEntries:  maxEntries,
+	})
+}
+
+// ReadRangeWithLLSN is similar to ReadRange except that it specifies the
+// range with LLSN.
+func (c *LogClient) ReadRangeWithLLSN(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, begin, end types.LLSN, maxEntries int32) ([]varlogpb.LogEntry, error) {
+	return c.readRange(ctx, &snpb.ReadRangeRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		LLSNBegin:   begin,
+		LLSNEnd:     end,
+		MaxEntries:  maxEntries,
+	})
+}
+
+func (c *LogClient) readRange(ctx context.Context, req *snpb.ReadRangeRequest) ([]varlogpb.LogEntry, error) {
+	rsp, err := c.rpcClient.ReadRange(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	for i := range rsp.LogEntries {
+		if err := decompressLogEntry(&rsp.LogEntries[i]); err != nil {
+			return nil, err
+		}
+	}
+	return rsp.LogEntries, nil
+}
+
+// decompressLogEntry decompresses the payload of the log entry stored
+// compressed so that callers always get the payload as it was appended.
+func decompressLogEntry(logEntry *varlogpb.LogEntry) error {
+	if err := compress.DecompressLogEntry(logEntry); err != nil {
+		return fmt.Errorf("logclient: %w", err)
+	}
+	return nil
+}
+
 // TrimDeprecated deletes log entries greater than or equal to given GLSN in
 // the storage node. The number of deleted log entries are returned.
 func (c *LogClient) TrimDeprecated(ctx context.Context, tpid types.TopicID, glsn types.GLSN) error {
@@ -187,6 +352,35 @@ func (c *LogClient) LogStreamReplicaMetadata(ctx context.Context, tpid types.Top
 	return rsp.LogStreamReplica, nil
 }
 
+// AbortTransaction discards the log entries of the transaction staged in the
+// log stream.
+func (c *LogClient) AbortTransaction(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, transactionID uint64) error {
+	_, err := c.rpcClient.AbortTransaction(ctx, &snpb.AbortTransactionRequest{
+		TopicID:       tpid,
+		LogStreamID:   lsid,
+		TransactionID: transactionID,
+	})
+	if err != nil {
+		return fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return nil
+}
+
+// LookupGLSNByTime finds the first log entry committed at or after the time
+// in the log stream. It returns an error wrapping verrors.ErrNoEntry if there
+// is no such log entry.
+func (c *LogClient) LookupGLSNByTime(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, t time.Time) (varlogpb.LogSequenceNumber, error) {
+	rsp, err := c.rpcClient.LookupGLSNByTime(ctx, &snpb.LookupGLSNByTimeRequest{
+		TopicID:     tpid,
+		LogStreamID: lsid,
+		Time:        t,
+	})
+	if err != nil {
+		return varlogpb.LogSequenceNumber{}, fmt.Errorf("logclient: %w", verrors.FromStatusError(err))
+	}
+	return varlogpb.LogSequenceNumber{LLSN: rsp.LLSN, GLSN: rsp.GLSN}, nil
+}
+
 // Target returns connected storage node.
 func (c *LogClient) Target() varlogpb.StorageNode {
 	return c.target
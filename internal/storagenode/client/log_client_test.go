@@ -91,8 +91,13 @@ func newMockStorageNodeServiceClient(ctrl *gomock.Controller, sn *storageNode, t
 			return nil, fmt.Errorf("no entry")
 		}
 		return &snpb.ReadResponse{
-			Payload: data,
-			GLSN:    req.GetGLSN(),
+			LogEntry: varlogpb.LogEntry{
+				LogEntryMeta: varlogpb.LogEntryMeta{
+					GLSN: req.GetGLSN(),
+					LLSN: sn.glsnToLLSN[req.GetGLSN()],
+				},
+				Data: data,
+			},
 		}, nil
 	}).AnyTimes()
 
@@ -183,6 +188,14 @@ func TestBasicOperations(t *testing.T) {
 		So(err, ShouldBeNil)
 		So(currGLSN, ShouldBeGreaterThan, prevGLSN)
 
+		le, err := client.Read(context.TODO(), topicID, logStreamID, currGLSN)
+		So(err, ShouldBeNil)
+		So(le.GLSN, ShouldEqual, currGLSN)
+		So(string(le.Data), ShouldEqual, "msg-2")
+
+		_, err = client.Read(context.TODO(), topicID, logStreamID, currGLSN+1)
+		So(err, ShouldNotBeNil)
+
 		ch, err := client.Subscribe(context.TODO(), topicID, logStreamID, types.GLSN(0), types.GLSN(10))
 		So(err, ShouldBeNil)
 		subRes := <-ch
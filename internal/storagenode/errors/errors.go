@@ -7,4 +7,8 @@ var (
 	ErrClosed          = stderrors.New("closed")
 	ErrTooManyReplicas = stderrors.New("too many log stream replicas")
 	ErrNotExist        = stderrors.New("not exist")
+	// ErrTransactionInProgress is returned when a transaction is appended to
+	// the log stream replica that has staged another transaction or the same
+	// one.
+	ErrTransactionInProgress = stderrors.New("transaction in progress")
 )
@@ -16,6 +16,7 @@ import (
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type logServer struct {
@@ -61,8 +62,12 @@ func (ls *logServer) Append(stream snpb.LogIO_AppendServer) error {
 	switch err {
 	case verrors.ErrSealed:
 		code = codes.FailedPrecondition
+	case verrors.ErrDuplicate:
+		code = codes.AlreadyExists
 	case snerrors.ErrNotPrimary:
 		code = codes.Unavailable
+	case snerrors.ErrTransactionInProgress:
+		code = codes.Aborted
 	default:
 		code = status.Code(err)
 		if code == codes.Unknown {
@@ -116,6 +121,11 @@ func (ls *logServer) appendStreamRecvLoop(stream snpb.LogIO_AppendServer, cq cha
 			goto Out
 		}
 
+		if err = req.ValidateAttributes(); err != nil {
+			err = status.Error(codes.InvalidArgument, err.Error())
+			goto Out
+		}
+
 		if lse == nil {
 			lse, loaded = ls.sn.executors.Load(tpid, lsid)
 			if !loaded {
@@ -124,7 +134,13 @@ func (ls *logServer) appendStreamRecvLoop(stream snpb.LogIO_AppendServer, cq cha
 			}
 		}
 
-		err = lse.AppendAsync(ctx, req.Payload, appendTask)
+		if req.ProducerID != 0 {
+			appendTask.SetProducerSequence(req.ProducerID, req.Sequence)
+		}
+		if req.TransactionID != 0 {
+			appendTask.SetTransactionID(req.TransactionID)
+		}
+		err = lse.AppendAsync(ctx, req.Payload, req.Attributes, appendTask)
 	Out:
 		if err != nil {
 			appendTask.SetError(err)
@@ -174,8 +190,101 @@ func (ls *logServer) appendStreamDrainCQLoop(cq <-chan *logstream.AppendTask) {
 	}
 }
 
-func (ls *logServer) Read(context.Context, *snpb.ReadRequest) (*snpb.ReadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "deprecated")
+// maxReadRangeEntries is the maximum number of log entries in a response of
+// ReadRange.
+const maxReadRangeEntries = 1024
+
+func (ls *logServer) Read(_ context.Context, req *snpb.ReadRequest) (*snpb.ReadResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := req.ValidatePosition(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	var (
+		le  varlogpb.LogEntry
+		err error
+	)
+	if !req.GLSN.Invalid() {
+		le, err = lse.ReadWithGLSN(req.GLSN)
+	} else {
+		le, err = lse.ReadWithLLSN(req.LLSN)
+	}
+	if err != nil {
+		return nil, verrors.ToStatusErrorWithCode(err, readErrorCode(err))
+	}
+	return &snpb.ReadResponse{LogEntry: le}, nil
+}
+
+func (ls *logServer) ReadRange(_ context.Context, req *snpb.ReadRangeRequest) (*snpb.ReadRangeResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := req.ValidateRange(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	maxEntries := int(req.MaxEntries)
+	if maxEntries == 0 || maxEntries > maxReadRangeEntries {
+		maxEntries = maxReadRangeEntries
+	}
+
+	var (
+		les []varlogpb.LogEntry
+		err error
+	)
+	if !req.GLSNEnd.Invalid() {
+		les, err = lse.ReadRangeWithGLSN(req.GLSNBegin, req.GLSNEnd, maxEntries)
+	} else {
+		les, err = lse.ReadRangeWithLLSN(req.LLSNBegin, req.LLSNEnd, maxEntries)
+	}
+	if err != nil {
+		return nil, verrors.ToStatusErrorWithCode(err, readErrorCode(err))
+	}
+	return &snpb.ReadRangeResponse{LogEntries: les}, nil
+}
+
+func (ls *logServer) LookupGLSNByTime(_ context.Context, req *snpb.LookupGLSNByTimeRequest) (*snpb.LookupGLSNByTimeResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	lsn, err := lse.LookupGLSNByTime(req.Time)
+	if err != nil {
+		return nil, verrors.ToStatusErrorWithCode(err, readErrorCode(err))
+	}
+	return &snpb.LookupGLSNByTimeResponse{GLSN: lsn.GLSN, LLSN: lsn.LLSN}, nil
+}
+
+func readErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, verrors.ErrClosed):
+		return codes.Unavailable
+	case errors.Is(err, verrors.ErrInvalid):
+		return codes.InvalidArgument
+	case errors.Is(err, verrors.ErrTrimmed):
+		return codes.OutOfRange
+	case errors.Is(err, verrors.ErrNoEntry):
+		return codes.NotFound
+	default:
+		return status.FromContextError(err).Code()
+	}
 }
 
 func (ls *logServer) Subscribe(req *snpb.SubscribeRequest, stream snpb.LogIO_SubscribeServer) error {
@@ -189,7 +298,7 @@ func (ls *logServer) Subscribe(req *snpb.SubscribeRequest, stream snpb.LogIO_Sub
 	}
 
 	ctx := stream.Context()
-	sr, err := lse.SubscribeWithGLSN(req.GLSNBegin, req.GLSNEnd)
+	sr, err := lse.SubscribeWithGLSN(req.GLSNBegin, req.GLSNEnd, logstream.WithFilter(req.Filter))
 	if err != nil {
 		var code codes.Code
 		if errors.Is(err, verrors.ErrClosed) {
@@ -218,6 +327,11 @@ Loop:
 			rsp.GLSN = le.GLSN
 			rsp.LLSN = le.LLSN
 			rsp.Payload = le.Data
+			rsp.Attributes = nil
+			if !le.LogEntryAttributes.Empty() {
+				rsp.Attributes = &le.LogEntryAttributes
+			}
+			rsp.Filtered = sr.Filtered(le)
 			err = stream.SendMsg(rsp)
 			if err != nil {
 				break Loop
@@ -246,8 +360,20 @@ func (ls *logServer) SubscribeTo(req *snpb.SubscribeToRequest, stream snpb.LogIO
 		return status.Error(codes.NotFound, "no such log stream")
 	}
 
+	begin := req.LLSNBegin
+	if req.StartPosition != snpb.StartPositionUnspecified {
+		begin, err = lse.ResolveStartLLSN(req.StartPosition)
+		if err != nil {
+			return verrors.ToStatusErrorWithCode(err, readErrorCode(err))
+		}
+		if begin >= req.LLSNEnd {
+			// Nothing to subscribe to in the range.
+			return nil
+		}
+	}
+
 	ctx := stream.Context()
-	sr, err := lse.SubscribeWithLLSN(req.LLSNBegin, req.LLSNEnd)
+	sr, err := lse.SubscribeWithLLSN(begin, req.LLSNEnd, logstream.WithFilter(req.Filter))
 	if err != nil {
 		var code codes.Code
 		if errors.Is(err, verrors.ErrClosed) {
@@ -274,6 +400,7 @@ Loop:
 				break Loop
 			}
 			rsp.LogEntry = le
+			rsp.Filtered = sr.Filtered(le)
 			err = stream.SendMsg(rsp)
 			if err != nil {
 				break Loop
@@ -313,3 +440,21 @@ func (ls *logServer) LogStreamReplicaMetadata(_ context.Context, req *snpb.LogSt
 	}
 	return &snpb.LogStreamReplicaMetadataResponse{LogStreamReplica: lsrmd}, nil
 }
+
+func (ls *logServer) AbortTransaction(ctx context.Context, req *snpb.AbortTransactionRequest) (*snpb.AbortTransactionResponse, error) {
+	if err := snpb.ValidateTopicLogStream(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lse, loaded := ls.sn.executors.Load(req.TopicID, req.LogStreamID)
+	if !loaded {
+		return nil, status.Error(codes.NotFound, "no such log stream")
+	}
+
+	if err := lse.AbortTransaction(ctx, req.TransactionID); err != nil {
+		if err == verrors.ErrClosed || err == snerrors.ErrNotPrimary {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Error(status.FromContextError(err).Code(), err.Error())
+	}
+	return &snpb.AbortTransactionResponse{}, nil
+}
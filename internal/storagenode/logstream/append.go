@@ -12,6 +12,7 @@ import (
 	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 var appendTaskPool = sync.Pool{
@@ -27,6 +28,10 @@ type AppendTask struct {
 	start        time.Time
 	apc          appendContext
 	dataBatchLen int
+	producerID   uint64
+	sequence     uint64
+	// transactionID is not zero if the batch is staged by a transaction.
+	transactionID uint64
 }
 
 func NewAppendTask() *AppendTask {
@@ -38,6 +43,20 @@ func (at *AppendTask) SetError(err error) {
 	at.err = err
 }
 
+// SetProducerSequence makes the append idempotent. The argument sequence is
+// the sequence number of the first log entry in the batch given by the
+// producer. It should be called before AppendAsync.
+func (at *AppendTask) SetProducerSequence(producerID, sequence uint64) {
+	at.producerID = producerID
+	at.sequence = sequence
+}
+
+// SetTransactionID makes the batch staged by the transaction. It should be
+// called before AppendAsync.
+func (at *AppendTask) SetTransactionID(transactionID uint64) {
+	at.transactionID = transactionID
+}
+
 func (at *AppendTask) Release() {
 	if at.deferredFunc != nil {
 		at.deferredFunc(at)
@@ -118,7 +137,11 @@ type appendContext struct {
 	totalBytes int64
 }
 
-func (lse *Executor) AppendAsync(ctx context.Context, dataBatch [][]byte, appendTask *AppendTask) error {
+// AppendAsync appends a batch of logs to the log stream asynchronously. The
+// argument attrsBatch can be empty; otherwise, its length should be the same
+// as dataBatch. The result of the append should be waited for by the
+// argument appendTask.
+func (lse *Executor) AppendAsync(ctx context.Context, dataBatch [][]byte, attrsBatch []varlogpb.LogEntryAttributes, appendTask *AppendTask) error {
 	lse.inflight.Add(1)
 	lse.inflightAppend.Add(1)
 
@@ -139,6 +162,11 @@ func (lse *Executor) AppendAsync(ctx context.Context, dataBatch [][]byte, append
 	if !lse.isPrimary() {
 		return snerrors.ErrNotPrimary
 	}
+	if appendTask.transactionID != 0 {
+		if err := lse.ts.reserve(appendTask.transactionID, startTime.Add(lse.transactionTimeout)); err != nil {
+			return err
+		}
+	}
 
 	_, batchletLen := batchlet.SelectLengthClass(dataBatchLen)
 	batchletCount := dataBatchLen / batchletLen
@@ -162,7 +190,15 @@ func (lse *Executor) AppendAsync(ctx context.Context, dataBatch [][]byte, append
 		}
 	}()
 
-	lse.prepareAppendContext(dataBatch, &appendTask.apc)
+	var pb *producerBatch
+	if appendTask.producerID != 0 {
+		pb = &producerBatch{
+			producerID: appendTask.producerID,
+			begin:      appendTask.sequence,
+			end:        appendTask.sequence + uint64(dataBatchLen),
+		}
+	}
+	lse.prepareAppendContext(dataBatch, attrsBatch, pb, appendTask.transactionID, &appendTask.apc)
 	preparationDuration = time.Since(startTime)
 	lse.sendSequenceTasks(ctx, appendTask.apc.sts)
 	return nil
@@ -216,7 +252,7 @@ func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.App
 		lse.lsm.AppendPreparationMicro.Add(preparationDuration.Microseconds())
 	}()
 
-	lse.prepareAppendContext(dataBatch, &apc)
+	lse.prepareAppendContext(dataBatch, nil, nil, 0, &apc)
 	preparationDuration = time.Since(startTime)
 	lse.sendSequenceTasks(ctx, apc.sts)
 	res, err := lse.waitForCompletionOfAppends(ctx, dataBatchLen, apc.awgs)
@@ -228,7 +264,10 @@ func (lse *Executor) Append(ctx context.Context, dataBatch [][]byte) ([]snpb.App
 	return res, err
 }
 
-func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext) {
+// prepareAppendContext splits the batch into sequence tasks. The argument pb
+// is nil unless the batch is appended by an idempotent producer, and the
+// argument transactionID is zero unless the batch is staged by a transaction.
+func (lse *Executor) prepareAppendContext(dataBatch [][]byte, attrsBatch []varlogpb.LogEntryAttributes, pb *producerBatch, transactionID uint64, apc *appendContext) {
 	begin, end := 0, len(dataBatch)
 	for begin < end {
 		batchletClassIdx, batchletLen := batchlet.SelectLengthClass(end - begin)
@@ -237,20 +276,37 @@ func (lse *Executor) prepareAppendContext(dataBatch [][]byte, apc *appendContext
 			batchletEndIdx = end
 		}
 
-		lse.prepareAppendContextInternal(dataBatch, begin, batchletEndIdx, batchletClassIdx, apc)
+		lse.prepareAppendContextInternal(dataBatch, attrsBatch, pb, transactionID, begin, batchletEndIdx, batchletClassIdx, apc)
 		begin = batchletEndIdx
 	}
 }
 
-func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end, batchletClassIdx int, apc *appendContext) {
+func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, attrsBatch []varlogpb.LogEntryAttributes, pb *producerBatch, transactionID uint64, begin, end, batchletClassIdx int, apc *appendContext) {
 	numBackups := len(lse.primaryBackups) - 1
 	batchletData := dataBatch[begin:end]
+	var batchletAttrs []varlogpb.LogEntryAttributes
+	if len(attrsBatch) > 0 {
+		batchletAttrs = attrsBatch[begin:end]
+	}
 
 	st := newSequenceTask()
 	apc.sts = append(apc.sts, st)
 
 	// data batch
 	st.dataBatch = batchletData
+	st.attrsBatch = batchletAttrs
+
+	// producer
+	var sequence uint64
+	if pb != nil {
+		sequence = pb.begin + uint64(begin)
+		st.pb = pb
+		st.sequence = sequence
+	}
+
+	// transaction
+	st.transactionID = transactionID
+	st.lastInTransaction = transactionID != 0 && end == len(dataBatch)
 
 	// replicate tasks
 	st.rts = newReplicateTaskSlice()
@@ -259,6 +315,12 @@ func (lse *Executor) prepareAppendContextInternal(dataBatch [][]byte, begin, end
 		rt.tpid = lse.tpid
 		rt.lsid = lse.lsid
 		rt.dataList = batchletData
+		rt.attrsList = batchletAttrs
+		if pb != nil {
+			rt.producerID = pb.producerID
+			rt.sequence = sequence
+		}
+		rt.transactionID = transactionID
 		st.rts.tasks = append(st.rts.tasks, rt)
 	}
 
@@ -218,10 +218,12 @@ func (cm *committer) commit(_ context.Context, ct *commitTask) error {
 		CommittedLLSNBegin: uncommittedLLSNBegin,
 	}
 
-	return cm.commitInternal(commitContext, true)
+	return cm.commitInternal(commitContext, ct.commitTime, true)
 }
 
-func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitTasks bool) (err error) {
+// commitInternal commits log entries in the range of the argument cc. If the
+// argument commitTime is not zero, it is recorded in the time index.
+func (cm *committer) commitInternal(cc storage.CommitContext, commitTime time.Time, requireCommitWaitTasks bool) (err error) {
 	_, _, uncommittedBegin, _ := cm.lse.lsc.reportCommitBase()
 	uncommttedLLSNBegin := uncommittedBegin.LLSN
 
@@ -285,6 +287,12 @@ func (cm *committer) commitInternal(cc storage.CommitContext, requireCommitWaitT
 
 		iter.next()
 	}
+	if numCommits > 0 && !commitTime.IsZero() {
+		err = cb.SetCommitTime(commitTime, cc.CommittedLLSNBegin, cc.CommittedGLSNBegin)
+		if err != nil {
+			return err
+		}
+	}
 	err = cb.Apply()
 	if err != nil {
 		return err
@@ -446,6 +454,7 @@ type commitTask struct {
 	committedGLSNBegin types.GLSN
 	committedGLSNEnd   types.GLSN
 	committedLLSNBegin types.LLSN
+	commitTime         time.Time
 }
 
 func newCommitTask() *commitTask {
@@ -458,6 +467,7 @@ func (ct *commitTask) release() {
 	ct.committedGLSNBegin = types.InvalidGLSN
 	ct.committedGLSNEnd = types.InvalidGLSN
 	ct.committedLLSNBegin = types.InvalidLLSN
+	ct.commitTime = time.Time{}
 	commitTaskPool.Put(ct)
 }
 
@@ -1,6 +1,7 @@
 package logstream
 
 import (
+	"fmt"
 	"time"
 
 	"go.uber.org/zap"
@@ -17,6 +18,8 @@ const (
 	DefaultCommitQueueCapacity          = 1024
 	DefaultReplicateClientQueueCapacity = 1024
 	DefaultSyncTimeout                  = 10 * time.Second
+	DefaultProducerSequenceWindow       = 4096
+	DefaultTransactionTimeout           = 10 * time.Second
 )
 
 type executorConfig struct {
@@ -34,6 +37,8 @@ type executorConfig struct {
 	logger                       *zap.Logger
 	lsm                          *telemetry.LogStreamMetrics
 	syncTimeout                  time.Duration
+	producerSequenceWindow       int
+	transactionTimeout           time.Duration
 }
 
 func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
@@ -44,6 +49,8 @@ func newExecutorConfig(opts []ExecutorOption) (executorConfig, error) {
 		replicateClientQueueCapacity: DefaultReplicateClientQueueCapacity,
 		logger:                       zap.NewNop(),
 		syncTimeout:                  DefaultSyncTimeout,
+		producerSequenceWindow:       DefaultProducerSequenceWindow,
+		transactionTimeout:           DefaultTransactionTimeout,
 	}
 	for _, opt := range opts {
 		opt.applyExecutor(&cfg)
@@ -71,6 +78,12 @@ func (cfg executorConfig) validate() error {
 	if err := validateQueueCapacity("replicate client", cfg.replicateClientQueueCapacity); err != nil {
 		return err
 	}
+	if cfg.producerSequenceWindow <= 0 {
+		return fmt.Errorf("log stream: producer sequence window must be positive: %d", cfg.producerSequenceWindow)
+	}
+	if cfg.transactionTimeout <= 0 {
+		return fmt.Errorf("log stream: transaction timeout must be positive: %s", cfg.transactionTimeout)
+	}
 	if cfg.stg == nil {
 		return errStorageIsNil
 	}
@@ -182,3 +195,22 @@ func WithSyncTimeout(syncTimeout time.Duration) ExecutorOption {
 		cfg.syncTimeout = syncTimeout
 	})
 }
+
+// WithProducerSequenceWindow sets the number of recent log entries in which
+// the log stream remembers the sequence numbers of idempotent producers. An
+// append from a producer whose last log entry is older than the window is not
+// deduplicated.
+func WithProducerSequenceWindow(window int) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.producerSequenceWindow = window
+	})
+}
+
+// WithTransactionTimeout sets the time for which the primary replica waits for
+// the staged transaction to be committed. Once it expires, the replica stops
+// accepting appends and waits to be sealed to discard the transaction.
+func WithTransactionTimeout(timeout time.Duration) ExecutorOption {
+	return newFuncExecutorOption(func(cfg *executorConfig) {
+		cfg.transactionTimeout = timeout
+	})
+}
@@ -39,6 +39,10 @@ type Executor struct {
 	wr      *writer
 	cm      *committer
 	bw      *backupWriter
+	// pt remembers the recent sequence numbers of idempotent producers.
+	pt *producerTable
+	// ts is the transaction staged in the log stream replica.
+	ts transactionStage
 
 	inflight       atomic.Int64
 	inflightAppend atomic.Int64
@@ -91,6 +95,7 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 	lse = &Executor{
 		executorConfig: cfg,
 		esm:            newExecutorStateManager(executorStateSealing),
+		pt:             newProducerTable(cfg.producerSequenceWindow),
 		sts:            make(map[types.StorageNodeID]*syncTracker),
 		createdTime:    time.Now(),
 		metricAttrs: []attribute.KeyValue{
@@ -104,6 +109,10 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 	if err != nil {
 		return nil, err
 	}
+	rp, err = lse.discardStagedTransactions(rp)
+	if err != nil {
+		return nil, err
+	}
 	lse.lsc = lse.restoreLogStreamContext(rp)
 
 	lse.decider = newDecidableCondition(lse.lsc)
@@ -157,10 +166,21 @@ func NewExecutor(opts ...ExecutorOption) (lse *Executor, err error) {
 		return
 	}
 
+	err = lse.restoreProducerSequences(rp)
+	if err != nil {
+		return
+	}
+
 	return lse, err
 }
 
-func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte) error {
+// Replicate stores log entries replicated from the primary replica. The
+// argument attrsList can be empty; otherwise, its length should be the same as
+// dataList. The argument producer has a zero ProducerID unless the log entries
+// are appended by an idempotent producer, and its Sequence is of the first log
+// entry. The argument transactionID is zero unless the log entries are staged
+// by a transaction.
+func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataList [][]byte, attrsList []varlogpb.LogEntryAttributes, producer storage.ProducerSequence, transactionID uint64) error {
 	lse.inflight.Add(1)
 	defer lse.inflight.Add(-1)
 
@@ -194,11 +214,29 @@ func (lse *Executor) Replicate(ctx context.Context, llsnList []types.LLSN, dataL
 	wb := lse.stg.NewWriteBatch()
 	cwts := newListQueue()
 	for i := 0; i < len(llsnList); i++ {
-		_ = wb.Set(llsnList[i], dataList[i])
+		var attrs *varlogpb.LogEntryAttributes
+		if len(attrsList) > 0 {
+			attrs = &attrsList[i]
+		}
+		ps := storage.ProducerSequence{}
+		if producer.ProducerID != 0 {
+			ps.ProducerID = producer.ProducerID
+			ps.Sequence = producer.Sequence + uint64(i)
+		}
+		if transactionID != 0 {
+			_ = wb.SetInTransaction(llsnList[i], dataList[i], attrs, ps, transactionID)
+		} else if producer.ProducerID != 0 {
+			_ = wb.SetWithProducerSequence(llsnList[i], dataList[i], attrs, ps)
+		} else {
+			_ = wb.SetWithAttributes(llsnList[i], dataList[i], attrs)
+		}
 		dataBytes += int64(len(dataList[i]))
 		cwts.PushFront(newCommitWaitTask(nil))
 	}
 	bwt := newBackupWriteTask(wb, oldLLSN, newLLSN)
+	if transactionID != 0 {
+		lse.ts.stage(transactionID, oldLLSN)
+	}
 
 	preparationDuration = time.Since(startTime)
 
@@ -382,6 +420,17 @@ func (lse *Executor) resetInternalState(lastCommittedLLSN types.LLSN, discardCom
 	// reset llsn
 	lse.sq.llsn = lastCommittedLLSN
 
+	// Uncommitted log entries will be overwritten, thus, sequence numbers
+	// of idempotent producers should be restored from the committed ones.
+	if err := lse.resetProducerSequences(lastCommittedLLSN); err != nil {
+		lse.logger.Error("could not restore sequences of producers", zap.Error(err))
+		lse.pt.reset(make(map[uint64]storage.LastProducerSequence))
+	}
+
+	// The staged transaction is discarded together with uncommitted log
+	// entries.
+	lse.ts.reset()
+
 	// log stream context
 	lse.lsc.uncommittedLLSNEnd.Store(lastCommittedLLSN + 1)
 }
@@ -414,6 +463,7 @@ func (lse *Executor) Report(_ context.Context) (report snpb.LogStreamUncommitRep
 		UncommittedLLSNOffset: uncommittedLLSNBegin,
 		UncommittedLLSNLength: uint64(uncommittedLLSNEnd - uncommittedLLSNBegin),
 	}
+	lse.reportStagedTransaction(&report)
 	prevUncommittedLLSNEnd := lse.prevUncommittedLLSNEnd.Load()
 	if prevUncommittedLLSNEnd != uncommittedLLSNEnd {
 		if ce := lse.logger.Check(zap.DebugLevel, "log stream: report"); ce != nil {
@@ -457,6 +507,9 @@ func (lse *Executor) Commit(ctx context.Context, commitResult snpb.LogStreamComm
 	ct.committedGLSNBegin = commitResult.CommittedGLSNOffset
 	ct.committedGLSNEnd = commitResult.CommittedGLSNOffset + types.GLSN(commitResult.CommittedGLSNLength)
 	ct.committedLLSNBegin = commitResult.CommittedLLSNOffset
+	if commitResult.CommitTime != nil {
+		ct.commitTime = *commitResult.CommitTime
+	}
 	if err := lse.cm.sendCommitTask(ctx, ct); err != nil {
 		ct.release()
 		return err
@@ -493,7 +546,11 @@ func (lse *Executor) metadataDescriptor(state executorState) snpb.LogStreamRepli
 	}
 
 	localLowWatermark, localHighWatermark, _ := lse.lsc.localWatermarks()
-	version, globalHighWatermark, _, _ := lse.lsc.reportCommitBase()
+	version, globalHighWatermark, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+	var uncommittedLLSNLength uint64
+	if uncommittedLLSNEnd := lse.lsc.uncommittedLLSNEnd.Load(); uncommittedLLSNEnd > uncommittedBegin.LLSN {
+		uncommittedLLSNLength = uint64(uncommittedLLSNEnd - uncommittedBegin.LLSN)
+	}
 	return snpb.LogStreamReplicaMetadataDescriptor{
 		LogStreamReplica: varlogpb.LogStreamReplica{
 			StorageNode: varlogpb.StorageNode{
@@ -518,6 +575,9 @@ func (lse *Executor) metadataDescriptor(state executorState) snpb.LogStreamRepli
 		Path:             lse.stg.Path(),
 		StorageSizeBytes: lse.stg.DiskUsage(),
 		CreatedTime:      lse.createdTime,
+
+		InflightAppends:       lse.inflightAppend.Load(),
+		UncommittedLLSNLength: uncommittedLLSNLength,
 	}
 }
 
@@ -766,3 +826,103 @@ func (lse *Executor) restoreCommitWaitTasks(rp storage.RecoveryPoints) error {
 	}
 	return nil
 }
+
+// restoreProducerSequences restores the recent sequence numbers of idempotent
+// producers from the log entries, including uncommitted ones, in the storage.
+func (lse *Executor) restoreProducerSequences(rp storage.RecoveryPoints) error {
+	lastLLSN := types.InvalidLLSN
+	if last := rp.CommittedLogEntry.Last; last != nil {
+		lastLLSN = last.LLSN
+	}
+	if !rp.UncommittedLLSN.End.Invalid() {
+		lastLLSN = rp.UncommittedLLSN.End - 1
+	}
+	return lse.resetProducerSequences(lastLLSN)
+}
+
+// resetProducerSequences resets the sequence numbers of idempotent producers
+// to ones read from the log entries within the window up to the argument
+// lastLLSN.
+func (lse *Executor) resetProducerSequences(lastLLSN types.LLSN) error {
+	lasts := make(map[uint64]storage.LastProducerSequence)
+	if !lastLLSN.Invalid() {
+		var err error
+		begin, end := lse.pt.restoreWindow(lastLLSN)
+		lasts, err = lse.stg.ReadProducerSequences(begin, end)
+		if err != nil {
+			return err
+		}
+	}
+	lse.pt.reset(lasts)
+	return nil
+}
+
+// discardStagedTransactions discards uncommitted log entries from the first
+// one staged by a transaction. Though the transaction might have been
+// committed by the metadata repository, the log stream replica cannot know
+// that after restart. Reporting them could make the metadata repository
+// commit a part of the transaction, thus, they are discarded and restored
+// by synchronization if necessary.
+func (lse *Executor) discardStagedTransactions(rp storage.RecoveryPoints) (storage.RecoveryPoints, error) {
+	if rp.UncommittedLLSN.Begin.Invalid() {
+		return rp, nil
+	}
+	staged, err := lse.stg.ReadFirstStagedLLSN(rp.UncommittedLLSN.Begin, rp.UncommittedLLSN.End)
+	if err != nil || staged.Invalid() {
+		return rp, err
+	}
+	lse.logger.Info("discard staged transaction",
+		zap.Uint64("uncommitted_begin", uint64(rp.UncommittedLLSN.Begin)),
+		zap.Uint64("uncommitted_end", uint64(rp.UncommittedLLSN.End)),
+		zap.Uint64("staged", uint64(staged)),
+	)
+	if staged == rp.UncommittedLLSN.Begin {
+		rp.UncommittedLLSN.Begin = types.InvalidLLSN
+		rp.UncommittedLLSN.End = types.InvalidLLSN
+	} else {
+		rp.UncommittedLLSN.End = staged
+	}
+	return rp, nil
+}
+
+// reportStagedTransaction adds the staged transaction to the report. If the
+// primary replica has waited for the transaction to be committed too long, it
+// stops accepting appends and waits to be sealed.
+func (lse *Executor) reportStagedTransaction(report *snpb.LogStreamUncommitReport) {
+	id, begin, end, deadline := lse.ts.staged(report.UncommittedLLSNOffset)
+	if id == 0 {
+		return
+	}
+	if !deadline.IsZero() && time.Now().After(deadline) && lse.esm.compareAndSwap(executorStateAppendable, executorStateSealing) {
+		lse.logger.Warn("transaction timed out", zap.Uint64("transaction_id", id))
+	}
+	if begin.Invalid() {
+		return
+	}
+	report.TransactionID = id
+	report.TransactionLLSNOffset = begin
+	report.TransactionLLSNEnd = end
+}
+
+// AbortTransaction discards the transaction staged in the log stream. Since
+// uncommitted log entries can follow the staged ones, the replica stops
+// accepting appends and waits to be sealed, which discards all uncommitted
+// log entries. It does nothing if the transaction is not staged.
+func (lse *Executor) AbortTransaction(_ context.Context, transactionID uint64) error {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return verrors.ErrClosed
+	}
+	if !lse.isPrimary() {
+		return snerrors.ErrNotPrimary
+	}
+	if !lse.ts.contains(transactionID) {
+		return nil
+	}
+	if lse.esm.compareAndSwap(executorStateAppendable, executorStateSealing) {
+		lse.logger.Info("transaction aborted", zap.Uint64("transaction_id", transactionID))
+	}
+	return nil
+}
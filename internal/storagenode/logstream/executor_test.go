@@ -84,7 +84,7 @@ func TestExecutor_Closed(t *testing.T) {
 	_, err := lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
 	assert.ErrorIs(t, err, verrors.ErrClosed)
 
-	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, storage.ProducerSequence{}, 0)
 	assert.ErrorIs(t, err, verrors.ErrClosed)
 
 	_, _, err = lse.Seal(context.Background(), types.MinGLSN)
@@ -182,7 +182,7 @@ func TestExecutor_Sealing(t *testing.T) {
 				assert.Equal(t, varlogpb.LogStreamStatusSealing, st)
 				assert.Equal(t, executorStateSealing, lse.esm.load())
 
-				err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+				err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, storage.ProducerSequence{}, 0)
 				assert.ErrorIs(t, err, verrors.ErrSealed)
 			},
 		},
@@ -286,7 +286,7 @@ func TestExecutor_Sealed(t *testing.T) {
 	_, err = lse.Append(context.Background(), TestNewBatchData(t, 1, 0))
 	assert.ErrorIs(t, err, verrors.ErrSealed)
 
-	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0))
+	err = lse.Replicate(context.Background(), []types.LLSN{1}, TestNewBatchData(t, 1, 0), nil, storage.ProducerSequence{}, 0)
 	assert.ErrorIs(t, err, verrors.ErrSealed)
 }
 
@@ -585,6 +585,50 @@ func TestExecutor_Append(t *testing.T) {
 	}
 }
 
+func TestExecutor_MetadataLoadHints(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	lsrmd, err := lse.Metadata()
+	require.NoError(t, err)
+	require.Zero(t, lsrmd.InflightAppends)
+	require.Zero(t, lsrmd.UncommittedLLSNLength)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := lse.Append(context.Background(), [][]byte{[]byte("hello"), []byte("world")})
+		assert.NoError(t, err)
+	}()
+
+	// The append waits for the commit.
+	require.Eventually(t, func() bool {
+		lsrmd, err := lse.Metadata()
+		require.NoError(t, err)
+		return lsrmd.InflightAppends == 1 && lsrmd.UncommittedLLSNLength == 2
+	}, time.Second, 10*time.Millisecond)
+
+	err = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+		TopicID:             lse.tpid,
+		LogStreamID:         lse.lsid,
+		CommittedLLSNOffset: types.MinLLSN,
+		CommittedGLSNOffset: types.MinGLSN,
+		CommittedGLSNLength: 2,
+		Version:             1,
+		HighWatermark:       2,
+	})
+	require.NoError(t, err)
+	wg.Wait()
+
+	lsrmd, err = lse.Metadata()
+	require.NoError(t, err)
+	require.Zero(t, lsrmd.InflightAppends)
+	require.Zero(t, lsrmd.UncommittedLLSNLength)
+}
+
 func TestExecutor_Replicate(t *testing.T) {
 	testCases := []struct {
 		name      string
@@ -616,7 +660,7 @@ func TestExecutor_Replicate(t *testing.T) {
 
 			// primary
 			if tc.isErr {
-				err := lse.Replicate(context.Background(), []types.LLSN{1}, [][]byte{nil})
+				err := lse.Replicate(context.Background(), []types.LLSN{1}, [][]byte{nil}, nil, storage.ProducerSequence{}, 0)
 				assert.Error(t, err)
 				return
 			}
@@ -630,7 +674,7 @@ func TestExecutor_Replicate(t *testing.T) {
 					llsn++
 					llsnList[i] = llsn
 				}
-				err := lse.Replicate(context.Background(), llsnList, dataList)
+				err := lse.Replicate(context.Background(), llsnList, dataList, nil, storage.ProducerSequence{}, 0)
 				assert.NoError(t, err)
 			}
 
@@ -875,7 +919,7 @@ func TestExecutor_ReplicateSeal(t *testing.T) {
 	go func() {
 		defer wg.Done()
 		for llsn := lastLLSN + 1; llsn < types.MaxLLSN; llsn++ {
-			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil})
+			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil}, nil, storage.ProducerSequence{}, 0)
 			if err != nil {
 				break
 			}
@@ -935,7 +979,7 @@ func TestExecutor_ReplicateSeal(t *testing.T) {
 	go func() {
 		defer wg.Done()
 		for llsn := lastLLSN + 1; llsn < types.MaxLLSN; llsn++ {
-			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil})
+			err := lse.Replicate(context.Background(), []types.LLSN{llsn}, [][]byte{nil}, nil, storage.ProducerSequence{}, 0)
 			if err != nil {
 				break
 			}
@@ -1627,6 +1671,170 @@ func TestExecutor_Subscribe(t *testing.T) {
 	appendWg.Wait()
 }
 
+func TestExecutor_SubscribeWithFilter(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	dataBatch := [][]byte{[]byte("1"), []byte("2"), []byte("3"), []byte("4")}
+	attrsBatch := []varlogpb.LogEntryAttributes{
+		{Key: []byte("a-1")},
+		{Key: []byte("b-2")},
+		{Key: []byte("a-3")},
+		{},
+	}
+	numLogs := len(dataBatch)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		at := NewAppendTask()
+		defer at.Release()
+		err := lse.AppendAsync(context.Background(), dataBatch, attrsBatch, at)
+		assert.NoError(t, err)
+		res, err := at.WaitForCompletion(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, res, numLogs)
+		at.ReleaseWriteWaitGroups()
+	}()
+	assert.Eventually(t, func() bool {
+		_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+			TopicID:             lse.tpid,
+			LogStreamID:         lse.lsid,
+			CommittedLLSNOffset: types.MinLLSN,
+			CommittedGLSNOffset: types.MinGLSN,
+			CommittedGLSNLength: uint64(numLogs),
+			Version:             types.MinVersion,
+			HighWatermark:       types.GLSN(numLogs),
+		})
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.Version == types.MinVersion
+	}, time.Second, 10*time.Millisecond)
+	wg.Wait()
+
+	filter := &varlogpb.LogEntryFilter{KeyPrefix: []byte("a-")}
+	expectedFiltered := []bool{false, true, false, true}
+
+	// Log entries not matching the filter are stripped but still delivered
+	// in order.
+	sr, err := lse.SubscribeWithGLSN(types.MinGLSN, types.GLSN(numLogs+1), WithFilter(filter))
+	require.NoError(t, err)
+	for i := 0; i < numLogs; i++ {
+		le := <-sr.Result()
+		assert.Equal(t, types.GLSN(i+1), le.GLSN)
+		assert.Equal(t, expectedFiltered[i], sr.Filtered(le))
+		if expectedFiltered[i] {
+			assert.Empty(t, le.Data)
+			assert.True(t, le.LogEntryAttributes.Empty())
+			continue
+		}
+		assert.Equal(t, dataBatch[i], le.Data)
+		assert.Equal(t, attrsBatch[i], le.LogEntryAttributes)
+	}
+	_, ok := <-sr.Result()
+	assert.False(t, ok)
+	sr.Stop()
+	assert.NoError(t, sr.Err())
+
+	sr, err = lse.SubscribeWithLLSN(types.MinLLSN, types.LLSN(numLogs+1), WithFilter(filter))
+	require.NoError(t, err)
+	for i := 0; i < numLogs; i++ {
+		le := <-sr.Result()
+		assert.Equal(t, types.LLSN(i+1), le.LLSN)
+		assert.Equal(t, expectedFiltered[i], sr.Filtered(le))
+	}
+	_, ok = <-sr.Result()
+	assert.False(t, ok)
+	sr.Stop()
+	assert.NoError(t, sr.Err())
+
+	// An empty filter matches all log entries.
+	sr, err = lse.SubscribeWithGLSN(types.MinGLSN, types.GLSN(numLogs+1), WithFilter(&varlogpb.LogEntryFilter{}))
+	require.NoError(t, err)
+	for i := 0; i < numLogs; i++ {
+		le := <-sr.Result()
+		assert.False(t, sr.Filtered(le))
+		assert.Equal(t, dataBatch[i], le.Data)
+	}
+	sr.Stop()
+}
+
+func TestExecutor_ResolveStartLLSN(t *testing.T) {
+	const numLogs = 4
+
+	lse := testNewPrimaryExecutor(t)
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	_, err := lse.ResolveStartLLSN(snpb.StartPositionUnspecified)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// No log entries.
+	begin, err := lse.ResolveStartLLSN(snpb.StartPositionEarliest)
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, begin)
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionLatest)
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, begin)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dataBatch := make([][]byte, numLogs)
+		for i := range dataBatch {
+			dataBatch[i] = []byte("hello")
+		}
+		_, err := lse.Append(context.Background(), dataBatch)
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+			TopicID:             lse.tpid,
+			LogStreamID:         lse.lsid,
+			CommittedLLSNOffset: types.MinLLSN,
+			CommittedGLSNOffset: types.MinGLSN,
+			CommittedGLSNLength: numLogs,
+			Version:             types.MinVersion,
+			HighWatermark:       numLogs,
+		})
+		rpt, err := lse.Report(context.Background())
+		assert.NoError(t, err)
+		return rpt.Version == types.MinVersion
+	}, time.Second, 10*time.Millisecond)
+	wg.Wait()
+
+	// LLSN: 1 2 3 4
+	// GLSN: 1 2 3 4
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionEarliest)
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, begin)
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionLatest)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(numLogs+1), begin)
+
+	// LLSN: _ _ 3 4
+	// GLSN: _ _ 3 4
+	require.NoError(t, lse.Trim(context.Background(), 2))
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionEarliest)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(3), begin)
+
+	// LLSN: _ _ _ _
+	// GLSN: _ _ _ _
+	require.NoError(t, lse.Trim(context.Background(), numLogs))
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionEarliest)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(numLogs+1), begin)
+	begin, err = lse.ResolveStartLLSN(snpb.StartPositionLatest)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(numLogs+1), begin)
+}
+
 func TestExecutor_Recover(t *testing.T) {
 	const (
 		numClients       = 20
@@ -1725,6 +1933,275 @@ func TestExecutor_Recover(t *testing.T) {
 	}, localHWM)
 }
 
+func TestExecutor_IdempotentAppend(t *testing.T) {
+	lse := testNewPrimaryExecutor(t)
+	path := lse.stg.Path()
+	replicas := lse.primaryBackups
+	defer func() {
+		assert.NoError(t, lse.Close())
+	}()
+
+	var (
+		lastLLSN    = types.InvalidLLSN
+		lastGLSN    = types.InvalidGLSN
+		lastVersion = types.InvalidVersion
+	)
+	commit := func(batchLen int) {
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: lastLLSN + 1,
+				CommittedGLSNOffset: lastGLSN + 1,
+				CommittedGLSNLength: uint64(batchLen),
+				Version:             lastVersion + 1,
+				HighWatermark:       lastGLSN + types.GLSN(batchLen),
+			})
+			rpt, err := lse.Report(context.Background())
+			assert.NoError(t, err)
+			if rpt.Version != lastVersion+1 {
+				return false
+			}
+			lastVersion++
+			lastLLSN += types.LLSN(batchLen)
+			lastGLSN += types.GLSN(batchLen)
+			return true
+		}, time.Second, 10*time.Millisecond)
+	}
+	appendIdempotent := func(producerID, sequence uint64, batchLen int) ([]snpb.AppendResult, error) {
+		at := NewAppendTask()
+		defer at.Release()
+		at.SetProducerSequence(producerID, sequence)
+		if err := lse.AppendAsync(context.Background(), TestNewBatchData(t, batchLen, 0), nil, at); err != nil {
+			return nil, err
+		}
+		res, err := at.WaitForCompletion(context.Background())
+		if err == nil {
+			at.ReleaseWriteWaitGroups()
+		}
+		return res, err
+	}
+	appendAndCommit := func(producerID, sequence uint64, batchLen int) {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := appendIdempotent(producerID, sequence, batchLen)
+			assert.NoError(t, err)
+			assert.Len(t, res, batchLen)
+		}()
+		commit(batchLen)
+		wg.Wait()
+	}
+
+	appendAndCommit(1, 1, 2)
+
+	// duplicate
+	_, err := appendIdempotent(1, 1, 2)
+	require.ErrorIs(t, err, verrors.ErrDuplicate)
+	// partially duplicate
+	_, err = appendIdempotent(1, 2, 2)
+	require.ErrorIs(t, err, verrors.ErrDuplicate)
+
+	// other producer
+	appendAndCommit(2, 1, 1)
+	appendAndCommit(1, 3, 1)
+
+	// batch split into several sequence tasks
+	batchLen := batchlet.LengthClasses[0] + 1
+	appendAndCommit(3, 1, batchLen)
+	_, err = appendIdempotent(3, 1, batchLen)
+	require.ErrorIs(t, err, verrors.ErrDuplicate)
+
+	// Sealing discards uncommitted log entries, so their sequence numbers
+	// are forgotten.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := appendIdempotent(4, 1, 1)
+		assert.Error(t, err)
+	}()
+	require.Eventually(t, func() bool {
+		return lse.lsc.uncommittedLLSNEnd.Load() == lastLLSN+2
+	}, time.Second, 10*time.Millisecond)
+	status, _, err := lse.Seal(context.Background(), lastGLSN)
+	require.NoError(t, err)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, status)
+	wg.Wait()
+	require.NoError(t, lse.Unseal(context.Background(), replicas))
+	appendAndCommit(4, 1, 1)
+
+	// Sequence numbers survive the restart.
+	require.NoError(t, lse.Close())
+	lse = testRespawnExecutor(t, lse, path, lastGLSN)
+	_, err = appendIdempotent(1, 3, 1)
+	require.ErrorIs(t, err, verrors.ErrDuplicate)
+	_, err = appendIdempotent(4, 1, 1)
+	require.ErrorIs(t, err, verrors.ErrDuplicate)
+	appendAndCommit(1, 4, 1)
+}
+
+func TestExecutor_Transaction(t *testing.T) {
+	appendAsync := func(t *testing.T, lse *Executor, transactionID uint64, batchLen int) ([]snpb.AppendResult, error) {
+		at := NewAppendTask()
+		defer at.Release()
+		at.SetTransactionID(transactionID)
+		if err := lse.AppendAsync(context.Background(), TestNewBatchData(t, batchLen, 0), nil, at); err != nil {
+			return nil, err
+		}
+		res, err := at.WaitForCompletion(context.Background())
+		if err == nil {
+			at.ReleaseWriteWaitGroups()
+		}
+		return res, err
+	}
+
+	t.Run("CommitAndAbort", func(t *testing.T) {
+		lse := testNewPrimaryExecutor(t, WithTransactionTimeout(time.Hour))
+		replicas := lse.primaryBackups
+		defer func() {
+			assert.NoError(t, lse.Close())
+		}()
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := appendAsync(t, lse, 7, 2)
+			assert.NoError(t, err)
+			assert.Len(t, res, 2)
+		}()
+
+		// The staged transaction is reported.
+		require.Eventually(t, func() bool {
+			rpt, err := lse.Report(context.Background())
+			require.NoError(t, err)
+			return rpt.TransactionID == 7 && rpt.TransactionLLSNEnd == 3
+		}, time.Second, 10*time.Millisecond)
+		rpt, err := lse.Report(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, types.LLSN(1), rpt.TransactionLLSNOffset)
+		require.EqualValues(t, 2, rpt.UncommittedLLSNLength)
+
+		// Only one transaction can be staged at a time.
+		_, err = appendAsync(t, lse, 8, 1)
+		require.ErrorIs(t, err, snerrors.ErrTransactionInProgress)
+
+		assert.Eventually(t, func() bool {
+			_ = lse.Commit(context.Background(), snpb.LogStreamCommitResult{
+				TopicID:             lse.tpid,
+				LogStreamID:         lse.lsid,
+				CommittedLLSNOffset: 1,
+				CommittedGLSNOffset: 1,
+				CommittedGLSNLength: 2,
+				Version:             1,
+				HighWatermark:       2,
+			})
+			rpt, err := lse.Report(context.Background())
+			require.NoError(t, err)
+			return rpt.Version == 1
+		}, time.Second, 10*time.Millisecond)
+		wg.Wait()
+
+		rpt, err = lse.Report(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, rpt.TransactionID)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := appendAsync(t, lse, 9, 1)
+			assert.Error(t, err)
+		}()
+		require.Eventually(t, func() bool {
+			return lse.ts.contains(9)
+		}, time.Second, 10*time.Millisecond)
+
+		// Aborting unknown transaction does nothing.
+		require.NoError(t, lse.AbortTransaction(context.Background(), 10))
+		require.Equal(t, executorStateAppendable, lse.esm.load())
+
+		require.NoError(t, lse.AbortTransaction(context.Background(), 9))
+		require.Equal(t, executorStateSealing, lse.esm.load())
+
+		status, _, err := lse.Seal(context.Background(), 2)
+		require.NoError(t, err)
+		require.Equal(t, varlogpb.LogStreamStatusSealed, status)
+		wg.Wait()
+		require.False(t, lse.ts.contains(9))
+
+		require.NoError(t, lse.Unseal(context.Background(), replicas))
+		require.Equal(t, executorStateAppendable, lse.esm.load())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		lse := testNewPrimaryExecutor(t, WithTransactionTimeout(10*time.Millisecond))
+		var wg sync.WaitGroup
+		defer func() {
+			assert.NoError(t, lse.Close())
+			wg.Wait()
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := appendAsync(t, lse, 7, 1)
+			assert.Error(t, err)
+		}()
+
+		require.Eventually(t, func() bool {
+			_, err := lse.Report(context.Background())
+			require.NoError(t, err)
+			return lse.esm.load() == executorStateSealing
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("DiscardAfterRestart", func(t *testing.T) {
+		lse := testNewPrimaryExecutor(t, WithTransactionTimeout(time.Hour))
+		path := lse.stg.Path()
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := appendAsync(t, lse, 0, 1)
+			assert.Error(t, err)
+		}()
+		require.Eventually(t, func() bool {
+			return lse.lsc.uncommittedLLSNEnd.Load() == 2
+		}, time.Second, 10*time.Millisecond)
+		go func() {
+			defer wg.Done()
+			_, err := appendAsync(t, lse, 7, 2)
+			assert.Error(t, err)
+		}()
+		require.Eventually(t, func() bool {
+			return lse.lsc.uncommittedLLSNEnd.Load() == 4
+		}, time.Second, 10*time.Millisecond)
+		require.NoError(t, lse.Close())
+		wg.Wait()
+
+		lse = testNewExecutor(t,
+			WithStorageNodeID(lse.snid),
+			WithTopicID(lse.tpid),
+			WithLogStreamID(lse.lsid),
+			WithStorage(storage.TestNewStorage(t, storage.WithPath(path))),
+		)
+		defer func() {
+			assert.NoError(t, lse.Close())
+		}()
+
+		// The log entry preceding the transaction remains uncommitted,
+		// but the staged ones are discarded.
+		rpt, err := lse.Report(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, types.LLSN(1), rpt.UncommittedLLSNOffset)
+		require.EqualValues(t, 1, rpt.UncommittedLLSNLength)
+		require.Zero(t, rpt.TransactionID)
+	})
+}
+
 func TestExecutor_SealAfterRestart(t *testing.T) {
 	const (
 		cid  = types.ClusterID(1)
@@ -3461,6 +3938,55 @@ func TestExecutorSyncReplicate(t *testing.T) {
 				require.Equal(t, types.GLSN(lastCommittedLSN), localHWM)
 			},
 		},
+		{
+			name: "SucceedWithCommitTime",
+			testf: func(t *testing.T, dst *Executor, src varlogpb.LogStreamReplica) {
+				const lastCommittedLSN = numLogs + 10
+				makeLearningState(t, dst, src, lastCommittedLSN, snpb.SyncRange{
+					FirstLLSN: 1,
+					LastLLSN:  lastCommittedLSN,
+				}, snpb.SyncRange{
+					FirstLLSN: numLogs + 1,
+					LastLLSN:  lastCommittedLSN,
+				})
+				commitTime := time.Now()
+				for lsn := numLogs + 1; lsn <= lastCommittedLSN; lsn++ {
+					payload := snpb.SyncPayload{
+						LogEntry: &varlogpb.LogEntry{
+							LogEntryMeta: varlogpb.LogEntryMeta{
+								TopicID:     dst.tpid,
+								LogStreamID: dst.lsid,
+								LLSN:        types.LLSN(lsn),
+								GLSN:        types.GLSN(lsn),
+							},
+						},
+					}
+					if lsn == numLogs+1 {
+						payload.CommitTime = &commitTime
+					}
+					err := dst.SyncReplicate(context.Background(), src, payload)
+					require.NoError(t, err)
+				}
+				err := dst.SyncReplicate(context.Background(), src, snpb.SyncPayload{
+					CommitContext: &varlogpb.CommitContext{
+						Version:            types.Version(2),
+						HighWatermark:      lastCommittedLSN,
+						CommittedGLSNBegin: numLogs + 1,
+						CommittedGLSNEnd:   lastCommittedLSN + 1,
+						CommittedLLSNBegin: numLogs + 1,
+					},
+				})
+				require.NoError(t, err)
+
+				lsn, err := dst.LookupGLSNByTime(time.Time{})
+				require.NoError(t, err)
+				require.Equal(t, types.GLSN(numLogs+1), lsn.GLSN)
+				require.Equal(t, types.LLSN(numLogs+1), lsn.LLSN)
+
+				_, err = dst.LookupGLSNByTime(commitTime.Add(time.Nanosecond))
+				require.ErrorIs(t, err, verrors.ErrNoEntry)
+			},
+		},
 	}
 
 	for _, tc := range tcs {
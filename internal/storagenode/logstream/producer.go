@@ -0,0 +1,106 @@
+package logstream
+
+import (
+	"sync"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// producerBatch is a batch of log entries appended by an idempotent producer.
+// Sequence tasks split from the batch share it so that the sequencer accepts
+// or rejects the batch as a whole.
+type producerBatch struct {
+	producerID uint64
+	// begin and end are sequence numbers of the batch, [begin, end).
+	begin uint64
+	end   uint64
+	// checked and err are set by the sequencer when it sequences the first
+	// sequence task of the batch.
+	checked bool
+	err     error
+}
+
+// producerTable remembers the last sequence number of each idempotent
+// producer among the recent log entries to reject duplicated appends. Only
+// producers that have appended log entries within the window are remembered;
+// it is the same as what storage.ReadProducerSequences restores from the
+// window of log entries.
+type producerTable struct {
+	window types.LLSN
+
+	mu         sync.Mutex
+	lasts      map[uint64]storage.LastProducerSequence
+	nextExpiry types.LLSN
+}
+
+func newProducerTable(window int) *producerTable {
+	return &producerTable{
+		window: types.LLSN(window),
+		lasts:  make(map[uint64]storage.LastProducerSequence),
+	}
+}
+
+// check accepts or rejects the batch when it is called for the first sequence
+// task of the batch. The batch is rejected with verrors.ErrDuplicate if the
+// producer has already appended any of its sequence numbers.
+func (pt *producerTable) check(pb *producerBatch) error {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	if pb.checked {
+		return pb.err
+	}
+	pb.checked = true
+	if last, ok := pt.lasts[pb.producerID]; ok && pb.begin <= last.Sequence {
+		pb.err = verrors.ErrDuplicate
+	}
+	return pb.err
+}
+
+// advance records that the log entry of the producer whose sequence number is
+// the argument sequence has the LLSN. It also forgets producers that have not
+// appended log entries within the window.
+func (pt *producerTable) advance(producerID, sequence uint64, llsn types.LLSN) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+
+	pt.lasts[producerID] = storage.LastProducerSequence{
+		Sequence: sequence,
+		LLSN:     llsn,
+	}
+	pt.expire(llsn)
+}
+
+func (pt *producerTable) expire(llsn types.LLSN) {
+	if llsn < pt.nextExpiry {
+		return
+	}
+	for producerID, last := range pt.lasts {
+		if last.LLSN+pt.window <= llsn {
+			delete(pt.lasts, producerID)
+		}
+	}
+	pt.nextExpiry = llsn + pt.window
+}
+
+// reset replaces the sequence numbers with the ones restored from the storage.
+func (pt *producerTable) reset(lasts map[uint64]storage.LastProducerSequence) {
+	pt.mu.Lock()
+	defer pt.mu.Unlock()
+	pt.lasts = lasts
+	pt.nextExpiry = types.InvalidLLSN
+}
+
+// restoreWindow returns the range of LLSNs, [begin, end), from which the
+// sequence numbers should be restored when the last LLSN is the argument
+// lastLLSN.
+func (pt *producerTable) restoreWindow(lastLLSN types.LLSN) (begin, end types.LLSN) {
+	end = lastLLSN + 1
+	begin = types.MinLLSN
+	if end > pt.window {
+		begin = end - pt.window
+	}
+	return begin, end
+}
@@ -0,0 +1,49 @@
+package logstream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+func TestProducerTable(t *testing.T) {
+	const window = 10
+
+	pt := newProducerTable(window)
+
+	// The first batch is accepted, and the decision is shared by sequence
+	// tasks of the batch.
+	pb := &producerBatch{producerID: 1, begin: 1, end: 3}
+	require.NoError(t, pt.check(pb))
+	pt.advance(1, 1, 1)
+	require.NoError(t, pt.check(pb))
+	pt.advance(1, 2, 2)
+
+	require.ErrorIs(t, pt.check(&producerBatch{producerID: 1, begin: 1, end: 3}), verrors.ErrDuplicate)
+	require.ErrorIs(t, pt.check(&producerBatch{producerID: 1, begin: 2, end: 4}), verrors.ErrDuplicate)
+	require.NoError(t, pt.check(&producerBatch{producerID: 1, begin: 3, end: 4}))
+	require.NoError(t, pt.check(&producerBatch{producerID: 2, begin: 1, end: 2}))
+
+	// The producer 1 is forgotten once its last log entry gets out of the
+	// window.
+	pt.advance(2, 1, window+2)
+	require.NoError(t, pt.check(&producerBatch{producerID: 1, begin: 1, end: 2}))
+	require.ErrorIs(t, pt.check(&producerBatch{producerID: 2, begin: 1, end: 2}), verrors.ErrDuplicate)
+
+	pt.reset(map[uint64]storage.LastProducerSequence{
+		1: {Sequence: 5, LLSN: 20},
+	})
+	require.ErrorIs(t, pt.check(&producerBatch{producerID: 1, begin: 5, end: 6}), verrors.ErrDuplicate)
+	require.NoError(t, pt.check(&producerBatch{producerID: 2, begin: 1, end: 2}))
+
+	begin, end := pt.restoreWindow(5)
+	require.Equal(t, types.MinLLSN, begin)
+	require.Equal(t, types.LLSN(6), end)
+	begin, end = pt.restoreWindow(20)
+	require.Equal(t, types.LLSN(11), begin)
+	require.Equal(t, types.LLSN(21), end)
+}
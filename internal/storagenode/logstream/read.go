@@ -0,0 +1,187 @@
+package logstream
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kakao/varlog/internal/storage"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// ReadWithGLSN reads the committed log entry at the given GLSN.
+// It returns verrors.ErrTrimmed if the log entry is already trimmed, and
+// verrors.ErrNoEntry if the log stream replica has no such log entry.
+func (lse *Executor) ReadWithGLSN(glsn types.GLSN) (le varlogpb.LogEntry, err error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return le, verrors.ErrClosed
+	}
+
+	if glsn.Invalid() {
+		return le, fmt.Errorf("log stream: invalid glsn %d: %w", glsn, verrors.ErrInvalid)
+	}
+
+	lse.globalLowWatermark.mu.Lock()
+	if glsn < lse.globalLowWatermark.glsn {
+		lse.globalLowWatermark.mu.Unlock()
+		return le, fmt.Errorf("log stream: %w", verrors.ErrTrimmed)
+	}
+	lse.globalLowWatermark.mu.Unlock()
+
+	return lse.read(storage.AtGLSN(glsn))
+}
+
+// ReadWithLLSN reads the committed log entry at the given LLSN.
+// It returns verrors.ErrTrimmed if the log entry is already trimmed, and
+// verrors.ErrNoEntry if the log entry is not committed yet.
+func (lse *Executor) ReadWithLLSN(llsn types.LLSN) (le varlogpb.LogEntry, err error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return le, verrors.ErrClosed
+	}
+
+	if llsn.Invalid() {
+		return le, fmt.Errorf("log stream: invalid llsn %d: %w", llsn, verrors.ErrInvalid)
+	}
+
+	localLWM, _, _ := lse.lsc.localWatermarks()
+	if llsn < localLWM.LLSN {
+		return le, fmt.Errorf("log stream: %w", verrors.ErrTrimmed)
+	}
+	_, _, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+	if llsn >= uncommittedBegin.LLSN {
+		return le, fmt.Errorf("log stream: llsn %d: %w", llsn, verrors.ErrNoEntry)
+	}
+
+	return lse.read(storage.AtLLSN(llsn))
+}
+
+func (lse *Executor) read(opt storage.ReadOption) (le varlogpb.LogEntry, err error) {
+	le, err = lse.stg.Read(opt)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoLogEntry) {
+			err = verrors.ErrNoEntry
+		}
+		return le, fmt.Errorf("log stream: %w", err)
+	}
+	le.TopicID = lse.tpid
+	le.LogStreamID = lse.lsid
+	return le, nil
+}
+
+// ReadRangeWithGLSN reads committed log entries in the range of GLSNs [begin,
+// end). It returns at most maxEntries log entries. Unlike SubscribeWithGLSN, it
+// does not wait for log entries in the range to be committed.
+func (lse *Executor) ReadRangeWithGLSN(begin, end types.GLSN, maxEntries int) ([]varlogpb.LogEntry, error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return nil, verrors.ErrClosed
+	}
+
+	if begin >= end || maxEntries <= 0 {
+		return nil, fmt.Errorf("log stream: invalid range: %w", verrors.ErrInvalid)
+	}
+
+	lse.globalLowWatermark.mu.Lock()
+	if begin < lse.globalLowWatermark.glsn {
+		lse.globalLowWatermark.mu.Unlock()
+		return nil, fmt.Errorf("log stream: %w", verrors.ErrTrimmed)
+	}
+	lse.globalLowWatermark.mu.Unlock()
+
+	return lse.scanRange(begin, end, types.MaxLLSN, maxEntries)
+}
+
+// ReadRangeWithLLSN reads committed log entries in the range of LLSNs [begin,
+// end). It returns at most maxEntries log entries. Unlike SubscribeWithLLSN,
+// it does not wait for log entries in the range to be committed.
+func (lse *Executor) ReadRangeWithLLSN(begin, end types.LLSN, maxEntries int) ([]varlogpb.LogEntry, error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return nil, verrors.ErrClosed
+	}
+
+	if begin >= end || maxEntries <= 0 {
+		return nil, fmt.Errorf("log stream: invalid range: %w", verrors.ErrInvalid)
+	}
+
+	localLWM, _, _ := lse.lsc.localWatermarks()
+	if begin < localLWM.LLSN {
+		return nil, fmt.Errorf("log stream: %w", verrors.ErrTrimmed)
+	}
+	_, _, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+	if begin >= uncommittedBegin.LLSN {
+		return nil, nil
+	}
+
+	// Log entries scanned by LLSN do not have their GLSNs. Hence, it finds the
+	// GLSN of the first log entry and then scans log entries by GLSN.
+	first, err := lse.stg.Read(storage.AtLLSN(begin))
+	if err != nil {
+		if errors.Is(err, storage.ErrNoLogEntry) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("log stream: %w", err)
+	}
+	return lse.scanRange(first.GLSN, types.MaxGLSN, end, maxEntries)
+}
+
+// scanRange scans committed log entries in the range of GLSNs [begin, end)
+// until the LLSN reaches llsnEnd or the number of log entries reaches
+// maxEntries.
+func (lse *Executor) scanRange(begin, end types.GLSN, llsnEnd types.LLSN, maxEntries int) ([]varlogpb.LogEntry, error) {
+	var les []varlogpb.LogEntry
+	scanner := lse.stg.NewScanner(storage.WithGLSN(begin, end))
+	defer func() {
+		_ = scanner.Close()
+	}()
+	for scanner.Valid() && len(les) < maxEntries {
+		le, err := scanner.Value()
+		if err != nil {
+			return nil, fmt.Errorf("log stream: %w", err)
+		}
+		if le.LLSN >= llsnEnd {
+			break
+		}
+		le.TopicID = lse.tpid
+		le.LogStreamID = lse.lsid
+		les = append(les, le)
+		_ = scanner.Next()
+	}
+	return les, nil
+}
+
+// LookupGLSNByTime returns the first log entry committed at or after the given
+// time. Since commit times are recorded for each commit, the log entry is the
+// first one of the commit. It returns verrors.ErrNoEntry if there is no such
+// log entry.
+func (lse *Executor) LookupGLSNByTime(t time.Time) (lsn varlogpb.LogSequenceNumber, err error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return lsn, verrors.ErrClosed
+	}
+
+	lem, err := lse.stg.LookupTime(t)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoLogEntry) {
+			err = verrors.ErrNoEntry
+		}
+		return lsn, fmt.Errorf("log stream: %w", err)
+	}
+	lsn.LLSN = lem.LLSN
+	lsn.GLSN = lem.GLSN
+	return lsn, nil
+}
@@ -140,6 +140,10 @@ func (rc *replicateClient) sendLoopInternal(_ context.Context, rt *replicateTask
 	copy(req.LLSN, rt.llsnList)
 	//req.LLSN = rt.llsnList
 	req.Data = rt.dataList
+	req.Attributes = rt.attrsList
+	req.ProducerID = rt.producerID
+	req.Sequence = rt.sequence
+	req.TransactionID = rt.transactionID
 	rt.release()
 	err := rc.streamClient.Send(req)
 	inflight := rc.inflight.Add(-1)
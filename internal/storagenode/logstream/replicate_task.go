@@ -5,14 +5,22 @@ import (
 
 	"github.com/kakao/varlog/internal/batchlet"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 // replicateTask is a task struct including a list of LLSNs and bytes of data.
 type replicateTask struct {
-	tpid     types.TopicID
-	lsid     types.LogStreamID
-	llsnList []types.LLSN
-	dataList [][]byte
+	tpid      types.TopicID
+	lsid      types.LogStreamID
+	llsnList  []types.LLSN
+	dataList  [][]byte
+	attrsList []varlogpb.LogEntryAttributes
+	// producerID and sequence are set if the log entries are appended by an
+	// idempotent producer. The sequence is of the first log entry.
+	producerID uint64
+	sequence   uint64
+	// transactionID is set if the log entries are staged by a transaction.
+	transactionID uint64
 
 	poolIdx int
 }
@@ -30,6 +38,10 @@ func (rt *replicateTask) release() {
 	rt.lsid = 0
 	rt.llsnList = rt.llsnList[0:0]
 	rt.dataList = nil
+	rt.attrsList = nil
+	rt.producerID = 0
+	rt.sequence = 0
+	rt.transactionID = 0
 	replicateTaskPools[rt.poolIdx].Put(rt)
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type sequencer struct {
@@ -102,6 +103,22 @@ func (sq *sequencer) sequenceLoopInternal(ctx context.Context, st *sequenceTask)
 
 	startTime = time.Now()
 
+	// An idempotent producer may retry the batch that has been appended
+	// already. The sequencer, which issues LLSNs serially, decides whether
+	// the batch is duplicated.
+	if st.pb != nil {
+		if err := sq.lse.pt.check(st.pb); err != nil {
+			operationEndTime = time.Now()
+			st.wwg.done(err)
+			_ = st.wb.Close()
+			releaseCommitWaitTaskList(st.cwts)
+			releaseReplicateTasks(st.rts.tasks)
+			releaseReplicateTaskSlice(st.rts)
+			st.release()
+			return
+		}
+	}
+
 	for dataIdx := 0; dataIdx < len(st.awgs); dataIdx++ {
 		sq.llsn++
 		st.awgs[dataIdx].setLLSN(sq.llsn)
@@ -112,12 +129,44 @@ func (sq *sequencer) sequenceLoopInternal(ctx context.Context, st *sequenceTask)
 			// NOTE: Use "append" since the length of st.rts is not enough to use index. Its capacity is enough because it is created to be reused.
 			st.rts.tasks[replicaIdx].llsnList = append(st.rts.tasks[replicaIdx].llsnList, sq.llsn)
 		}
-		//nolint:staticcheck
-		if err := st.wb.Set(sq.llsn, st.dataBatch[dataIdx]); err != nil {
-			// TODO: handle error
+		var attrs *varlogpb.LogEntryAttributes
+		if len(st.attrsBatch) > 0 {
+			attrs = &st.attrsBatch[dataIdx]
+		}
+		var producer storage.ProducerSequence
+		if st.pb != nil {
+			producer.ProducerID = st.pb.producerID
+			producer.Sequence = st.sequence + uint64(dataIdx)
+		}
+		if st.transactionID != 0 {
+			//nolint:staticcheck
+			if err := st.wb.SetInTransaction(sq.llsn, st.dataBatch[dataIdx], attrs, producer, st.transactionID); err != nil {
+				// TODO: handle error
+			}
+		} else if st.pb != nil {
+			//nolint:staticcheck
+			if err := st.wb.SetWithProducerSequence(sq.llsn, st.dataBatch[dataIdx], attrs, producer); err != nil {
+				// TODO: handle error
+			}
+		} else {
+			//nolint:staticcheck
+			if err := st.wb.SetWithAttributes(sq.llsn, st.dataBatch[dataIdx], attrs); err != nil {
+				// TODO: handle error
+			}
 		}
 		// st.dwb.SetLLSN(dataIdx, sq.llsn)
 	}
+	if st.pb != nil {
+		sq.lse.pt.advance(st.pb.producerID, st.sequence+uint64(len(st.awgs))-1, sq.llsn)
+	}
+	// The staged transaction should be recorded before the writer makes
+	// its log entries reported.
+	if st.transactionID != 0 {
+		sq.lse.ts.stage(st.transactionID, sq.llsn-types.LLSN(len(st.awgs))+1)
+		if st.lastInTransaction {
+			sq.lse.ts.ready(st.transactionID, sq.llsn+1)
+		}
+	}
 
 	operationEndTime = time.Now()
 
@@ -253,8 +302,18 @@ type sequenceTask struct {
 	// dwb  *storage.DeferredWriteBatch
 	wb        *storage.WriteBatch
 	dataBatch [][]byte
-	cwts      *listQueue
-	rts       *replicateTaskSlice
+	// attrsBatch is either empty or has the same length as dataBatch.
+	attrsBatch []varlogpb.LogEntryAttributes
+	// pb is not nil if the batch is appended by an idempotent producer, and
+	// sequence is the sequence number of the first log entry in dataBatch.
+	pb       *producerBatch
+	sequence uint64
+	// transactionID is not zero if the batch is staged by a transaction, and
+	// lastInTransaction is true if the task is the last one of the batch.
+	transactionID     uint64
+	lastInTransaction bool
+	cwts              *listQueue
+	rts               *replicateTaskSlice
 }
 
 func newSequenceTask() *sequenceTask {
@@ -278,6 +337,11 @@ func (st *sequenceTask) release() {
 	// st.dwb = nil
 	st.wb = nil
 	st.dataBatch = nil
+	st.attrsBatch = nil
+	st.pb = nil
+	st.sequence = 0
+	st.transactionID = 0
+	st.lastInTransaction = false
 	st.cwts = nil
 	st.rts = nil
 	sequenceTaskPool.Put(st)
@@ -8,24 +8,44 @@ import (
 	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
+// SubscribeOption is an option for SubscribeWithGLSN and SubscribeWithLLSN.
+type SubscribeOption func(*SubscribeResult)
+
+// WithFilter sets the filter of the subscription. Log entries that do not
+// match the filter are still sent to the channel of the result in order, but
+// they are stripped of their payloads and attributes. It lets the subscriber
+// advance its position without receiving them.
+func WithFilter(filter *varlogpb.LogEntryFilter) SubscribeOption {
+	return func(sr *SubscribeResult) {
+		if !filter.Empty() {
+			sr.filter = filter
+		}
+	}
+}
+
 type SubscribeResult struct {
 	c       chan varlogpb.LogEntry
+	filter  *varlogpb.LogEntryFilter
 	decider *decidableCondition
 	cancel  context.CancelFunc
 	wg      sync.WaitGroup
 	err     error
 }
 
-func (lse *Executor) newSubscribeResult() (*SubscribeResult, context.Context) {
+func (lse *Executor) newSubscribeResult(opts ...SubscribeOption) (*SubscribeResult, context.Context) {
 	ctx, cancel := context.WithCancel(context.Background())
 	sr := &SubscribeResult{
 		c:       make(chan varlogpb.LogEntry),
 		decider: lse.decider,
 		cancel:  cancel,
 	}
+	for _, opt := range opts {
+		opt(sr)
+	}
 	return sr, ctx
 }
 
@@ -34,6 +54,27 @@ func (sr *SubscribeResult) Result() <-chan varlogpb.LogEntry {
 	return sr.c
 }
 
+// Filtered returns true if the log entry received from the channel of the
+// result does not match the filter of the subscription. Such a log entry has
+// only its metadata.
+func (sr *SubscribeResult) Filtered(le varlogpb.LogEntry) bool {
+	return !sr.filter.Match(le.LogEntryAttributes)
+}
+
+// send sends the log entry to the channel of the result. It strips the log
+// entry if it does not match the filter.
+func (sr *SubscribeResult) send(ctx context.Context, le varlogpb.LogEntry) bool {
+	if !sr.filter.Match(le.LogEntryAttributes) {
+		le = varlogpb.LogEntry{LogEntryMeta: le.LogEntryMeta}
+	}
+	select {
+	case sr.c <- le:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Stop stops the subscription.
 // This should be called to release resources after using SubscribeResult.
 func (sr *SubscribeResult) Stop() {
@@ -63,7 +104,7 @@ func (lse *Executor) newEmptySubscribeResult() (*SubscribeResult, context.Contex
 
 // SubscribeWithGLSN subscribes to the log stream with the given range of GLSNs.
 // TODO: The first argument ctx may not be necessary, since the subscription can be stopped by the `internal/varlogsn/logstream.(*SubscribeResult).Stop()`.
-func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN) (*SubscribeResult, error) {
+func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN, opts ...SubscribeOption) (*SubscribeResult, error) {
 	lse.inflight.Add(1)
 	defer lse.inflight.Add(-1)
 
@@ -100,7 +141,7 @@ func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN) (*SubscribeResult,
 		return sr, nil
 	}
 
-	sr, ctx := lse.newSubscribeResult()
+	sr, ctx := lse.newSubscribeResult(opts...)
 	sr.wg.Add(1)
 	go func() {
 		defer sr.wg.Done()
@@ -111,7 +152,7 @@ func (lse *Executor) SubscribeWithGLSN(begin, end types.GLSN) (*SubscribeResult,
 
 // SubscribeWithLLSN subscribes to the log stream with the given range of LLSNs.
 // TODO: The first argument ctx may not be necessary, since the subscription can be stopped by the `internal/varlogsn/logstream.(*SubscribeResult).Stop()`.
-func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN) (*SubscribeResult, error) {
+func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN, opts ...SubscribeOption) (*SubscribeResult, error) {
 	lse.inflight.Add(1)
 	defer lse.inflight.Add(-1)
 
@@ -134,7 +175,7 @@ func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN) (*SubscribeResult,
 		//return sr, nil
 	}
 
-	sr, ctx := lse.newSubscribeResult()
+	sr, ctx := lse.newSubscribeResult(opts...)
 	sr.wg.Add(1)
 	go func() {
 		defer sr.wg.Done()
@@ -143,6 +184,36 @@ func (lse *Executor) SubscribeWithLLSN(begin, end types.LLSN) (*SubscribeResult,
 	return sr, nil
 }
 
+// ResolveStartLLSN returns the LLSN from which a subscription starting at the
+// symbolic position pos begins. The position is resolved against the commit
+// context of the log stream replica: StartPositionLatest is the LLSN following
+// the last log entry committed up to the global high watermark, and
+// StartPositionEarliest is the LLSN of the first log entry that is not
+// trimmed.
+func (lse *Executor) ResolveStartLLSN(pos snpb.StartPosition) (types.LLSN, error) {
+	lse.inflight.Add(1)
+	defer lse.inflight.Add(-1)
+
+	if lse.esm.load() == executorStateClosed {
+		return types.InvalidLLSN, verrors.ErrClosed
+	}
+
+	_, _, uncommittedBegin, _ := lse.lsc.reportCommitBase()
+	switch pos {
+	case snpb.StartPositionLatest:
+		return uncommittedBegin.LLSN, nil
+	case snpb.StartPositionEarliest:
+		localLWM, _, _ := lse.lsc.localWatermarks()
+		if localLWM.LLSN.Invalid() {
+			// There are no log entries in the log stream replica.
+			return uncommittedBegin.LLSN, nil
+		}
+		return localLWM.LLSN, nil
+	default:
+		return types.InvalidLLSN, fmt.Errorf("log stream: invalid start position %s: %w", pos, verrors.ErrInvalid)
+	}
+}
+
 func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, sr *SubscribeResult) error {
 	defer close(sr.c)
 	scanBegin := begin
@@ -171,9 +242,7 @@ func (lse *Executor) scanWithGLSN(ctx context.Context, begin, end types.GLSN, sr
 			le.TopicID = lse.tpid
 			le.LogStreamID = lse.lsid
 			lastGLSN = le.GLSN
-			select {
-			case sr.c <- le:
-			case <-ctx.Done():
+			if !sr.send(ctx, le) {
 				_ = scanner.Close()
 				return nil
 			}
@@ -221,9 +290,7 @@ func (lse *Executor) scanWithLLSN(ctx context.Context, begin, end types.LLSN, sr
 			le.TopicID = lse.tpid
 			le.LogStreamID = lse.lsid
 			lastLLSN = le.LLSN
-			select {
-			case sr.c <- le:
-			case <-ctx.Done():
+			if !sr.send(ctx, le) {
 				_ = scanner.Close()
 				return nil
 			}
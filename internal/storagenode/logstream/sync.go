@@ -208,8 +208,17 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 			err = fmt.Errorf("scan: %w", err)
 			return
 		}
+		first := true
 		for le := range sr.Result() {
 			req.Payload.LogEntry = &le
+			// The commit time accompanies the first log entry of each
+			// commit and the sync range so that the destination can
+			// rebuild its time index.
+			req.Payload.CommitTime = nil
+			if tie, errTime := lse.stg.ReadCommitTime(le.GLSN); errTime == nil && (first || tie.GLSN == le.GLSN) {
+				req.Payload.CommitTime = &tie.CommitTime
+			}
+			first = false
 			// TODO: Configure syncReplicate timeout
 			err = stream.SendMsg(req)
 			if err != nil {
@@ -246,6 +255,7 @@ func (lse *Executor) syncLoop(_ context.Context, sc *syncClient, st *syncTracker
 		}
 	}
 	req.Payload.LogEntry = nil
+	req.Payload.CommitTime = nil
 	req.Payload.CommitContext = &varlogpb.CommitContext{
 		Version:            cc.Version,
 		HighWatermark:      cc.HighWatermark,
@@ -510,10 +520,16 @@ func (lse *Executor) SyncReplicate(_ context.Context, srcReplica varlogpb.LogStr
 			return err
 		}
 
-		err = batch.SetLogEntry(logEntry.LLSN, logEntry.GLSN, logEntry.Data)
+		err = batch.SetLogEntry(logEntry.LLSN, logEntry.GLSN, logEntry.Data, &logEntry.LogEntryAttributes)
 		if err != nil {
 			return err
 		}
+		if payload.CommitTime != nil {
+			err = batch.SetCommitTime(*payload.CommitTime, logEntry.LLSN, logEntry.GLSN)
+			if err != nil {
+				return err
+			}
+		}
 		if ce := lse.logger.Check(zap.DebugLevel, "log stream: sync replicate: copy"); ce != nil {
 			ce.Write(zap.String("log entry", logEntry.String()))
 		}
@@ -0,0 +1,105 @@
+package logstream
+
+import (
+	"sync"
+	"time"
+
+	snerrors "github.com/kakao/varlog/internal/storagenode/errors"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// transactionStage keeps the transaction whose log entries are staged in the
+// log stream replica. The log stream replica reports the staged transaction
+// to the metadata repository, which holds log entries from the first one of
+// the transaction until it commits the transaction with other log streams
+// atomically. A log stream replica stages one transaction at a time.
+//
+// The primary replica reserves the stage when it receives the transactional
+// append, and the sequencer records the LLSNs of the transaction. The backup
+// replica records them when it receives the replicated log entries.
+type transactionStage struct {
+	mu sync.Mutex
+	id uint64
+	// begin is the LLSN of the first log entry of the transaction. It is
+	// invalid until the log entry is sequenced.
+	begin types.LLSN
+	// end is the LLSN next to the last log entry of the transaction. It is
+	// set only in the primary replica once all log entries of the
+	// transaction are sequenced.
+	end types.LLSN
+	// deadline is the time until which the primary replica waits for the
+	// transaction to be committed.
+	deadline time.Time
+}
+
+// reserve makes the stage hold the transaction. It returns
+// snerrors.ErrTransactionInProgress if another transaction is staged or the
+// same transaction has been staged already.
+func (ts *transactionStage) reserve(id uint64, deadline time.Time) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.id != 0 {
+		return snerrors.ErrTransactionInProgress
+	}
+	ts.id = id
+	ts.begin = types.InvalidLLSN
+	ts.end = types.InvalidLLSN
+	ts.deadline = deadline
+	return nil
+}
+
+// stage records the LLSN of the first log entry of the transaction. The
+// backup replica that has no staged transaction starts staging the
+// transaction.
+func (ts *transactionStage) stage(id uint64, llsn types.LLSN) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.id == 0 {
+		ts.id = id
+	}
+	if ts.id == id && ts.begin.Invalid() {
+		ts.begin = llsn
+	}
+}
+
+// ready records the LLSN next to the last log entry of the transaction.
+func (ts *transactionStage) ready(id uint64, end types.LLSN) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.id == id {
+		ts.end = end
+	}
+}
+
+// staged returns the staged transaction. The argument uncommittedLLSNBegin
+// is the LLSN of the first uncommitted log entry; if the transaction has
+// been committed already, the stage is cleared. The returned id is zero if
+// there is no staged transaction.
+func (ts *transactionStage) staged(uncommittedLLSNBegin types.LLSN) (id uint64, begin, end types.LLSN, deadline time.Time) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.id != 0 && !ts.begin.Invalid() && ts.begin < uncommittedLLSNBegin {
+		ts.resetNoLock()
+	}
+	return ts.id, ts.begin, ts.end, ts.deadline
+}
+
+// contains returns true if the transaction is staged.
+func (ts *transactionStage) contains(id uint64) bool {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.id != 0 && ts.id == id
+}
+
+func (ts *transactionStage) reset() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.resetNoLock()
+}
+
+func (ts *transactionStage) resetNoLock() {
+	ts.id = 0
+	ts.begin = types.InvalidLLSN
+	ts.end = types.InvalidLLSN
+	ts.deadline = time.Time{}
+}
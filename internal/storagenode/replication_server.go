@@ -9,6 +9,7 @@ import (
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/kakao/varlog/internal/storage"
 	"github.com/kakao/varlog/internal/storagenode/logstream"
 	"github.com/kakao/varlog/proto/snpb"
 )
@@ -167,7 +168,10 @@ func (rs *replicationServer) replicate(ctx context.Context, requestC <-chan *rep
 
 			lse.Metrics().ReplicateServerOperations.Add(1)
 
-			err = lse.Replicate(ctx, rst.req.LLSN, rst.req.Data)
+			err = lse.Replicate(ctx, rst.req.LLSN, rst.req.Data, rst.req.Attributes, storage.ProducerSequence{
+				ProducerID: rst.req.ProducerID,
+				Sequence:   rst.req.Sequence,
+			}, rst.req.TransactionID)
 			if err != nil {
 				rst.release()
 				return
@@ -368,6 +368,118 @@ func TestStorageNode(t *testing.T) {
 	wg.Wait()
 }
 
+func TestStorageNode_ReplicateLogEntryAttributes(t *testing.T) {
+	const (
+		cid   = types.ClusterID(1)
+		snid1 = types.StorageNodeID(1)
+		snid2 = types.StorageNodeID(2)
+		tpid  = types.TopicID(1)
+		lsid  = types.LogStreamID(1)
+	)
+
+	var wg sync.WaitGroup
+	nodes := make([]*StorageNode, 2)
+	for i, snid := range []types.StorageNodeID{snid1, snid2} {
+		sn := TestNewSimpleStorageNode(t, WithClusterID(cid), WithStorageNodeID(snid))
+		nodes[i] = sn
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = sn.Serve()
+		}()
+	}
+	defer func() {
+		for _, sn := range nodes {
+			require.NoError(t, sn.Close())
+		}
+		wg.Wait()
+	}()
+
+	// The first is the primary, and the second is the backup.
+	replicas := make([]varlogpb.LogStreamReplica, 0, len(nodes))
+	for _, sn := range nodes {
+		TestWaitForStartingOfServe(t, sn)
+		replicas = append(replicas, varlogpb.LogStreamReplica{
+			StorageNode: varlogpb.StorageNode{
+				StorageNodeID: sn.snid,
+				Address:       sn.advertise,
+			},
+			TopicLogStream: varlogpb.TopicLogStream{
+				TopicID:     tpid,
+				LogStreamID: lsid,
+			},
+		})
+	}
+	for _, sn := range nodes {
+		TestAddLogStreamReplica(t, cid, sn.snid, tpid, lsid, sn.snPaths[0], sn.advertise)
+		lss, lastGLSN := TestSealLogStreamReplica(t, cid, sn.snid, tpid, lsid, types.InvalidGLSN, sn.advertise)
+		require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+		require.True(t, lastGLSN.Invalid())
+		TestUnsealLogStreamReplica(t, cid, sn.snid, tpid, lsid, replicas, sn.advertise)
+	}
+
+	ts := time.Unix(1700000000, 0).UTC()
+	attrs := []varlogpb.LogEntryAttributes{
+		{
+			Key:       []byte("key"),
+			Timestamp: &ts,
+			Headers: []varlogpb.LogEntryHeader{
+				{Key: "h1", Value: []byte("v1")},
+			},
+		},
+		{},
+	}
+	dataBatch := [][]byte{[]byte("foo"), []byte("bar")}
+
+	lc, closer := TestNewLogIOClient(t, snid1, nodes[0].advertise)
+	defer closer()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		res, err := lc.Append(context.Background(), tpid, lsid, dataBatch, attrs...)
+		assert.NoError(t, err)
+		assert.Len(t, res, len(dataBatch))
+	}()
+
+	for _, sn := range nodes {
+		require.Eventually(t, func() bool {
+			reportcommitter.TestCommit(t, sn.advertise, snpb.CommitRequest{
+				StorageNodeID: sn.snid,
+				CommitResult: snpb.LogStreamCommitResult{
+					TopicID:             tpid,
+					LogStreamID:         lsid,
+					CommittedLLSNOffset: 1,
+					CommittedGLSNOffset: 1,
+					CommittedGLSNLength: uint64(len(dataBatch)),
+					Version:             1,
+					HighWatermark:       types.GLSN(len(dataBatch)),
+				},
+			})
+			reports := reportcommitter.TestGetReport(t, sn.advertise)
+			require.Len(t, reports, 1)
+			return reports[0].Version == types.Version(1)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// Both the primary and the backup keep the attributes.
+	for _, sn := range nodes {
+		les := TestSubscribe(t, tpid, lsid, types.MinGLSN, types.GLSN(len(dataBatch)+1), sn.snid, sn.advertise)
+		require.Len(t, les, len(dataBatch))
+		for i, le := range les {
+			require.Equal(t, dataBatch[i], le.Data)
+			require.True(t, attrs[i].Equal(le.LogEntryAttributes))
+		}
+
+		les = TestSubscribeTo(t, tpid, lsid, types.MinLLSN, types.LLSN(len(dataBatch)+1), sn.snid, sn.advertise)
+		require.Len(t, les, len(dataBatch))
+		for i, le := range les {
+			require.Equal(t, dataBatch[i], le.Data)
+			require.True(t, attrs[i].Equal(le.LogEntryAttributes))
+		}
+	}
+}
+
 func TestStorageNode_InvalidConfig(t *testing.T) {
 	// bad id
 	_, err := NewStorageNode(
@@ -662,6 +774,83 @@ func TestStorageNode_Append(t *testing.T) {
 				wg.Wait()
 			},
 		},
+		{
+			name: "InvalidAttributes",
+			testf: func(t *testing.T, _ string, lc *client.LogClient) {
+				attrs := []varlogpb.LogEntryAttributes{{Key: []byte("k1")}, {Key: []byte("k2")}}
+				_, err := lc.Append(context.Background(), tpid, lsid, payload, attrs...)
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "AppendWithAttributes",
+			testf: func(t *testing.T, addr string, lc *client.LogClient) {
+				lss, lastGLSN := TestSealLogStreamReplica(t, cid, snid, tpid, lsid, types.InvalidGLSN, addr)
+				require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+				require.True(t, lastGLSN.Invalid())
+
+				TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid, []varlogpb.LogStreamReplica{
+					{
+						StorageNode: varlogpb.StorageNode{
+							StorageNodeID: snid,
+							Address:       addr,
+						},
+						TopicLogStream: varlogpb.TopicLogStream{
+							TopicID:     tpid,
+							LogStreamID: lsid,
+						},
+					},
+				}, addr)
+
+				ts := time.Unix(1700000000, 0).UTC()
+				attrs := varlogpb.LogEntryAttributes{
+					Key:       []byte("key"),
+					Timestamp: &ts,
+					Headers: []varlogpb.LogEntryHeader{
+						{Key: "h1", Value: []byte("v1")},
+					},
+				}
+
+				var wg sync.WaitGroup
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					res, err := lc.Append(context.Background(), tpid, lsid, [][]byte{[]byte("msg")}, attrs)
+					assert.NoError(t, err)
+					assert.Len(t, res, 1)
+					assert.Empty(t, res[0].Error)
+				}()
+
+				require.Eventually(t, func() bool {
+					reportcommitter.TestCommit(t, addr, snpb.CommitRequest{
+						StorageNodeID: snid,
+						CommitResult: snpb.LogStreamCommitResult{
+							TopicID:             tpid,
+							LogStreamID:         lsid,
+							CommittedLLSNOffset: 1,
+							CommittedGLSNOffset: 1,
+							CommittedGLSNLength: 1,
+							Version:             1,
+							HighWatermark:       1,
+						},
+					})
+					reports := reportcommitter.TestGetReport(t, addr)
+					require.Len(t, reports, 1)
+					return reports[0].Version == types.Version(1)
+				}, time.Second, 10*time.Millisecond)
+				wg.Wait()
+
+				les := TestSubscribe(t, tpid, lsid, 1, 2, snid, addr)
+				require.Len(t, les, 1)
+				require.Equal(t, []byte("msg"), les[0].Data)
+				require.True(t, attrs.Equal(les[0].LogEntryAttributes))
+
+				les = TestSubscribeTo(t, tpid, lsid, 1, 2, snid, addr)
+				require.Len(t, les, 1)
+				require.True(t, attrs.Equal(les[0].LogEntryAttributes))
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -1263,6 +1452,287 @@ func TestStorageNode_SubscribeTo(t *testing.T) {
 	}
 }
 
+func TestStorageNode_Read(t *testing.T) {
+	const (
+		cid  = types.ClusterID(1)
+		snid = types.StorageNodeID(2)
+		tpid = types.TopicID(3)
+		lsid = types.LogStreamID(4)
+	)
+	payload := [][]byte{[]byte("foo"), []byte("bar")}
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, addr string, lc snpb.LogIOClient)
+	}{
+		{
+			name: "InvalidTopicID",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     types.TopicID(0),
+					LogStreamID: lsid,
+					GLSN:        1,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "InvalidPosition",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				_, err = lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSN:        1,
+					LLSN:        1,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoSuchLogStream",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid + 1,
+					GLSN:        1,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "NoEntry",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSN:        3,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.NotFound, status.Code(err))
+
+				_, err = lc.Read(context.Background(), &snpb.ReadRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					LLSN:        3,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "ReadByGLSN",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				for i := range payload {
+					rsp, err := lc.Read(context.Background(), &snpb.ReadRequest{
+						TopicID:     tpid,
+						LogStreamID: lsid,
+						GLSN:        types.GLSN(i + 1),
+					})
+					require.NoError(t, err)
+					require.Equal(t, tpid, rsp.LogEntry.TopicID)
+					require.Equal(t, lsid, rsp.LogEntry.LogStreamID)
+					require.Equal(t, types.GLSN(i+1), rsp.LogEntry.GLSN)
+					require.Equal(t, types.LLSN(i+1), rsp.LogEntry.LLSN)
+					require.Equal(t, payload[i], rsp.LogEntry.Data)
+				}
+			},
+		},
+		{
+			name: "ReadByLLSN",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				for i := range payload {
+					rsp, err := lc.Read(context.Background(), &snpb.ReadRequest{
+						TopicID:     tpid,
+						LogStreamID: lsid,
+						LLSN:        types.LLSN(i + 1),
+					})
+					require.NoError(t, err)
+					require.Equal(t, types.GLSN(i+1), rsp.LogEntry.GLSN)
+					require.Equal(t, types.LLSN(i+1), rsp.LogEntry.LLSN)
+					require.Equal(t, payload[i], rsp.LogEntry.Data)
+				}
+			},
+		},
+		{
+			name: "ReadRangeInvalidRange",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				_, err := lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSNBegin:   2,
+					GLSNEnd:     1,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+				_, err = lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSNBegin:   1,
+					GLSNEnd:     3,
+					LLSNBegin:   1,
+					LLSNEnd:     3,
+				})
+				require.Error(t, err)
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "ReadRangeByGLSN",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				rsp, err := lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSNBegin:   1,
+					GLSNEnd:     10,
+				})
+				require.NoError(t, err)
+				require.Len(t, rsp.LogEntries, len(payload))
+				for i, le := range rsp.LogEntries {
+					require.Equal(t, types.GLSN(i+1), le.GLSN)
+					require.Equal(t, payload[i], le.Data)
+				}
+
+				rsp, err = lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					GLSNBegin:   1,
+					GLSNEnd:     10,
+					MaxEntries:  1,
+				})
+				require.NoError(t, err)
+				require.Len(t, rsp.LogEntries, 1)
+				require.Equal(t, types.GLSN(1), rsp.LogEntries[0].GLSN)
+			},
+		},
+		{
+			name: "ReadRangeByLLSN",
+			testf: func(t *testing.T, _ string, lc snpb.LogIOClient) {
+				rsp, err := lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					LLSNBegin:   2,
+					LLSNEnd:     10,
+				})
+				require.NoError(t, err)
+				require.Len(t, rsp.LogEntries, 1)
+				require.Equal(t, types.LLSN(2), rsp.LogEntries[0].LLSN)
+				require.Equal(t, types.GLSN(2), rsp.LogEntries[0].GLSN)
+				require.Equal(t, payload[1], rsp.LogEntries[0].Data)
+
+				rsp, err = lc.ReadRange(context.Background(), &snpb.ReadRangeRequest{
+					TopicID:     tpid,
+					LogStreamID: lsid,
+					LLSNBegin:   3,
+					LLSNEnd:     10,
+				})
+				require.NoError(t, err)
+				require.Empty(t, rsp.LogEntries)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			sn := TestNewSimpleStorageNode(t, WithClusterID(cid), WithStorageNodeID(snid))
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = sn.Serve()
+			}()
+			defer func() {
+				require.NoError(t, sn.Close())
+				wg.Wait()
+			}()
+
+			addr := TestGetAdvertiseAddress(t, sn)
+
+			mc, mcClose := TestNewManagementClient(t, cid, snid, addr)
+			defer mcClose()
+
+			_, err := mc.AddLogStreamReplica(context.Background(), tpid, lsid, sn.snPaths[0])
+			require.NoError(t, err)
+
+			lss, lastGLSN := TestSealLogStreamReplica(t, cid, snid, tpid, lsid, types.InvalidGLSN, addr)
+			require.Equal(t, varlogpb.LogStreamStatusSealed, lss)
+			require.True(t, lastGLSN.Invalid())
+
+			TestUnsealLogStreamReplica(t, cid, snid, tpid, lsid, []varlogpb.LogStreamReplica{
+				{
+					StorageNode: varlogpb.StorageNode{
+						StorageNodeID: snid,
+						Address:       addr,
+					},
+					TopicLogStream: varlogpb.TopicLogStream{
+						TopicID:     tpid,
+						LogStreamID: lsid,
+					},
+				},
+			}, addr)
+
+			var appendWg sync.WaitGroup
+			appendWg.Add(2)
+			go func() {
+				defer appendWg.Done()
+				res := TestAppend(t, tpid, lsid, payload, []varlogpb.LogStreamReplica{
+					{
+						StorageNode: varlogpb.StorageNode{
+							StorageNodeID: snid,
+							Address:       addr,
+						},
+						TopicLogStream: varlogpb.TopicLogStream{
+							TopicID:     tpid,
+							LogStreamID: lsid,
+						},
+					},
+				})
+				assert.Len(t, res, len(payload))
+			}()
+			go func() {
+				defer appendWg.Done()
+				assert.Eventually(t, func() bool {
+					reportcommitter.TestCommit(t, addr, snpb.CommitRequest{
+						StorageNodeID: snid,
+						CommitResult: snpb.LogStreamCommitResult{
+							TopicID:             tpid,
+							LogStreamID:         lsid,
+							CommittedLLSNOffset: 1,
+							CommittedGLSNOffset: 1,
+							CommittedGLSNLength: 2,
+							Version:             1,
+							HighWatermark:       2,
+						},
+					})
+					reports := reportcommitter.TestGetReport(t, addr)
+					assert.Len(t, reports, 1)
+					return reports[0].Version == types.Version(1)
+				}, time.Second, 10*time.Millisecond)
+			}()
+			appendWg.Wait()
+
+			rpcConn, err := rpc.NewConn(context.Background(), addr)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, rpcConn.Close())
+			}()
+			lc := snpb.NewLogIOClient(rpcConn.Conn)
+
+			tc.testf(t, addr, lc)
+		})
+	}
+}
+
 func TestStorageNode_LogStreamReplicaMetadata(t *testing.T) {
 	const (
 		cid  = types.ClusterID(1)
@@ -1856,6 +2326,92 @@ func TestStorageNode_Sync(t *testing.T) {
 				}, snmd.LogStreamReplicas[0].LocalHighWatermark)
 			},
 		},
+		{
+			// ver: +-1-+
+			// src:  1 2  <with attributes>
+			// dst:
+			name: "CopyLogEntryAttributes",
+			testf: func(t *testing.T, src, dst *StorageNode) {
+				const ver = types.Version(1)
+				lastCommittedGLSN := lastGLSN(ver)
+
+				ts := time.Unix(1700000000, 0).UTC()
+				attrs := []varlogpb.LogEntryAttributes{
+					{
+						Key:       []byte("key"),
+						Timestamp: &ts,
+						Headers: []varlogpb.LogEntryHeader{
+							{Key: "h1", Value: []byte("v1")},
+						},
+					},
+					{},
+				}
+
+				lc, closer := TestNewLogIOClient(t, src.snid, src.advertise)
+				defer closer()
+				var wg sync.WaitGroup
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					res, err := lc.Append(context.Background(), tpid, lsid, [][]byte{[]byte("foo"), []byte("bar")}, attrs...)
+					assert.NoError(t, err)
+					assert.Len(t, res, 2)
+				}()
+				require.Eventually(t, func() bool {
+					reportcommitter.TestCommit(t, src.advertise, snpb.CommitRequest{
+						StorageNodeID: src.snid,
+						CommitResult: snpb.LogStreamCommitResult{
+							TopicID:             tpid,
+							LogStreamID:         lsid,
+							CommittedLLSNOffset: 1,
+							CommittedGLSNOffset: 1,
+							CommittedGLSNLength: 2,
+							Version:             ver,
+							HighWatermark:       lastCommittedGLSN,
+						},
+					})
+					reports := reportcommitter.TestGetReport(t, src.advertise)
+					assert.Len(t, reports, 1)
+					return reports[0].Version == ver
+				}, time.Second, 10*time.Millisecond)
+				wg.Wait()
+
+				status, localHWM := TestSealLogStreamReplica(t, cid, src.snid, tpid, lsid, lastCommittedGLSN, src.advertise)
+				require.Equal(t, varlogpb.LogStreamStatusSealed, status)
+				require.Equal(t, lastCommittedGLSN, localHWM)
+
+				status, localHWM = TestSealLogStreamReplica(t, cid, dst.snid, tpid, lsid, lastCommittedGLSN, dst.advertise)
+				require.Equal(t, varlogpb.LogStreamStatusSealing, status)
+				require.Equal(t, types.InvalidGLSN, localHWM)
+
+				require.Eventually(t, func() bool {
+					syncStatus := TestSync(t, cid, src.snid, tpid, lsid, 0 /*unused*/, src.advertise, varlogpb.StorageNode{
+						StorageNodeID: dst.snid,
+						Address:       dst.advertise,
+					})
+					return syncStatus.State == snpb.SyncStateComplete
+				}, 10*time.Second, 100*time.Millisecond)
+
+				status, localHWM = TestSealLogStreamReplica(t, cid, dst.snid, tpid, lsid, lastCommittedGLSN, dst.advertise)
+				require.Equal(t, varlogpb.LogStreamStatusSealed, status)
+				require.Equal(t, lastCommittedGLSN, localHWM)
+
+				lse, ok := dst.executors.Load(tpid, lsid)
+				require.True(t, ok)
+				stg := logstream.TestGetStorage(t, lse)
+				for i, data := range [][]byte{[]byte("foo"), []byte("bar")} {
+					le, err := stg.Read(storage.AtGLSN(types.GLSN(i + 1)))
+					require.NoError(t, err)
+					require.Equal(t, data, le.Data)
+					require.True(t, attrs[i].Equal(le.LogEntryAttributes))
+
+					le, err = stg.Read(storage.AtLLSN(types.LLSN(i + 1)))
+					require.NoError(t, err)
+					require.Equal(t, data, le.Data)
+					require.True(t, attrs[i].Equal(le.LogEntryAttributes))
+				}
+			},
+		},
 		{
 			// ver: +-1-+ +-2-+
 			// src:        3 4
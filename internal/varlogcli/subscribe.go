@@ -19,8 +19,20 @@ const (
 )
 
 func Subscribe(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID) error {
-	const size = 10
+	vlog, err := open(mrAddrs, clusterID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = vlog.Close()
+	}()
+
+	return subscribeFrom(vlog, topicID, types.MinGLSN)
+}
 
+// SubscribeFromTime subscribes to log entries of the topic committed at or
+// after the argument fromTime.
+func SubscribeFromTime(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID, fromTime time.Time) error {
 	vlog, err := open(mrAddrs, clusterID)
 	if err != nil {
 		return err
@@ -29,7 +41,53 @@ func Subscribe(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicI
 		_ = vlog.Close()
 	}()
 
-	for begin := types.MinGLSN; begin < types.MaxGLSN; begin += size {
+	ctx, cancel := context.WithTimeout(context.Background(), subscribeTimeout)
+	defer cancel()
+	begin, err := vlog.LookupGLSNByTime(ctx, topicID, fromTime)
+	if err != nil {
+		return errors.WithMessagef(err, "could not find log entries since %s", fromTime.Format(time.RFC3339))
+	}
+	return subscribeFrom(vlog, topicID, begin)
+}
+
+// SubscribeFromPosition subscribes to log entries of the topic from the
+// argument pos, for instance, only new log entries if it is
+// varlog.StartPositionLatest.
+func SubscribeFromPosition(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID, pos varlog.StartPosition) (err error) {
+	vlog, err := open(mrAddrs, clusterID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = multierr.Append(err, vlog.Close())
+	}()
+
+	// Unlike subscribeFrom, it cannot split the subscription into small
+	// ranges since the first GLSN is decided by the client library.
+	it, err := vlog.SubscribeIterator(context.Background(), topicID, types.MinGLSN, types.MaxGLSN, varlog.WithStartPosition(pos))
+	if err != nil {
+		return errors.WithMessage(err, "could not subscribe")
+	}
+	defer func() {
+		err = multierr.Append(err, it.Close())
+	}()
+
+	for {
+		logEntry, err := it.Next(context.Background())
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.WithMessage(err, "could not subscribe")
+		}
+		log.Printf("Subscribe: %s (%+v)", string(logEntry.Data), logEntry)
+	}
+}
+
+func subscribeFrom(vlog varlog.Log, topicID types.TopicID, from types.GLSN) error {
+	const size = 10
+
+	for begin := from; begin < types.MaxGLSN; begin += size {
 		if err := subscribe(vlog, topicID, begin, begin+size); err != nil {
 			return err
 		}
@@ -66,7 +124,7 @@ func subscribe(vlog varlog.Log, topicID types.TopicID, begin, end types.GLSN) er
 	return errors.WithMessage(err, "could not subscribe")
 }
 
-func SubscribeTo(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID, logStreamID types.LogStreamID) (err error) {
+func SubscribeTo(mrAddrs []string, clusterID types.ClusterID, topicID types.TopicID, logStreamID types.LogStreamID, opts ...varlog.SubscribeOption) (err error) {
 	vlog, err := open(mrAddrs, clusterID)
 	if err != nil {
 		return err
@@ -75,7 +133,7 @@ func SubscribeTo(mrAddrs []string, clusterID types.ClusterID, topicID types.Topi
 		err = multierr.Append(err, vlog.Close())
 	}()
 
-	subscriber := vlog.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, types.MaxLLSN)
+	subscriber := vlog.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, types.MaxLLSN, opts...)
 	defer func() {
 		err = multierr.Append(err, subscriber.Close())
 	}()
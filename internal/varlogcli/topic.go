@@ -0,0 +1,43 @@
+package varlogcli
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/mrc/mrconnector"
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// LookupTopic returns the ID of the topic whose name is the argument name by
+// reading the metadata from the metadata repository.
+func LookupTopic(mrAddrs []string, clusterID types.ClusterID, name string) (tpid types.TopicID, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), openTimeout)
+	defer cancel()
+
+	connector, err := mrconnector.New(ctx,
+		mrconnector.WithSeed(mrAddrs),
+		mrconnector.WithClusterID(clusterID),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		err = multierr.Append(err, connector.Close())
+	}()
+
+	client, err := connector.Client(ctx)
+	if err != nil {
+		return 0, err
+	}
+	md, err := client.GetMetadata(ctx)
+	if err != nil {
+		return 0, err
+	}
+	td := md.GetTopicByName(name)
+	if td == nil {
+		return 0, errors.Errorf("no such topic %q", name)
+	}
+	return td.TopicID, nil
+}
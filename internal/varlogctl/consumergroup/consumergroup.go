@@ -0,0 +1,40 @@
+package consumergroup
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Describe returns a function to list consumer groups or to get the consumer
+// group named group. The result includes the lags of the committed offsets.
+func Describe(group ...string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		if len(group) > 0 {
+			return adm.GetConsumerGroup(ctx, group[0])
+		}
+		return adm.ListConsumerGroups(ctx)
+	}
+}
+
+// Reset returns a function to overwrite the offset of the consumer group named
+// group.
+func Reset(group string, offset varlogpb.ConsumerGroupOffset) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.ResetConsumerGroupOffset(ctx, group, offset)
+	}
+}
+
+// Remove returns a function to delete the consumer group named group.
+func Remove(group string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		if err := adm.DeleteConsumerGroup(ctx, group); err != nil {
+			return nil, err
+		}
+		return empty.Empty{}, nil
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"go.uber.org/goleak"
 
 	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/internal/varlogctl/consumergroup"
 	"github.com/kakao/varlog/internal/varlogctl/logstream"
 	"github.com/kakao/varlog/internal/varlogctl/metarepos"
+	"github.com/kakao/varlog/internal/varlogctl/schema"
 	"github.com/kakao/varlog/internal/varlogctl/storagenode"
 	"github.com/kakao/varlog/internal/varlogctl/topic"
 	"github.com/kakao/varlog/pkg/types"
@@ -156,6 +158,17 @@ var (
 			lsid2,
 		},
 	}
+	td2 = &varlogpb.TopicDescriptor{
+		TopicID: tpid1 + 1,
+		Status:  varlogpb.TopicStatusRunning,
+		Name:    "orders-events",
+		Config: varlogpb.TopicConfig{
+			ReplicationFactor: 3,
+			Retention:         24 * time.Hour,
+			MaxLogStreams:     4,
+			Description:       "events of orders",
+		},
+	}
 
 	lsd1 = &varlogpb.LogStreamDescriptor{
 		TopicID:     tpid1,
@@ -185,6 +198,40 @@ var (
 		Leader:  true,
 		Learner: false,
 	}
+
+	cgm1 = &admpb.ConsumerGroupMetadata{
+		Name: "cg",
+		Offsets: []admpb.ConsumerGroupOffsetLag{
+			{
+				Offset: varlogpb.ConsumerGroupOffset{
+					TopicID:    tpid1,
+					GLSN:       types.GLSN(90),
+					UpdateTime: time.Date(2022, time.November, 1, 12, 0, 0, 0, time.UTC),
+				},
+				HighWatermark: 97,
+				Lag:           7,
+			},
+			{
+				Offset: varlogpb.ConsumerGroupOffset{
+					TopicID:     tpid1,
+					LogStreamID: lsid1,
+					LLSN:        types.LLSN(51),
+					UpdateTime:  time.Date(2022, time.November, 1, 12, 0, 1, 0, time.UTC),
+				},
+				HighWatermark: 51,
+				Lag:           0,
+			},
+		},
+	}
+
+	sd1 = &varlogpb.SchemaDescriptor{
+		SchemaID:   types.SchemaID(1),
+		TopicID:    tpid1,
+		Version:    1,
+		Type:       varlogpb.SchemaTypeJSON,
+		Definition: []byte(`{"type":"object"}`),
+		CreateTime: time.Date(2022, time.November, 1, 12, 0, 0, 0, time.UTC),
+	}
 )
 
 func TestController(t *testing.T) {
@@ -259,6 +306,14 @@ func TestController(t *testing.T) {
 				adm.EXPECT().GetTopic(gomock.Any(), td1.TopicID).Return(td1, nil)
 			},
 		},
+		{
+			name:        "GetTopicByName",
+			golden:      "varlogctl/gettopicbyname.0.golden.json",
+			executeFunc: topic.DescribeByName(td2.Name),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().GetTopicByName(gomock.Any(), td2.Name).Return(td2, nil)
+			},
+		},
 		{
 			name:        "ListTopics0",
 			golden:      "varlogctl/listtopics.0.golden.json",
@@ -283,6 +338,14 @@ func TestController(t *testing.T) {
 				adm.EXPECT().AddTopic(gomock.Any()).Return(td1, nil)
 			},
 		},
+		{
+			name:        "AddNamedTopic",
+			golden:      "varlogctl/addnamedtopic.0.golden.json",
+			executeFunc: topic.AddNamed(td2.Name, td2.Config),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().AddNamedTopic(gomock.Any(), td2.Name, td2.Config).Return(td2, nil)
+			},
+		},
 		{
 			name:        "UnregisterTopic",
 			golden:      "varlogctl/unregistertopic.0.golden.json",
@@ -291,6 +354,15 @@ func TestController(t *testing.T) {
 				adm.EXPECT().UnregisterTopic(gomock.Any(), td1.TopicID).Return(nil)
 			},
 		},
+		{
+			name:        "UnregisterTopicByName",
+			golden:      "varlogctl/unregistertopic.0.golden.json",
+			executeFunc: topic.RemoveByName(td2.Name),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().GetTopicByName(gomock.Any(), td2.Name).Return(td2, nil)
+				adm.EXPECT().UnregisterTopic(gomock.Any(), td2.TopicID).Return(nil)
+			},
+		},
 		{
 			name:        "GetLogStream",
 			golden:      "varlogctl/getlogstream.0.golden.json",
@@ -414,6 +486,93 @@ func TestController(t *testing.T) {
 				adm.EXPECT().DeleteMetadataRepositoryNode(gomock.Any(), types.NewNodeIDFromURL(rafturl1)).Return(nil)
 			},
 		},
+		{
+			name:        "ListConsumerGroups0",
+			golden:      "varlogctl/listconsumergroups.0.golden.json",
+			executeFunc: consumergroup.Describe(),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListConsumerGroups(gomock.Any()).Return([]admpb.ConsumerGroupMetadata{}, nil)
+			},
+		},
+		{
+			name:        "ListConsumerGroups1",
+			golden:      "varlogctl/listconsumergroups.1.golden.json",
+			executeFunc: consumergroup.Describe(),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListConsumerGroups(gomock.Any()).Return([]admpb.ConsumerGroupMetadata{*cgm1}, nil)
+			},
+		},
+		{
+			name:        "GetConsumerGroup",
+			golden:      "varlogctl/getconsumergroup.0.golden.json",
+			executeFunc: consumergroup.Describe(cgm1.Name),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().GetConsumerGroup(gomock.Any(), cgm1.Name).Return(cgm1, nil)
+			},
+		},
+		{
+			name:        "ResetConsumerGroupOffset",
+			golden:      "varlogctl/resetconsumergroupoffset.0.golden.json",
+			executeFunc: consumergroup.Reset(cgm1.Name, varlogpb.ConsumerGroupOffset{TopicID: tpid1}),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ResetConsumerGroupOffset(gomock.Any(), cgm1.Name, varlogpb.ConsumerGroupOffset{TopicID: tpid1}).Return(
+					&admpb.ConsumerGroupMetadata{
+						Name: cgm1.Name,
+						Offsets: []admpb.ConsumerGroupOffsetLag{
+							{
+								Offset: varlogpb.ConsumerGroupOffset{
+									TopicID:    tpid1,
+									UpdateTime: time.Date(2022, time.November, 2, 9, 0, 0, 0, time.UTC),
+								},
+								HighWatermark: 97,
+								Lag:           97,
+							},
+							cgm1.Offsets[1],
+						},
+					}, nil,
+				)
+			},
+		},
+		{
+			name:        "DeleteConsumerGroup",
+			golden:      "varlogctl/deleteconsumergroup.0.golden.json",
+			executeFunc: consumergroup.Remove(cgm1.Name),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().DeleteConsumerGroup(gomock.Any(), cgm1.Name).Return(nil)
+			},
+		},
+		{
+			name:        "RegisterSchema",
+			golden:      "varlogctl/registerschema.0.golden.json",
+			executeFunc: schema.Register(tpid1, sd1.Type, sd1.Definition, varlogpb.SchemaCompatibilityBackward),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().RegisterSchema(gomock.Any(), tpid1, sd1.Type, sd1.Definition, varlogpb.SchemaCompatibilityBackward).Return(sd1, nil)
+			},
+		},
+		{
+			name:        "GetSchema",
+			golden:      "varlogctl/getschema.0.golden.json",
+			executeFunc: schema.Describe(sd1.SchemaID),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().GetSchema(gomock.Any(), sd1.SchemaID).Return(sd1, nil)
+			},
+		},
+		{
+			name:        "ListSchemas0",
+			golden:      "varlogctl/listschemas.0.golden.json",
+			executeFunc: schema.List(tpid1),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListSchemas(gomock.Any(), tpid1).Return([]varlogpb.SchemaDescriptor{}, nil)
+			},
+		},
+		{
+			name:        "ListSchemas1",
+			golden:      "varlogctl/listschemas.1.golden.json",
+			executeFunc: schema.List(tpid1),
+			initMock: func(adm *varlog.MockAdmin) {
+				adm.EXPECT().ListSchemas(gomock.Any(), tpid1).Return([]varlogpb.SchemaDescriptor{*sd1}, nil)
+			},
+		},
 	}
 
 	for _, tc := range tcs {
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/kakao/varlog/internal/varlogctl"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Register returns a function to register a new version of the schema of the
+// topic.
+func Register(tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.RegisterSchema(ctx, tpid, typ, definition, compat)
+	}
+}
+
+// Describe returns a function to get the schema specified by the argument
+// schemaID.
+func Describe(schemaID types.SchemaID) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.GetSchema(ctx, schemaID)
+	}
+}
+
+// List returns a function to list all versions of the schema of the topic.
+func List(tpid types.TopicID) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.ListSchemas(ctx, tpid)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kakao/varlog/internal/varlogctl"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 // Add returns a function to add a new topic.
@@ -17,6 +18,14 @@ func Add() varlogctl.ExecuteFunc {
 	}
 }
 
+// AddNamed returns a function to add a new topic with the name and
+// configuration.
+func AddNamed(name string, config varlogpb.TopicConfig) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.AddNamedTopic(ctx, name, config)
+	}
+}
+
 // Remove returns a function to remove the topic identified with id.
 func Remove(id types.TopicID) varlogctl.ExecuteFunc {
 	return func(ctx context.Context, adm varlog.Admin) (any, error) {
@@ -27,6 +36,18 @@ func Remove(id types.TopicID) varlogctl.ExecuteFunc {
 	}
 }
 
+// RemoveByName returns a function to remove the topic whose name is the
+// argument name.
+func RemoveByName(name string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		td, err := adm.GetTopicByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		return Remove(td.TopicID)(ctx, adm)
+	}
+}
+
 // Describe returns a function to list of topics or to get the topic identified with id.
 func Describe(id ...types.TopicID) varlogctl.ExecuteFunc {
 	return func(ctx context.Context, adm varlog.Admin) (any, error) {
@@ -36,3 +57,11 @@ func Describe(id ...types.TopicID) varlogctl.ExecuteFunc {
 		return adm.ListTopics(ctx)
 	}
 }
+
+// DescribeByName returns a function to get the topic whose name is the
+// argument name.
+func DescribeByName(name string) varlogctl.ExecuteFunc {
+	return func(ctx context.Context, adm varlog.Admin) (any, error) {
+		return adm.GetTopicByName(ctx, name)
+	}
+}
@@ -21,7 +21,7 @@ import (
 type MetadataRepositoryClient interface {
 	RegisterStorageNode(context.Context, *varlogpb.StorageNodeDescriptor) error
 	UnregisterStorageNode(context.Context, types.StorageNodeID) error
-	RegisterTopic(context.Context, types.TopicID) error
+	RegisterTopic(context.Context, *varlogpb.TopicDescriptor) error
 	UnregisterTopic(context.Context, types.TopicID) error
 	RegisterLogStream(context.Context, *varlogpb.LogStreamDescriptor) error
 	UnregisterLogStream(context.Context, types.LogStreamID) error
@@ -29,6 +29,15 @@ type MetadataRepositoryClient interface {
 	GetMetadata(context.Context) (*varlogpb.MetadataDescriptor, error)
 	Seal(context.Context, types.LogStreamID) (types.GLSN, error)
 	Unseal(context.Context, types.LogStreamID) error
+	CommitConsumerGroupOffset(context.Context, string, varlogpb.ConsumerGroupOffset) error
+	GetConsumerGroup(context.Context, string) (*varlogpb.ConsumerGroupDescriptor, error)
+	ListConsumerGroups(context.Context) ([]varlogpb.ConsumerGroupDescriptor, error)
+	DeleteConsumerGroup(context.Context, string) error
+	CommitTransaction(context.Context, uint64, []varlogpb.TopicLogStream) error
+	GetCommitResults(context.Context, types.TopicID) (*mrpb.LogStreamCommitResults, error)
+	RegisterSchema(context.Context, types.TopicID, varlogpb.SchemaType, []byte, varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error)
+	GetSchema(context.Context, types.SchemaID) (*varlogpb.SchemaDescriptor, error)
+	ListSchemas(context.Context, types.TopicID) ([]varlogpb.SchemaDescriptor, error)
 	Close() error
 }
 
@@ -96,14 +105,16 @@ func (c *metadataRepositoryClient) UnregisterStorageNode(ctx context.Context, sn
 	return verrors.FromStatusError(errors.WithStack(err))
 }
 
-func (c *metadataRepositoryClient) RegisterTopic(ctx context.Context, topicID types.TopicID) error {
+func (c *metadataRepositoryClient) RegisterTopic(ctx context.Context, topic *varlogpb.TopicDescriptor) error {
 	req := &mrpb.TopicRequest{
-		TopicID: topicID,
+		TopicID: topic.TopicID,
+		Name:    topic.Name,
+		Config:  topic.Config,
 	}
 
 	_, err := c.client.RegisterTopic(ctx, req)
 	if err != nil {
-		if code := status.Code(err); code == codes.ResourceExhausted {
+		if code := status.Code(err); code == codes.ResourceExhausted || code == codes.AlreadyExists {
 			return err
 		}
 		return verrors.FromStatusError(errors.WithStack(err))
@@ -184,3 +195,80 @@ func (c *metadataRepositoryClient) Unseal(ctx context.Context, lsID types.LogStr
 	}
 	return nil
 }
+
+func (c *metadataRepositoryClient) CommitConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	_, err := c.client.CommitConsumerGroupOffset(ctx, &mrpb.CommitConsumerGroupOffsetRequest{
+		Group:  group,
+		Offset: offset,
+	})
+	return verrors.FromStatusError(errors.WithStack(err))
+}
+
+func (c *metadataRepositoryClient) CommitTransaction(ctx context.Context, transactionID uint64, participants []varlogpb.TopicLogStream) error {
+	_, err := c.client.CommitTransaction(ctx, &mrpb.CommitTransactionRequest{
+		TransactionID: transactionID,
+		Participants:  participants,
+	})
+	return verrors.FromStatusError(errors.WithStack(err))
+}
+
+func (c *metadataRepositoryClient) GetCommitResults(ctx context.Context, topicID types.TopicID) (*mrpb.LogStreamCommitResults, error) {
+	rsp, err := c.client.GetCommitResults(ctx, &mrpb.GetCommitResultsRequest{TopicID: topicID})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return &mrpb.LogStreamCommitResults{
+		Version:       rsp.Version,
+		CommitResults: rsp.CommitResults,
+	}, nil
+}
+
+func (c *metadataRepositoryClient) RegisterSchema(ctx context.Context, topicID types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	rsp, err := c.client.RegisterSchema(ctx, &mrpb.RegisterSchemaRequest{
+		TopicID:       topicID,
+		Type:          typ,
+		Definition:    definition,
+		Compatibility: compat,
+	})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return &rsp.Schema, nil
+}
+
+func (c *metadataRepositoryClient) GetSchema(ctx context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	rsp, err := c.client.GetSchema(ctx, &mrpb.GetSchemaRequest{SchemaID: schemaID})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return &rsp.Schema, nil
+}
+
+func (c *metadataRepositoryClient) ListSchemas(ctx context.Context, topicID types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	rsp, err := c.client.ListSchemas(ctx, &mrpb.ListSchemasRequest{TopicID: topicID})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return rsp.Schemas, nil
+}
+
+func (c *metadataRepositoryClient) GetConsumerGroup(ctx context.Context, group string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	rsp, err := c.client.GetConsumerGroup(ctx, &mrpb.GetConsumerGroupRequest{Group: group})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return &rsp.ConsumerGroup, nil
+}
+
+func (c *metadataRepositoryClient) ListConsumerGroups(ctx context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	rsp, err := c.client.ListConsumerGroups(ctx, &mrpb.ListConsumerGroupsRequest{})
+	if err != nil {
+		return nil, verrors.FromStatusError(errors.WithStack(err))
+	}
+	return rsp.ConsumerGroups, nil
+}
+
+func (c *metadataRepositoryClient) DeleteConsumerGroup(ctx context.Context, group string) error {
+	_, err := c.client.DeleteConsumerGroup(ctx, &mrpb.DeleteConsumerGroupRequest{Group: group})
+	return verrors.FromStatusError(errors.WithStack(err))
+}
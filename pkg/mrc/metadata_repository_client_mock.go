@@ -9,8 +9,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-
 	types "github.com/kakao/varlog/pkg/types"
+	mrpb "github.com/kakao/varlog/proto/mrpb"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -51,6 +51,78 @@ func (mr *MockMetadataRepositoryClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).Close))
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockMetadataRepositoryClient) CommitConsumerGroupOffset(arg0 context.Context, arg1 string, arg2 varlogpb.ConsumerGroupOffset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockMetadataRepositoryClientMockRecorder) CommitConsumerGroupOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).CommitConsumerGroupOffset), arg0, arg1, arg2)
+}
+
+// CommitTransaction mocks base method.
+func (m *MockMetadataRepositoryClient) CommitTransaction(arg0 context.Context, arg1 uint64, arg2 []varlogpb.TopicLogStream) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitTransaction indicates an expected call of CommitTransaction.
+func (mr *MockMetadataRepositoryClientMockRecorder) CommitTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTransaction", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).CommitTransaction), arg0, arg1, arg2)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryClient) DeleteConsumerGroup(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryClientMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
+// GetCommitResults mocks base method.
+func (m *MockMetadataRepositoryClient) GetCommitResults(arg0 context.Context, arg1 types.TopicID) (*mrpb.LogStreamCommitResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitResults", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.LogStreamCommitResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitResults indicates an expected call of GetCommitResults.
+func (mr *MockMetadataRepositoryClientMockRecorder) GetCommitResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitResults", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetCommitResults), arg0, arg1)
+}
+
+// GetConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryClient) GetConsumerGroup(arg0 context.Context, arg1 string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*varlogpb.ConsumerGroupDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockMetadataRepositoryClientMockRecorder) GetConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetConsumerGroup), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryClient) GetMetadata(arg0 context.Context) (*varlogpb.MetadataDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +138,51 @@ func (mr *MockMetadataRepositoryClientMockRecorder) GetMetadata(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetMetadata), arg0)
 }
 
+// GetSchema mocks base method.
+func (m *MockMetadataRepositoryClient) GetSchema(arg0 context.Context, arg1 types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockMetadataRepositoryClientMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).GetSchema), arg0, arg1)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockMetadataRepositoryClient) ListConsumerGroups(arg0 context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumerGroups", arg0)
+	ret0, _ := ret[0].([]varlogpb.ConsumerGroupDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockMetadataRepositoryClientMockRecorder) ListConsumerGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).ListConsumerGroups), arg0)
+}
+
+// ListSchemas mocks base method.
+func (m *MockMetadataRepositoryClient) ListSchemas(arg0 context.Context, arg1 types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchemas", arg0, arg1)
+	ret0, _ := ret[0].([]varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockMetadataRepositoryClientMockRecorder) ListSchemas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).ListSchemas), arg0, arg1)
+}
+
 // RegisterLogStream mocks base method.
 func (m *MockMetadataRepositoryClient) RegisterLogStream(arg0 context.Context, arg1 *varlogpb.LogStreamDescriptor) error {
 	m.ctrl.T.Helper()
@@ -80,6 +197,21 @@ func (mr *MockMetadataRepositoryClientMockRecorder) RegisterLogStream(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLogStream", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).RegisterLogStream), arg0, arg1)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockMetadataRepositoryClient) RegisterSchema(arg0 context.Context, arg1 types.TopicID, arg2 varlogpb.SchemaType, arg3 []byte, arg4 varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSchema", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockMetadataRepositoryClientMockRecorder) RegisterSchema(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockMetadataRepositoryClient)(nil).RegisterSchema), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RegisterStorageNode mocks base method.
 func (m *MockMetadataRepositoryClient) RegisterStorageNode(arg0 context.Context, arg1 *varlogpb.StorageNodeDescriptor) error {
 	m.ctrl.T.Helper()
@@ -95,7 +227,7 @@ func (mr *MockMetadataRepositoryClientMockRecorder) RegisterStorageNode(arg0, ar
 }
 
 // RegisterTopic mocks base method.
-func (m *MockMetadataRepositoryClient) RegisterTopic(arg0 context.Context, arg1 types.TopicID) error {
+func (m *MockMetadataRepositoryClient) RegisterTopic(arg0 context.Context, arg1 *varlogpb.TopicDescriptor) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterTopic", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -76,7 +76,7 @@ func (m *mrProxy) UnregisterStorageNode(ctx context.Context, id types.StorageNod
 	return m.cl.UnregisterStorageNode(ctx, id)
 }
 
-func (m *mrProxy) RegisterTopic(ctx context.Context, id types.TopicID) error {
+func (m *mrProxy) RegisterTopic(ctx context.Context, topic *varlogpb.TopicDescriptor) error {
 	m.mu.RLock()
 	defer func() {
 		m.inflight.Add(-1)
@@ -85,7 +85,7 @@ func (m *mrProxy) RegisterTopic(ctx context.Context, id types.TopicID) error {
 	}()
 	m.inflight.Add(1)
 
-	return m.cl.RegisterTopic(ctx, id)
+	return m.cl.RegisterTopic(ctx, topic)
 }
 
 func (m *mrProxy) UnregisterTopic(ctx context.Context, id types.TopicID) error {
@@ -172,6 +172,114 @@ func (m *mrProxy) Unseal(ctx context.Context, id types.LogStreamID) error {
 	return m.cl.Unseal(ctx, id)
 }
 
+func (m *mrProxy) CommitConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.CommitConsumerGroupOffset(ctx, group, offset)
+}
+
+func (m *mrProxy) CommitTransaction(ctx context.Context, transactionID uint64, participants []varlogpb.TopicLogStream) error {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.CommitTransaction(ctx, transactionID, participants)
+}
+
+func (m *mrProxy) GetCommitResults(ctx context.Context, topicID types.TopicID) (*mrpb.LogStreamCommitResults, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.GetCommitResults(ctx, topicID)
+}
+
+func (m *mrProxy) RegisterSchema(ctx context.Context, topicID types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.RegisterSchema(ctx, topicID, typ, definition, compat)
+}
+
+func (m *mrProxy) GetSchema(ctx context.Context, schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.GetSchema(ctx, schemaID)
+}
+
+func (m *mrProxy) ListSchemas(ctx context.Context, topicID types.TopicID) ([]varlogpb.SchemaDescriptor, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.ListSchemas(ctx, topicID)
+}
+
+func (m *mrProxy) GetConsumerGroup(ctx context.Context, group string) (*varlogpb.ConsumerGroupDescriptor, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.GetConsumerGroup(ctx, group)
+}
+
+func (m *mrProxy) ListConsumerGroups(ctx context.Context) ([]varlogpb.ConsumerGroupDescriptor, error) {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.ListConsumerGroups(ctx)
+}
+
+func (m *mrProxy) DeleteConsumerGroup(ctx context.Context, group string) error {
+	m.mu.RLock()
+	defer func() {
+		m.inflight.Add(-1)
+		m.mu.RUnlock()
+		m.cond.Signal()
+	}()
+	m.inflight.Add(1)
+
+	return m.cl.DeleteConsumerGroup(ctx, group)
+}
+
 func (m *mrProxy) AddPeer(ctx context.Context, clusterID types.ClusterID, nodeID types.NodeID, url string) error {
 	m.mu.RLock()
 	defer func() {
@@ -0,0 +1,64 @@
+package otelgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor(propagation.TraceContext{})
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	// No trace context to propagate.
+	err := interceptor(context.Background(), "/varlog.snpb.LogIO/Append", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Empty(t, md.Get("traceparent"))
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	ctx = metadata.AppendToOutgoingContext(ctx, "foo", "bar")
+	err = interceptor(ctx, "/varlog.snpb.LogIO/Append", nil, nil, nil, invoker)
+	require.NoError(t, err)
+	require.Equal(t, []string{"00-01000000000000000000000000000000-0200000000000000-01"}, md.Get("traceparent"))
+	require.Equal(t, []string{"bar"}, md.Get("foo"))
+
+	// The server extracts the same span context.
+	extracted := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), metadataCarrier(md)))
+	require.Equal(t, sc.TraceID(), extracted.TraceID())
+	require.Equal(t, sc.SpanID(), extracted.SpanID())
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	interceptor := StreamClientInterceptor(propagation.TraceContext{})
+
+	var md metadata.MD
+	streamer := func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x03},
+		SpanID:     trace.SpanID{0x04},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	_, err := interceptor(ctx, &grpc.StreamDesc{}, nil, "/varlog.snpb.LogIO/Subscribe", streamer)
+	require.NoError(t, err)
+	require.Len(t, md.Get("traceparent"), 1)
+}
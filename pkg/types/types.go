@@ -81,6 +81,25 @@ func (tpid TopicID) Invalid() bool {
 	return tpid <= 0
 }
 
+type SchemaID int32
+
+const MinSchemaID = SchemaID(1)
+
+var _ fmt.Stringer = (*SchemaID)(nil)
+
+func ParseSchemaID(s string) (SchemaID, error) {
+	id, err := strconv.ParseInt(s, 10, 32)
+	return SchemaID(id), err
+}
+
+func (sid SchemaID) String() string {
+	return strconv.FormatInt(int64(sid), 10)
+}
+
+func (sid SchemaID) Invalid() bool {
+	return sid < MinSchemaID
+}
+
 type Version uint64
 
 const (
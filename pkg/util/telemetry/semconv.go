@@ -7,15 +7,20 @@ import (
 )
 
 const (
-	ClusterIDKey   = attribute.Key("varlog.cluster.id")
-	TopicIDKey     = attribute.Key("varlog.topic.id")
-	LogStreamIDKey = attribute.Key("varlog.logstream.id")
+	ClusterIDKey     = attribute.Key("varlog.cluster.id")
+	StorageNodeIDKey = attribute.Key("varlog.storagenode.id")
+	TopicIDKey       = attribute.Key("varlog.topic.id")
+	LogStreamIDKey   = attribute.Key("varlog.logstream.id")
 )
 
 func ClusterID(val types.ClusterID) attribute.KeyValue {
 	return ClusterIDKey.Int(int(val))
 }
 
+func StorageNodeID(val types.StorageNodeID) attribute.KeyValue {
+	return StorageNodeIDKey.Int(int(val))
+}
+
 func TopicID(val types.TopicID) attribute.KeyValue {
 	return TopicIDKey.Int(int(val))
 }
@@ -69,6 +69,10 @@ type Admin interface {
 	// If the admin could not fetch cluster metadata, it returns an error,
 	// and users can retry this RPC.
 	GetTopic(ctx context.Context, tpid types.TopicID, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
+	// GetTopicByName returns the metadata of the topic whose name is the
+	// argument name.
+	// It returns the ErrNotExist error if the topic does not exist.
+	GetTopicByName(ctx context.Context, name string, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
 	// ListTopics returns a list of all topics in the cluster.
 	//
 	// Note that it should return an empty slice rather than nil to encode
@@ -79,6 +83,14 @@ type Admin interface {
 	// It returns an error if rejected by the metadata repository due to
 	// redundant topic ID or something else, and users can retry this RPC.
 	AddTopic(ctx context.Context, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
+	// AddNamedTopic adds a new topic with the name and configuration, and
+	// returns its metadata. The name should be unique in the cluster;
+	// otherwise, it returns a gRPC AlreadyExists error. Zero values of
+	// fields of the config mean the defaults of the cluster.
+	// It returns a gRPC InvalidArgument error if the name or config is
+	// invalid, for instance, the name has characters other than
+	// alphanumerics, '.', '_' and '-'.
+	AddNamedTopic(ctx context.Context, name string, config varlogpb.TopicConfig, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error)
 	// UnregisterTopic removes a topic identified by the argument tpid from
 	// the cluster.
 	// It is okay to delete not existed topic.
@@ -157,6 +169,45 @@ type Admin interface {
 	// RemoveMRPeer unregisters the metadata repository from the cluster.
 	RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminCallOption) error
 
+	// ListConsumerGroups returns all consumer groups and the lags of their
+	// offsets against the high watermarks of the topics and log streams.
+	//
+	// Note that it should return an empty slice rather than nil to encode
+	// to an empty array in JSON if no consumer group exists in the cluster.
+	ListConsumerGroups(ctx context.Context, opts ...AdminCallOption) ([]admpb.ConsumerGroupMetadata, error)
+	// GetConsumerGroup returns the consumer group specified by the argument
+	// group and the lags of its offsets.
+	// It returns the ErrNotExist if the consumer group does not exist.
+	GetConsumerGroup(ctx context.Context, group string, opts ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error)
+	// ResetConsumerGroupOffset overwrites the offset of the consumer group
+	// specified by the argument group. The new offset can be behind the
+	// committed one; consumers of the group will resume from it.
+	ResetConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset, opts ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error)
+	// DeleteConsumerGroup deletes the consumer group specified by the
+	// argument group.
+	// It returns the ErrNotExist if the consumer group does not exist.
+	DeleteConsumerGroup(ctx context.Context, group string, opts ...AdminCallOption) error
+
+	// RegisterSchema registers a new version of the schema of the topic
+	// specified by the argument tpid, and returns it. The new version should
+	// be compatible with the latest version of the topic according to the
+	// argument compat. If the definition is the same as a registered version
+	// of the topic, it returns that version rather than registering a new
+	// one.
+	// It returns the ErrNotExist if the topic does not exist. If the schema
+	// is incompatible, the error has the gRPC code FailedPrecondition.
+	RegisterSchema(ctx context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility, opts ...AdminCallOption) (*varlogpb.SchemaDescriptor, error)
+	// GetSchema returns the schema specified by the argument schemaID.
+	// It returns the ErrNotExist if the schema does not exist.
+	GetSchema(ctx context.Context, schemaID types.SchemaID, opts ...AdminCallOption) (*varlogpb.SchemaDescriptor, error)
+	// ListSchemas returns all versions of the schema of the topic specified
+	// by the argument tpid sorted by their versions.
+	// It returns the ErrNotExist if the topic does not exist.
+	//
+	// Note that it should return an empty slice rather than nil to encode
+	// to an empty array in JSON if the topic has no schema.
+	ListSchemas(ctx context.Context, tpid types.TopicID, opts ...AdminCallOption) ([]varlogpb.SchemaDescriptor, error)
+
 	// Close closes a connection to the admin server.
 	// Once this method is called, the Client can't be used anymore.
 	Close() error
@@ -284,6 +335,23 @@ func (c *admin) GetTopic(ctx context.Context, tpid types.TopicID, opts ...AdminC
 	return rsp.GetTopic(), nil
 }
 
+func (c *admin) GetTopicByName(ctx context.Context, name string, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.GetTopicByName(ctx, &admpb.GetTopicByNameRequest{
+		Name: name,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: get topic by name")
+	}
+	return rsp.GetTopic(), nil
+}
+
 func (c *admin) ListTopics(ctx context.Context, opts ...AdminCallOption) ([]varlogpb.TopicDescriptor, error) {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -312,6 +380,21 @@ func (c *admin) AddTopic(ctx context.Context, opts ...AdminCallOption) (*varlogp
 	return rsp.Topic, nil
 }
 
+func (c *admin) AddNamedTopic(ctx context.Context, name string, config varlogpb.TopicConfig, opts ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.AddTopic(ctx, &admpb.AddTopicRequest{
+		Name:   name,
+		Config: config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Topic, nil
+}
+
 func (c *admin) UnregisterTopic(ctx context.Context, topicID types.TopicID, opts ...AdminCallOption) error {
 	cfg := newAdminCallConfig(c.adminCallOptions, opts)
 	ctx, cancel := cfg.withTimeoutContext(ctx)
@@ -562,3 +645,126 @@ func (c *admin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...AdminC
 	_, err := c.rpcClient.RemoveMRPeer(ctx, &admpb.RemoveMRPeerRequest{RaftURL: raftURL})
 	return err
 }
+
+func (c *admin) ListConsumerGroups(ctx context.Context, opts ...AdminCallOption) ([]admpb.ConsumerGroupMetadata, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.ListConsumerGroups(ctx, &admpb.ListConsumerGroupsRequest{})
+	if err != nil {
+		return nil, errors.WithMessage(err, "admin: list consumer groups")
+	}
+
+	if len(rsp.ConsumerGroups) > 0 {
+		return rsp.ConsumerGroups, nil
+	}
+	return []admpb.ConsumerGroupMetadata{}, nil
+}
+
+func (c *admin) GetConsumerGroup(ctx context.Context, group string, opts ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.GetConsumerGroup(ctx, &admpb.GetConsumerGroupRequest{
+		Group: group,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: get consumer group")
+	}
+	return rsp.ConsumerGroup, nil
+}
+
+func (c *admin) ResetConsumerGroupOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset, opts ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.ResetConsumerGroupOffset(ctx, &admpb.ResetConsumerGroupOffsetRequest{
+		Group:  group,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "admin: reset consumer group offset")
+	}
+	return rsp.ConsumerGroup, nil
+}
+
+func (c *admin) DeleteConsumerGroup(ctx context.Context, group string, opts ...AdminCallOption) error {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	_, err := c.rpcClient.DeleteConsumerGroup(ctx, &admpb.DeleteConsumerGroupRequest{
+		Group: group,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return errors.WithMessage(err, "admin: delete consumer group")
+	}
+	return nil
+}
+
+func (c *admin) RegisterSchema(ctx context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility, opts ...AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.RegisterSchema(ctx, &admpb.RegisterSchemaRequest{
+		TopicID:       tpid,
+		Type:          typ,
+		Definition:    definition,
+		Compatibility: compat,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: register schema")
+	}
+	return rsp.Schema, nil
+}
+
+func (c *admin) GetSchema(ctx context.Context, schemaID types.SchemaID, opts ...AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.GetSchema(ctx, &admpb.GetSchemaRequest{
+		SchemaID: schemaID,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: get schema")
+	}
+	return rsp.Schema, nil
+}
+
+func (c *admin) ListSchemas(ctx context.Context, tpid types.TopicID, opts ...AdminCallOption) ([]varlogpb.SchemaDescriptor, error) {
+	cfg := newAdminCallConfig(c.adminCallOptions, opts)
+	ctx, cancel := cfg.withTimeoutContext(ctx)
+	defer cancel()
+
+	rsp, err := c.rpcClient.ListSchemas(ctx, &admpb.ListSchemasRequest{
+		TopicID: tpid,
+	})
+	if err != nil {
+		if st := status.Convert(err); st.Code() == codes.NotFound {
+			err = verrors.ErrNotExist
+		}
+		return nil, errors.WithMessage(err, "admin: list schemas")
+	}
+
+	if len(rsp.Schemas) > 0 {
+		return rsp.Schemas, nil
+	}
+	return []varlogpb.SchemaDescriptor{}, nil
+}
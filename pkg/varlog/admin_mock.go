@@ -9,7 +9,6 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-
 	types "github.com/kakao/varlog/pkg/types"
 	admpb "github.com/kakao/varlog/proto/admpb"
 	snpb "github.com/kakao/varlog/proto/snpb"
@@ -99,6 +98,26 @@ func (mr *MockAdminMockRecorder) AddMetadataRepositoryNode(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMetadataRepositoryNode", reflect.TypeOf((*MockAdmin)(nil).AddMetadataRepositoryNode), varargs...)
 }
 
+// AddNamedTopic mocks base method.
+func (m *MockAdmin) AddNamedTopic(arg0 context.Context, arg1 string, arg2 varlogpb.TopicConfig, arg3 ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddNamedTopic", varargs...)
+	ret0, _ := ret[0].(*varlogpb.TopicDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNamedTopic indicates an expected call of AddNamedTopic.
+func (mr *MockAdminMockRecorder) AddNamedTopic(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNamedTopic", reflect.TypeOf((*MockAdmin)(nil).AddNamedTopic), varargs...)
+}
+
 // AddStorageNode mocks base method.
 func (m *MockAdmin) AddStorageNode(arg0 context.Context, arg1 types.StorageNodeID, arg2 string, arg3 ...AdminCallOption) (*admpb.StorageNodeMetadata, error) {
 	m.ctrl.T.Helper()
@@ -153,6 +172,25 @@ func (mr *MockAdminMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAdmin)(nil).Close))
 }
 
+// DeleteConsumerGroup mocks base method.
+func (m *MockAdmin) DeleteConsumerGroup(arg0 context.Context, arg1 string, arg2 ...AdminCallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockAdminMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockAdmin)(nil).DeleteConsumerGroup), varargs...)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockAdmin) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 types.NodeID, arg2 ...AdminCallOption) error {
 	m.ctrl.T.Helper()
@@ -192,6 +230,26 @@ func (mr *MockAdminMockRecorder) DescribeTopic(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockAdmin)(nil).DescribeTopic), varargs...)
 }
 
+// GetConsumerGroup mocks base method.
+func (m *MockAdmin) GetConsumerGroup(arg0 context.Context, arg1 string, arg2 ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*admpb.ConsumerGroupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockAdminMockRecorder) GetConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockAdmin)(nil).GetConsumerGroup), varargs...)
+}
+
 // GetLogStream mocks base method.
 func (m *MockAdmin) GetLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 ...AdminCallOption) (*varlogpb.LogStreamDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -252,6 +310,26 @@ func (mr *MockAdminMockRecorder) GetMetadataRepositoryNode(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockAdmin)(nil).GetMetadataRepositoryNode), varargs...)
 }
 
+// GetSchema mocks base method.
+func (m *MockAdmin) GetSchema(arg0 context.Context, arg1 types.SchemaID, arg2 ...AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSchema", varargs...)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockAdminMockRecorder) GetSchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockAdmin)(nil).GetSchema), varargs...)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockAdmin) GetStorageNode(arg0 context.Context, arg1 types.StorageNodeID, arg2 ...AdminCallOption) (*admpb.StorageNodeMetadata, error) {
 	m.ctrl.T.Helper()
@@ -312,6 +390,46 @@ func (mr *MockAdminMockRecorder) GetTopic(arg0, arg1 interface{}, arg2 ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockAdmin)(nil).GetTopic), varargs...)
 }
 
+// GetTopicByName mocks base method.
+func (m *MockAdmin) GetTopicByName(arg0 context.Context, arg1 string, arg2 ...AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTopicByName", varargs...)
+	ret0, _ := ret[0].(*varlogpb.TopicDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopicByName indicates an expected call of GetTopicByName.
+func (mr *MockAdminMockRecorder) GetTopicByName(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopicByName", reflect.TypeOf((*MockAdmin)(nil).GetTopicByName), varargs...)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockAdmin) ListConsumerGroups(arg0 context.Context, arg1 ...AdminCallOption) ([]admpb.ConsumerGroupMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConsumerGroups", varargs...)
+	ret0, _ := ret[0].([]admpb.ConsumerGroupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockAdminMockRecorder) ListConsumerGroups(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockAdmin)(nil).ListConsumerGroups), varargs...)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockAdmin) ListLogStreams(arg0 context.Context, arg1 types.TopicID, arg2 ...AdminCallOption) ([]varlogpb.LogStreamDescriptor, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +470,26 @@ func (mr *MockAdminMockRecorder) ListMetadataRepositoryNodes(arg0 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockAdmin)(nil).ListMetadataRepositoryNodes), varargs...)
 }
 
+// ListSchemas mocks base method.
+func (m *MockAdmin) ListSchemas(arg0 context.Context, arg1 types.TopicID, arg2 ...AdminCallOption) ([]varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchemas", varargs...)
+	ret0, _ := ret[0].([]varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockAdminMockRecorder) ListSchemas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockAdmin)(nil).ListSchemas), varargs...)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockAdmin) ListStorageNodes(arg0 context.Context, arg1 ...AdminCallOption) ([]admpb.StorageNodeMetadata, error) {
 	m.ctrl.T.Helper()
@@ -392,6 +530,26 @@ func (mr *MockAdminMockRecorder) ListTopics(arg0 interface{}, arg1 ...interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockAdmin)(nil).ListTopics), varargs...)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockAdmin) RegisterSchema(arg0 context.Context, arg1 types.TopicID, arg2 varlogpb.SchemaType, arg3 []byte, arg4 varlogpb.SchemaCompatibility, arg5 ...AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3, arg4}
+	for _, a := range arg5 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSchema", varargs...)
+	ret0, _ := ret[0].(*varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockAdminMockRecorder) RegisterSchema(arg0, arg1, arg2, arg3, arg4 interface{}, arg5 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3, arg4}, arg5...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockAdmin)(nil).RegisterSchema), varargs...)
+}
+
 // RemoveLogStreamReplica mocks base method.
 func (m *MockAdmin) RemoveLogStreamReplica(arg0 context.Context, arg1 types.StorageNodeID, arg2 types.TopicID, arg3 types.LogStreamID, arg4 ...AdminCallOption) error {
 	m.ctrl.T.Helper()
@@ -430,6 +588,26 @@ func (mr *MockAdminMockRecorder) RemoveMRPeer(arg0, arg1 interface{}, arg2 ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMRPeer", reflect.TypeOf((*MockAdmin)(nil).RemoveMRPeer), varargs...)
 }
 
+// ResetConsumerGroupOffset mocks base method.
+func (m *MockAdmin) ResetConsumerGroupOffset(arg0 context.Context, arg1 string, arg2 varlogpb.ConsumerGroupOffset, arg3 ...AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*admpb.ConsumerGroupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConsumerGroupOffset indicates an expected call of ResetConsumerGroupOffset.
+func (mr *MockAdminMockRecorder) ResetConsumerGroupOffset(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConsumerGroupOffset", reflect.TypeOf((*MockAdmin)(nil).ResetConsumerGroupOffset), varargs...)
+}
+
 // Seal mocks base method.
 func (m *MockAdmin) Seal(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 ...AdminCallOption) (*admpb.SealResponse, error) {
 	m.ctrl.T.Helper()
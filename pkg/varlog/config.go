@@ -108,6 +108,7 @@ type logStreamAppenderConfig struct {
 	lsid                 types.LogStreamID
 	pipelineSize         int
 	callTimeout          time.Duration
+	retryPolicy          *RetryPolicy
 }
 
 func newLogStreamAppenderConfig(opts []LogStreamAppenderOption) logStreamAppenderConfig {
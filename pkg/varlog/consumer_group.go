@@ -0,0 +1,57 @@
+package varlog
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func (v *logImpl) commitOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	if group == "" {
+		return fmt.Errorf("commit offset: empty consumer group: %w", verrors.ErrInvalid)
+	}
+	if err := offset.Validate(); err != nil {
+		return fmt.Errorf("commit offset: %s: %w", err.Error(), verrors.ErrInvalid)
+	}
+
+	client, err := v.mrConnector.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("commit offset: %w", err)
+	}
+	if err := client.CommitConsumerGroupOffset(ctx, group, offset); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return fmt.Errorf("commit offset: %s: %w", err.Error(), verrors.ErrInvalid)
+		case codes.NotFound:
+			return fmt.Errorf("commit offset: %s: %w", err.Error(), verrors.ErrNotExist)
+		}
+		return fmt.Errorf("commit offset: %w", multierr.Append(err, client.Close()))
+	}
+	return nil
+}
+
+func (v *logImpl) fetchOffset(ctx context.Context, group string, tpid types.TopicID, lsid types.LogStreamID) (varlogpb.ConsumerGroupOffset, error) {
+	client, err := v.mrConnector.Client(ctx)
+	if err != nil {
+		return varlogpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: %w", err)
+	}
+	cgd, err := client.GetConsumerGroup(ctx, group)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return varlogpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: consumer group %s: %w", group, verrors.ErrNotExist)
+		}
+		return varlogpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: %w", multierr.Append(err, client.Close()))
+	}
+	offset, ok := cgd.GetOffset(tpid, lsid)
+	if !ok {
+		return varlogpb.ConsumerGroupOffset{}, fmt.Errorf("fetch offset: consumer group %s, tpid %d, lsid %d: %w", group, tpid, lsid, verrors.ErrNotExist)
+	}
+	return offset, nil
+}
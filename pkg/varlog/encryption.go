@@ -0,0 +1,159 @@
+package varlog
+
+import (
+	"context"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/kakao/varlog/internal/compress"
+	"github.com/kakao/varlog/internal/encrypt"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// KeyProvider manages key encryption keys that wrap data keys encrypting
+// payloads of log entries. It is supplied by users with WithEncryption and
+// can be backed by, for instance, a key management service. Implementations
+// should be safe for concurrent use.
+type KeyProvider interface {
+	// WrapKey encrypts the data key with the current key encryption key. It
+	// returns the ID of the key encryption key and the wrapped data key.
+	WrapKey(ctx context.Context, dataKey []byte) (keyID string, wrappedKey []byte, err error)
+
+	// UnwrapKey decrypts the wrapped data key with the key encryption key
+	// identified by the argument keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrappedKey []byte) (dataKey []byte, err error)
+}
+
+// errDecrypt is returned if the payload of a log entry cannot be decrypted,
+// for instance, the key provider does not have the key encryption key.
+var errDecrypt = errors.New("decrypt")
+
+// maxCachedDataKeys is the maximum number of unwrapped data keys kept by the
+// payloadCipher. Since a data key is shared by log entries in a batch,
+// caching it avoids calling the KeyProvider for every log entry.
+const maxCachedDataKeys = 1024
+
+// payloadCipher encrypts and decrypts payloads of log entries by using data
+// keys wrapped by the KeyProvider. A nil payloadCipher neither encrypts nor
+// decrypts payloads.
+type payloadCipher struct {
+	kp KeyProvider
+
+	mu       sync.Mutex
+	dataKeys map[string]cipher.AEAD
+}
+
+func newPayloadCipher(kp KeyProvider) *payloadCipher {
+	if kp == nil {
+		return nil
+	}
+	return &payloadCipher{
+		kp:       kp,
+		dataKeys: make(map[string]cipher.AEAD),
+	}
+}
+
+// encryptBatch encrypts each payload of the batch with a new data key and
+// records the wrapped data key in the attributes of each log entry. It should
+// be called after the batch is compressed: the codec of each log entry moves
+// into its encryption attribute since storage nodes and the storage node
+// client should not see the codec of the encrypted payload. The argument
+// attrs can be empty; otherwise, it must have an attribute for each payload.
+// The caller's attributes are not modified.
+func (pc *payloadCipher) encryptBatch(ctx context.Context, data [][]byte, attrs []varlogpb.LogEntryAttributes) ([][]byte, []varlogpb.LogEntryAttributes, error) {
+	if pc == nil {
+		return data, attrs, nil
+	}
+	if len(attrs) > 0 && len(attrs) != len(data) {
+		return nil, nil, fmt.Errorf("encrypt: unmatched attributes: %d payloads, %d attributes", len(data), len(attrs))
+	}
+
+	dataKey, err := encrypt.NewKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := encrypt.AEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyID, wrappedKey, err := pc.kp.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encrypt: wrap data key: %w", err)
+	}
+
+	encryptedData := make([][]byte, len(data))
+	encryptedAttrs := make([]varlogpb.LogEntryAttributes, len(data))
+	copy(encryptedAttrs, attrs)
+	for i := range data {
+		encryptedData[i], err = encrypt.Seal(aead, data[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		encryptedAttrs[i].Encryption = &varlogpb.LogEntryEncryption{
+			KeyID:            keyID,
+			WrappedDataKey:   wrappedKey,
+			CompressionCodec: encryptedAttrs[i].CompressionCodec,
+		}
+		encryptedAttrs[i].CompressionCodec = varlogpb.CompressionCodecNone
+	}
+	return encryptedData, encryptedAttrs, nil
+}
+
+// decryptLogEntry decrypts and decompresses the payload of the log entry in
+// place and removes its encryption attribute. A log entry that is not
+// encrypted is left as it is. If the payloadCipher is nil, an encrypted log
+// entry is also left as it is so that clients without keys can still read
+// its metadata.
+func (pc *payloadCipher) decryptLogEntry(ctx context.Context, logEntry *varlogpb.LogEntry) error {
+	enc := logEntry.Encryption
+	if pc == nil || enc == nil {
+		return nil
+	}
+
+	aead, err := pc.dataKey(ctx, enc.KeyID, enc.WrappedDataKey)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errDecrypt, err)
+	}
+	data, err := encrypt.Open(aead, logEntry.Data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errDecrypt, err)
+	}
+	data, err = compress.Decompress(enc.CompressionCodec, data)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errDecrypt, err)
+	}
+	logEntry.Data = data
+	logEntry.Encryption = nil
+	return nil
+}
+
+// dataKey returns the cipher of the data key, unwrapping it by the
+// KeyProvider unless it is cached.
+func (pc *payloadCipher) dataKey(ctx context.Context, keyID string, wrappedKey []byte) (cipher.AEAD, error) {
+	cacheKey := keyID + "/" + string(wrappedKey)
+	pc.mu.Lock()
+	aead, ok := pc.dataKeys[cacheKey]
+	pc.mu.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	dataKey, err := pc.kp.UnwrapKey(ctx, keyID, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: unwrap data key: %w", err)
+	}
+	aead, err = encrypt.AEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+	if len(pc.dataKeys) >= maxCachedDataKeys {
+		pc.dataKeys = make(map[string]cipher.AEAD)
+	}
+	pc.dataKeys[cacheKey] = aead
+	return aead, nil
+}
@@ -0,0 +1,152 @@
+package varlog
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/internal/compress"
+	"github.com/kakao/varlog/internal/encrypt"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func testWriteKeyFile(t *testing.T, keyIDs ...string) string {
+	var sb strings.Builder
+	sb.WriteString("# test keys\n\n")
+	for _, keyID := range keyIDs {
+		key, err := encrypt.NewKey()
+		require.NoError(t, err)
+		sb.WriteString(keyID + " " + base64.StdEncoding.EncodeToString(key) + "\n")
+	}
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte(sb.String()), 0o600))
+	return path
+}
+
+func TestPayloadCipher(t *testing.T) {
+	ctx := context.Background()
+	path := testWriteKeyFile(t, "key-1")
+	kp, err := NewFileKeyProvider(path)
+	require.NoError(t, err)
+	pc := newPayloadCipher(kp)
+
+	data := [][]byte{
+		[]byte("foo"),
+		[]byte(strings.Repeat("bar", 100)),
+	}
+	attrs := []varlogpb.LogEntryAttributes{
+		{Key: []byte("k1")},
+		{Key: []byte("k2")},
+	}
+
+	// Payloads are compressed and then encrypted.
+	compressedData, compressedAttrs, err := compress.CompressBatch(varlogpb.CompressionCodecZstd, data, attrs)
+	require.NoError(t, err)
+	require.Equal(t, varlogpb.CompressionCodecZstd, compressedAttrs[1].CompressionCodec)
+	encryptedData, encryptedAttrs, err := pc.encryptBatch(ctx, compressedData, compressedAttrs)
+	require.NoError(t, err)
+	require.Len(t, encryptedData, len(data))
+	require.Nil(t, attrs[0].Encryption)
+	for i := range data {
+		require.NotContains(t, string(encryptedData[i]), "foo")
+		require.NotContains(t, string(encryptedData[i]), "bar")
+		require.Equal(t, attrs[i].Key, encryptedAttrs[i].Key)
+		require.Equal(t, varlogpb.CompressionCodecNone, encryptedAttrs[i].CompressionCodec)
+		require.Equal(t, "key-1", encryptedAttrs[i].Encryption.KeyID)
+		require.Equal(t, compressedAttrs[i].CompressionCodec, encryptedAttrs[i].Encryption.CompressionCodec)
+		require.False(t, encryptedAttrs[i].Empty())
+	}
+
+	for i := range data {
+		le := varlogpb.LogEntry{Data: encryptedData[i], LogEntryAttributes: encryptedAttrs[i]}
+		require.NoError(t, pc.decryptLogEntry(ctx, &le))
+		require.Equal(t, data[i], le.Data)
+		require.Nil(t, le.Encryption)
+		require.Equal(t, attrs[i].Key, le.Key)
+	}
+
+	// A client without a key provider gets the encrypted payload as it is.
+	le := varlogpb.LogEntry{Data: encryptedData[0], LogEntryAttributes: encryptedAttrs[0]}
+	require.NoError(t, (*payloadCipher)(nil).decryptLogEntry(ctx, &le))
+	require.Equal(t, encryptedData[0], le.Data)
+	require.NotNil(t, le.Encryption)
+
+	// The payload encrypted with an unknown key cannot be decrypted.
+	otherKP, err := NewFileKeyProvider(testWriteKeyFile(t, "key-2"))
+	require.NoError(t, err)
+	le = varlogpb.LogEntry{Data: encryptedData[0], LogEntryAttributes: encryptedAttrs[0]}
+	require.ErrorIs(t, newPayloadCipher(otherKP).decryptLogEntry(ctx, &le), errDecrypt)
+
+	// The payload is not encrypted without a key provider.
+	plainData, plainAttrs, err := (*payloadCipher)(nil).encryptBatch(ctx, data, attrs)
+	require.NoError(t, err)
+	require.Equal(t, data, plainData)
+	require.Equal(t, attrs, plainAttrs)
+
+	_, _, err = pc.encryptBatch(ctx, data, attrs[:1])
+	require.Error(t, err)
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	ctx := context.Background()
+
+	dataKey, err := encrypt.NewKey()
+	require.NoError(t, err)
+
+	// Keys are rotated by appending a new key.
+	path := testWriteKeyFile(t, "key-1")
+	kp, err := NewFileKeyProvider(path)
+	require.NoError(t, err)
+	keyID, wrappedKey, err := kp.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "key-1", keyID)
+
+	buf, err := os.ReadFile(path)
+	require.NoError(t, err)
+	key, err := encrypt.NewKey()
+	require.NoError(t, err)
+	buf = append(buf, []byte("key-2\t"+base64.StdEncoding.EncodeToString(key)+"\n")...)
+	require.NoError(t, os.WriteFile(path, buf, 0o600))
+
+	kp, err = NewFileKeyProvider(path)
+	require.NoError(t, err)
+	keyID, _, err = kp.WrapKey(ctx, dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "key-2", keyID)
+	unwrappedKey, err := kp.UnwrapKey(ctx, "key-1", wrappedKey)
+	require.NoError(t, err)
+	require.Equal(t, dataKey, unwrappedKey)
+
+	_, err = kp.UnwrapKey(ctx, "key-3", wrappedKey)
+	require.Error(t, err)
+	_, err = kp.UnwrapKey(ctx, "key-2", wrappedKey)
+	require.Error(t, err)
+
+	tcs := []struct {
+		name     string
+		contents string
+	}{
+		{name: "NoKey", contents: "# no key\n"},
+		{name: "MalformedLine", contents: "key-1\n"},
+		{name: "InvalidBase64", contents: "key-1 !!!\n"},
+		{name: "InvalidKeySize", contents: "key-1 " + base64.StdEncoding.EncodeToString([]byte("short")) + "\n"},
+		{name: "DuplicateKeyID", contents: "key-1 " + base64.StdEncoding.EncodeToString(key) + "\nkey-1 " + base64.StdEncoding.EncodeToString(key) + "\n"},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys")
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600))
+			_, err := NewFileKeyProvider(path)
+			require.Error(t, err)
+		})
+	}
+
+	_, err = NewFileKeyProvider(filepath.Join(t.TempDir(), "nonexistent"))
+	require.Error(t, err)
+}
@@ -5,4 +5,7 @@ import "errors"
 var (
 	ErrClosed      = errors.New("client: closed")
 	ErrCallTimeout = errors.New("client: call timeout")
+	// ErrDecode is returned if a log entry cannot be decoded into a value
+	// with its schema.
+	ErrDecode = errors.New("client: decode")
 )
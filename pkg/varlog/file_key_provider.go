@@ -0,0 +1,94 @@
+package varlog
+
+import (
+	"bufio"
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kakao/varlog/internal/encrypt"
+)
+
+type fileKeyProvider struct {
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+var _ KeyProvider = (*fileKeyProvider)(nil)
+
+// NewFileKeyProvider returns a KeyProvider that reads key encryption keys from
+// the local file at the argument path. It is intended for testing rather than
+// production, where keys should be kept in a key management service.
+//
+// Each line of the file has a key ID and a base64-encoded 32-byte key
+// separated by whitespace. Empty lines and lines beginning with '#' are
+// ignored. The key in the last line wraps new data keys, and every key in the
+// file can unwrap data keys. Therefore, keys are rotated by appending a new
+// key to the file and reopening the client while keeping old keys as long as
+// log entries encrypted with them are read.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("file key provider: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	kp := &fileKeyProvider{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(f)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("file key provider: %s:%d: malformed line", path, lineno)
+		}
+		keyID := fields[0]
+		if _, ok := kp.keys[keyID]; ok {
+			return nil, fmt.Errorf("file key provider: %s:%d: duplicate key id %s", path, lineno, keyID)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("file key provider: %s:%d: %w", path, lineno, err)
+		}
+		aead, err := encrypt.AEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("file key provider: %s:%d: %w", path, lineno, err)
+		}
+		kp.keys[keyID] = aead
+		kp.currentKeyID = keyID
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("file key provider: %w", err)
+	}
+	if len(kp.keys) == 0 {
+		return nil, fmt.Errorf("file key provider: %s: no key", path)
+	}
+	return kp, nil
+}
+
+func (kp *fileKeyProvider) WrapKey(_ context.Context, dataKey []byte) (string, []byte, error) {
+	wrappedKey, err := encrypt.Seal(kp.keys[kp.currentKeyID], dataKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("file key provider: %w", err)
+	}
+	return kp.currentKeyID, wrappedKey, nil
+}
+
+func (kp *fileKeyProvider) UnwrapKey(_ context.Context, keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := kp.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("file key provider: unknown key id %s", keyID)
+	}
+	dataKey, err := encrypt.Open(aead, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("file key provider: %w", err)
+	}
+	return dataKey, nil
+}
@@ -0,0 +1,292 @@
+package varlog
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// LogStreamSelectionPolicy decides how Append chooses a log stream when
+// neither the log stream nor the selection key is given.
+type LogStreamSelectionPolicy int
+
+const (
+	// LogStreamSelectionPolicyRandom picks one of the appendable log streams
+	// uniformly at random.
+	LogStreamSelectionPolicyRandom LogStreamSelectionPolicy = iota + 1
+	// LogStreamSelectionPolicyLoadAware favors healthy and lightly loaded log
+	// streams. It scores log streams by the append latency and the error
+	// rate observed by the client, and by the load hints reported by the
+	// primary replicas, for instance, the number of inflight appends. See
+	// WithLoadHintInterval. It compares two log streams picked at random and
+	// takes the better one so that clients do not rush to the same log
+	// stream.
+	LogStreamSelectionPolicyLoadAware
+)
+
+func (p LogStreamSelectionPolicy) String() string {
+	switch p {
+	case LogStreamSelectionPolicyRandom:
+		return "random"
+	case LogStreamSelectionPolicyLoadAware:
+		return "load-aware"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	// loadDecay is the weight of a new observation in the exponentially
+	// weighted moving averages of the latency and the error rate.
+	loadDecay = 0.2
+	// loadErrorPenalty multiplies the score of a log stream whose appends
+	// always fail.
+	loadErrorPenalty = 10
+	// loadHintTTL is how many hint intervals a load hint stays valid
+	// without being refreshed.
+	loadHintTTL = 3
+)
+
+// logStreamLoad is the load of a log stream seen by the client.
+type logStreamLoad struct {
+	mu sync.Mutex
+
+	// latency is the moving average of the latency of successful appends
+	// in microseconds.
+	latency float64
+	// errorRate is the moving average of the ratio of failed appends.
+	errorRate float64
+	observed  bool
+
+	// Load hints reported by the primary replica.
+	status                varlogpb.LogStreamStatus
+	inflightAppends       int64
+	uncommittedLLSNLength uint64
+	hintTime              time.Time
+}
+
+// loadAwareSelector implements LogStreamSelector according to
+// LogStreamSelectionPolicyLoadAware. It selects one of the log streams in the
+// allowlist, hence, log streams denied by failures are excluded as in the
+// random policy.
+type loadAwareSelector struct {
+	allowlist Allowlist
+	// hintTTL is the duration a load hint stays valid. Load hints are
+	// ignored if it is zero.
+	hintTTL time.Duration
+	loads   sync.Map // map[varlogpb.TopicLogStream]*logStreamLoad
+	now     func() time.Time
+}
+
+var _ LogStreamSelector = (*loadAwareSelector)(nil)
+
+func newLoadAwareSelector(allowlist Allowlist, hintInterval time.Duration) *loadAwareSelector {
+	las := &loadAwareSelector{
+		allowlist: allowlist,
+		now:       time.Now,
+	}
+	if hintInterval > 0 {
+		las.hintTTL = hintInterval * loadHintTTL
+	}
+	return las
+}
+
+// Select implements (LogStreamSelector).Select method.
+func (las *loadAwareSelector) Select(topicID types.TopicID) (types.LogStreamID, bool) {
+	lsids := las.allowlist.GetAll(topicID)
+	switch len(lsids) {
+	case 0:
+		return 0, false
+	case 1:
+		return lsids[0], true
+	}
+
+	i := rand.Intn(len(lsids))
+	j := rand.Intn(len(lsids) - 1)
+	if j >= i {
+		j++
+	}
+	if las.score(topicID, lsids[j]) < las.score(topicID, lsids[i]) {
+		return lsids[j], true
+	}
+	return lsids[i], true
+}
+
+// GetAll implements (LogStreamSelector).GetAll method.
+func (las *loadAwareSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return las.allowlist.GetAll(topicID)
+}
+
+// score returns the cost of appending to the log stream; the lower, the
+// better. Log streams the client knows nothing about have the lowest score so
+// that they get appends to be observed, whereas log streams that are not
+// appendable according to their load hints have the highest score.
+func (las *loadAwareSelector) score(topicID types.TopicID, logStreamID types.LogStreamID) float64 {
+	load, ok := las.load(topicID, logStreamID)
+	if !ok {
+		return 0
+	}
+
+	load.mu.Lock()
+	defer load.mu.Unlock()
+
+	if !load.observed && load.errorRate > 0 {
+		// No append to the log stream has succeeded yet.
+		return math.Inf(1)
+	}
+	score := (load.latency + 1) * (1 + loadErrorPenalty*load.errorRate)
+	if las.hintTTL > 0 && !load.hintTime.IsZero() && las.now().Sub(load.hintTime) < las.hintTTL {
+		if load.status != varlogpb.LogStreamStatusRunning {
+			return math.Inf(1)
+		}
+		// Inflight appends queue up ahead of a new append, while the
+		// backlog of uncommitted log entries slows it down only
+		// gradually.
+		score *= 1 + float64(load.inflightAppends) + math.Log1p(float64(load.uncommittedLLSNLength))
+	}
+	return score
+}
+
+// observe records the result of an append to the log stream.
+func (las *loadAwareSelector) observe(topicID types.TopicID, logStreamID types.LogStreamID, latency time.Duration, failed bool) {
+	load := las.loadOrCreate(topicID, logStreamID)
+	load.mu.Lock()
+	defer load.mu.Unlock()
+
+	failure := 0.0
+	if failed {
+		failure = 1
+	}
+	load.errorRate = loadDecay*failure + (1-loadDecay)*load.errorRate
+
+	// Failed appends can return quickly, which does not mean that the log
+	// stream is fast.
+	if failed {
+		return
+	}
+	sample := float64(latency.Microseconds())
+	if !load.observed {
+		load.latency = sample
+		load.observed = true
+		return
+	}
+	load.latency = loadDecay*sample + (1-loadDecay)*load.latency
+}
+
+// updateHint records load hints reported by the primary replica of the log
+// stream.
+func (las *loadAwareSelector) updateHint(lsrmd snpb.LogStreamReplicaMetadataDescriptor) {
+	load := las.loadOrCreate(lsrmd.TopicID, lsrmd.LogStreamID)
+	load.mu.Lock()
+	defer load.mu.Unlock()
+
+	load.status = lsrmd.Status
+	load.inflightAppends = lsrmd.InflightAppends
+	load.uncommittedLLSNLength = lsrmd.UncommittedLLSNLength
+	load.hintTime = las.now()
+}
+
+// topics returns topics to which the client has appended.
+func (las *loadAwareSelector) topics() []types.TopicID {
+	seen := make(map[types.TopicID]struct{})
+	var tpids []types.TopicID
+	las.loads.Range(func(key, _ any) bool {
+		tpid := key.(varlogpb.TopicLogStream).TopicID
+		if _, ok := seen[tpid]; !ok {
+			seen[tpid] = struct{}{}
+			tpids = append(tpids, tpid)
+		}
+		return true
+	})
+	return tpids
+}
+
+// retain forgets the loads of log streams in the topic that are not in the
+// argument replicasMap, for instance, removed log streams.
+func (las *loadAwareSelector) retain(topicID types.TopicID, replicasMap map[types.LogStreamID][]varlogpb.LogStreamReplica) {
+	las.loads.Range(func(key, _ any) bool {
+		tlsid := key.(varlogpb.TopicLogStream)
+		if tlsid.TopicID != topicID {
+			return true
+		}
+		if _, ok := replicasMap[tlsid.LogStreamID]; !ok {
+			las.loads.Delete(key)
+		}
+		return true
+	})
+}
+
+func (las *loadAwareSelector) load(topicID types.TopicID, logStreamID types.LogStreamID) (*logStreamLoad, bool) {
+	load, ok := las.loads.Load(varlogpb.TopicLogStream{TopicID: topicID, LogStreamID: logStreamID})
+	if !ok {
+		return nil, false
+	}
+	return load.(*logStreamLoad), true
+}
+
+func (las *loadAwareSelector) loadOrCreate(topicID types.TopicID, logStreamID types.LogStreamID) *logStreamLoad {
+	key := varlogpb.TopicLogStream{TopicID: topicID, LogStreamID: logStreamID}
+	if load, ok := las.loads.Load(key); ok {
+		return load.(*logStreamLoad)
+	}
+	load, _ := las.loads.LoadOrStore(key, &logStreamLoad{})
+	return load.(*logStreamLoad)
+}
+
+// refreshLoadHints fetches load hints from the primary replicas of log
+// streams in topics to which the client has appended, periodically.
+func (v *logImpl) refreshLoadHints(ctx context.Context) {
+	ticker := time.NewTicker(v.opts.loadHintInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			v.fetchLoadHints(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (v *logImpl) fetchLoadHints(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, v.opts.loadHintInterval)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, tpid := range v.loadAwareSelector.topics() {
+		replicasMap := v.replicasRetriever.All(tpid)
+		v.loadAwareSelector.retain(tpid, replicasMap)
+		for lsid, replicas := range replicasMap {
+			if len(replicas) == 0 {
+				continue
+			}
+			wg.Add(1)
+			go func(tpid types.TopicID, lsid types.LogStreamID, primary varlogpb.LogStreamReplica) {
+				defer wg.Done()
+				cl, err := v.logCLManager.GetOrConnect(ctx, primary.StorageNodeID, primary.Address)
+				if err != nil {
+					return
+				}
+				lsrmd, err := cl.LogStreamReplicaMetadata(ctx, tpid, lsid)
+				if err != nil {
+					v.logger.Debug("could not fetch load hint",
+						zap.Int32("tpid", int32(tpid)),
+						zap.Int32("lsid", int32(lsid)),
+						zap.Error(err),
+					)
+					return
+				}
+				v.loadAwareSelector.updateHint(lsrmd)
+			}(tpid, lsid, replicas[0])
+		}
+	}
+	wg.Wait()
+}
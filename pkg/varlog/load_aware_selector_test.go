@@ -0,0 +1,191 @@
+package varlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// staticAllowlist allows a fixed set of log streams.
+type staticAllowlist map[types.TopicID][]types.LogStreamID
+
+var _ Allowlist = staticAllowlist(nil)
+
+func (al staticAllowlist) GetAll(topicID types.TopicID) []types.LogStreamID {
+	return append([]types.LogStreamID(nil), al[topicID]...)
+}
+
+func (al staticAllowlist) Pick(types.TopicID) (types.LogStreamID, bool) {
+	return 0, false
+}
+
+func (al staticAllowlist) Deny(types.TopicID, types.LogStreamID) {}
+
+func (al staticAllowlist) Contains(topicID types.TopicID, logStreamID types.LogStreamID) bool {
+	for _, lsid := range al[topicID] {
+		if lsid == logStreamID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestLoadAwareSelector(t *testing.T) {
+	const (
+		tpid         = types.TopicID(1)
+		numSelects   = 1000
+		hintInterval = time.Second
+	)
+
+	selectAll := func(las *loadAwareSelector) map[types.LogStreamID]int {
+		counts := make(map[types.LogStreamID]int)
+		for i := 0; i < numSelects; i++ {
+			lsid, ok := las.Select(tpid)
+			require.True(t, ok)
+			counts[lsid]++
+		}
+		return counts
+	}
+
+	hint := func(lsid types.LogStreamID, status varlogpb.LogStreamStatus, inflight int64) snpb.LogStreamReplicaMetadataDescriptor {
+		lsrmd := snpb.LogStreamReplicaMetadataDescriptor{
+			Status:          status,
+			InflightAppends: inflight,
+		}
+		lsrmd.TopicID = tpid
+		lsrmd.LogStreamID = lsid
+		return lsrmd
+	}
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, las *loadAwareSelector)
+	}{
+		{
+			name: "NoLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				_, ok := las.Select(tpid + 1)
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "NoObservation",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				counts := selectAll(las)
+				require.Len(t, counts, 3)
+			},
+		},
+		{
+			name: "AvoidSlowLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				las.observe(tpid, 1, 10*time.Millisecond, false)
+				las.observe(tpid, 2, time.Millisecond, false)
+				las.observe(tpid, 3, time.Millisecond, false)
+				counts := selectAll(las)
+				require.Zero(t, counts[1])
+				require.Positive(t, counts[2])
+				require.Positive(t, counts[3])
+			},
+		},
+		{
+			name: "AvoidFailingLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				las.observe(tpid, 1, time.Millisecond, false)
+				for i := 0; i < 5; i++ {
+					las.observe(tpid, 1, time.Microsecond, true)
+				}
+				las.observe(tpid, 2, time.Millisecond, false)
+				las.observe(tpid, 3, 0, true)
+				counts := selectAll(las)
+				// The log stream 1 is taken only when it is compared
+				// with the log stream 3 that has never succeeded.
+				require.Greater(t, counts[2], counts[1])
+				require.Zero(t, counts[3])
+			},
+		},
+		{
+			name: "PreferUnobservedLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				las.observe(tpid, 1, time.Millisecond, false)
+				las.observe(tpid, 2, time.Millisecond, false)
+				counts := selectAll(las)
+				// The log stream 3 is always taken when it is compared.
+				require.Greater(t, counts[3], counts[1])
+				require.Greater(t, counts[3], counts[2])
+			},
+		},
+		{
+			name: "AvoidLoadedLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				for lsid := types.LogStreamID(1); lsid <= 3; lsid++ {
+					las.observe(tpid, lsid, time.Millisecond, false)
+				}
+				las.updateHint(hint(1, varlogpb.LogStreamStatusRunning, 10))
+				las.updateHint(hint(2, varlogpb.LogStreamStatusRunning, 0))
+				las.updateHint(hint(3, varlogpb.LogStreamStatusRunning, 0))
+				counts := selectAll(las)
+				require.Zero(t, counts[1])
+			},
+		},
+		{
+			name: "AvoidSealedLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				las.updateHint(hint(1, varlogpb.LogStreamStatusSealed, 0))
+				counts := selectAll(las)
+				require.Zero(t, counts[1])
+			},
+		},
+		{
+			name: "IgnoreExpiredHint",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				for lsid := types.LogStreamID(1); lsid <= 3; lsid++ {
+					las.observe(tpid, lsid, time.Millisecond, false)
+				}
+				las.updateHint(hint(1, varlogpb.LogStreamStatusSealed, 0))
+				now := time.Now()
+				las.now = func() time.Time {
+					return now.Add(loadHintTTL * hintInterval)
+				}
+				counts := selectAll(las)
+				require.Positive(t, counts[1])
+			},
+		},
+		{
+			name: "ForgetRemovedLogStream",
+			testf: func(t *testing.T, las *loadAwareSelector) {
+				las.observe(tpid, 1, time.Millisecond, false)
+				las.observe(tpid, 2, time.Millisecond, false)
+				las.observe(tpid+1, 1, time.Millisecond, false)
+				require.ElementsMatch(t, []types.TopicID{tpid, tpid + 1}, las.topics())
+
+				las.retain(tpid, map[types.LogStreamID][]varlogpb.LogStreamReplica{2: nil})
+				_, ok := las.load(tpid, 1)
+				require.False(t, ok)
+				_, ok = las.load(tpid, 2)
+				require.True(t, ok)
+				_, ok = las.load(tpid+1, 1)
+				require.True(t, ok)
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			allowlist := staticAllowlist{tpid: {1, 2, 3}}
+			las := newLoadAwareSelector(allowlist, hintInterval)
+			tc.testf(t, las)
+		})
+	}
+}
+
+func TestLogStreamSelectionPolicy_String(t *testing.T) {
+	require.Equal(t, "random", LogStreamSelectionPolicyRandom.String())
+	require.Equal(t, "load-aware", LogStreamSelectionPolicyLoadAware.String())
+	require.Equal(t, "unknown", LogStreamSelectionPolicy(0).String())
+}
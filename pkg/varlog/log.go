@@ -4,15 +4,22 @@ package varlog
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync"
 	"sync/atomic"
+	"time"
 
+	"go.opentelemetry.io/otel/propagation"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/pkg/mrc/mrconnector"
+	"github.com/kakao/varlog/pkg/rpc/interceptors/otelgrpc"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -34,8 +41,36 @@ type Log interface {
 
 	SubscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...SubscribeOption) Subscriber
 
+	// SubscribeIterator returns a TopicIterator that pulls log entries of
+	// the topic in the range of GLSNs [begin, end). It merges log entries
+	// of all log streams in the topic as Subscribe does; however, the
+	// consumer takes them at its own pace by calling TopicIterator.Next.
+	// The prefetch window set by WithPrefetchWindow bounds the log entries
+	// buffered for each log stream.
+	SubscribeIterator(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) (TopicIterator, error)
+
 	Trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts TrimOption) error
 
+	// ReadAt reads the log entry at the glsn from the log stream specified by
+	// the arguments tpid and lsid. It tries replicas of the log stream in
+	// order until one of them returns the log entry; hence, it can read the
+	// log entry even if some replicas are unavailable. It returns an error
+	// wrapping verrors.ErrTrimmed if the log entry is already trimmed, and an
+	// error wrapping verrors.ErrNoEntry if no replica has the log entry, for
+	// instance, the log entry is not committed yet.
+	ReadAt(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) (varlogpb.LogEntry, error)
+
+	// LookupGLSNByTime returns the first GLSN committed at or after the
+	// time t in the topic specified by the argument tpid. Commit times are
+	// issued by the metadata repository, and each log stream replica keeps
+	// them for each commit; hence, the result is the first log entry of a
+	// commit. Subscribing from the returned GLSN reads log entries
+	// committed since the time t.
+	// It returns an error wrapping verrors.ErrNoEntry if no log entry has
+	// been committed at or after the time t, and an error wrapping
+	// verrors.ErrNotExist if the topic does not exist.
+	LookupGLSNByTime(ctx context.Context, tpid types.TopicID, t time.Time) (types.GLSN, error)
+
 	// PeekLogStream returns the log sequence numbers at the first and the
 	// last. It fetches the metadata for each replica of a log stream lsid
 	// concurrently and takes a result from either appendable or sealed
@@ -43,12 +78,62 @@ type Log interface {
 	// sealed, it returns an error.
 	PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error)
 
+	// PeekTopic returns the boundaries of the topic specified by the
+	// argument tpid: the first and the last GLSNs, the global high
+	// watermark, and the first and the last log sequence numbers of each
+	// log stream. It combines the last commit results in the metadata
+	// repository with the metadata of replicas fetched as PeekLogStream
+	// does; hence, it fails if any log stream in the topic cannot be
+	// peeked. It returns an error wrapping verrors.ErrNotExist if the topic
+	// does not exist.
+	PeekTopic(ctx context.Context, tpid types.TopicID) (TopicBoundary, error)
+
 	// NewLogStreamAppender returns a new LogStreamAppender.
 	NewLogStreamAppender(tpid types.TopicID, lsid types.LogStreamID, opts ...LogStreamAppenderOption) (LogStreamAppender, error)
 
+	// NewTopicAppender returns a new TopicAppender that appends to the
+	// appendable log streams of the topic specified by the argument tpid.
+	// The argument opts configure the TopicAppender and the
+	// LogStreamAppenders used by it.
+	NewTopicAppender(tpid types.TopicID, opts ...LogStreamAppenderOption) (TopicAppender, error)
+
 	// AppendableLogStreams returns all writable log streams belonging to the
 	// topic specified by the argument tpid.
 	AppendableLogStreams(tpid types.TopicID) map[types.LogStreamID]struct{}
+
+	// CommitOffset stores the offset processed by the consumer group
+	// specified by the argument group in the metadata repository; hence,
+	// the offset survives restarts of clients and the cluster. A
+	// topic-level offset has the last processed GLSN of the topic, and a
+	// log-stream-level offset has the last processed LLSN of the log
+	// stream. It overwrites the committed offset even if the new one is
+	// behind it.
+	// It returns an error wrapping verrors.ErrInvalid if the offset is
+	// malformed, and an error wrapping verrors.ErrNotExist if the topic or
+	// the log stream does not exist.
+	CommitOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error
+
+	// FetchOffset returns the offset committed by the consumer group
+	// specified by the argument group. The argument lsid should be zero to
+	// fetch the topic-level offset. Consumers resume from the next
+	// position of the returned offset, for instance, by calling Subscribe
+	// with offset.GLSN+1.
+	// It returns an error wrapping verrors.ErrNotExist if the consumer
+	// group has not committed the offset yet.
+	FetchOffset(ctx context.Context, group string, tpid types.TopicID, lsid types.LogStreamID) (varlogpb.ConsumerGroupOffset, error)
+
+	// GetSchema returns the schema specified by the argument schemaID,
+	// which is registered by Admin.RegisterSchema. Since schemas are
+	// immutable, it caches them; the Definition of the returned schema
+	// should not be modified.
+	// It returns an error wrapping verrors.ErrNotExist if the schema does
+	// not exist.
+	GetSchema(ctx context.Context, schemaID types.SchemaID) (varlogpb.SchemaDescriptor, error)
+
+	// BeginTransaction returns a new Transaction that appends log entries
+	// to several log streams atomically. Log entries of the transaction
+	// become visible in all log streams at once, or in none of them.
+	BeginTransaction() (Transaction, error)
 }
 
 type AppendResult struct {
@@ -60,10 +145,23 @@ type OnNext func(logEntry varlogpb.LogEntry, err error)
 
 type logImpl struct {
 	clusterID         types.ClusterID
+	mrConnector       mrconnector.Connector
 	refresher         MetadataRefresher
 	lsSelector        LogStreamSelector
 	replicasRetriever ReplicasRetriever
 	allowlist         Allowlist
+	producer          *producer
+	telemetry         *clientTelemetry
+	// loadAwareSelector is the log stream selector under
+	// LogStreamSelectionPolicyLoadAware, otherwise nil.
+	loadAwareSelector *loadAwareSelector
+	// rrSelector orders replicas of log streams to subscribe to.
+	rrSelector *readReplicaSelector
+	// payloadCipher encrypts and decrypts payloads. It is nil unless
+	// WithEncryption is set.
+	payloadCipher *payloadCipher
+	// schemas caches schemas fetched by GetSchema.
+	schemas sync.Map // map[types.SchemaID]varlogpb.SchemaDescriptor
 
 	logCLManager *client.Manager[*client.LogClient]
 	logger       *zap.Logger
@@ -89,6 +187,39 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		logger:    logOpts.logger,
 		opts:      &logOpts,
 		runner:    runner.New("varlog", logOpts.logger),
+		telemetry: newClientTelemetry(logOpts.meterProvider, logOpts.tracerProvider),
+
+		payloadCipher: newPayloadCipher(logOpts.keyProvider),
+	}
+
+	if v.opts.tracerProvider != nil {
+		propagator := propagation.TraceContext{}
+		v.opts.grpcDialOptions = append(v.opts.grpcDialOptions,
+			grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(propagator)),
+			grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(propagator)),
+		)
+	}
+
+	if !v.opts.compressionCodec.Valid() {
+		return nil, fmt.Errorf("open: unknown compression codec %d: %w", v.opts.compressionCodec, verrors.ErrInvalid)
+	}
+	switch v.opts.logStreamSelectionPolicy {
+	case LogStreamSelectionPolicyRandom, LogStreamSelectionPolicyLoadAware:
+	default:
+		return nil, fmt.Errorf("open: unknown log stream selection policy %d: %w", v.opts.logStreamSelectionPolicy, verrors.ErrInvalid)
+	}
+	switch v.opts.readReplicaPolicy {
+	case ReadReplicaPolicyPrimary, ReadReplicaPolicyAny, ReadReplicaPolicyNearest, ReadReplicaPolicyLeastLoaded:
+	default:
+		return nil, fmt.Errorf("open: unknown read replica policy %d: %w", v.opts.readReplicaPolicy, verrors.ErrInvalid)
+	}
+
+	if v.opts.idempotentProducer {
+		producer, err := newProducer()
+		if err != nil {
+			return nil, err
+		}
+		v.producer = producer
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, v.opts.openTimeout)
@@ -105,6 +236,7 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 	if err != nil {
 		return nil, err
 	}
+	v.mrConnector = connector
 
 	// allowlist
 	allowlist, err := newTransientAllowlist(v.opts.denyTTL, v.opts.expireDenyInterval, v.logger)
@@ -114,7 +246,12 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 	v.allowlist = allowlist
 
 	// log stream selector
-	v.lsSelector = newAppendableLogStreamSelector(allowlist)
+	if v.opts.logStreamSelectionPolicy == LogStreamSelectionPolicyLoadAware {
+		v.loadAwareSelector = newLoadAwareSelector(allowlist, v.opts.loadHintInterval)
+		v.lsSelector = v.loadAwareSelector
+	} else {
+		v.lsSelector = newAppendableLogStreamSelector(allowlist)
+	}
 
 	// replicas retriever
 	replicasRetriever := &renewableReplicasRetriever{}
@@ -129,6 +266,7 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		replicasRetriever,
 		v.opts.metadataRefreshInterval,
 		v.opts.metadataRefreshTimeout,
+		v.telemetry,
 		v.logger,
 	)
 	if err != nil {
@@ -148,6 +286,7 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		return nil, err
 	}
 	v.logCLManager = logCLManager
+	v.rrSelector = newReadReplicaSelector(v.opts.readReplicaPolicy, logCLManager)
 
 	for _, snd := range metadata.GetStorageNodes() {
 		if _, err := v.logCLManager.GetOrConnect(ctx, snd.StorageNodeID, snd.Address); err != nil {
@@ -156,6 +295,13 @@ func Open(ctx context.Context, clusterID types.ClusterID, mrAddrs []string, opts
 		}
 	}
 
+	if v.loadAwareSelector != nil && v.opts.loadHintInterval > 0 {
+		if _, err := v.runner.Run(v.refreshLoadHints); err != nil {
+			_ = v.logCLManager.Close()
+			return nil, err
+		}
+	}
+
 	return v, nil
 }
 
@@ -172,6 +318,10 @@ func (v *logImpl) Subscribe(ctx context.Context, topicID types.TopicID, begin ty
 	return v.subscribe(ctx, topicID, begin, end, onNextFunc, opts...)
 }
 
+func (v *logImpl) SubscribeIterator(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) (TopicIterator, error) {
+	return v.subscribeIterator(ctx, topicID, begin, end, opts...)
+}
+
 func (v *logImpl) SubscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...SubscribeOption) Subscriber {
 	return v.subscribeTo(ctx, topicID, logStreamID, begin, end, opts...)
 }
@@ -180,14 +330,30 @@ func (v *logImpl) Trim(ctx context.Context, topicID types.TopicID, until types.G
 	return v.trim(ctx, topicID, until, opts)
 }
 
+func (v *logImpl) ReadAt(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	return v.readAt(ctx, tpid, lsid, glsn)
+}
+
+func (v *logImpl) LookupGLSNByTime(ctx context.Context, tpid types.TopicID, t time.Time) (types.GLSN, error) {
+	return v.lookupGLSNByTime(ctx, tpid, t)
+}
+
 func (v *logImpl) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error) {
 	return v.peekLogStream(ctx, tpid, lsid)
 }
 
+func (v *logImpl) PeekTopic(ctx context.Context, tpid types.TopicID) (TopicBoundary, error) {
+	return v.peekTopic(ctx, tpid)
+}
+
 func (v *logImpl) NewLogStreamAppender(tpid types.TopicID, lsid types.LogStreamID, opts ...LogStreamAppenderOption) (LogStreamAppender, error) {
 	return v.newLogStreamAppender(context.Background(), tpid, lsid, opts...)
 }
 
+func (v *logImpl) NewTopicAppender(tpid types.TopicID, opts ...LogStreamAppenderOption) (TopicAppender, error) {
+	return v.newTopicAppender(tpid, opts...)
+}
+
 func (v *logImpl) AppendableLogStreams(tpid types.TopicID) map[types.LogStreamID]struct{} {
 	ids := v.lsSelector.GetAll(tpid)
 	ret := make(map[types.LogStreamID]struct{})
@@ -197,6 +363,22 @@ func (v *logImpl) AppendableLogStreams(tpid types.TopicID) map[types.LogStreamID
 	return ret
 }
 
+func (v *logImpl) CommitOffset(ctx context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	return v.commitOffset(ctx, group, offset)
+}
+
+func (v *logImpl) FetchOffset(ctx context.Context, group string, tpid types.TopicID, lsid types.LogStreamID) (varlogpb.ConsumerGroupOffset, error) {
+	return v.fetchOffset(ctx, group, tpid, lsid)
+}
+
+func (v *logImpl) GetSchema(ctx context.Context, schemaID types.SchemaID) (varlogpb.SchemaDescriptor, error) {
+	return v.getSchema(ctx, schemaID)
+}
+
+func (v *logImpl) BeginTransaction() (Transaction, error) {
+	return v.beginTransaction()
+}
+
 func (v *logImpl) Close() (err error) {
 	if v.closed.Load() {
 		return
@@ -7,6 +7,7 @@ package varlog
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 
@@ -89,6 +90,21 @@ func (mr *MockLogMockRecorder) AppendableLogStreams(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendableLogStreams", reflect.TypeOf((*MockLog)(nil).AppendableLogStreams), arg0)
 }
 
+// BeginTransaction mocks base method.
+func (m *MockLog) BeginTransaction() (Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTransaction")
+	ret0, _ := ret[0].(Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTransaction indicates an expected call of BeginTransaction.
+func (mr *MockLogMockRecorder) BeginTransaction() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTransaction", reflect.TypeOf((*MockLog)(nil).BeginTransaction))
+}
+
 // Close mocks base method.
 func (m *MockLog) Close() error {
 	m.ctrl.T.Helper()
@@ -103,6 +119,65 @@ func (mr *MockLogMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockLog)(nil).Close))
 }
 
+// CommitOffset mocks base method.
+func (m *MockLog) CommitOffset(arg0 context.Context, arg1 string, arg2 varlogpb.ConsumerGroupOffset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffset", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitOffset indicates an expected call of CommitOffset.
+func (mr *MockLogMockRecorder) CommitOffset(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffset", reflect.TypeOf((*MockLog)(nil).CommitOffset), arg0, arg1, arg2)
+}
+
+// FetchOffset mocks base method.
+func (m *MockLog) FetchOffset(arg0 context.Context, arg1 string, arg2 types.TopicID, arg3 types.LogStreamID) (varlogpb.ConsumerGroupOffset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchOffset", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(varlogpb.ConsumerGroupOffset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchOffset indicates an expected call of FetchOffset.
+func (mr *MockLogMockRecorder) FetchOffset(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchOffset", reflect.TypeOf((*MockLog)(nil).FetchOffset), arg0, arg1, arg2, arg3)
+}
+
+// GetSchema mocks base method.
+func (m *MockLog) GetSchema(arg0 context.Context, arg1 types.SchemaID) (varlogpb.SchemaDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(varlogpb.SchemaDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockLogMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockLog)(nil).GetSchema), arg0, arg1)
+}
+
+// LookupGLSNByTime mocks base method.
+func (m *MockLog) LookupGLSNByTime(arg0 context.Context, arg1 types.TopicID, arg2 time.Time) (types.GLSN, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupGLSNByTime", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types.GLSN)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSNByTime indicates an expected call of LookupGLSNByTime.
+func (mr *MockLogMockRecorder) LookupGLSNByTime(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSNByTime", reflect.TypeOf((*MockLog)(nil).LookupGLSNByTime), arg0, arg1, arg2)
+}
+
 // NewLogStreamAppender mocks base method.
 func (m *MockLog) NewLogStreamAppender(arg0 types.TopicID, arg1 types.LogStreamID, arg2 ...LogStreamAppenderOption) (LogStreamAppender, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +198,26 @@ func (mr *MockLogMockRecorder) NewLogStreamAppender(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLogStreamAppender", reflect.TypeOf((*MockLog)(nil).NewLogStreamAppender), varargs...)
 }
 
+// NewTopicAppender mocks base method.
+func (m *MockLog) NewTopicAppender(arg0 types.TopicID, arg1 ...LogStreamAppenderOption) (TopicAppender, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewTopicAppender", varargs...)
+	ret0, _ := ret[0].(TopicAppender)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTopicAppender indicates an expected call of NewTopicAppender.
+func (mr *MockLogMockRecorder) NewTopicAppender(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTopicAppender", reflect.TypeOf((*MockLog)(nil).NewTopicAppender), varargs...)
+}
+
 // PeekLogStream mocks base method.
 func (m *MockLog) PeekLogStream(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID) (varlogpb.LogSequenceNumber, varlogpb.LogSequenceNumber, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +234,36 @@ func (mr *MockLogMockRecorder) PeekLogStream(arg0, arg1, arg2 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekLogStream", reflect.TypeOf((*MockLog)(nil).PeekLogStream), arg0, arg1, arg2)
 }
 
+// PeekTopic mocks base method.
+func (m *MockLog) PeekTopic(arg0 context.Context, arg1 types.TopicID) (TopicBoundary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeekTopic", arg0, arg1)
+	ret0, _ := ret[0].(TopicBoundary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeekTopic indicates an expected call of PeekTopic.
+func (mr *MockLogMockRecorder) PeekTopic(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekTopic", reflect.TypeOf((*MockLog)(nil).PeekTopic), arg0, arg1)
+}
+
+// ReadAt mocks base method.
+func (m *MockLog) ReadAt(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3 types.GLSN) (varlogpb.LogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(varlogpb.LogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAt indicates an expected call of ReadAt.
+func (mr *MockLogMockRecorder) ReadAt(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAt", reflect.TypeOf((*MockLog)(nil).ReadAt), arg0, arg1, arg2, arg3)
+}
+
 // Subscribe mocks base method.
 func (m *MockLog) Subscribe(arg0 context.Context, arg1 types.TopicID, arg2, arg3 types.GLSN, arg4 OnNext, arg5 ...SubscribeOption) (SubscribeCloser, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +284,26 @@ func (mr *MockLogMockRecorder) Subscribe(arg0, arg1, arg2, arg3, arg4 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockLog)(nil).Subscribe), varargs...)
 }
 
+// SubscribeIterator mocks base method.
+func (m *MockLog) SubscribeIterator(arg0 context.Context, arg1 types.TopicID, arg2, arg3 types.GLSN, arg4 ...SubscribeOption) (TopicIterator, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeIterator", varargs...)
+	ret0, _ := ret[0].(TopicIterator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeIterator indicates an expected call of SubscribeIterator.
+func (mr *MockLogMockRecorder) SubscribeIterator(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeIterator", reflect.TypeOf((*MockLog)(nil).SubscribeIterator), varargs...)
+}
+
 // SubscribeTo mocks base method.
 func (m *MockLog) SubscribeTo(arg0 context.Context, arg1 types.TopicID, arg2 types.LogStreamID, arg3, arg4 types.LLSN, arg5 ...SubscribeOption) Subscriber {
 	m.ctrl.T.Helper()
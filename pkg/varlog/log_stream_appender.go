@@ -6,12 +6,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/puzpuzpuz/xsync/v2"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/kakao/varlog/internal/compress"
+	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/telemetry"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -36,11 +42,15 @@ type LogStreamAppender interface {
 	// Once the stream in the LogStreamAppender is either done or broken, the
 	// AppendBatch returns an error. It returns an ErrClosed when the
 	// LogStreamAppender is closed and an ErrCallTimeout when the call timeout
-	// expires.
+	// expires. With WithRetryPolicy, the LogStreamAppender reconnects the
+	// broken stream instead of failing subsequent AppendBatch calls.
 	//
 	// It is safe to have multiple goroutines calling AppendBatch
 	// simultaneously, but the order between them is not guaranteed.
-	AppendBatch(dataBatch [][]byte, callback BatchCallback) error
+	//
+	// Attributes of log entries can be set by WithLogEntryAttributes. Other
+	// AppendOptions are ignored.
+	AppendBatch(dataBatch [][]byte, callback BatchCallback, opts ...AppendOption) error
 
 	// Close closes the LogStreamAppender client. Once the client is closed,
 	// calling AppendBatch will fail immediately. If AppendBatch still waits
@@ -56,9 +66,14 @@ type BatchCallback func([]varlogpb.LogEntryMeta, error)
 type cbQueueEntry struct {
 	cb         BatchCallback
 	data       [][]byte
+	attrs      []varlogpb.LogEntryAttributes
 	err        error
 	meta       []varlogpb.LogEntryMeta
 	expireTime time.Time
+	startTime  time.Time
+	span       trace.Span
+	// stream is the stream over which the batch was sent.
+	stream *appendStream
 }
 
 func newCallbackQueueEntry() *cbQueueEntry {
@@ -76,9 +91,24 @@ var callbackQueueEntryPool = sync.Pool{
 	},
 }
 
+// appendStream is a stream of a LogStreamAppender. Its context is canceled
+// with the cause once the stream is broken.
+type appendStream struct {
+	snpb.LogIO_AppendClient
+	ctx        context.Context
+	cancelFunc context.CancelCauseFunc
+}
+
 type logStreamAppender struct {
 	logStreamAppenderConfig
-	stream     snpb.LogIO_AppendClient
+	codec             varlogpb.CompressionCodec
+	cipher            *payloadCipher
+	telemetry         *clientTelemetry
+	replicasRetriever ReplicasRetriever
+	logCLManager      *client.Manager[*client.LogClient]
+	deny              func(context.Context, types.TopicID, types.LogStreamID)
+	// stream is the current stream, which only sendLoop replaces.
+	stream     *appendStream
 	cancelFunc context.CancelCauseFunc
 	causeFunc  func() error
 	sema       chan struct{}
@@ -95,32 +125,24 @@ type logStreamAppender struct {
 var _ LogStreamAppender = (*logStreamAppender)(nil)
 
 func (v *logImpl) newLogStreamAppender(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, opts ...LogStreamAppenderOption) (LogStreamAppender, error) {
-	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
-	if !ok {
-		return nil, fmt.Errorf("client: log stream %d of topic %d does not exist", lsid, tpid)
-	}
-
-	snid := replicas[0].StorageNodeID
-	addr := replicas[0].Address
-	cl, err := v.logCLManager.GetOrConnect(ctx, snid, addr)
-	if err != nil {
-		v.allowlist.Deny(tpid, lsid)
-		return nil, fmt.Errorf("client: %w", err)
-	}
-
-	ctx, cancelFunc := context.WithCancelCause(ctx)
-	stream, err := cl.AppendStream(ctx)
-	if err != nil {
-		cancelFunc(err)
-		return nil, fmt.Errorf("client: %w", err)
-	}
-
 	cfg := newLogStreamAppenderConfig(opts)
 	cfg.tpid = tpid
 	cfg.lsid = lsid
+	if cfg.retryPolicy != nil {
+		if err := cfg.retryPolicy.validate(); err != nil {
+			return nil, fmt.Errorf("client: %w", err)
+		}
+	}
+
+	ctx, cancelFunc := context.WithCancelCause(ctx)
 	lsa := &logStreamAppender{
 		logStreamAppenderConfig: cfg,
-		stream:                  stream,
+		codec:                   v.opts.compressionCodec,
+		cipher:                  v.payloadCipher,
+		telemetry:               v.telemetry,
+		replicasRetriever:       v.replicasRetriever,
+		logCLManager:            v.logCLManager,
+		deny:                    v.deny,
 		sema:                    make(chan struct{}, cfg.pipelineSize),
 		sq:                      make(chan *cbQueueEntry, cfg.pipelineSize),
 		rq:                      make(chan *cbQueueEntry, cfg.pipelineSize),
@@ -130,6 +152,12 @@ func (v *logImpl) newLogStreamAppender(ctx context.Context, tpid types.TopicID,
 			return context.Cause(ctx)
 		},
 	}
+	stream, err := lsa.connect(ctx, false)
+	if err != nil {
+		cancelFunc(err)
+		return nil, err
+	}
+	lsa.stream = stream
 	_, err = v.runner.Run(func(context.Context) {
 		lsa.callbackLoop()
 	})
@@ -137,18 +165,100 @@ func (v *logImpl) newLogStreamAppender(ctx context.Context, tpid types.TopicID,
 		return nil, fmt.Errorf("client: %w", err)
 	}
 	lsa.wg.Add(2)
-	go lsa.sendLoop()
+	go lsa.sendLoop(ctx)
 	go lsa.recvLoop()
 	return lsa, nil
 }
 
-func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback BatchCallback) error {
+// connect opens a stream to the primary replica of the log stream. If the
+// argument checkSealed is true, it fails with ErrSealed unless the log stream
+// is running.
+func (lsa *logStreamAppender) connect(ctx context.Context, checkSealed bool) (*appendStream, error) {
+	replicas, ok := lsa.replicasRetriever.Retrieve(lsa.tpid, lsa.lsid)
+	if !ok {
+		return nil, fmt.Errorf("client: log stream %d of topic %d does not exist", lsa.lsid, lsa.tpid)
+	}
+
+	cl, err := lsa.logCLManager.GetOrConnect(ctx, replicas[0].StorageNodeID, replicas[0].Address)
+	if err != nil {
+		lsa.deny(ctx, lsa.tpid, lsa.lsid)
+		return nil, fmt.Errorf("client: %w", err)
+	}
+
+	if checkSealed {
+		lsrmd, err := cl.LogStreamReplicaMetadata(ctx, lsa.tpid, lsa.lsid)
+		if err != nil {
+			return nil, fmt.Errorf("client: %w", err)
+		}
+		if !lsrmd.Status.Running() {
+			return nil, fmt.Errorf("client: log stream %d of topic %d is %s: %w", lsa.lsid, lsa.tpid, lsrmd.Status, verrors.ErrSealed)
+		}
+	}
+
+	ctx, cancelFunc := context.WithCancelCause(ctx)
+	stream, err := cl.AppendStream(ctx)
+	if err != nil {
+		cancelFunc(err)
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	return &appendStream{
+		LogIO_AppendClient: stream,
+		ctx:                ctx,
+		cancelFunc:         cancelFunc,
+	}, nil
+}
+
+// reconnect replaces the broken stream with a new one as the retry policy
+// allows. The argument cause is why the stream was broken. Since the log
+// stream may have been sealed, it does not reconnect until the log stream is
+// running. It returns a non-nil error if it cannot reconnect, after which the
+// LogStreamAppender cannot send any batch.
+func (lsa *logStreamAppender) reconnect(ctx context.Context, cause error) error {
+	_ = lsa.stream.CloseSend()
+	policy := lsa.retryPolicy
+	if policy == nil {
+		return cause
+	}
+
+	start := time.Now()
+	for retry := 1; ; retry++ {
+		if !policy.retryable(cause) || !policy.wait(ctx, retry, start) {
+			lsa.cancelFunc(cause)
+			return lsa.causeFunc()
+		}
+		lsa.telemetry.recordAppendRetry(ctx, lsa.tpid)
+		stream, err := lsa.connect(ctx, true)
+		if err == nil {
+			lsa.stream = stream
+			return nil
+		}
+		cause = err
+	}
+}
+
+func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback BatchCallback, opts ...AppendOption) error {
 	rt := lsa.closed.RLock()
 	defer lsa.closed.RUnlock(rt)
 	if lsa.closed.value {
 		return ErrClosed
 	}
 
+	var appendOpts appendOptions
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	if len(appendOpts.attrs) > 0 && len(appendOpts.attrs) != len(dataBatch) {
+		return fmt.Errorf("client: %d attributes for %d data: %w", len(appendOpts.attrs), len(dataBatch), verrors.ErrInvalid)
+	}
+	dataBatch, attrs, err := compress.CompressBatch(lsa.codec, dataBatch, appendOpts.attrs)
+	if err != nil {
+		return fmt.Errorf("client: %w", err)
+	}
+	dataBatch, attrs, err = lsa.cipher.encryptBatch(context.Background(), dataBatch, attrs)
+	if err != nil {
+		return fmt.Errorf("client: %w", err)
+	}
+
 	if err := lsa.causeFunc(); err != nil {
 		return err
 	}
@@ -169,8 +279,15 @@ func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback BatchCall
 
 	qe := newCallbackQueueEntry()
 	qe.data = dataBatch
+	qe.attrs = attrs
 	qe.cb = callback
 	qe.expireTime = now.Add(lsa.callTimeout)
+	qe.startTime = now
+	_, qe.span = lsa.telemetry.tracer.Start(context.Background(), "varlog.LogStreamAppender.AppendBatch",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(now),
+		trace.WithAttributes(telemetry.TopicID(lsa.tpid), telemetry.LogStreamID(lsa.lsid)),
+	)
 	lsa.sq <- qe
 	return nil
 }
@@ -189,7 +306,7 @@ func (lsa *logStreamAppender) Close() {
 	lsa.wg.Wait()
 }
 
-func (lsa *logStreamAppender) sendLoop() {
+func (lsa *logStreamAppender) sendLoop(ctx context.Context) {
 	defer func() {
 		close(lsa.rq)
 		lsa.wg.Done()
@@ -201,8 +318,16 @@ func (lsa *logStreamAppender) sendLoop() {
 		LogStreamID: lsa.lsid,
 	}
 	for qe := range lsa.sq {
-		if sendErr == nil {
+		for sendErr == nil {
+			// A batch is sent over a new stream if the current one is
+			// broken, thus, batches not sent yet keep their order.
+			if cause := context.Cause(lsa.stream.ctx); cause != nil {
+				sendErr = lsa.reconnect(ctx, cause)
+				continue
+			}
+
 			req.Payload = qe.data
+			req.Attributes = qe.attrs
 
 			var wg sync.WaitGroup
 			var watchdog *time.Timer
@@ -213,16 +338,20 @@ func (lsa *logStreamAppender) sendLoop() {
 					lsa.cancelFunc(ErrCallTimeout)
 				})
 			}
-			sendErr = lsa.stream.Send(req)
+			err := lsa.stream.Send(req)
 			if watchdog != nil && !watchdog.Stop() {
 				wg.Wait()
 			}
-			if sendErr != nil {
-				if cause := lsa.causeFunc(); cause != nil {
-					sendErr = cause
-				}
+			if err == nil {
+				qe.stream = lsa.stream
+				break
+			}
+			if cause := lsa.causeFunc(); cause != nil {
+				sendErr = cause
 				_ = lsa.stream.CloseSend()
+				break
 			}
+			lsa.stream.cancelFunc(err)
 		}
 		if sendErr != nil {
 			qe.err = sendErr
@@ -237,36 +366,41 @@ func (lsa *logStreamAppender) recvLoop() {
 		lsa.wg.Done()
 	}()
 
-	var recvErr error
+	// Batches sent over the broken stream fail with the same error as the
+	// first failed batch.
+	var (
+		broken    *appendStream
+		brokenErr error
+	)
 	rsp := &snpb.AppendResponse{}
 	for qe := range lsa.rq {
-		if recvErr == nil {
-			var wg sync.WaitGroup
-			var watchdog *time.Timer
-			var meta []varlogpb.LogEntryMeta
-
-			recvErr = qe.err
-			if recvErr != nil {
-				goto Call
-			}
+		var wg sync.WaitGroup
+		var watchdog *time.Timer
+		var meta []varlogpb.LogEntryMeta
 
-			if lsa.callTimeout > 0 {
-				wg.Add(1)
-				watchdog = time.AfterFunc(time.Until(qe.expireTime), func() {
-					defer wg.Done()
-					lsa.cancelFunc(ErrCallTimeout)
-				})
-			}
+		recvErr := qe.err
+		if recvErr != nil {
+			goto Call
+		}
+		if qe.stream == broken {
+			recvErr = brokenErr
+			goto Call
+		}
 
-			rsp.Reset()
-			recvErr = lsa.stream.RecvMsg(rsp)
-			if watchdog != nil && !watchdog.Stop() {
-				wg.Wait()
-			}
-			if recvErr != nil {
-				goto Call
-			}
+		if lsa.callTimeout > 0 {
+			wg.Add(1)
+			watchdog = time.AfterFunc(time.Until(qe.expireTime), func() {
+				defer wg.Done()
+				lsa.cancelFunc(ErrCallTimeout)
+			})
+		}
 
+		rsp.Reset()
+		recvErr = qe.stream.RecvMsg(rsp)
+		if watchdog != nil && !watchdog.Stop() {
+			wg.Wait()
+		}
+		if recvErr == nil {
 			meta = make([]varlogpb.LogEntryMeta, len(rsp.Results))
 			for idx, res := range rsp.Results {
 				if len(res.Error) == 0 {
@@ -274,11 +408,20 @@ func (lsa *logStreamAppender) recvLoop() {
 					continue
 				}
 				recvErr = errors.New(res.Error)
-				lsa.cancelFunc(recvErr)
+				if lsa.retryPolicy == nil {
+					lsa.cancelFunc(recvErr)
+				}
 				break
 			}
 			qe.meta = meta
 		}
+		if recvErr != nil {
+			if strings.Contains(recvErr.Error(), "sealed") {
+				recvErr = fmt.Errorf("client: %s: %w", recvErr.Error(), verrors.ErrSealed)
+			}
+			broken, brokenErr = qe.stream, recvErr
+			qe.stream.cancelFunc(recvErr)
+		}
 
 	Call:
 		if recvErr != nil {
@@ -299,6 +442,12 @@ func (lsa *logStreamAppender) callbackLoop() {
 		} else {
 			cb = lsa.defaultBatchCallback
 		}
+		logs := 0
+		if qe.err == nil {
+			logs = len(qe.meta)
+		}
+		lsa.telemetry.recordLogStreamAppend(context.Background(), lsa.tpid, lsa.lsid, qe.startTime, logs)
+		endSpan(qe.span, qe.err)
 		if cb != nil {
 			cb(qe.meta, qe.err)
 		}
@@ -34,17 +34,22 @@ func (m *MockLogStreamAppender) EXPECT() *MockLogStreamAppenderMockRecorder {
 }
 
 // AppendBatch mocks base method.
-func (m *MockLogStreamAppender) AppendBatch(arg0 [][]byte, arg1 BatchCallback) error {
+func (m *MockLogStreamAppender) AppendBatch(arg0 [][]byte, arg1 BatchCallback, arg2 ...AppendOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AppendBatch", arg0, arg1)
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendBatch", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AppendBatch indicates an expected call of AppendBatch.
-func (mr *MockLogStreamAppenderMockRecorder) AppendBatch(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockLogStreamAppenderMockRecorder) AppendBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBatch", reflect.TypeOf((*MockLogStreamAppender)(nil).AppendBatch), arg0, arg1)
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBatch", reflect.TypeOf((*MockLogStreamAppender)(nil).AppendBatch), varargs...)
 }
 
 // Close mocks base method.
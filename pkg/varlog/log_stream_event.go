@@ -0,0 +1,58 @@
+package varlog
+
+import (
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// LogStreamEventType is the type of change in the set of log streams
+// subscribed by Subscribe or SubscribeIterator.
+type LogStreamEventType int
+
+const (
+	// LogStreamEventAdded means that a log stream was added to the topic
+	// while subscribing. The subscription starts reading the log stream from
+	// the GLSN of the event. Since the subscription delivers log entries in
+	// order of GLSN, none of the log entries in the log stream precede it.
+	LogStreamEventAdded LogStreamEventType = iota + 1
+	// LogStreamEventRemoved means that a log stream was unregistered from the
+	// topic while subscribing. Since a log stream is sealed before
+	// unregistered, the subscription keeps reading the log entries committed
+	// to the log stream until drained.
+	LogStreamEventRemoved
+	// LogStreamEventDrained means that the subscription stopped reading the
+	// log stream removed from the topic. It always follows
+	// LogStreamEventRemoved of the same log stream.
+	LogStreamEventDrained
+)
+
+func (typ LogStreamEventType) String() string {
+	switch typ {
+	case LogStreamEventAdded:
+		return "added"
+	case LogStreamEventRemoved:
+		return "removed"
+	case LogStreamEventDrained:
+		return "drained"
+	default:
+		return "unknown"
+	}
+}
+
+// LogStreamEvent describes a change in the set of log streams subscribed by
+// Subscribe or SubscribeIterator.
+type LogStreamEvent struct {
+	Type        LogStreamEventType
+	TopicID     types.TopicID
+	LogStreamID types.LogStreamID
+	// GLSN is the position of the subscription when the event occurs, that
+	// is, the GLSN of the next log entry to be delivered. All log entries
+	// before it have been handed over to the subscription.
+	GLSN types.GLSN
+}
+
+// LogStreamListener is notified of changes in the set of log streams
+// subscribed by Subscribe or SubscribeIterator. It is called synchronously by
+// the goroutine merging log entries of the log streams; hence, it should not
+// block. Note that it is not synchronized with OnNext or TopicIterator.Next,
+// thus, log entries before the GLSN of the event might not be consumed yet.
+type LogStreamListener func(event LogStreamEvent)
@@ -1,7 +1,12 @@
 package varlog
 
 import (
+	"encoding/binary"
 	"errors"
+	"sort"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
 
 	"github.com/kakao/varlog/pkg/types"
 )
@@ -39,3 +44,164 @@ func (als *alsSelector) Select(topicID types.TopicID) (types.LogStreamID, bool)
 func (als *alsSelector) GetAll(topicID types.TopicID) []types.LogStreamID {
 	return als.allowlist.GetAll(topicID)
 }
+
+// KeyedLogStreamSelector is the interface that wraps the SelectByKey method.
+//
+// SelectByKey orders the log streams given by the argument logStreamIDs by
+// preference for the argument key. The first one is the owner of the key, and
+// the others are candidates to which the key is remapped in that order when
+// the owner cannot accept appends, for instance, it is sealed. It must return
+// the same order for the same key and log streams so that appends with the
+// same key keep their order. It returns an empty slice if logStreamIDs is
+// empty.
+type KeyedLogStreamSelector interface {
+	SelectByKey(topicID types.TopicID, key []byte, logStreamIDs []types.LogStreamID) []types.LogStreamID
+}
+
+// SealedLogStreamPolicy decides what an append with a selection key does when
+// the log stream owning the key is sealed or unavailable.
+type SealedLogStreamPolicy int
+
+const (
+	// SealedLogStreamPolicyWait makes the append wait until the log stream
+	// owning the key is unsealed or the context is done. It keeps the order
+	// of log entries with the same key.
+	SealedLogStreamPolicyWait SealedLogStreamPolicy = iota + 1
+	// SealedLogStreamPolicyRemap makes the append go to the next candidate
+	// returned by KeyedLogStreamSelector, skipping log streams that have
+	// failed recently. All clients remap the same key to the same log stream
+	// as long as they see the same log streams, but the order of log entries
+	// with the key across the owner and the remapped log stream is not
+	// guaranteed.
+	SealedLogStreamPolicyRemap
+)
+
+func (p SealedLogStreamPolicy) String() string {
+	switch p {
+	case SealedLogStreamPolicyWait:
+		return "wait"
+	case SealedLogStreamPolicyRemap:
+		return "remap"
+	default:
+		return "unknown"
+	}
+}
+
+const defaultVirtualNodes = 128
+
+// consistentHashSelector implements KeyedLogStreamSelector by using a hash
+// ring with virtual nodes. Adding or removing a log stream moves only the keys
+// adjacent to it on the ring.
+type consistentHashSelector struct {
+	virtualNodes int
+	rings        sync.Map // map[types.TopicID]*hashRing
+}
+
+var _ KeyedLogStreamSelector = (*consistentHashSelector)(nil)
+
+// NewConsistentHashSelector returns a KeyedLogStreamSelector based on
+// consistent hashing. The argument virtualNodes is the number of points of
+// each log stream on the hash ring; if it is not positive, a default value is
+// used.
+func NewConsistentHashSelector(virtualNodes int) KeyedLogStreamSelector {
+	if virtualNodes <= 0 {
+		virtualNodes = defaultVirtualNodes
+	}
+	return &consistentHashSelector{virtualNodes: virtualNodes}
+}
+
+// SelectByKey implements (KeyedLogStreamSelector).SelectByKey method.
+func (chs *consistentHashSelector) SelectByKey(topicID types.TopicID, key []byte, logStreamIDs []types.LogStreamID) []types.LogStreamID {
+	if len(logStreamIDs) == 0 {
+		return nil
+	}
+
+	var ring *hashRing
+	if ringIf, ok := chs.rings.Load(topicID); ok {
+		ring = ringIf.(*hashRing)
+	}
+	if ring == nil || !ring.builtFrom(logStreamIDs) {
+		ring = newHashRing(logStreamIDs, chs.virtualNodes)
+		chs.rings.Store(topicID, ring)
+	}
+	return ring.walk(xxhash.Sum64(key))
+}
+
+type hashRing struct {
+	logStreamIDs []types.LogStreamID // sorted
+	points       []uint64            // sorted
+	owners       []types.LogStreamID // owners[i] owns points[i]
+}
+
+func newHashRing(logStreamIDs []types.LogStreamID, virtualNodes int) *hashRing {
+	ring := &hashRing{
+		logStreamIDs: make([]types.LogStreamID, len(logStreamIDs)),
+	}
+	copy(ring.logStreamIDs, logStreamIDs)
+	sort.Slice(ring.logStreamIDs, func(i, j int) bool {
+		return ring.logStreamIDs[i] < ring.logStreamIDs[j]
+	})
+
+	type point struct {
+		hash  uint64
+		owner types.LogStreamID
+	}
+	points := make([]point, 0, len(logStreamIDs)*virtualNodes)
+	var buf [8]byte
+	for _, lsid := range ring.logStreamIDs {
+		binary.BigEndian.PutUint32(buf[:4], uint32(lsid))
+		for i := 0; i < virtualNodes; i++ {
+			binary.BigEndian.PutUint32(buf[4:], uint32(i))
+			points = append(points, point{hash: xxhash.Sum64(buf[:]), owner: lsid})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].owner < points[j].owner
+		}
+		return points[i].hash < points[j].hash
+	})
+
+	ring.points = make([]uint64, len(points))
+	ring.owners = make([]types.LogStreamID, len(points))
+	for i, p := range points {
+		ring.points[i] = p.hash
+		ring.owners[i] = p.owner
+	}
+	return ring
+}
+
+// builtFrom returns true if the ring consists of the given log streams.
+func (ring *hashRing) builtFrom(logStreamIDs []types.LogStreamID) bool {
+	if len(ring.logStreamIDs) != len(logStreamIDs) {
+		return false
+	}
+	for _, lsid := range logStreamIDs {
+		idx := sort.Search(len(ring.logStreamIDs), func(i int) bool {
+			return ring.logStreamIDs[i] >= lsid
+		})
+		if idx == len(ring.logStreamIDs) || ring.logStreamIDs[idx] != lsid {
+			return false
+		}
+	}
+	return true
+}
+
+// walk returns distinct log streams met while walking the ring clockwise from
+// the given hash.
+func (ring *hashRing) walk(hash uint64) []types.LogStreamID {
+	ret := make([]types.LogStreamID, 0, len(ring.logStreamIDs))
+	seen := make(map[types.LogStreamID]struct{}, len(ring.logStreamIDs))
+	start := sort.Search(len(ring.points), func(i int) bool {
+		return ring.points[i] >= hash
+	})
+	for i := 0; i < len(ring.points) && len(ret) < len(ring.logStreamIDs); i++ {
+		owner := ring.owners[(start+i)%len(ring.points)]
+		if _, ok := seen[owner]; ok {
+			continue
+		}
+		seen[owner] = struct{}{}
+		ret = append(ret, owner)
+	}
+	return ret
+}
@@ -0,0 +1,111 @@
+package varlog
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+func TestConsistentHashSelector(t *testing.T) {
+	const (
+		tpid    = types.TopicID(1)
+		numKeys = 1000
+	)
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, sel KeyedLogStreamSelector)
+	}{
+		{
+			name: "NoLogStream",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				require.Empty(t, sel.SelectByKey(tpid, []byte("key"), nil))
+			},
+		},
+		{
+			name: "AllLogStreamsInPreferenceOrder",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				lsids := []types.LogStreamID{1, 2, 3, 4}
+				for i := 0; i < numKeys; i++ {
+					candidates := sel.SelectByKey(tpid, []byte(fmt.Sprintf("key-%d", i)), lsids)
+					require.ElementsMatch(t, lsids, candidates)
+				}
+			},
+		},
+		{
+			name: "Deterministic",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				lsids := []types.LogStreamID{1, 2, 3, 4}
+				other := NewConsistentHashSelector(0)
+				for i := 0; i < numKeys; i++ {
+					key := []byte(fmt.Sprintf("key-%d", i))
+					expected := sel.SelectByKey(tpid, key, lsids)
+					require.Equal(t, expected, sel.SelectByKey(tpid, key, lsids))
+					require.Equal(t, expected, sel.SelectByKey(tpid, key, []types.LogStreamID{4, 3, 2, 1}))
+					require.Equal(t, expected, other.SelectByKey(tpid, key, lsids))
+				}
+			},
+		},
+		{
+			name: "Spread",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				lsids := []types.LogStreamID{1, 2, 3, 4}
+				owners := make(map[types.LogStreamID]int)
+				for i := 0; i < numKeys; i++ {
+					owners[sel.SelectByKey(tpid, []byte(fmt.Sprintf("key-%d", i)), lsids)[0]]++
+				}
+				require.Len(t, owners, len(lsids))
+				for _, cnt := range owners {
+					require.Greater(t, cnt, numKeys/len(lsids)/2)
+				}
+			},
+		},
+		{
+			name: "AddLogStream",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				before := []types.LogStreamID{1, 2, 3}
+				after := []types.LogStreamID{1, 2, 3, 4}
+				moved := 0
+				for i := 0; i < numKeys; i++ {
+					key := []byte(fmt.Sprintf("key-%d", i))
+					oldOwner := sel.SelectByKey(tpid, key, before)[0]
+					newOwner := sel.SelectByKey(tpid, key, after)[0]
+					if oldOwner != newOwner {
+						require.Equal(t, types.LogStreamID(4), newOwner)
+						moved++
+					}
+				}
+				require.Less(t, moved, numKeys/2)
+			},
+		},
+		{
+			name: "RemapToNextCandidate",
+			testf: func(t *testing.T, sel KeyedLogStreamSelector) {
+				// When the owner goes away, keys move to the next candidate
+				// that has been used for remapping.
+				all := []types.LogStreamID{1, 2, 3, 4}
+				for i := 0; i < numKeys; i++ {
+					key := []byte(fmt.Sprintf("key-%d", i))
+					candidates := sel.SelectByKey(tpid, key, all)
+					var rest []types.LogStreamID
+					for _, lsid := range all {
+						if lsid != candidates[0] {
+							rest = append(rest, lsid)
+						}
+					}
+					require.Equal(t, candidates[1], sel.SelectByKey(tpid, key, rest)[0])
+				}
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tc.testf(t, NewConsistentHashSelector(0))
+		})
+	}
+}
@@ -39,6 +39,7 @@ type metadataRefresher struct {
 	group             singleflight.Group
 	runner            *runner.Runner
 	cancel            context.CancelFunc
+	telemetry         *clientTelemetry
 	logger            *zap.Logger
 }
 
@@ -49,6 +50,7 @@ func newMetadataRefresher(
 	replicasRetriever RenewableReplicasRetriever,
 	refreshInterval,
 	refreshTimeout time.Duration,
+	telemetry *clientTelemetry,
 	logger *zap.Logger) (*metadataRefresher, error) {
 	if logger == nil {
 		logger = zap.NewNop()
@@ -60,6 +62,7 @@ func newMetadataRefresher(
 		refreshInterval:   refreshInterval,
 		allowlist:         allowlist,
 		replicasRetriever: replicasRetriever,
+		telemetry:         telemetry,
 		logger:            logger,
 		runner:            runner.New("metarefresher", logger),
 	}
@@ -100,7 +103,13 @@ func (mr *metadataRefresher) refresher(ctx context.Context) {
 }
 
 func (mr *metadataRefresher) refresh(ctx context.Context) error {
-	_, err, _ := mr.group.Do("refresh", func() (interface{}, error) {
+	_, err, _ := mr.group.Do("refresh", func() (_ interface{}, err error) {
+		ctx, span := mr.telemetry.startSpan(ctx, "varlog.metadataRefresher.refresh")
+		defer func(start time.Time) {
+			mr.telemetry.recordMetadataRefresh(ctx, start, err)
+			endSpan(span, err)
+		}(time.Now())
+
 		// TODO
 		// 1) Get MetadataDescriptor
 		// 2) Compare underlying metadata
@@ -2,14 +2,19 @@ package varlog
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/multierr"
 
+	"github.com/kakao/varlog/internal/compress"
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
@@ -22,12 +27,71 @@ func (v *logImpl) append(ctx context.Context, tpid types.TopicID, lsid types.Log
 		opt.apply(&appendOpts)
 	}
 
+	spanName := "varlog.Append"
+	spanAttrs := []attribute.KeyValue{telemetry.TopicID(tpid)}
+	if !appendOpts.selectLogStream {
+		spanName = "varlog.AppendTo"
+		spanAttrs = append(spanAttrs, telemetry.LogStreamID(lsid))
+	}
+	ctx, span := v.telemetry.startSpan(ctx, spanName, spanAttrs...)
+	defer func(start time.Time) {
+		v.telemetry.recordAppend(ctx, tpid, start, len(result.Metadata))
+		endSpan(span, result.Err)
+	}(time.Now())
+
+	if len(appendOpts.attrs) > 0 && len(appendOpts.attrs) != len(data) {
+		result.Err = fmt.Errorf("append: %d attributes for %d data: %w", len(appendOpts.attrs), len(data), verrors.ErrInvalid)
+		return result
+	}
+	if err := appendOpts.retryPolicy.validate(); err != nil {
+		result.Err = fmt.Errorf("append: %w", err)
+		return result
+	}
+
+	// The batch is compressed once so that retries do not compress it again.
+	data, attrs, err := compress.CompressBatch(v.opts.compressionCodec, data, appendOpts.attrs)
+	if err != nil {
+		result.Err = fmt.Errorf("append: %w", err)
+		return result
+	}
+	// Encryption follows compression since encrypted payloads cannot be
+	// compressed.
+	data, attrs, err = v.payloadCipher.encryptBatch(ctx, data, attrs)
+	if err != nil {
+		result.Err = fmt.Errorf("append: %w", err)
+		return result
+	}
+	appendOpts.attrs = attrs
+
+	if v.producer != nil {
+		appendOpts.sequences = make(map[types.LogStreamID]uint64)
+	}
+
+	if appendOpts.selectLogStream && appendOpts.selectionKey != nil {
+		return v.appendByKey(ctx, tpid, data, appendOpts)
+	}
+
 	lsidx := 0
 	var lsids []types.LogStreamID
+	// retrySameLogStream is true if the failed request might have been
+	// appended. An idempotent producer retries it to the same log stream,
+	// which discards the duplicate.
+	retrySameLogStream := false
+	policy := &appendOpts.retryPolicy
+	start := time.Now()
 
 RETRY:
-	for i := 0; i < appendOpts.retryCount+1; i++ {
-		if appendOpts.selectLogStream {
+	for i := 0; i < policy.MaxRetries+1; i++ {
+		if i > 0 {
+			if !policy.wait(ctx, i, start) {
+				if err := ctx.Err(); err != nil {
+					result.Err = multierr.Append(result.Err, err)
+				}
+				break
+			}
+			v.telemetry.recordAppendRetry(ctx, tpid)
+		}
+		if appendOpts.selectLogStream && !retrySameLogStream {
 			if appendOpts.allowedLogStreams == nil {
 				var ok bool
 				if lsid, ok = v.lsSelector.Select(tpid); !ok {
@@ -65,45 +129,218 @@ RETRY:
 			}
 		}
 
-		res, err := v.appendTo(ctx, tpid, lsid, data)
-		if err != nil {
-			result.Err = err
+		var ok bool
+		if result, ok = v.appendToLogStream(ctx, tpid, lsid, data, appendOpts); !ok {
+			if !policy.retryable(result.Err) {
+				break
+			}
+			retrySameLogStream = v.producer != nil && !errors.Is(result.Err, verrors.ErrSealed)
 			continue
 		}
-		result.Err = nil
+		break
+	}
+	return result
+}
+
+// appendToLogStream appends data to the log stream and converts its response
+// into AppendResult. The second return value is false if the request itself
+// failed and can be retried. A batch rejected as a duplicate is not retried.
+func (v *logImpl) appendToLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, appendOpts appendOptions) (result AppendResult, ok bool) {
+	var sequence uint64
+	if v.producer != nil {
+		if sequence, ok = appendOpts.sequences[lsid]; !ok {
+			sequence = v.producer.reserve(lsid, len(data))
+			appendOpts.sequences[lsid] = sequence
+		}
+	}
+
+	if v.loadAwareSelector != nil {
+		defer func(start time.Time) {
+			failed := result.Err != nil && !errors.Is(result.Err, verrors.ErrDuplicate)
+			v.loadAwareSelector.observe(tpid, lsid, time.Since(start), failed)
+		}(time.Now())
+	}
 
-		for idx := 0; idx < len(res); idx++ {
-			if len(res[idx].Error) > 0 {
-				if strings.Contains(err.Error(), "sealed") {
-					result.Err = fmt.Errorf("append: %s: %w", res[idx].Error, verrors.ErrSealed)
-				} else {
-					result.Err = fmt.Errorf("append: %s", res[idx].Error)
+	res, err := v.appendTo(ctx, tpid, lsid, sequence, data, appendOpts.attrs)
+	if err != nil {
+		result.Err = err
+		return result, errors.Is(err, verrors.ErrDuplicate)
+	}
+
+	for idx := 0; idx < len(res); idx++ {
+		if len(res[idx].Error) > 0 {
+			if strings.Contains(res[idx].Error, "sealed") {
+				result.Err = fmt.Errorf("append: %s: %w", res[idx].Error, verrors.ErrSealed)
+			} else {
+				result.Err = fmt.Errorf("append: %s", res[idx].Error)
+			}
+			break
+		}
+		result.Metadata = append(result.Metadata, res[idx].Meta)
+	}
+	return result, true
+}
+
+// appendByKey appends data to the log stream chosen by the selection key. The
+// log stream is selected among all log streams of the topic, rather than
+// appendable ones, so that a key stays with its log stream while the log
+// stream is sealed. What to do then is decided by the SealedLogStreamPolicy.
+func (v *logImpl) appendByKey(ctx context.Context, tpid types.TopicID, data [][]byte, appendOpts appendOptions) (result AppendResult) {
+	selector := appendOpts.lsSelector
+	if selector == nil {
+		selector = v.opts.keyedLogStreamSelector
+	}
+	policy := appendOpts.sealedLogStreamPolicy
+	if policy == 0 {
+		policy = v.opts.sealedLogStreamPolicy
+	}
+
+	var lsids []types.LogStreamID
+	for _, lsid := range v.refresher.Metadata().GetTopic(tpid).GetLogStreams() {
+		if appendOpts.allowedLogStreams != nil {
+			if _, ok := appendOpts.allowedLogStreams[lsid]; !ok {
+				continue
+			}
+		}
+		lsids = append(lsids, lsid)
+	}
+	candidates := selector.SelectByKey(tpid, appendOpts.selectionKey, lsids)
+	if len(candidates) == 0 {
+		result.Err = fmt.Errorf("append: no usable log stream in topic %d", tpid)
+		return result
+	}
+
+	switch policy {
+	case SealedLogStreamPolicyWait:
+		return v.appendWaitingUnsealed(ctx, tpid, candidates[0], data, appendOpts)
+	case SealedLogStreamPolicyRemap:
+		return v.appendRemapping(ctx, tpid, candidates, data, appendOpts)
+	default:
+		result.Err = fmt.Errorf("append: unknown sealed log stream policy %d: %w", policy, verrors.ErrInvalid)
+		return result
+	}
+}
+
+// appendWaitingUnsealed appends data to the given log stream. While the log
+// stream is sealed, it retries the append periodically until the context is
+// done. Failed requests are retried as the retry policy allows, and partially
+// appended batches are not retried.
+func (v *logImpl) appendWaitingUnsealed(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte, appendOpts appendOptions) (result AppendResult) {
+	policy := &appendOpts.retryPolicy
+	start := time.Now()
+	retries := 0
+	for {
+		var ok bool
+		result, ok = v.appendToLogStream(ctx, tpid, lsid, data, appendOpts)
+		if result.Err == nil || len(result.Metadata) > 0 {
+			return result
+		}
+		if !errors.Is(result.Err, verrors.ErrSealed) {
+			if ok || !policy.retryable(result.Err) {
+				return result
+			}
+			retries++
+			if !policy.wait(ctx, retries, start) {
+				if err := ctx.Err(); err != nil {
+					result.Err = multierr.Append(result.Err, err)
 				}
+				return result
+			}
+			v.telemetry.recordAppendRetry(ctx, tpid)
+			continue
+		}
+
+		timer := time.NewTimer(v.opts.sealedLogStreamWaitInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			result.Err = multierr.Append(result.Err, ctx.Err())
+			return result
+		case <-timer.C:
+		}
+		v.telemetry.recordAppendRetry(ctx, tpid)
+	}
+}
+
+// appendRemapping appends data to the first candidate that has not failed
+// recently. If the log stream is sealed or the request fails, it moves to the
+// next candidate as the retry policy allows.
+func (v *logImpl) appendRemapping(ctx context.Context, tpid types.TopicID, candidates []types.LogStreamID, data [][]byte, appendOpts appendOptions) (result AppendResult) {
+	policy := &appendOpts.retryPolicy
+	start := time.Now()
+	attempts := 0
+	for _, lsid := range candidates {
+		if attempts > policy.MaxRetries {
+			break
+		}
+		if !v.allowlist.Contains(tpid, lsid) {
+			continue
+		}
+		attempts++
+		if attempts > 1 {
+			if !policy.wait(ctx, attempts-1, start) {
 				break
 			}
-			result.Metadata = append(result.Metadata, res[idx].Meta)
+			v.telemetry.recordAppendRetry(ctx, tpid)
 		}
-		break
+		var ok bool
+		result, ok = v.appendToLogStream(ctx, tpid, lsid, data, appendOpts)
+		if result.Err == nil || len(result.Metadata) > 0 {
+			return result
+		}
+		if ok {
+			// The request reached the log stream, but it could not append
+			// anything. Only the sealed log stream is worth remapping.
+			if !errors.Is(result.Err, verrors.ErrSealed) {
+				return result
+			}
+			v.deny(ctx, tpid, lsid)
+		} else if !policy.retryable(result.Err) {
+			return result
+		}
+	}
+	if attempts == 0 {
+		result.Err = fmt.Errorf("append: no usable log stream in topic %d", tpid)
 	}
 	return result
 }
 
-func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, data [][]byte) ([]snpb.AppendResult, error) {
+// appendTo sends data to the primary replica of the log stream. The argument
+// sequence is the sequence number of the first log entry if the client is an
+// idempotent producer.
+func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, sequence uint64, data [][]byte, attrs []varlogpb.LogEntryAttributes) (res []snpb.AppendResult, err error) {
 	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
 	if !ok {
 		return nil, fmt.Errorf("append: log stream %d of topic %d does not exist", lsid, tpid)
 	}
 	snid := replicas[0].StorageNodeID
 	addr := replicas[0].Address
+
+	ctx, span := v.telemetry.startSpan(ctx, "varlog.appendTo",
+		telemetry.TopicID(tpid),
+		telemetry.LogStreamID(lsid),
+		telemetry.StorageNodeID(snid),
+	)
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	cl, err := v.logCLManager.GetOrConnect(ctx, snid, addr)
 	if err != nil {
 		// add deny list
-		v.allowlist.Deny(tpid, lsid)
+		v.deny(ctx, tpid, lsid)
 		return nil, fmt.Errorf("append: %w", err)
 	}
 
-	res, err := cl.Append(ctx, tpid, lsid, data)
+	if v.producer != nil {
+		res, err = cl.AppendWithProducer(ctx, tpid, lsid, v.producer.id, sequence, data, attrs...)
+	} else {
+		res, err = cl.Append(ctx, tpid, lsid, data, attrs...)
+	}
 	if err != nil {
+		if errors.Is(verrors.FromStatusError(err), verrors.ErrDuplicate) {
+			return nil, fmt.Errorf("append: %w", verrors.ErrDuplicate)
+		}
 		if strings.Contains(err.Error(), "sealed") {
 			err = fmt.Errorf("append: %s: %w", err.Error(), verrors.ErrSealed)
 		}
@@ -112,7 +349,7 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 		// _ = cl.Close()
 
 		// add deny list
-		v.allowlist.Deny(tpid, lsid)
+		v.deny(ctx, tpid, lsid)
 
 		return nil, err
 	}
@@ -120,6 +357,12 @@ func (v *logImpl) appendTo(ctx context.Context, tpid types.TopicID, lsid types.L
 	return res, nil
 }
 
+// deny excludes the log stream from appends for a while.
+func (v *logImpl) deny(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) {
+	v.allowlist.Deny(tpid, lsid)
+	v.telemetry.recordDenial(ctx, tpid, lsid)
+}
+
 func (v *logImpl) peekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error) {
 	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
 	if !ok {
@@ -177,3 +420,120 @@ func (v *logImpl) peekLogStream(ctx context.Context, tpid types.TopicID, lsid ty
 	err = multierr.Combine(errs...)
 	return first, last, err
 }
+
+// readAt reads the log entry at the glsn from the replicas of the log stream
+// in order. If a replica fails to read the log entry, for instance, it is
+// unavailable or lagging behind, it tries the next replica.
+func (v *logImpl) readAt(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, glsn types.GLSN) (varlogpb.LogEntry, error) {
+	if glsn.Invalid() {
+		return varlogpb.InvalidLogEntry(), fmt.Errorf("read: invalid glsn %d: %w", glsn, verrors.ErrInvalid)
+	}
+
+	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
+	if !ok {
+		return varlogpb.InvalidLogEntry(), errNoLogStream
+	}
+
+	var errs []error
+	for _, replica := range replicas {
+		cl, err := v.logCLManager.GetOrConnect(ctx, replica.StorageNodeID, replica.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("read: snid %d: %w", replica.StorageNodeID, err))
+			continue
+		}
+		le, err := cl.Read(ctx, tpid, lsid, glsn)
+		if err == nil {
+			if err := v.payloadCipher.decryptLogEntry(ctx, &le); err != nil {
+				return varlogpb.InvalidLogEntry(), fmt.Errorf("read: %w", err)
+			}
+			return le, nil
+		}
+		// Other replicas cannot have the trimmed log entry either.
+		if errors.Is(err, verrors.ErrTrimmed) || errors.Is(err, verrors.ErrInvalid) || ctx.Err() != nil {
+			return varlogpb.InvalidLogEntry(), fmt.Errorf("read: %w", err)
+		}
+		errs = append(errs, fmt.Errorf("read: snid %d: %w", replica.StorageNodeID, err))
+	}
+	return varlogpb.InvalidLogEntry(), multierr.Combine(errs...)
+}
+
+// lookupGLSNByTime finds the first GLSN committed at or after the time t in
+// the topic. It asks all log streams of the topic concurrently and takes the
+// smallest GLSN among their answers.
+func (v *logImpl) lookupGLSNByTime(ctx context.Context, tpid types.TopicID, t time.Time) (types.GLSN, error) {
+	replicasMap := v.replicasRetriever.All(tpid)
+	if len(replicasMap) == 0 {
+		return types.InvalidGLSN, fmt.Errorf("lookup glsn by time: topic %d: %w", tpid, verrors.ErrNotExist)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		errs  []error
+		found = types.InvalidGLSN
+	)
+	for lsid, replicas := range replicasMap {
+		wg.Add(1)
+		go func(lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) {
+			defer wg.Done()
+			glsn, err := v.lookupLogStreamByTime(ctx, tpid, lsid, replicas, t)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if !errors.Is(err, verrors.ErrNoEntry) {
+					errs = append(errs, err)
+				}
+				return
+			}
+			if found.Invalid() || glsn < found {
+				found = glsn
+			}
+		}(lsid, replicas)
+	}
+	wg.Wait()
+
+	// A log stream that could not answer might have an earlier log entry.
+	if len(errs) > 0 {
+		return types.InvalidGLSN, multierr.Combine(errs...)
+	}
+	if found.Invalid() {
+		return types.InvalidGLSN, fmt.Errorf("lookup glsn by time: %w", verrors.ErrNoEntry)
+	}
+	return found, nil
+}
+
+// lookupLogStreamByTime asks the replicas of the log stream in order to find
+// the first GLSN committed at or after the time t. If a replica fails, it
+// tries the next replica. It returns an error wrapping verrors.ErrNoEntry if
+// no replica finds the log entry and at least one of them reports that the
+// log stream has no such log entry.
+func (v *logImpl) lookupLogStreamByTime(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica, t time.Time) (types.GLSN, error) {
+	var (
+		errs    []error
+		noEntry error
+	)
+	for _, replica := range replicas {
+		cl, err := v.logCLManager.GetOrConnect(ctx, replica.StorageNodeID, replica.Address)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("lookup glsn by time: snid %d: %w", replica.StorageNodeID, err))
+			continue
+		}
+		lsn, err := cl.LookupGLSNByTime(ctx, tpid, lsid, t)
+		if err == nil {
+			return lsn.GLSN, nil
+		}
+		if ctx.Err() != nil {
+			return types.InvalidGLSN, fmt.Errorf("lookup glsn by time: %w", err)
+		}
+		err = fmt.Errorf("lookup glsn by time: lsid %d: snid %d: %w", lsid, replica.StorageNodeID, err)
+		if errors.Is(err, verrors.ErrNoEntry) {
+			noEntry = err
+			continue
+		}
+		errs = append(errs, err)
+	}
+	if noEntry != nil {
+		return types.InvalidGLSN, noEntry
+	}
+	return types.InvalidGLSN, multierr.Combine(errs...)
+}
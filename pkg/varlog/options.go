@@ -4,11 +4,14 @@ import (
 	"math"
 	"time"
 
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 const (
@@ -21,9 +24,18 @@ const (
 	defaultMetadataRefreshTimeout  = 1 * time.Second
 
 	defaultSubscribeTimeout = 10 * time.Millisecond
+	defaultPrefetchWindow   = 256
 
 	defaultDenyTTL            = 10 * time.Minute
 	defaultExpireDenyInterval = 1 * time.Second
+
+	defaultSealedLogStreamPolicy       = SealedLogStreamPolicyWait
+	defaultSealedLogStreamWaitInterval = 100 * time.Millisecond
+
+	defaultLogStreamSelectionPolicy = LogStreamSelectionPolicyRandom
+	defaultLoadHintInterval         = 1 * time.Second
+
+	defaultReadReplicaPolicy = ReadReplicaPolicyPrimary
 )
 
 func defaultOptions() options {
@@ -36,6 +48,15 @@ func defaultOptions() options {
 		metadataRefreshInterval: defaultMetadataRefreshInterval,
 		metadataRefreshTimeout:  defaultMetadataRefreshTimeout,
 
+		keyedLogStreamSelector:      NewConsistentHashSelector(defaultVirtualNodes),
+		sealedLogStreamPolicy:       defaultSealedLogStreamPolicy,
+		sealedLogStreamWaitInterval: defaultSealedLogStreamWaitInterval,
+
+		logStreamSelectionPolicy: defaultLogStreamSelectionPolicy,
+		loadHintInterval:         defaultLoadHintInterval,
+
+		readReplicaPolicy: defaultReadReplicaPolicy,
+
 		denyTTL:            defaultDenyTTL,
 		expireDenyInterval: defaultExpireDenyInterval,
 		logger:             zap.NewNop(),
@@ -70,9 +91,46 @@ type options struct {
 	denyTTL            time.Duration
 	expireDenyInterval time.Duration
 
+	// keyedLogStreamSelector selects a log stream for appends with a
+	// selection key.
+	keyedLogStreamSelector KeyedLogStreamSelector
+	// sealedLogStreamPolicy decides what appends with a selection key do
+	// when the log stream owning the key is sealed.
+	sealedLogStreamPolicy SealedLogStreamPolicy
+	// sealedLogStreamWaitInterval is the interval to retry appends waiting
+	// for a sealed log stream.
+	sealedLogStreamWaitInterval time.Duration
+
+	// logStreamSelectionPolicy decides how appends without a log stream and
+	// a selection key choose a log stream.
+	logStreamSelectionPolicy LogStreamSelectionPolicy
+	// loadHintInterval is the period to fetch load hints from storage nodes
+	// under LogStreamSelectionPolicyLoadAware.
+	loadHintInterval time.Duration
+
+	// readReplicaPolicy decides which replica of a log stream serves
+	// subscriptions.
+	readReplicaPolicy ReadReplicaPolicy
+
+	// idempotentProducer makes appends carry a producer ID and sequence
+	// numbers so that log streams can discard duplicates.
+	idempotentProducer bool
+
+	// compressionCodec compresses payloads of appended log entries.
+	compressionCodec varlogpb.CompressionCodec
+
+	// keyProvider wraps data keys encrypting payloads of appended log
+	// entries. Payloads are not encrypted if it is nil.
+	keyProvider KeyProvider
+
 	// grpcOptions
 	grpcDialOptions []grpc.DialOption
 
+	// meterProvider and tracerProvider instrument the client. Both are nil
+	// unless WithTelemetry is set.
+	meterProvider  metric.MeterProvider
+	tracerProvider trace.TracerProvider
+
 	logger *zap.Logger
 }
 
@@ -134,12 +192,131 @@ func WithExpireDenyInterval(interval time.Duration) Option {
 	})
 }
 
+// WithLogStreamSelector sets the selector choosing a log stream for appends
+// with a selection key set by WithSelectionKey. The default is a selector
+// based on consistent hashing created by NewConsistentHashSelector.
+func WithLogStreamSelector(selector KeyedLogStreamSelector) Option {
+	return newOption(func(opts *options) {
+		opts.keyedLogStreamSelector = selector
+	})
+}
+
+// WithSealedLogStreamPolicy sets what appends with a selection key do when the
+// log stream owning the key is sealed. The default is
+// SealedLogStreamPolicyWait.
+func WithSealedLogStreamPolicy(policy SealedLogStreamPolicy) Option {
+	return newOption(func(opts *options) {
+		opts.sealedLogStreamPolicy = policy
+	})
+}
+
+// WithSealedLogStreamWaitInterval sets the interval to retry appends waiting
+// for a sealed log stream under SealedLogStreamPolicyWait.
+func WithSealedLogStreamWaitInterval(interval time.Duration) Option {
+	return newOption(func(opts *options) {
+		opts.sealedLogStreamWaitInterval = interval
+	})
+}
+
+// WithLogStreamSelectionPolicy sets how Append chooses a log stream when
+// neither the log stream nor the selection key is given. The default is
+// LogStreamSelectionPolicyRandom.
+func WithLogStreamSelectionPolicy(policy LogStreamSelectionPolicy) Option {
+	return newOption(func(opts *options) {
+		opts.logStreamSelectionPolicy = policy
+	})
+}
+
+// WithLoadHintInterval sets the period to fetch load hints of log streams
+// from their primary replicas under LogStreamSelectionPolicyLoadAware. The
+// client fetches load hints of log streams only in topics it has appended
+// to. A non-positive interval disables load hints, thus, the client relies
+// only on the latency and the error rate it observes. The default is one
+// second.
+func WithLoadHintInterval(interval time.Duration) Option {
+	return newOption(func(opts *options) {
+		opts.loadHintInterval = interval
+	})
+}
+
+// WithReadReplicaPolicy sets which replica of a log stream serves Subscribe
+// and SubscribeTo. The default is ReadReplicaPolicyPrimary.
+func WithReadReplicaPolicy(policy ReadReplicaPolicy) Option {
+	return newOption(func(opts *options) {
+		opts.readReplicaPolicy = policy
+	})
+}
+
+// WithIdempotentProducer makes the client an idempotent producer. The client
+// gets a random producer ID and attaches sequence numbers for each log stream
+// to appends. Retries of an append reuse its sequence numbers and go to the
+// same log stream unless it is sealed, thus, a batch appended already but
+// whose response is lost is not appended again. Instead, the append fails
+// with an error wrapping verrors.ErrDuplicate, which means the batch has been
+// appended.
+//
+// Log streams remember the sequence numbers of recent producers only. See
+// the option WithProducerSequenceWindow of the log stream executor. Appends
+// through LogStreamAppender do not carry sequence numbers.
+func WithIdempotentProducer() Option {
+	return newOption(func(opts *options) {
+		opts.idempotentProducer = true
+	})
+}
+
+// WithCompression makes the client compress the payload of each log entry by
+// the codec before appending it. Appends through Log.Append, Log.AppendTo,
+// LogStreamAppender and Transaction are compressed. The codec is recorded in
+// the attributes of each log entry, thus, storage nodes store and replicate
+// the compressed payload as it is, and subscribers and readers decompress it
+// transparently. A payload that does not get smaller is appended without
+// compression.
+//
+// Storage nodes reject appends compressed by codecs they do not know, so the
+// codec should be supported by all storage nodes in the cluster. The default
+// is varlogpb.CompressionCodecNone.
+func WithCompression(codec varlogpb.CompressionCodec) Option {
+	return newOption(func(opts *options) {
+		opts.compressionCodec = codec
+	})
+}
+
+// WithEncryption makes the client encrypt the payload of each log entry
+// before appending it and decrypt it after reading it. Appends through
+// Log.Append, Log.AppendTo, LogStreamAppender and Transaction are encrypted
+// with a new data key per batch, and the data key is wrapped by the argument
+// kp. The ID of the key encryption key and the wrapped data key are recorded
+// in the attributes of each log entry, thus, storage nodes cannot read the
+// payload, and keys can be rotated without rewriting log entries.
+//
+// Subscribers and readers decrypt payloads transparently. Clients without
+// this option receive encrypted payloads as they are. Payloads are
+// compressed, if WithCompression is set, before they are encrypted.
+func WithEncryption(kp KeyProvider) Option {
+	return newOption(func(opts *options) {
+		opts.keyProvider = kp
+	})
+}
+
 func WithLogger(logger *zap.Logger) Option {
 	return newOption(func(opts *options) {
 		opts.logger = logger
 	})
 }
 
+// WithTelemetry makes the client export OpenTelemetry metrics and traces
+// through the given providers. Append, AppendTo, LogStreamAppender,
+// Subscribe, SubscribeIterator, SubscribeTo, Trim and metadata refreshes
+// emit spans and metrics, and the trace context is propagated to storage
+// nodes through gRPC metadata. Either provider can be nil, in which case
+// the client does not export that signal.
+func WithTelemetry(meterProvider metric.MeterProvider, tracerProvider trace.TracerProvider) Option {
+	return newOption(func(opts *options) {
+		opts.meterProvider = meterProvider
+		opts.tracerProvider = tracerProvider
+	})
+}
+
 // WithGRPCReadBufferSize sets the size of the gRPC read buffer. Internally, it
 // calls `google.golang.org/grpc.WithReadBufferSize`.
 func WithGRPCReadBufferSize(bytes int) Option {
@@ -186,15 +363,24 @@ const (
 
 func defaultAppendOptions() appendOptions {
 	return appendOptions{
-		retryCount:      defaultRetryCount,
+		retryPolicy:     RetryPolicy{MaxRetries: defaultRetryCount},
 		selectLogStream: true,
 	}
 }
 
 type appendOptions struct {
-	retryCount        int
+	retryPolicy       RetryPolicy
 	selectLogStream   bool
 	allowedLogStreams map[types.LogStreamID]struct{}
+	attrs             []varlogpb.LogEntryAttributes
+
+	selectionKey          []byte
+	lsSelector            KeyedLogStreamSelector
+	sealedLogStreamPolicy SealedLogStreamPolicy
+
+	// sequences are sequence numbers reserved for the batch by an
+	// idempotent producer. They are kept across retries.
+	sequences map[types.LogStreamID]uint64
 }
 
 type AppendOption interface {
@@ -213,9 +399,10 @@ func newAppendOption(f func(*appendOptions)) *appendOption {
 	return &appendOption{f: f}
 }
 
+// WithRetryCount sets the maximum number of retries of the retry policy.
 func WithRetryCount(retryCount int) AppendOption {
 	return newAppendOption(func(opts *appendOptions) {
-		opts.retryCount = retryCount
+		opts.retryPolicy.MaxRetries = retryCount
 	})
 }
 
@@ -231,14 +418,82 @@ func WithAllowedLogStreams(logStreams map[types.LogStreamID]struct{}) AppendOpti
 	})
 }
 
+// WithLogEntryAttributes sets optional attributes, such as a key, a timestamp
+// and headers, of each log entry to append. The length of the argument attrs
+// must be the same as the number of data in the batch, and the i-th
+// attributes belong to the i-th data. Subscribers receive the attributes in
+// varlogpb.LogEntry.
+func WithLogEntryAttributes(attrs []varlogpb.LogEntryAttributes) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.attrs = attrs
+	})
+}
+
+// WithSelectionKey makes Append choose a log stream by the argument key rather
+// than randomly. Appends with the same key go to the same log stream as long
+// as the log streams of the topic do not change, thus, their order is kept.
+// If the log stream is sealed, the append waits or is remapped according to
+// the SealedLogStreamPolicy. It has no effect on AppendTo.
+func WithSelectionKey(key []byte) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		if key == nil {
+			key = []byte{}
+		}
+		opts.selectionKey = key
+	})
+}
+
+// WithAppendLogStreamSelector overrides, for an append, the selector set by
+// WithLogStreamSelector.
+func WithAppendLogStreamSelector(selector KeyedLogStreamSelector) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.lsSelector = selector
+	})
+}
+
+// WithAppendSealedLogStreamPolicy overrides, for an append, the policy set by
+// WithSealedLogStreamPolicy.
+func WithAppendSealedLogStreamPolicy(policy SealedLogStreamPolicy) AppendOption {
+	return newAppendOption(func(opts *appendOptions) {
+		opts.sealedLogStreamPolicy = policy
+	})
+}
+
+// SelectionKeyOf returns the selection key set by WithSelectionKey among the
+// given options. The second return value is false if it is not set.
+func SelectionKeyOf(opts ...AppendOption) ([]byte, bool) {
+	var appendOpts appendOptions
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	return appendOpts.selectionKey, appendOpts.selectionKey != nil
+}
+
+// LogEntryAttributesOf returns the attributes of log entries set by
+// WithLogEntryAttributes among the given options. It returns nil if they are
+// not set. It helps other implementations of Log, for instance, varlogtest,
+// to handle the attributes.
+func LogEntryAttributesOf(opts ...AppendOption) []varlogpb.LogEntryAttributes {
+	var appendOpts appendOptions
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	return appendOpts.attrs
+}
+
 func defaultSubscribeOptions() subscribeOptions {
 	return subscribeOptions{
-		timeout: defaultSubscribeTimeout,
+		timeout:        defaultSubscribeTimeout,
+		prefetchWindow: defaultPrefetchWindow,
 	}
 }
 
 type subscribeOptions struct {
-	timeout time.Duration
+	timeout        time.Duration
+	prefetchWindow int
+	filter         *varlogpb.LogEntryFilter
+	startPosition  StartPosition
+	listener       LogStreamListener
 }
 
 type SubscribeOption interface {
@@ -262,3 +517,64 @@ func WithSubscribeTimeout(timeout time.Duration) SubscribeOption {
 		opts.timeout = timeout
 	})
 }
+
+// WithPrefetchWindow sets the number of log entries a TopicIterator buffers
+// ahead of the consumer for each log stream. Once the window of a log stream
+// is full, the client stops receiving log entries of the log stream, and the
+// storage node pauses sending them until the consumer catches up. It applies
+// to Log.SubscribeIterator only. The default is 256.
+func WithPrefetchWindow(size int) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.prefetchWindow = size
+	})
+}
+
+// WithFilter makes storage nodes deliver only log entries matching the
+// filter. Storage nodes evaluate the filter, thus, non-matching log entries
+// do not cross the network except for their metadata. The subscription still
+// advances over them in order of GLSN or LLSN without handing them to the
+// user.
+func WithFilter(filter *varlogpb.LogEntryFilter) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.filter = filter
+	})
+}
+
+// WithStartPosition makes the subscription start from the symbolic position
+// pos rather than the argument begin, which is ignored. The argument end
+// still bounds the subscription. See StartPosition for details.
+func WithStartPosition(pos StartPosition) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.startPosition = pos
+	})
+}
+
+// WithLogStreamListener sets the listener notified when log streams are added
+// to or unregistered from the topic while subscribing. The subscription
+// watches the metadata fetched by the MetadataRefresher to detect the changes.
+// It applies to Log.Subscribe and Log.SubscribeIterator only.
+func WithLogStreamListener(listener LogStreamListener) SubscribeOption {
+	return newSubscribeOption(func(opts *subscribeOptions) {
+		opts.listener = listener
+	})
+}
+
+// StartPositionOf returns the start position set by WithStartPosition among
+// the given options. It returns zero if it is not set.
+func StartPositionOf(opts ...SubscribeOption) StartPosition {
+	var subscribeOpts subscribeOptions
+	for _, opt := range opts {
+		opt.apply(&subscribeOpts)
+	}
+	return subscribeOpts.startPosition
+}
+
+// FilterOf returns the filter set by WithFilter among the given options. It
+// returns nil if it is not set.
+func FilterOf(opts ...SubscribeOption) *varlogpb.LogEntryFilter {
+	var subscribeOpts subscribeOptions
+	for _, opt := range opts {
+		opt.apply(&subscribeOpts)
+	}
+	return subscribeOpts.filter
+}
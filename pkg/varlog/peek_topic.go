@@ -0,0 +1,111 @@
+package varlog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// TopicBoundary is the range of log entries in a topic returned by
+// Log.PeekTopic.
+type TopicBoundary struct {
+	// First is the GLSN of the first log entry in the topic that is not
+	// trimmed. It is invalid if the topic has no log entry.
+	First types.GLSN
+	// Last is the GLSN of the last log entry in the topic. It is invalid if
+	// the topic has no log entry.
+	Last types.GLSN
+	// GlobalHighWatermark is the highest GLSN issued to the topic by the
+	// metadata repository at its last commit.
+	GlobalHighWatermark types.GLSN
+	// LogStreams have the boundaries of each log stream in the topic.
+	LogStreams map[types.LogStreamID]LogStreamBoundary
+}
+
+// LogStreamBoundary is the range of log entries in a log stream. Both First
+// and Last are invalid if the log stream has no log entry.
+type LogStreamBoundary struct {
+	First varlogpb.LogSequenceNumber
+	Last  varlogpb.LogSequenceNumber
+}
+
+// peekTopic fetches the last commit results of the topic from the metadata
+// repository and peeks all log streams in the topic concurrently. The last
+// log entry of a log stream is the later one between the commit result and
+// the replicas since replicas can lag behind the commit.
+func (v *logImpl) peekTopic(ctx context.Context, tpid types.TopicID) (TopicBoundary, error) {
+	client, err := v.mrConnector.Client(ctx)
+	if err != nil {
+		return TopicBoundary{}, fmt.Errorf("peek topic: %w", err)
+	}
+	crs, err := client.GetCommitResults(ctx, tpid)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return TopicBoundary{}, fmt.Errorf("peek topic: topic %d: %w", tpid, verrors.ErrNotExist)
+		}
+		return TopicBoundary{}, fmt.Errorf("peek topic: %w", multierr.Append(err, client.Close()))
+	}
+
+	tb := TopicBoundary{
+		LogStreams: make(map[types.LogStreamID]LogStreamBoundary),
+	}
+	commitResults := make(map[types.LogStreamID]snpb.LogStreamCommitResult, len(crs.CommitResults))
+	for _, cr := range crs.CommitResults {
+		commitResults[cr.LogStreamID] = cr
+		if tb.GlobalHighWatermark < cr.HighWatermark {
+			tb.GlobalHighWatermark = cr.HighWatermark
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for lsid := range v.replicasRetriever.All(tpid) {
+		lsid := lsid
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			first, last, err := v.peekLogStream(ctx, tpid, lsid)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("peek topic: lsid %d: %w", lsid, err))
+				mu.Unlock()
+				return
+			}
+			if cr, ok := commitResults[lsid]; ok && cr.CommittedGLSNLength > 0 {
+				lastLLSN := cr.CommittedLLSNOffset + types.LLSN(cr.CommittedGLSNLength) - 1
+				if last.LLSN < lastLLSN {
+					last.LLSN = lastLLSN
+					last.GLSN = cr.CommittedGLSNOffset + types.GLSN(cr.CommittedGLSNLength) - 1
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			tb.LogStreams[lsid] = LogStreamBoundary{First: first, Last: last}
+			if !first.GLSN.Invalid() && (tb.First.Invalid() || first.GLSN < tb.First) {
+				tb.First = first.GLSN
+			}
+			if tb.Last < last.GLSN {
+				tb.Last = last.GLSN
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return TopicBoundary{}, multierr.Combine(errs...)
+	}
+	return tb, nil
+}
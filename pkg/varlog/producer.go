@@ -0,0 +1,60 @@
+package varlog
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// producer is an idempotent producer enabled by WithIdempotentProducer. It
+// assigns sequence numbers to log entries for each log stream. Log streams
+// remember the last sequence number of the producer and reject batches whose
+// sequence numbers have already been appended.
+type producer struct {
+	id uint64
+
+	mu   sync.Mutex
+	next map[types.LogStreamID]uint64
+}
+
+func newProducer() (*producer, error) {
+	id, err := newRandomID()
+	if err != nil {
+		return nil, fmt.Errorf("producer: %w", err)
+	}
+	return &producer{
+		id:   id,
+		next: make(map[types.LogStreamID]uint64),
+	}, nil
+}
+
+// newRandomID returns a random nonzero identifier. Zero means that the append
+// does not belong to any producer or transaction.
+func newRandomID() (uint64, error) {
+	var buf [8]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			return 0, err
+		}
+		if id := binary.BigEndian.Uint64(buf[:]); id != 0 {
+			return id, nil
+		}
+	}
+}
+
+// reserve returns the sequence number of the first log entry of a batch
+// whose length is the argument n appended to the log stream lsid. Sequence
+// numbers of each log stream start from one.
+func (p *producer) reserve(lsid types.LogStreamID, n int) uint64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	seq, ok := p.next[lsid]
+	if !ok {
+		seq = 1
+	}
+	p.next[lsid] = seq + uint64(n)
+	return seq
+}
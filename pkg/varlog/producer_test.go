@@ -0,0 +1,18 @@
+package varlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProducer(t *testing.T) {
+	p, err := newProducer()
+	require.NoError(t, err)
+	require.NotZero(t, p.id)
+
+	require.EqualValues(t, 1, p.reserve(1, 2))
+	require.EqualValues(t, 3, p.reserve(1, 1))
+	require.EqualValues(t, 1, p.reserve(2, 1))
+	require.EqualValues(t, 4, p.reserve(1, 1))
+}
@@ -0,0 +1,184 @@
+package varlog
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kakao/varlog/internal/storagenode/client"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// ReadReplicaPolicy decides which replica of a log stream serves Subscribe and
+// SubscribeTo.
+//
+// Every replica of a log stream can serve committed log entries. Under
+// policies other than ReadReplicaPolicyPrimary, the client asks the replicas
+// for their metadata before subscribing and prefers replicas that have
+// already caught up to the position to read. Replicas that fall behind or do
+// not respond are tried last. If the subscription to a replica fails, the
+// client moves to another replica.
+type ReadReplicaPolicy int
+
+const (
+	// ReadReplicaPolicyPrimary reads from the primary replica, and from the
+	// backup replicas only if the primary fails.
+	ReadReplicaPolicyPrimary ReadReplicaPolicy = iota + 1
+	// ReadReplicaPolicyAny reads from a replica chosen at random.
+	ReadReplicaPolicyAny
+	// ReadReplicaPolicyNearest reads from the replica whose storage node
+	// responds fastest to the metadata request.
+	ReadReplicaPolicyNearest
+	// ReadReplicaPolicyLeastLoaded reads from the replica that has the
+	// fewest inflight appends and uncommitted log entries.
+	ReadReplicaPolicyLeastLoaded
+)
+
+func (p ReadReplicaPolicy) String() string {
+	switch p {
+	case ReadReplicaPolicyPrimary:
+		return "primary"
+	case ReadReplicaPolicyAny:
+		return "any"
+	case ReadReplicaPolicyNearest:
+		return "nearest"
+	case ReadReplicaPolicyLeastLoaded:
+		return "least-loaded"
+	default:
+		return "unknown"
+	}
+}
+
+// readReplicaProbeTimeout bounds the time to fetch metadata from replicas
+// before subscribing.
+const readReplicaProbeTimeout = time.Second
+
+// readReplicaCandidate is a replica probed to serve reads.
+type readReplicaCandidate struct {
+	replica varlogpb.LogStreamReplica
+	lsrmd   snpb.LogStreamReplicaMetadataDescriptor
+	rtt     time.Duration
+	err     error
+}
+
+// readReplicaSelector orders replicas of a log stream to read according to
+// the ReadReplicaPolicy.
+type readReplicaSelector struct {
+	policy ReadReplicaPolicy
+	probe  func(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) []readReplicaCandidate
+}
+
+func newReadReplicaSelector(policy ReadReplicaPolicy, logCLManager *client.Manager[*client.LogClient]) *readReplicaSelector {
+	return &readReplicaSelector{
+		policy: policy,
+		probe: func(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) []readReplicaCandidate {
+			return probeReplicas(ctx, logCLManager, tpid, lsid, replicas)
+		},
+	}
+}
+
+// order returns the replicas of the log stream in the order to try. The
+// argument covers reports whether the replica has caught up to the position
+// to read. The argument failed is the storage node whose subscription has
+// just failed, if any; its replica is tried last.
+func (rrs *readReplicaSelector) order(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica, covers func(snpb.LogStreamReplicaMetadataDescriptor) bool, failed types.StorageNodeID) []varlogpb.LogStreamReplica {
+	ordered := make([]varlogpb.LogStreamReplica, 0, len(replicas))
+	if rrs.policy == ReadReplicaPolicyPrimary || len(replicas) < 2 {
+		ordered = append(ordered, replicas...)
+		return moveToBack(ordered, failed)
+	}
+
+	candidates := rrs.probe(ctx, tpid, lsid, replicas)
+	if rrs.policy == ReadReplicaPolicyAny {
+		rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+	}
+
+	// If no replica has caught up, for instance, the subscription waits
+	// for new log entries, every responding replica is as good as the
+	// others.
+	anyCovers := false
+	for _, c := range candidates {
+		if c.err == nil && covers(c.lsrmd) {
+			anyCovers = true
+			break
+		}
+	}
+	rank := func(c readReplicaCandidate) int {
+		switch {
+		case c.err != nil:
+			return 2
+		case anyCovers && !covers(c.lsrmd):
+			return 1
+		default:
+			return 0
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ri, rj := rank(ci), rank(cj); ri != rj {
+			return ri < rj
+		}
+		switch rrs.policy {
+		case ReadReplicaPolicyNearest:
+			return ci.rtt < cj.rtt
+		case ReadReplicaPolicyLeastLoaded:
+			return readLoad(ci.lsrmd) < readLoad(cj.lsrmd)
+		default:
+			return false
+		}
+	})
+	for _, c := range candidates {
+		ordered = append(ordered, c.replica)
+	}
+	return moveToBack(ordered, failed)
+}
+
+// probeReplicas fetches metadata from the replicas concurrently.
+func probeReplicas(ctx context.Context, logCLManager *client.Manager[*client.LogClient], tpid types.TopicID, lsid types.LogStreamID, replicas []varlogpb.LogStreamReplica) []readReplicaCandidate {
+	ctx, cancel := context.WithTimeout(ctx, readReplicaProbeTimeout)
+	defer cancel()
+
+	candidates := make([]readReplicaCandidate, len(replicas))
+	var wg sync.WaitGroup
+	for i := range replicas {
+		candidates[i].replica = replicas[i]
+		wg.Add(1)
+		go func(c *readReplicaCandidate) {
+			defer wg.Done()
+			start := time.Now()
+			cl, err := logCLManager.GetOrConnect(ctx, c.replica.StorageNodeID, c.replica.Address)
+			if err != nil {
+				c.err = err
+				return
+			}
+			c.lsrmd, c.err = cl.LogStreamReplicaMetadata(ctx, tpid, lsid)
+			c.rtt = time.Since(start)
+		}(&candidates[i])
+	}
+	wg.Wait()
+	return candidates
+}
+
+// readLoad is the load of the replica considered by
+// ReadReplicaPolicyLeastLoaded.
+func readLoad(lsrmd snpb.LogStreamReplicaMetadataDescriptor) uint64 {
+	return uint64(lsrmd.InflightAppends) + lsrmd.UncommittedLLSNLength
+}
+
+func moveToBack(replicas []varlogpb.LogStreamReplica, snid types.StorageNodeID) []varlogpb.LogStreamReplica {
+	for i := range replicas {
+		if replicas[i].StorageNodeID == snid {
+			failed := replicas[i]
+			copy(replicas[i:], replicas[i+1:])
+			replicas[len(replicas)-1] = failed
+			break
+		}
+	}
+	return replicas
+}
@@ -0,0 +1,170 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/snpb"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestReadReplicaSelector(t *testing.T) {
+	const (
+		tpid = types.TopicID(1)
+		lsid = types.LogStreamID(1)
+	)
+
+	replicas := []varlogpb.LogStreamReplica{
+		{StorageNode: varlogpb.StorageNode{StorageNodeID: 1}},
+		{StorageNode: varlogpb.StorageNode{StorageNodeID: 2}},
+		{StorageNode: varlogpb.StorageNode{StorageNodeID: 3}},
+	}
+	candidate := func(snid types.StorageNodeID, hwm types.GLSN, rtt time.Duration, inflight int64, err error) readReplicaCandidate {
+		c := readReplicaCandidate{
+			replica: varlogpb.LogStreamReplica{StorageNode: varlogpb.StorageNode{StorageNodeID: snid}},
+			rtt:     rtt,
+			err:     err,
+		}
+		c.lsrmd.GlobalHighWatermark = hwm
+		c.lsrmd.InflightAppends = inflight
+		return c
+	}
+	covers := func(glsn types.GLSN) func(snpb.LogStreamReplicaMetadataDescriptor) bool {
+		return func(lsrmd snpb.LogStreamReplicaMetadataDescriptor) bool {
+			return lsrmd.GlobalHighWatermark >= glsn
+		}
+	}
+	snids := func(replicas []varlogpb.LogStreamReplica) []types.StorageNodeID {
+		ret := make([]types.StorageNodeID, 0, len(replicas))
+		for _, r := range replicas {
+			ret = append(ret, r.StorageNodeID)
+		}
+		return ret
+	}
+
+	tcs := []struct {
+		name       string
+		policy     ReadReplicaPolicy
+		candidates []readReplicaCandidate
+		covers     types.GLSN
+		failed     types.StorageNodeID
+		want       []types.StorageNodeID
+	}{
+		{
+			name:   "Primary",
+			policy: ReadReplicaPolicyPrimary,
+			want:   []types.StorageNodeID{1, 2, 3},
+		},
+		{
+			name:   "PrimaryFailed",
+			policy: ReadReplicaPolicyPrimary,
+			failed: 1,
+			want:   []types.StorageNodeID{2, 3, 1},
+		},
+		{
+			name:   "Nearest",
+			policy: ReadReplicaPolicyNearest,
+			candidates: []readReplicaCandidate{
+				candidate(1, 10, 3*time.Millisecond, 0, nil),
+				candidate(2, 10, time.Millisecond, 0, nil),
+				candidate(3, 10, 2*time.Millisecond, 0, nil),
+			},
+			covers: 10,
+			want:   []types.StorageNodeID{2, 3, 1},
+		},
+		{
+			name:   "NearestFailed",
+			policy: ReadReplicaPolicyNearest,
+			candidates: []readReplicaCandidate{
+				candidate(1, 10, 3*time.Millisecond, 0, nil),
+				candidate(2, 10, time.Millisecond, 0, nil),
+				candidate(3, 10, 2*time.Millisecond, 0, nil),
+			},
+			covers: 10,
+			failed: 2,
+			want:   []types.StorageNodeID{3, 1, 2},
+		},
+		{
+			name:   "LeastLoaded",
+			policy: ReadReplicaPolicyLeastLoaded,
+			candidates: []readReplicaCandidate{
+				candidate(1, 10, time.Millisecond, 5, nil),
+				candidate(2, 10, time.Millisecond, 1, nil),
+				candidate(3, 10, time.Millisecond, 3, nil),
+			},
+			covers: 10,
+			want:   []types.StorageNodeID{2, 3, 1},
+		},
+		{
+			name:   "AvoidLaggingAndUnresponsiveReplicas",
+			policy: ReadReplicaPolicyNearest,
+			candidates: []readReplicaCandidate{
+				candidate(1, 10, 3*time.Millisecond, 0, nil),
+				candidate(2, 0, 0, 0, errors.New("unavailable")),
+				candidate(3, 5, time.Millisecond, 0, nil),
+			},
+			covers: 10,
+			want:   []types.StorageNodeID{1, 3, 2},
+		},
+		{
+			name:   "NoReplicaCoveringRange",
+			policy: ReadReplicaPolicyNearest,
+			candidates: []readReplicaCandidate{
+				candidate(1, 10, 3*time.Millisecond, 0, nil),
+				candidate(2, 5, time.Millisecond, 0, nil),
+				candidate(3, 10, 2*time.Millisecond, 0, nil),
+			},
+			covers: 11,
+			want:   []types.StorageNodeID{2, 3, 1},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rrs := &readReplicaSelector{
+				policy: tc.policy,
+				probe: func(context.Context, types.TopicID, types.LogStreamID, []varlogpb.LogStreamReplica) []readReplicaCandidate {
+					return append([]readReplicaCandidate(nil), tc.candidates...)
+				},
+			}
+			got := rrs.order(context.Background(), tpid, lsid, replicas, covers(tc.covers), tc.failed)
+			require.Equal(t, tc.want, snids(got))
+			// The argument replicas are not modified.
+			require.Equal(t, []types.StorageNodeID{1, 2, 3}, snids(replicas))
+		})
+	}
+
+	t.Run("Any", func(t *testing.T) {
+		rrs := &readReplicaSelector{
+			policy: ReadReplicaPolicyAny,
+			probe: func(context.Context, types.TopicID, types.LogStreamID, []varlogpb.LogStreamReplica) []readReplicaCandidate {
+				return []readReplicaCandidate{
+					candidate(1, 10, 0, 0, nil),
+					candidate(2, 10, 0, 0, nil),
+					candidate(3, 5, 0, 0, nil),
+				}
+			},
+		}
+		firsts := make(map[types.StorageNodeID]bool)
+		for i := 0; i < 100; i++ {
+			got := rrs.order(context.Background(), tpid, lsid, replicas, covers(10), 0)
+			require.Equal(t, types.StorageNodeID(3), got[2].StorageNodeID)
+			firsts[got[0].StorageNodeID] = true
+		}
+		require.Len(t, firsts, 2)
+	})
+}
+
+func TestReadReplicaPolicy_String(t *testing.T) {
+	require.Equal(t, "primary", ReadReplicaPolicyPrimary.String())
+	require.Equal(t, "any", ReadReplicaPolicyAny.String())
+	require.Equal(t, "nearest", ReadReplicaPolicyNearest.String())
+	require.Equal(t, "least-loaded", ReadReplicaPolicyLeastLoaded.String())
+	require.Equal(t, "unknown", ReadReplicaPolicy(0).String())
+}
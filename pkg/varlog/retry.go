@@ -0,0 +1,184 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+// RetryPolicy decides whether and when failed appends are retried. It is
+// accepted by Log.Append, Log.AppendTo and Log.NewLogStreamAppender through
+// WithRetryPolicy.
+//
+// The wait before the n-th retry is InitialBackoff * Multiplier^(n-1), capped
+// by MaxBackoff and randomized by Jitter. The zero value never retries.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry. If it is zero,
+	// retries are made immediately.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between retries. If it is zero, the wait is
+	// not capped.
+	MaxBackoff time.Duration
+	// Multiplier grows the wait after each retry. A value less than one is
+	// regarded as one, which makes the wait constant.
+	Multiplier float64
+	// Jitter randomizes each wait by up to the given fraction of it. For
+	// instance, 0.2 makes the wait vary within ±20%. It should be in [0, 1].
+	Jitter float64
+	// MaxElapsedTime bounds the time since the first attempt. No retry is
+	// made once it has elapsed. If it is zero, the time is not bounded.
+	MaxElapsedTime time.Duration
+	// Retryable decides whether the error is worth retrying. If it is nil,
+	// IsRetryable is used.
+	Retryable func(error) bool
+}
+
+// IsRetryable is the default classifier of RetryPolicy. Transient errors, such
+// as unavailable storage nodes, and sealed log streams are retryable, as are
+// errors it does not know. Errors that retrying cannot resolve, for instance,
+// invalid arguments, closed clients, timeouts and canceled contexts, are not.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if verrors.IsTransient(err) || errors.Is(err, verrors.ErrSealed) {
+		return true
+	}
+	for _, target := range []error{
+		ErrClosed,
+		ErrCallTimeout,
+		verrors.ErrInvalid,
+		verrors.ErrInvalidArgument,
+		verrors.ErrDuplicate,
+		verrors.ErrTrimmed,
+		verrors.ErrNotExist,
+		context.Canceled,
+		context.DeadlineExceeded,
+	} {
+		if errors.Is(err, target) {
+			return false
+		}
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Canceled, codes.DeadlineExceeded, codes.InvalidArgument, codes.Unimplemented:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *RetryPolicy) validate() error {
+	if p.MaxRetries < 0 {
+		return fmt.Errorf("retry policy: negative max retries %d: %w", p.MaxRetries, verrors.ErrInvalid)
+	}
+	if p.InitialBackoff < 0 || p.MaxBackoff < 0 || p.MaxElapsedTime < 0 {
+		return fmt.Errorf("retry policy: negative duration: %w", verrors.ErrInvalid)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry policy: jitter %v out of range [0, 1]: %w", p.Jitter, verrors.ErrInvalid)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return IsRetryable(err)
+}
+
+// backoff returns the wait before the argument retry, which starts from one.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p.InitialBackoff <= 0 || retry < 1 {
+		return 0
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	wait := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		wait *= multiplier
+		if p.MaxBackoff > 0 && wait >= float64(p.MaxBackoff) {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && wait > float64(p.MaxBackoff) {
+		wait = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		wait += wait * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(wait)
+}
+
+// wait blocks for the backoff before the argument retry, which starts from
+// one. It returns false without waiting if the retries are exhausted or the
+// retry would begin after MaxElapsedTime since the argument start. It also
+// returns false if the context is done while waiting.
+func (p *RetryPolicy) wait(ctx context.Context, retry int, start time.Time) bool {
+	if retry > p.MaxRetries {
+		return false
+	}
+	wait := p.backoff(retry)
+	if p.MaxElapsedTime > 0 && time.Since(start)+wait > p.MaxElapsedTime {
+		return false
+	}
+	if wait <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// RetryPolicyOption is an option accepted by both appends and
+// LogStreamAppender.
+type RetryPolicyOption interface {
+	AppendOption
+	LogStreamAppenderOption
+}
+
+type retryPolicyOption struct {
+	policy RetryPolicy
+}
+
+func (opt retryPolicyOption) apply(opts *appendOptions) {
+	opts.retryPolicy = opt.policy
+}
+
+func (opt retryPolicyOption) applyLogStreamAppender(cfg *logStreamAppenderConfig) {
+	policy := opt.policy
+	cfg.retryPolicy = &policy
+}
+
+// WithRetryPolicy sets the retry policy of appends. Log.Append and
+// Log.AppendTo retry failed requests by default up to three times without
+// waiting, which is the same as WithRetryCount(3).
+//
+// A LogStreamAppender does not retry by default: once its stream is broken,
+// subsequent AppendBatch calls fail. With a retry policy, the
+// LogStreamAppender reconnects its stream after a retryable failure. If the
+// log stream was sealed, it waits for the log stream to be unsealed before
+// resuming. Batches that were sent before the failure are not resent, and
+// their callbacks receive the error, whereas batches not sent yet are sent
+// over the new stream in the order of AppendBatch calls. If the policy gives
+// up, the LogStreamAppender stops, and AppendBatch returns the error.
+func WithRetryPolicy(policy RetryPolicy) RetryPolicyOption {
+	return retryPolicyOption{policy: policy}
+}
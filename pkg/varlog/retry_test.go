@@ -0,0 +1,134 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/pkg/verrors"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+		Multiplier:     2,
+	}
+	require.Zero(t, policy.backoff(0))
+	require.Equal(t, 10*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 20*time.Millisecond, policy.backoff(2))
+	require.Equal(t, 40*time.Millisecond, policy.backoff(3))
+	require.Equal(t, 50*time.Millisecond, policy.backoff(4))
+	require.Equal(t, 50*time.Millisecond, policy.backoff(100))
+
+	// A multiplier less than one makes the backoff constant.
+	policy.Multiplier = 0
+	require.Equal(t, 10*time.Millisecond, policy.backoff(1))
+	require.Equal(t, 10*time.Millisecond, policy.backoff(5))
+
+	policy.Multiplier = 2
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		wait := policy.backoff(2)
+		require.GreaterOrEqual(t, wait, 10*time.Millisecond)
+		require.LessOrEqual(t, wait, 30*time.Millisecond)
+	}
+
+	policy = RetryPolicy{MaxRetries: 3}
+	require.Zero(t, policy.backoff(1))
+}
+
+func TestRetryPolicy_Wait(t *testing.T) {
+	ctx := context.Background()
+
+	policy := RetryPolicy{MaxRetries: 2}
+	require.True(t, policy.wait(ctx, 1, time.Now()))
+	require.True(t, policy.wait(ctx, 2, time.Now()))
+	require.False(t, policy.wait(ctx, 3, time.Now()))
+
+	policy = RetryPolicy{
+		MaxRetries:     10,
+		InitialBackoff: time.Hour,
+		MaxElapsedTime: time.Minute,
+	}
+	require.False(t, policy.wait(ctx, 1, time.Now()))
+
+	policy.MaxElapsedTime = 0
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, policy.wait(cctx, 1, time.Now()))
+
+	policy = RetryPolicy{MaxRetries: 1, InitialBackoff: 10 * time.Millisecond}
+	start := time.Now()
+	require.True(t, policy.wait(ctx, 1, start))
+	require.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	tcs := []struct {
+		policy RetryPolicy
+		ok     bool
+	}{
+		{policy: RetryPolicy{}, ok: true},
+		{policy: RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, Jitter: 1}, ok: true},
+		{policy: RetryPolicy{MaxRetries: -1}},
+		{policy: RetryPolicy{InitialBackoff: -1}},
+		{policy: RetryPolicy{MaxBackoff: -1}},
+		{policy: RetryPolicy{MaxElapsedTime: -1}},
+		{policy: RetryPolicy{Jitter: -0.1}},
+		{policy: RetryPolicy{Jitter: 1.1}},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(fmt.Sprintf("%+v", tc.policy), func(t *testing.T) {
+			err := tc.policy.validate()
+			if tc.ok {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, verrors.ErrInvalid)
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tcs := []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: fmt.Errorf("append: %w", verrors.ErrSealed), want: true},
+		{err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		{err: fmt.Errorf("append: %w", status.Error(codes.Unavailable, "unavailable")), want: true},
+		{err: verrors.ErrUndecidable, want: true},
+		{err: errors.New("unknown"), want: true},
+		{err: ErrClosed, want: false},
+		{err: ErrCallTimeout, want: false},
+		{err: fmt.Errorf("append: %w", verrors.ErrInvalid), want: false},
+		{err: fmt.Errorf("append: %w", verrors.ErrDuplicate), want: false},
+		{err: verrors.ErrTrimmed, want: false},
+		{err: verrors.ErrNotExist, want: false},
+		{err: context.Canceled, want: false},
+		{err: context.DeadlineExceeded, want: false},
+		{err: status.Error(codes.Canceled, "canceled"), want: false},
+		{err: status.Error(codes.InvalidArgument, "invalid"), want: false},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(fmt.Sprint(tc.err), func(t *testing.T) {
+			require.Equal(t, tc.want, IsRetryable(tc.err))
+		})
+	}
+
+	policy := RetryPolicy{Retryable: func(err error) bool {
+		return errors.Is(err, verrors.ErrNotExist)
+	}}
+	require.True(t, policy.retryable(verrors.ErrNotExist))
+	require.False(t, policy.retryable(verrors.ErrSealed))
+}
@@ -0,0 +1,224 @@
+package varlog
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+const (
+	// schemaMagic is the first byte of a payload encoded with a schema.
+	schemaMagic = byte(0)
+	// schemaHeaderSize is the size of the magic byte followed by the schema
+	// ID in big-endian.
+	schemaHeaderSize = 5
+)
+
+// EncodeSchemaPayload prefixes the payload with the schema ID so that
+// consumers can find the schema to decode the payload. The prefix is a zero
+// byte followed by the schema ID in four bytes of big-endian.
+func EncodeSchemaPayload(schemaID types.SchemaID, payload []byte) []byte {
+	data := make([]byte, schemaHeaderSize+len(payload))
+	data[0] = schemaMagic
+	binary.BigEndian.PutUint32(data[1:schemaHeaderSize], uint32(schemaID))
+	copy(data[schemaHeaderSize:], payload)
+	return data
+}
+
+// DecodeSchemaPayload splits the data encoded by EncodeSchemaPayload into the
+// schema ID and the payload. The returned payload shares the memory with the
+// argument data. It returns an error wrapping ErrDecode if the data is not
+// encoded by EncodeSchemaPayload.
+func DecodeSchemaPayload(data []byte) (types.SchemaID, []byte, error) {
+	if len(data) < schemaHeaderSize || data[0] != schemaMagic {
+		return 0, nil, fmt.Errorf("%w: no schema header", ErrDecode)
+	}
+	schemaID := types.SchemaID(binary.BigEndian.Uint32(data[1:schemaHeaderSize]))
+	if schemaID.Invalid() {
+		return 0, nil, fmt.Errorf("%w: invalid schema id %d", ErrDecode, schemaID)
+	}
+	return schemaID, data[schemaHeaderSize:], nil
+}
+
+// Codec encodes values of type T into payloads and decodes them back. The
+// payloads follow the format of SchemaType.
+type Codec[T any] interface {
+	SchemaType() varlogpb.SchemaType
+	Encode(value T) ([]byte, error)
+	Decode(payload []byte) (T, error)
+}
+
+// JSONCodec encodes values into JSON, whose schema is a JSON Schema.
+type JSONCodec[T any] struct{}
+
+var _ Codec[any] = JSONCodec[any]{}
+
+func (JSONCodec[T]) SchemaType() varlogpb.SchemaType {
+	return varlogpb.SchemaTypeJSON
+}
+
+func (JSONCodec[T]) Encode(value T) ([]byte, error) {
+	return json.Marshal(value)
+}
+
+func (JSONCodec[T]) Decode(payload []byte) (T, error) {
+	var value T
+	err := json.Unmarshal(payload, &value)
+	return value, err
+}
+
+// ProtoCodec encodes Protobuf messages of type T, for instance, *mypb.Event.
+// Its schema is made by ProtoSchemaDefinition.
+type ProtoCodec[T proto.Message] struct{}
+
+func (ProtoCodec[T]) SchemaType() varlogpb.SchemaType {
+	return varlogpb.SchemaTypeProtobuf
+}
+
+func (ProtoCodec[T]) Encode(value T) ([]byte, error) {
+	return proto.Marshal(value)
+}
+
+func (ProtoCodec[T]) Decode(payload []byte) (T, error) {
+	var zero T
+	// The type of a nil message can still create a new message.
+	value := zero.ProtoReflect().Type().New().Interface().(T)
+	if err := proto.Unmarshal(payload, value); err != nil {
+		return zero, err
+	}
+	return value, nil
+}
+
+// ProtoSchemaDefinition returns the definition of the Protobuf schema of the
+// message to be registered by Admin.RegisterSchema. It is a serialized
+// FileDescriptorSet that has the file defining the message and all of its
+// dependencies.
+func ProtoSchemaDefinition(msg proto.Message) ([]byte, error) {
+	var (
+		fds     descriptorpb.FileDescriptorSet
+		visited = make(map[string]bool)
+		add     func(protoreflect.FileDescriptor)
+	)
+	// Dependencies precede their dependents.
+	add = func(fd protoreflect.FileDescriptor) {
+		if visited[fd.Path()] {
+			return
+		}
+		visited[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(msg.ProtoReflect().Descriptor().ParentFile())
+	return proto.Marshal(&fds)
+}
+
+func (v *logImpl) getSchema(ctx context.Context, schemaID types.SchemaID) (varlogpb.SchemaDescriptor, error) {
+	// Schemas are immutable once registered; hence, they are cached
+	// forever.
+	if sd, ok := v.schemas.Load(schemaID); ok {
+		return sd.(varlogpb.SchemaDescriptor), nil
+	}
+
+	client, err := v.mrConnector.Client(ctx)
+	if err != nil {
+		return varlogpb.SchemaDescriptor{}, fmt.Errorf("get schema: %w", err)
+	}
+	sd, err := client.GetSchema(ctx, schemaID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return varlogpb.SchemaDescriptor{}, fmt.Errorf("get schema: schema %d: %w", schemaID, verrors.ErrNotExist)
+		}
+		return varlogpb.SchemaDescriptor{}, fmt.Errorf("get schema: %w", multierr.Append(err, client.Close()))
+	}
+	v.schemas.Store(schemaID, *sd)
+	return *sd, nil
+}
+
+// AppendValues encodes the values with the codec, prefixes them with the
+// schema ID as EncodeSchemaPayload does, and appends them to the topic. The
+// schema should belong to the topic and have the same type as the codec;
+// otherwise, it returns an error wrapping verrors.ErrInvalid.
+func AppendValues[T any](ctx context.Context, vlog Log, tpid types.TopicID, schemaID types.SchemaID, codec Codec[T], values []T, opts ...AppendOption) AppendResult {
+	sd, err := vlog.GetSchema(ctx, schemaID)
+	if err != nil {
+		return AppendResult{Err: err}
+	}
+	if sd.TopicID != tpid {
+		return AppendResult{Err: fmt.Errorf("append values: schema %d belongs to topic %d, not %d: %w", schemaID, sd.TopicID, tpid, verrors.ErrInvalid)}
+	}
+	if sd.Type != codec.SchemaType() {
+		return AppendResult{Err: fmt.Errorf("append values: schema %d is %v, but codec is %v: %w", schemaID, sd.Type, codec.SchemaType(), verrors.ErrInvalid)}
+	}
+
+	batch := make([][]byte, 0, len(values))
+	for i := range values {
+		payload, err := codec.Encode(values[i])
+		if err != nil {
+			return AppendResult{Err: fmt.Errorf("append values: encode value %d: %w", i, err)}
+		}
+		batch = append(batch, EncodeSchemaPayload(schemaID, payload))
+	}
+	return vlog.Append(ctx, tpid, batch, opts...)
+}
+
+// DecodeValue decodes the log entry appended by AppendValues, and returns the
+// value and the schema used to encode it. The schema is fetched from the
+// metadata repository unless it is cached. It returns an error wrapping
+// ErrDecode if the log entry is not encoded by the schema of its topic, the
+// schema cannot be fetched, or the codec fails.
+func DecodeValue[T any](ctx context.Context, vlog Log, codec Codec[T], logEntry varlogpb.LogEntry) (T, varlogpb.SchemaDescriptor, error) {
+	var zero T
+	schemaID, payload, err := DecodeSchemaPayload(logEntry.Data)
+	if err != nil {
+		return zero, varlogpb.SchemaDescriptor{}, err
+	}
+	sd, err := vlog.GetSchema(ctx, schemaID)
+	if err != nil {
+		return zero, varlogpb.SchemaDescriptor{}, fmt.Errorf("%w: %w", ErrDecode, err)
+	}
+	if sd.TopicID != logEntry.TopicID {
+		return zero, sd, fmt.Errorf("%w: schema %d belongs to topic %d, not %d", ErrDecode, schemaID, sd.TopicID, logEntry.TopicID)
+	}
+	if sd.Type != codec.SchemaType() {
+		return zero, sd, fmt.Errorf("%w: schema %d is %v, but codec is %v", ErrDecode, schemaID, sd.Type, codec.SchemaType())
+	}
+	value, err := codec.Decode(payload)
+	if err != nil {
+		return zero, sd, fmt.Errorf("%w: schema %d: %w", ErrDecode, schemaID, err)
+	}
+	return value, sd, nil
+}
+
+// OnNextValue is called for each log entry subscribed by SubscribeValues. The
+// argument err is either an error of the subscription, which is the same as
+// OnNext, or an error that decodes the log entry, which wraps ErrDecode.
+// Unlike the former, the latter does not stop the subscription.
+type OnNextValue[T any] func(logEntry varlogpb.LogEntry, value T, err error)
+
+// SubscribeValues subscribes to the topic as Log.Subscribe does, and decodes
+// each log entry with the codec by DecodeValue.
+func SubscribeValues[T any](ctx context.Context, vlog Log, tpid types.TopicID, begin, end types.GLSN, codec Codec[T], onNext OnNextValue[T], opts ...SubscribeOption) (SubscribeCloser, error) {
+	return vlog.Subscribe(ctx, tpid, begin, end, func(logEntry varlogpb.LogEntry, err error) {
+		var value T
+		if err == nil {
+			value, _, err = DecodeValue(ctx, vlog, codec, logEntry)
+		}
+		onNext(logEntry, value, err)
+	}, opts...)
+}
@@ -0,0 +1,86 @@
+package varlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/kakao/varlog/internal/schema"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+func TestSchemaPayload(t *testing.T) {
+	data := EncodeSchemaPayload(types.SchemaID(258), []byte("foo"))
+	require.Equal(t, []byte{0, 0, 0, 1, 2, 'f', 'o', 'o'}, data)
+
+	schemaID, payload, err := DecodeSchemaPayload(data)
+	require.NoError(t, err)
+	require.Equal(t, types.SchemaID(258), schemaID)
+	require.Equal(t, []byte("foo"), payload)
+
+	schemaID, payload, err = DecodeSchemaPayload(EncodeSchemaPayload(types.MinSchemaID, nil))
+	require.NoError(t, err)
+	require.Equal(t, types.MinSchemaID, schemaID)
+	require.Empty(t, payload)
+
+	for _, data := range [][]byte{
+		nil,
+		{0, 0, 0, 1},
+		{1, 0, 0, 0, 1},
+		{0, 0, 0, 0, 0},
+	} {
+		_, _, err := DecodeSchemaPayload(data)
+		require.ErrorIs(t, err, ErrDecode)
+	}
+}
+
+func TestJSONCodec(t *testing.T) {
+	type event struct {
+		ID int `json:"id"`
+	}
+
+	var codec Codec[event] = JSONCodec[event]{}
+	require.Equal(t, varlogpb.SchemaTypeJSON, codec.SchemaType())
+
+	payload, err := codec.Encode(event{ID: 1})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id": 1}`, string(payload))
+
+	value, err := codec.Decode(payload)
+	require.NoError(t, err)
+	require.Equal(t, event{ID: 1}, value)
+
+	_, err = codec.Decode([]byte("malformed"))
+	require.Error(t, err)
+}
+
+func TestProtoCodec(t *testing.T) {
+	var codec Codec[*timestamppb.Timestamp] = ProtoCodec[*timestamppb.Timestamp]{}
+	require.Equal(t, varlogpb.SchemaTypeProtobuf, codec.SchemaType())
+
+	want := &timestamppb.Timestamp{Seconds: 1, Nanos: 2}
+	payload, err := codec.Encode(want)
+	require.NoError(t, err)
+
+	got, err := codec.Decode(payload)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(want, got))
+
+	_, err = codec.Decode([]byte{0xff})
+	require.Error(t, err)
+}
+
+func TestProtoSchemaDefinition(t *testing.T) {
+	definition, err := ProtoSchemaDefinition(&timestamppb.Timestamp{})
+	require.NoError(t, err)
+	require.NoError(t, schema.Validate(varlogpb.SchemaTypeProtobuf, definition))
+
+	var fds descriptorpb.FileDescriptorSet
+	require.NoError(t, proto.Unmarshal(definition, &fds))
+	require.Len(t, fds.File, 1)
+	require.Equal(t, "google/protobuf/timestamp.proto", fds.File[0].GetName())
+}
@@ -0,0 +1,140 @@
+package varlog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/multierr"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+// StartPosition is a symbolic position from which Subscribe,
+// SubscribeIterator, and SubscribeTo start. It lets users start a
+// subscription, for instance, at the current end of a topic without finding
+// the position by calling PeekLogStream on every log stream.
+//
+// For SubscribeTo, the storage node resolves the position against the commit
+// context of the log stream replica when the subscription begins. For
+// Subscribe and SubscribeIterator, the client asks replicas of all log streams
+// in the topic for the global high watermark and the local low watermark
+// reported by their commit contexts, and then begins the subscription from
+// the GLSN derived from them.
+type StartPosition int
+
+const (
+	// StartPositionEarliest starts the subscription from the first log entry
+	// that is not trimmed.
+	StartPositionEarliest StartPosition = iota + 1
+	// StartPositionLatest starts the subscription from the first log entry
+	// committed after the global high watermark, that is, the subscription
+	// receives only log entries committed after it starts.
+	StartPositionLatest
+)
+
+func (p StartPosition) String() string {
+	switch p {
+	case StartPositionEarliest:
+		return "earliest"
+	case StartPositionLatest:
+		return "latest"
+	default:
+		return "unknown"
+	}
+}
+
+func (p StartPosition) proto() snpb.StartPosition {
+	switch p {
+	case StartPositionEarliest:
+		return snpb.StartPositionEarliest
+	case StartPositionLatest:
+		return snpb.StartPositionLatest
+	default:
+		return snpb.StartPositionUnspecified
+	}
+}
+
+// resolveStartGLSN returns the GLSN at which the subscription to the topic
+// starting from the argument pos begins.
+//
+// StartPositionLatest resolves to the GLSN following the highest global high
+// watermark reported by the replicas, which needs at least one replica to
+// respond. StartPositionEarliest resolves to the lowest local low watermark of
+// the log streams, which needs every log stream to respond. If no log stream
+// has log entries, it is the same as StartPositionLatest.
+func (v *logImpl) resolveStartGLSN(ctx context.Context, tpid types.TopicID, pos StartPosition) (types.GLSN, error) {
+	if pos != StartPositionEarliest && pos != StartPositionLatest {
+		return types.InvalidGLSN, fmt.Errorf("subscribe: invalid start position %d: %w", pos, verrors.ErrInvalid)
+	}
+
+	lsReplicas := v.replicasRetriever.All(tpid)
+	if len(lsReplicas) == 0 {
+		return types.InvalidGLSN, errNoLogStream
+	}
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		errs      []error
+		responded int
+		globalHWM types.GLSN
+		lowest    types.GLSN
+	)
+	for lsid, replicas := range lsReplicas {
+		lsid, replicas := lsid, replicas
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var (
+				ok       bool
+				hwm      types.GLSN
+				localLWM types.GLSN
+			)
+			for _, replica := range replicas {
+				cl, err := v.logCLManager.GetOrConnect(ctx, replica.StorageNodeID, replica.Address)
+				if err == nil {
+					var lsrmd snpb.LogStreamReplicaMetadataDescriptor
+					lsrmd, err = cl.LogStreamReplicaMetadata(ctx, tpid, lsid)
+					if err == nil {
+						ok = true
+						if hwm < lsrmd.GlobalHighWatermark {
+							hwm = lsrmd.GlobalHighWatermark
+						}
+						if lwm := lsrmd.LocalLowWatermark.GLSN; !lwm.Invalid() && (localLWM.Invalid() || lwm < localLWM) {
+							localLWM = lwm
+						}
+						continue
+					}
+				}
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("subscribe: lsid %d, snid %d: %w", lsid, replica.StorageNodeID, err))
+				mu.Unlock()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !ok {
+				return
+			}
+			responded++
+			if globalHWM < hwm {
+				globalHWM = hwm
+			}
+			if !localLWM.Invalid() && (lowest.Invalid() || localLWM < lowest) {
+				lowest = localLWM
+			}
+		}()
+	}
+	wg.Wait()
+
+	if responded == 0 || (pos == StartPositionEarliest && responded < len(lsReplicas)) {
+		return types.InvalidGLSN, multierr.Combine(errs...)
+	}
+	if pos == StartPositionEarliest && !lowest.Invalid() {
+		return lowest, nil
+	}
+	return globalHWM + 1, nil
+}
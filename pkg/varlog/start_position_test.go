@@ -0,0 +1,28 @@
+package varlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kakao/varlog/proto/snpb"
+)
+
+func TestStartPosition(t *testing.T) {
+	tcs := []struct {
+		pos   StartPosition
+		str   string
+		proto snpb.StartPosition
+	}{
+		{pos: StartPositionEarliest, str: "earliest", proto: snpb.StartPositionEarliest},
+		{pos: StartPositionLatest, str: "latest", proto: snpb.StartPositionLatest},
+		{pos: StartPosition(0), str: "unknown", proto: snpb.StartPositionUnspecified},
+	}
+	for _, tc := range tcs {
+		require.Equal(t, tc.str, tc.pos.String())
+		require.Equal(t, tc.proto, tc.pos.proto())
+	}
+
+	require.Equal(t, StartPosition(0), StartPositionOf())
+	require.Equal(t, StartPositionLatest, StartPositionOf(WithFilter(nil), WithStartPosition(StartPositionLatest)))
+}
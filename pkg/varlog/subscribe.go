@@ -4,69 +4,66 @@ import (
 	"container/heap"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/internal/storagenode/client"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/runner"
+	"github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
 
 type SubscribeCloser func()
 
 func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, onNext OnNext, opts ...SubscribeOption) (closer SubscribeCloser, err error) {
-	if begin >= end {
-		return nil, verrors.ErrInvalid
-	}
-
 	subscribeOpts := defaultSubscribeOptions()
 	for _, opt := range opts {
 		opt.apply(&subscribeOpts)
 	}
 
+	if subscribeOpts.startPosition != 0 {
+		begin, err = v.resolveStartGLSN(ctx, topicID, subscribeOpts.startPosition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if begin >= end {
+		return nil, verrors.ErrInvalid
+	}
+
 	subscribeRunner := runner.New("subscribe", v.logger.Named("subscribe").With(
 		zap.Int32("tpid", int32(topicID)),
 		zap.Uint64("begin", uint64(begin)),
 		zap.Uint64("end", uint64(end)),
 	))
 
-	transmitCV := make(chan struct{}, 1)
-
-	mctx, cancel := subscribeRunner.WithManagedCancel(context.Background())
+	span := v.startSubscribeSpan(ctx, "varlog.Subscribe", topicID, begin, end)
+	mctx, cancel := subscribeRunner.WithManagedCancel(trace.ContextWithSpan(context.Background(), span))
 	closer = func() {
 		cancel()
 		subscribeRunner.Stop()
+		span.End()
 	}
 
-	sleq := newSubscribedLogEntiresQueue(begin, end, closer, v.logger)
-
-	tlogger := v.logger.Named("transmitter")
-	tsm := &transmitter{
-		topicID:           topicID,
-		subscribers:       make(map[types.LogStreamID]*subscriber),
-		refresher:         v.refresher,
-		replicasRetriever: v.replicasRetriever,
-		logCLManager:      v.logCLManager,
-		sleq:              sleq,
-		wanted:            begin,
-		end:               end,
-		transmitQ:         &transmitQueue{pq: &PriorityQueue{}},
-		transmitCV:        transmitCV,
-		timeout:           subscribeOpts.timeout,
-		runner:            runner.New("transmitter", tlogger),
-		logger:            tlogger,
-	}
+	sleq := newSubscribedLogEntiresQueue(begin, end, int(end-begin), mctx.Done(), v.logger)
+	tsm := v.newTransmitter(topicID, begin, end, 0, sleq, subscribeOpts)
 
 	dis := &dispatcher{
 		onNextFunc: onNext,
 		sleq:       sleq,
+		telemetry:  v.telemetry,
+		attrs:      []attribute.KeyValue{telemetry.TopicID(topicID)},
 		logger:     v.logger,
 	}
 	if err = subscribeRunner.RunC(mctx, tsm.transmit); err != nil {
@@ -79,10 +76,52 @@ func (v *logImpl) subscribe(ctx context.Context, topicID types.TopicID, begin, e
 	return closer, nil
 
 errOut:
+	span.RecordError(err)
 	closer()
 	return nil, err
 }
 
+// startSubscribeSpan starts a span lasting until the subscription is closed.
+// The span is a child of the span in the argument ctx, if any, but it does not
+// inherit the cancellation of the argument ctx.
+func (v *logImpl) startSubscribeSpan(ctx context.Context, name string, topicID types.TopicID, begin, end types.GLSN) trace.Span {
+	_, span := v.telemetry.startSpan(ctx, name,
+		telemetry.TopicID(topicID),
+		attribute.Int64("varlog.subscribe.begin", int64(begin)),
+		attribute.Int64("varlog.subscribe.end", int64(end)),
+	)
+	return span
+}
+
+// newTransmitter returns a transmitter merging log entries of all log streams
+// in the topic into the sleq in order of GLSN. If the argument window is
+// positive, each subscriber of a log stream buffers at most window log
+// entries that the transmitter has not taken yet.
+func (v *logImpl) newTransmitter(topicID types.TopicID, begin, end types.GLSN, window int, sleq *subscribedLogEntriesQueue, subscribeOpts subscribeOptions) *transmitter {
+	tlogger := v.logger.Named("transmitter")
+	return &transmitter{
+		topicID:           topicID,
+		subscribers:       make(map[types.LogStreamID]*subscriber),
+		refresher:         v.refresher,
+		replicasRetriever: v.replicasRetriever,
+		rrSelector:        v.rrSelector,
+		logCLManager:      v.logCLManager,
+		sleq:              sleq,
+		wanted:            begin,
+		end:               end,
+		window:            window,
+		filter:            subscribeOpts.filter,
+		cipher:            v.payloadCipher,
+		transmitQ:         &transmitQueue{pq: &PriorityQueue{}},
+		transmitCV:        make(chan struct{}, 1),
+		timeout:           subscribeOpts.timeout,
+		draining:          make(map[types.LogStreamID]types.GLSN),
+		listener:          subscribeOpts.listener,
+		runner:            runner.New("transmitter", tlogger),
+		logger:            tlogger,
+	}
+}
+
 type PriorityQueueItem interface {
 	Priority() uint64
 }
@@ -116,6 +155,9 @@ type transmitResult struct {
 	logStreamID   types.LogStreamID
 	storageNodeID types.StorageNodeID
 	result        client.SubscribeResult
+	// window is the prefetch window of the subscriber that received the
+	// result. It is nil if the subscription has no prefetch window.
+	window chan struct{}
 }
 
 func (t transmitResult) Priority() uint64 {
@@ -134,6 +176,9 @@ func (tq *transmitQueue) Push(r transmitResult) {
 	heap.Push(tq.pq, r)
 }
 
+// Pop removes the result with the lowest GLSN from the queue. It also
+// releases the slot of the prefetch window taken by the result so that the
+// subscriber can receive the next one.
 func (tq *transmitQueue) Pop() (transmitResult, bool) {
 	tq.mu.Lock()
 	defer tq.mu.Unlock()
@@ -144,7 +189,11 @@ func (tq *transmitQueue) Pop() (transmitResult, bool) {
 		}, false
 	}
 
-	return heap.Pop(tq.pq).(transmitResult), true
+	r := heap.Pop(tq.pq).(transmitResult)
+	if r.window != nil {
+		<-r.window
+	}
+	return r, true
 }
 
 func (tq *transmitQueue) Front() (transmitResult, bool) {
@@ -167,9 +216,14 @@ type subscriber struct {
 	logCL           *client.LogClient
 	resultC         <-chan client.SubscribeResult
 	cancelSubscribe context.CancelFunc
+	cipher          *payloadCipher
 
 	transmitQ  *transmitQueue
 	transmitCV chan struct{}
+	// window limits the number of results pushed into the transmitQ but not
+	// popped yet. While the window is full, the subscriber stops receiving
+	// from the storage node, and flow control of the stream pauses it.
+	window chan struct{}
 
 	done     chan struct{}
 	closed   atomic.Bool
@@ -180,9 +234,9 @@ type subscriber struct {
 	logger *zap.Logger
 }
 
-func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, storageNodeID types.StorageNodeID, logCL *client.LogClient, begin, end types.GLSN, transmitQ *transmitQueue, transmitCV chan struct{}, logger *zap.Logger) (*subscriber, error) {
+func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, storageNodeID types.StorageNodeID, logCL *client.LogClient, begin, end types.GLSN, filter *varlogpb.LogEntryFilter, cipher *payloadCipher, transmitQ *transmitQueue, transmitCV chan struct{}, window int, logger *zap.Logger) (*subscriber, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	resultC, err := logCL.Subscribe(ctx, topicID, logStreamID, begin, end)
+	resultC, err := logCL.SubscribeWithFilter(ctx, topicID, logStreamID, begin, end, filter)
 	if err != nil {
 		cancel()
 		return nil, err
@@ -194,11 +248,15 @@ func newSubscriber(ctx context.Context, topicID types.TopicID, logStreamID types
 		logCL:           logCL,
 		resultC:         resultC,
 		cancelSubscribe: cancel,
+		cipher:          cipher,
 		transmitQ:       transmitQ,
 		transmitCV:      transmitCV,
 		done:            make(chan struct{}),
 		logger:          logger.Named("subscriber").With(zap.Int32("lsid", int32(logStreamID))),
 	}
+	if window > 0 {
+		s.window = make(chan struct{}, window)
+	}
 	s.lastSubscribeAt.Store(time.Now())
 	s.closed.Store(false)
 	s.complete.Store(false)
@@ -217,6 +275,18 @@ func (s *subscriber) subscribe(ctx context.Context) {
 		s.closed.Store(true)
 	}()
 	for {
+		if s.window != nil {
+			select {
+			case <-s.done:
+				return
+			case <-ctx.Done():
+				return
+			case s.window <- struct{}{}:
+			}
+			// Waiting for the window is not idleness of the stream.
+			s.lastSubscribeAt.Store(time.Now())
+		}
+
 		select {
 		case <-s.done:
 			return
@@ -226,10 +296,18 @@ func (s *subscriber) subscribe(ctx context.Context) {
 			r := transmitResult{
 				storageNodeID: s.storageNodeID,
 				logStreamID:   s.logStreamID,
+				window:        s.window,
 			}
 
 			if ok {
 				r.result = res
+				if res.Error == nil && !res.Filtered {
+					// The error keeps the GLSN so that the transmitter
+					// delivers it in order and stops the subscription.
+					if err := s.cipher.decryptLogEntry(ctx, &r.result.LogEntry); err != nil {
+						r.result.Error = fmt.Errorf("subscribe: %w", err)
+					}
+				}
 			} else {
 				r.result = client.InvalidSubscribeResult
 			}
@@ -259,14 +337,26 @@ func (s *subscriber) getLastSubscribeAt() time.Time {
 	return s.lastSubscribeAt.Load().(time.Time)
 }
 
+// paused returns true if the subscriber waits for the transmitter to take
+// results since its prefetch window is full.
+func (s *subscriber) paused() bool {
+	return s.window != nil && len(s.window) == cap(s.window)
+}
+
 type transmitter struct {
 	topicID           types.TopicID
 	subscribers       map[types.LogStreamID]*subscriber
 	refresher         MetadataRefresher
 	replicasRetriever ReplicasRetriever
+	rrSelector        *readReplicaSelector
 	sleq              *subscribedLogEntriesQueue
 	wanted            types.GLSN
 	end               types.GLSN
+	// window is the size of the prefetch window of each subscriber. Zero
+	// means no limit.
+	window int
+	filter *varlogpb.LogEntryFilter
+	cipher *payloadCipher
 
 	transmitQ  *transmitQueue
 	transmitCV chan struct{}
@@ -274,6 +364,17 @@ type transmitter struct {
 	timeout time.Duration
 	timer   *time.Timer
 
+	// members is the set of log streams in the topic according to the
+	// metadata whose applied index is appliedIndex. It is nil until the
+	// transmitter watches the metadata for the first time.
+	members      map[types.LogStreamID]struct{}
+	appliedIndex uint64
+	// draining maps log streams unregistered from the topic, whose
+	// subscribers may still deliver log entries, to the GLSNs of their last
+	// log entries. The GLSN is types.MaxGLSN if unknown.
+	draining map[types.LogStreamID]types.GLSN
+	listener LogStreamListener
+
 	logCLManager *client.Manager[*client.LogClient]
 	runner       *runner.Runner
 	logger       *zap.Logger
@@ -309,37 +410,44 @@ func (p *transmitter) transmit(ctx context.Context) {
 
 func (p *transmitter) refreshSubscriber(ctx context.Context) error {
 	p.refresher.Refresh(ctx)
+	p.watchLogStreams(ctx)
+	p.drain()
 
 	replicasMap := p.replicasRetriever.All(p.topicID)
 	for logStreamID, replicas := range replicasMap {
-		idx := 0
+		if _, ok := p.draining[logStreamID]; ok {
+			// The replicas retriever can lag behind the metadata.
+			continue
+		}
+
+		var failed types.StorageNodeID
 		if s, ok := p.subscribers[logStreamID]; ok {
 			if !s.closed.Load() || s.complete.Load() {
 				continue
 			}
-		SelectReplica:
-			for i, r := range replicas {
-				if r.StorageNodeID == s.storageNodeID {
-					idx = (i + 1) % len(replicas)
-					break SelectReplica
-				}
-			}
+			failed = s.storageNodeID
 		}
 
+		// A replica can serve the subscription without delay if it has
+		// received the commit of the wanted log entry.
+		wanted := p.wanted
+		replicas = p.rrSelector.order(ctx, p.topicID, logStreamID, replicas, func(lsrmd snpb.LogStreamReplicaMetadataDescriptor) bool {
+			return lsrmd.GlobalHighWatermark >= wanted
+		}, failed)
+
 		var s *subscriber
 		var err error
 	CONNECT:
-		for i := 0; i < len(replicas); i++ {
-			idx = (idx + i) % len(replicas)
-			snid := replicas[idx].GetStorageNodeID()
-			addr := replicas[idx].GetAddress()
+		for _, replica := range replicas {
+			snid := replica.GetStorageNodeID()
+			addr := replica.GetAddress()
 
 			logCL, err := p.logCLManager.GetOrConnect(ctx, snid, addr)
 			if err != nil {
 				continue CONNECT
 			}
 
-			s, err = newSubscriber(ctx, p.topicID, logStreamID, snid, logCL, p.wanted, p.end, p.transmitQ, p.transmitCV, p.logger)
+			s, err = newSubscriber(ctx, p.topicID, logStreamID, snid, logCL, p.wanted, p.end, p.filter, p.cipher, p.transmitQ, p.transmitCV, p.window, p.logger)
 			if err != nil {
 				// logCL.Close()
 				continue CONNECT
@@ -360,16 +468,135 @@ func (p *transmitter) refreshSubscriber(ctx context.Context) error {
 	return nil
 }
 
+// logStreamsChanged returns true if the refresher has fetched metadata that
+// the transmitter has not watched yet. It does not fetch metadata.
+func (p *transmitter) logStreamsChanged() bool {
+	md := p.refresher.Metadata()
+	return md != nil && md.GetAppliedIndex() != p.appliedIndex
+}
+
+// watchLogStreams compares the log streams of the topic in the metadata
+// fetched by the refresher with the ones seen before, and notifies the
+// listener of the differences. Subscribers of new log streams are started by
+// refreshSubscriber at p.wanted. It is the right position since the
+// transmitter cannot have advanced past any log entry of a log stream it has
+// not subscribed to. Unregistered log streams are drained by drain.
+func (p *transmitter) watchLogStreams(ctx context.Context) {
+	md := p.refresher.Metadata()
+	if md == nil || (p.members != nil && md.GetAppliedIndex() == p.appliedIndex) {
+		return
+	}
+	p.appliedIndex = md.GetAppliedIndex()
+
+	members := make(map[types.LogStreamID]struct{})
+	if td := md.GetTopic(p.topicID); td != nil {
+		for _, lsid := range td.LogStreams {
+			members[lsid] = struct{}{}
+		}
+	}
+	if p.members != nil {
+		for lsid := range members {
+			if _, ok := p.members[lsid]; !ok {
+				p.notify(LogStreamEventAdded, lsid)
+			}
+		}
+		for lsid := range p.members {
+			if _, ok := members[lsid]; !ok {
+				p.draining[lsid] = p.lastGLSN(ctx, lsid)
+				p.notify(LogStreamEventRemoved, lsid)
+			}
+		}
+	}
+	p.members = members
+}
+
+// lastGLSN returns the GLSN of the last log entry in the log stream
+// unregistered from the topic. Since the log stream is sealed before
+// unregistered, it does not grow anymore. It asks the storage node serving
+// the subscriber, and returns types.MaxGLSN if it cannot.
+func (p *transmitter) lastGLSN(ctx context.Context, lsid types.LogStreamID) types.GLSN {
+	s, ok := p.subscribers[lsid]
+	if !ok {
+		return types.MaxGLSN
+	}
+	lsrmd, err := s.logCL.LogStreamReplicaMetadata(ctx, p.topicID, lsid)
+	if err != nil {
+		p.logger.Warn("could not get the last log entry of the removed log stream", zap.Int32("lsid", int32(lsid)), zap.Error(err))
+		return types.MaxGLSN
+	}
+	return lsrmd.LocalHighWatermark.GLSN
+}
+
+// drain stops subscribers of log streams unregistered from the topic once
+// they have delivered all their log entries, that is, the transmitter has
+// passed their last log entries. If the last log entry is unknown, the
+// subscriber is regarded as drained once it stays idle for the timeout while
+// not waiting for the transmitter to take log entries. A subscriber that
+// completes or fails is also regarded as drained since it is not started
+// again.
+func (p *transmitter) drain() {
+	for lsid, last := range p.draining {
+		s, ok := p.subscribers[lsid]
+		if ok && !p.drained(s, last) {
+			continue
+		}
+		if ok {
+			if s.closed.Load() {
+				if !s.complete.Load() && p.wanted <= last && last != types.MaxGLSN {
+					p.logger.Warn("subscriber of the removed log stream stopped before drained",
+						zap.Int32("lsid", int32(lsid)),
+						zap.Uint64("last", uint64(last)),
+					)
+				}
+				s.cancelSubscribe()
+			} else {
+				s.stop()
+			}
+			delete(p.subscribers, lsid)
+		}
+		delete(p.draining, lsid)
+		p.notify(LogStreamEventDrained, lsid)
+	}
+}
+
+func (p *transmitter) drained(s *subscriber, last types.GLSN) bool {
+	if s.closed.Load() || s.complete.Load() || p.wanted > last {
+		return true
+	}
+	return last == types.MaxGLSN && !s.paused() && time.Since(s.getLastSubscribeAt()) >= p.timeout
+}
+
+func (p *transmitter) notify(typ LogStreamEventType, lsid types.LogStreamID) {
+	p.logger.Info("log stream changed",
+		zap.Stringer("event", typ),
+		zap.Int32("lsid", int32(lsid)),
+		zap.Uint64("glsn", uint64(p.wanted)),
+	)
+	if p.listener == nil {
+		return
+	}
+	p.listener(LogStreamEvent{
+		Type:        typ,
+		TopicID:     p.topicID,
+		LogStreamID: lsid,
+		GLSN:        p.wanted,
+	})
+}
+
 func (p *transmitter) handleTimeout(ctx context.Context) {
 	l := make([]*subscriber, 0, len(p.subscribers))
-	for _, s := range p.subscribers {
-		if !s.complete.Load() && !s.closed.Load() &&
+	for lsid, s := range p.subscribers {
+		if _, ok := p.draining[lsid]; ok {
+			// It is not started again once stopped.
+			continue
+		}
+		if !s.complete.Load() && !s.closed.Load() && !s.paused() &&
 			time.Since(s.getLastSubscribeAt()) >= p.timeout {
 			l = append(l, s)
 		}
 	}
 
-	if len(l) != len(p.subscribers) {
+	if len(l) != len(p.subscribers)-len(p.draining) {
 		for _, s := range l {
 			s.stop()
 		}
@@ -404,6 +631,10 @@ func (p *transmitter) handleResult(r transmitResult) error {
 		}
 	} else if p.wanted == r.result.GLSN {
 		p.sleq.pushBack(r.result)
+		if r.result.Error != nil {
+			// The log entry cannot be decrypted.
+			return r.result.Error
+		}
 		p.wanted++
 		p.timer.Reset(p.timeout)
 	}
@@ -424,7 +655,8 @@ func (p *transmitter) transmitLoop(ctx context.Context) bool {
 			res, _ := p.transmitQ.Pop()
 			err := p.handleResult(res)
 			if p.wanted == p.end ||
-				errors.Is(err, verrors.ErrTrimmed) {
+				errors.Is(err, verrors.ErrTrimmed) ||
+				errors.Is(err, errDecrypt) {
 				return false
 			}
 
@@ -434,6 +666,11 @@ func (p *transmitter) transmitLoop(ctx context.Context) bool {
 		}
 	}
 
+	// While log entries keep flowing, the timeout does not trigger to
+	// refresh subscribers. Watching the metadata periodically refreshed by
+	// the refresher catches changes in log streams of the topic.
+	needRefresh = needRefresh || p.logStreamsChanged()
+
 	if needRefresh {
 		p.refreshSubscriber(ctx) //nolint:errcheck,revive // TODO:: Handle an error returned.
 	}
@@ -443,18 +680,21 @@ func (p *transmitter) transmitLoop(ctx context.Context) bool {
 
 type subscribedLogEntriesQueue struct {
 	c      chan client.SubscribeResult
+	done   <-chan struct{}
 	wanted types.GLSN
 	end    types.GLSN
-	closer SubscribeCloser
 	logger *zap.Logger
 }
 
-func newSubscribedLogEntiresQueue(begin, end types.GLSN, closer SubscribeCloser, logger *zap.Logger) *subscribedLogEntriesQueue {
+// newSubscribedLogEntiresQueue returns a queue holding at most size log
+// entries. Pushing to the full queue blocks until the consumer takes a log
+// entry or the channel done is closed.
+func newSubscribedLogEntiresQueue(begin, end types.GLSN, size int, done <-chan struct{}, logger *zap.Logger) *subscribedLogEntriesQueue {
 	q := &subscribedLogEntriesQueue{
-		c:      make(chan client.SubscribeResult, end-begin),
+		c:      make(chan client.SubscribeResult, size),
+		done:   done,
 		wanted: begin,
 		end:    end,
-		closer: closer,
 		logger: logger.Named("subscribed_log_entries_queue"),
 	}
 	return q
@@ -464,9 +704,24 @@ func (q *subscribedLogEntriesQueue) pushBack(result client.SubscribeResult) {
 	if !q.pushable(result) {
 		q.logger.Panic("not pushable")
 	}
+	if result.Filtered && result.Error == nil {
+		// The log entry does not match the filter; it only advances the
+		// position.
+		q.wanted++
+		return
+	}
 	advance := result.Error == nil
-	// NOTE: the sendC is not blocking since its size is enough to receive messages.
-	q.sendC() <- result
+	// NOTE: The result is sent without blocking if the queue has room, even
+	// if the subscription is already stopped.
+	select {
+	case q.sendC() <- result:
+	default:
+		select {
+		case q.sendC() <- result:
+		case <-q.done:
+			return
+		}
+	}
 	if advance {
 		q.wanted++
 	}
@@ -478,7 +733,6 @@ func (q *subscribedLogEntriesQueue) pushable(result client.SubscribeResult) bool
 
 func (q *subscribedLogEntriesQueue) close() {
 	close(q.c)
-	// q.closer()
 }
 
 func (q *subscribedLogEntriesQueue) sendC() chan<- client.SubscribeResult {
@@ -492,15 +746,22 @@ func (q *subscribedLogEntriesQueue) recvC() <-chan client.SubscribeResult {
 type dispatcher struct {
 	onNextFunc OnNext
 	sleq       *subscribedLogEntriesQueue
+	telemetry  *clientTelemetry
+	attrs      []attribute.KeyValue
 	logger     *zap.Logger
 }
 
-func (p *dispatcher) dispatch(_ context.Context) {
+func (p *dispatcher) dispatch(ctx context.Context) {
 	sentErr := false
 	for res := range p.sleq.recvC() {
 		if sentErr {
 			p.logger.Panic("multiple errors in dispatcher", zap.Any("res", res), zap.Error(res.Error))
 		}
+		if res.Error == nil {
+			p.telemetry.recordSubscribe(ctx, len(p.sleq.c), p.attrs...)
+		} else if !errors.Is(res.Error, context.Canceled) {
+			trace.SpanFromContext(ctx).RecordError(res.Error)
+		}
 		p.onNextFunc(res.LogEntry, res.Error)
 		sentErr = sentErr || res.Error != nil
 	}
@@ -509,6 +770,145 @@ func (p *dispatcher) dispatch(_ context.Context) {
 	}
 }
 
+// TopicIterator pulls log entries of a topic in order of GLSN. Unlike
+// Log.Subscribe, it never runs user code on the goroutines receiving log
+// entries; it prefetches a bounded number of log entries instead, and storage
+// nodes pause sending log entries while the consumer falls behind.
+type TopicIterator interface {
+	// Next returns the next log entry. It blocks until the log entry is
+	// available or the argument ctx is done. It returns io.EOF after the last
+	// log entry in the range. Errors other than the error of the argument ctx
+	// are terminal, that is, subsequent calls return the same error.
+	Next(ctx context.Context) (varlogpb.LogEntry, error)
+
+	// Close stops the subscription. Next called after Close returns
+	// verrors.ErrClosed unless it has already returned a terminal error.
+	io.Closer
+}
+
+func (v *logImpl) subscribeIterator(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...SubscribeOption) (TopicIterator, error) {
+	subscribeOpts := defaultSubscribeOptions()
+	for _, opt := range opts {
+		opt.apply(&subscribeOpts)
+	}
+	if subscribeOpts.prefetchWindow <= 0 {
+		return nil, fmt.Errorf("subscribe: invalid prefetch window %d: %w", subscribeOpts.prefetchWindow, verrors.ErrInvalid)
+	}
+
+	if subscribeOpts.startPosition != 0 {
+		var err error
+		begin, err = v.resolveStartGLSN(ctx, topicID, subscribeOpts.startPosition)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if begin >= end {
+		return nil, verrors.ErrInvalid
+	}
+
+	subscribeRunner := runner.New("subscribe", v.logger.Named("subscribe").With(
+		zap.Int32("tpid", int32(topicID)),
+		zap.Uint64("begin", uint64(begin)),
+		zap.Uint64("end", uint64(end)),
+	))
+
+	span := v.startSubscribeSpan(ctx, "varlog.SubscribeIterator", topicID, begin, end)
+	mctx, cancel := subscribeRunner.WithManagedCancel(trace.ContextWithSpan(context.Background(), span))
+	closer := func() {
+		cancel()
+		subscribeRunner.Stop()
+		span.End()
+	}
+
+	sleq := newSubscribedLogEntiresQueue(begin, end, subscribeOpts.prefetchWindow, mctx.Done(), v.logger)
+	tsm := v.newTransmitter(topicID, begin, end, subscribeOpts.prefetchWindow, sleq, subscribeOpts)
+	if err := subscribeRunner.RunC(mctx, tsm.transmit); err != nil {
+		span.RecordError(err)
+		closer()
+		return nil, err
+	}
+
+	return &topicIterator{
+		sleq:      sleq,
+		closer:    closer,
+		closeC:    make(chan struct{}),
+		telemetry: v.telemetry,
+		attrs:     []attribute.KeyValue{telemetry.TopicID(topicID)},
+	}, nil
+}
+
+type topicIterator struct {
+	sleq   *subscribedLogEntriesQueue
+	closer SubscribeCloser
+
+	telemetry *clientTelemetry
+	attrs     []attribute.KeyValue
+
+	closeC    chan struct{}
+	closeOnce sync.Once
+
+	mu  sync.Mutex
+	err error
+}
+
+var _ TopicIterator = (*topicIterator)(nil)
+
+func (it *topicIterator) Next(ctx context.Context) (varlogpb.LogEntry, error) {
+	if err := it.getErr(); err != nil {
+		return varlogpb.InvalidLogEntry(), err
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+		return varlogpb.InvalidLogEntry(), ctx.Err()
+	case <-it.closeC:
+		err = verrors.ErrClosed
+	case res, ok := <-it.sleq.recvC():
+		switch {
+		case !ok:
+			err = io.EOF
+		case res.Error != nil:
+			err = res.Error
+		default:
+			it.telemetry.recordSubscribe(ctx, len(it.sleq.c), it.attrs...)
+			return res.LogEntry, nil
+		}
+		// The queue is also closed by Close.
+		select {
+		case <-it.closeC:
+			err = verrors.ErrClosed
+		default:
+		}
+	}
+	return varlogpb.InvalidLogEntry(), it.setErr(err)
+}
+
+func (it *topicIterator) Close() error {
+	it.closeOnce.Do(func() {
+		close(it.closeC)
+		it.closer()
+	})
+	return nil
+}
+
+func (it *topicIterator) getErr() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	return it.err
+}
+
+// setErr sets the terminal error if it is not set yet, and returns the
+// terminal error.
+func (it *topicIterator) setErr(err error) error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	if it.err == nil {
+		it.err = err
+	}
+	return it.err
+}
+
 type Subscriber interface {
 	Next() (varlogpb.LogEntry, error)
 	io.Closer
@@ -527,70 +927,129 @@ func (s invalidSubscriber) Close() error {
 }
 
 func (v *logImpl) subscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...SubscribeOption) Subscriber {
-	if begin >= end {
-		return invalidSubscriber{err: verrors.ErrInvalid}
-	}
-
 	subscribeOpts := defaultSubscribeOptions()
 	for _, opt := range opts {
 		opt.apply(&subscribeOpts)
 	}
 
+	if subscribeOpts.startPosition != 0 {
+		if subscribeOpts.startPosition.proto() == snpb.StartPositionUnspecified {
+			return invalidSubscriber{err: fmt.Errorf("subscribe: invalid start position %d: %w", subscribeOpts.startPosition, verrors.ErrInvalid)}
+		}
+		// The storage node decides the first LLSN.
+		begin = types.InvalidLLSN
+		if end.Invalid() {
+			return invalidSubscriber{err: verrors.ErrInvalid}
+		}
+	} else if begin >= end {
+		return invalidSubscriber{err: verrors.ErrInvalid}
+	}
+
 	logStreamReplicas, ok := v.replicasRetriever.Retrieve(topicID, logStreamID)
 	if !ok {
 		return invalidSubscriber{err: errors.New("no such log stream")}
 	}
 
+	attrs := []attribute.KeyValue{telemetry.TopicID(topicID), telemetry.LogStreamID(logStreamID)}
+	ctx, span := v.telemetry.startSpan(ctx, "varlog.SubscribeTo", append(attrs,
+		attribute.Int64("varlog.subscribe.begin", int64(begin)),
+		attribute.Int64("varlog.subscribe.end", int64(end)),
+	)...)
 	ctx, cancel := context.WithCancel(ctx)
-	var (
-		logCL   *client.LogClient
-		resultC <-chan client.SubscribeResult
-		err     error
-	)
+	s := &logStreamSubscriber{
+		ctx:    ctx,
+		cancel: cancel,
+		resubscribe: func(begin types.LLSN, failed types.StorageNodeID) (types.StorageNodeID, <-chan client.SubscribeResult, error) {
+			return v.subscribeToReplicas(ctx, topicID, logStreamID, begin, end, subscribeOpts, failed)
+		},
+		next:         begin,
+		maxFailovers: len(logStreamReplicas),
+		cipher:       v.payloadCipher,
+		telemetry:    v.telemetry,
+		attrs:        attrs,
+	}
+	var err error
+	s.storageNodeID, s.resultC, err = v.subscribeToReplicas(ctx, topicID, logStreamID, begin, end, subscribeOpts, 0)
+	if err != nil {
+		cancel()
+		endSpan(span, err)
+		return invalidSubscriber{err: err}
+	}
+
+	ch := make(chan struct{})
+	s.closeC = ch
+	s.closer = func() {
+		close(ch)
+		span.End()
+	}
+	return s
+}
+
+// subscribeToReplicas subscribes to one of the replicas of the log stream
+// in the order decided by the read replica policy. It returns the storage
+// node of the replica that accepts the subscription. If the argument begin is
+// invalid, the replica decides the first LLSN from the start position set in
+// the argument subscribeOpts.
+func (v *logImpl) subscribeToReplicas(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, subscribeOpts subscribeOptions, failed types.StorageNodeID) (types.StorageNodeID, <-chan client.SubscribeResult, error) {
+	logStreamReplicas, ok := v.replicasRetriever.Retrieve(topicID, logStreamID)
+	if !ok {
+		return 0, nil, errors.New("no such log stream")
+	}
+	// A replica can serve the subscription without delay if it has the
+	// first log entry to subscribe to.
+	logStreamReplicas = v.rrSelector.order(ctx, topicID, logStreamID, logStreamReplicas, func(lsrmd snpb.LogStreamReplicaMetadataDescriptor) bool {
+		return begin.Invalid() || lsrmd.LocalHighWatermark.LLSN >= begin
+	}, failed)
+
+	var err error
 	for _, logStreamReplica := range logStreamReplicas {
 		storageNodeID := logStreamReplica.StorageNodeID
 		storageNodeAddr := logStreamReplica.Address
-		var cerr error
-		logCL, cerr = v.logCLManager.GetOrConnect(ctx, storageNodeID, storageNodeAddr)
+		logCL, cerr := v.logCLManager.GetOrConnect(ctx, storageNodeID, storageNodeAddr)
 		if cerr != nil {
 			err = multierr.Append(err, cerr)
 			// _ = logCL.Close()
 			continue
 		}
 
-		resultC, cerr = logCL.SubscribeTo(ctx, topicID, logStreamID, begin, end)
+		var resultC <-chan client.SubscribeResult
+		if begin.Invalid() {
+			resultC, cerr = logCL.SubscribeToFrom(ctx, topicID, logStreamID, subscribeOpts.startPosition.proto(), end, subscribeOpts.filter)
+		} else {
+			resultC, cerr = logCL.SubscribeToWithFilter(ctx, topicID, logStreamID, begin, end, subscribeOpts.filter)
+		}
 		if cerr != nil {
 			err = multierr.Append(err, cerr)
 			// _ = logCL.Close()
 			continue
 		}
-
-		err = nil
-		break
-	}
-	if err != nil {
-		cancel()
-		return invalidSubscriber{err: err}
-	}
-
-	ch := make(chan struct{})
-	return &logStreamSubscriber{
-		ctx:     ctx,
-		cancel:  cancel,
-		closeC:  ch,
-		closer:  func() { close(ch) },
-		logCL:   logCL,
-		resultC: resultC,
+		return storageNodeID, resultC, nil
 	}
+	return 0, nil, err
 }
 
 type logStreamSubscriber struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	closeC  <-chan struct{}
-	logCL   *client.LogClient
-	resultC <-chan client.SubscribeResult
+	closeC        <-chan struct{}
+	storageNodeID types.StorageNodeID
+	resultC       <-chan client.SubscribeResult
+	// resubscribe subscribes to another replica from the argument begin
+	// when the subscription to the replica of the argument failed fails.
+	resubscribe func(begin types.LLSN, failed types.StorageNodeID) (types.StorageNodeID, <-chan client.SubscribeResult, error)
+	// next is the LLSN of the next log entry to receive. It is invalid
+	// until the first log entry is received if the subscription starts from
+	// a StartPosition.
+	next types.LLSN
+	// failovers is the number of resubscriptions since the last log entry
+	// received. It is at most maxFailovers.
+	failovers    int
+	maxFailovers int
+
+	cipher    *payloadCipher
+	telemetry *clientTelemetry
+	attrs     []attribute.KeyValue
 
 	mu      sync.Mutex
 	closer  func()
@@ -606,16 +1065,38 @@ func (s *logStreamSubscriber) Next() (logEntry varlogpb.LogEntry, err error) {
 		return
 	}
 
-	select {
-	case <-s.ctx.Done():
-		err = s.ctx.Err()
-	case <-s.closeC:
-		err = verrors.ErrClosed
-	case sr, ok := <-s.resultC:
-		if ok {
-			logEntry, err = sr.LogEntry, sr.Error
-		} else {
-			err = errors.New("already stopped SubscribeTo RPC")
+	// Log entries not matching the filter are skipped.
+	for filtered := true; filtered && err == nil; {
+		logEntry = varlogpb.InvalidLogEntry()
+		select {
+		case <-s.ctx.Done():
+			err = s.ctx.Err()
+		case <-s.closeC:
+			err = verrors.ErrClosed
+		case sr, ok := <-s.resultC:
+			if ok {
+				logEntry, err = sr.LogEntry, sr.Error
+				filtered = sr.Filtered
+				if err == nil {
+					s.next = logEntry.LLSN + 1
+					s.failovers = 0
+					if !filtered {
+						// Other replicas cannot decrypt the log entry
+						// either; hence, it does not fail over.
+						if err = s.cipher.decryptLogEntry(s.ctx, &logEntry); err != nil {
+							logEntry = varlogpb.InvalidLogEntry()
+							break
+						}
+						s.telemetry.recordSubscribe(s.ctx, len(s.resultC), s.attrs...)
+					}
+				}
+			} else {
+				err = errors.New("already stopped SubscribeTo RPC")
+			}
+			if err != nil && s.failover(err) {
+				err = nil
+				filtered = true
+			}
 		}
 	}
 	if err != nil {
@@ -626,6 +1107,29 @@ func (s *logStreamSubscriber) Next() (logEntry varlogpb.LogEntry, err error) {
 	return
 }
 
+// failover resubscribes to another replica from the next log entry if the
+// subscription to the current replica fails. It gives up after trying as many
+// times as the number of replicas without receiving any log entry.
+func (s *logStreamSubscriber) failover(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, verrors.ErrTrimmed) || errors.Is(err, verrors.ErrInvalid) || s.ctx.Err() != nil {
+		return false
+	}
+	s.mu.Lock()
+	closed := s.closer == nil
+	s.mu.Unlock()
+	if closed || s.failovers >= s.maxFailovers {
+		return false
+	}
+	s.failovers++
+	snid, resultC, rerr := s.resubscribe(s.next, s.storageNodeID)
+	if rerr != nil {
+		return false
+	}
+	s.storageNodeID = snid
+	s.resultC = resultC
+	return true
+}
+
 func (s *logStreamSubscriber) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -1,11 +1,218 @@
 package varlog
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/kakao/varlog/internal/storagenode/client"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/proto/varlogpb"
 	_ "github.com/kakao/varlog/vtesting"
 )
 
+func TestTransmitQueue_PrefetchWindow(t *testing.T) {
+	tq := &transmitQueue{pq: &PriorityQueue{}}
+	window := make(chan struct{}, 2)
+
+	for _, glsn := range []types.GLSN{3, 1} {
+		window <- struct{}{}
+		tq.Push(transmitResult{
+			result: client.SubscribeResult{LogEntry: varlogpb.LogEntry{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: glsn}}},
+			window: window,
+		})
+	}
+	require.Len(t, window, 2)
+
+	// Popping a result releases its slot in the window.
+	r, ok := tq.Pop()
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(1), r.result.GLSN)
+	require.Len(t, window, 1)
+
+	r, ok = tq.Pop()
+	require.True(t, ok)
+	require.Equal(t, types.GLSN(3), r.result.GLSN)
+	require.Empty(t, window)
+
+	_, ok = tq.Pop()
+	require.False(t, ok)
+}
+
+func TestSubscribedLogEntriesQueue_PushBack(t *testing.T) {
+	done := make(chan struct{})
+	sleq := newSubscribedLogEntiresQueue(types.MinGLSN, types.MaxGLSN, 1, done, zap.NewNop())
+
+	newResult := func(glsn types.GLSN) client.SubscribeResult {
+		return client.SubscribeResult{LogEntry: varlogpb.LogEntry{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: glsn}}}
+	}
+
+	sleq.pushBack(newResult(1))
+	require.Equal(t, types.GLSN(2), sleq.wanted)
+
+	// Pushing to the full queue blocks until the consumer takes a log entry.
+	pushed := make(chan struct{})
+	go func() {
+		defer close(pushed)
+		sleq.pushBack(newResult(2))
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("pushed to the full queue")
+	default:
+	}
+	require.Equal(t, types.GLSN(1), (<-sleq.recvC()).GLSN)
+	<-pushed
+	require.Equal(t, types.GLSN(3), sleq.wanted)
+
+	// Pushing to the full queue gives up once the subscription stops.
+	close(done)
+	sleq.pushBack(newResult(3))
+	require.Equal(t, types.GLSN(3), sleq.wanted)
+	require.Equal(t, types.GLSN(2), (<-sleq.recvC()).GLSN)
+}
+
+func TestSubscribedLogEntriesQueue_PushBackFiltered(t *testing.T) {
+	sleq := newSubscribedLogEntiresQueue(types.MinGLSN, types.MaxGLSN, 1, make(chan struct{}), zap.NewNop())
+
+	// A filtered log entry advances the position without taking the room of
+	// the queue.
+	sleq.pushBack(client.SubscribeResult{
+		LogEntry: varlogpb.LogEntry{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 1}},
+		Filtered: true,
+	})
+	require.Equal(t, types.GLSN(2), sleq.wanted)
+	require.Empty(t, sleq.c)
+
+	sleq.pushBack(client.SubscribeResult{
+		LogEntry: varlogpb.LogEntry{LogEntryMeta: varlogpb.LogEntryMeta{GLSN: 2}},
+	})
+	require.Equal(t, types.GLSN(3), sleq.wanted)
+	require.Equal(t, types.GLSN(2), (<-sleq.recvC()).GLSN)
+}
+
+func TestTransmitter_WatchLogStreams(t *testing.T) {
+	const tpid = types.TopicID(1)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	md := &varlogpb.MetadataDescriptor{
+		AppliedIndex: 1,
+		Topics: []*varlogpb.TopicDescriptor{
+			{TopicID: tpid, LogStreams: []types.LogStreamID{1, 2}},
+		},
+	}
+	refresher := NewMockMetadataRefresher(ctrl)
+	refresher.EXPECT().Metadata().DoAndReturn(func() *varlogpb.MetadataDescriptor {
+		return md
+	}).AnyTimes()
+
+	var events []LogStreamEvent
+	p := &transmitter{
+		topicID:     tpid,
+		subscribers: make(map[types.LogStreamID]*subscriber),
+		refresher:   refresher,
+		wanted:      types.GLSN(10),
+		draining:    make(map[types.LogStreamID]types.GLSN),
+		listener: func(event LogStreamEvent) {
+			events = append(events, event)
+		},
+		logger: zap.NewNop(),
+	}
+
+	// The log streams seen first are not changes.
+	require.True(t, p.logStreamsChanged())
+	p.watchLogStreams(context.Background())
+	require.False(t, p.logStreamsChanged())
+	require.Empty(t, events)
+
+	md = &varlogpb.MetadataDescriptor{
+		AppliedIndex: 2,
+		Topics: []*varlogpb.TopicDescriptor{
+			{TopicID: tpid, LogStreams: []types.LogStreamID{2, 3}},
+		},
+	}
+	require.True(t, p.logStreamsChanged())
+	p.watchLogStreams(context.Background())
+	require.Equal(t, []LogStreamEvent{
+		{Type: LogStreamEventAdded, TopicID: tpid, LogStreamID: 3, GLSN: 10},
+		{Type: LogStreamEventRemoved, TopicID: tpid, LogStreamID: 1, GLSN: 10},
+	}, events)
+	require.Contains(t, p.draining, types.LogStreamID(1))
+
+	// The removed log stream has no subscriber.
+	events = nil
+	p.drain()
+	require.Equal(t, []LogStreamEvent{
+		{Type: LogStreamEventDrained, TopicID: tpid, LogStreamID: 1, GLSN: 10},
+	}, events)
+	require.Empty(t, p.draining)
+}
+
+func TestTransmitter_Drain(t *testing.T) {
+	const tpid = types.TopicID(1)
+
+	newTestSubscriber := func() *subscriber {
+		s := &subscriber{
+			cancelSubscribe: func() {},
+			done:            make(chan struct{}),
+		}
+		s.lastSubscribeAt.Store(time.Now())
+		return s
+	}
+
+	var events []LogStreamEvent
+	p := &transmitter{
+		topicID:     tpid,
+		subscribers: make(map[types.LogStreamID]*subscriber),
+		wanted:      types.GLSN(10),
+		draining:    make(map[types.LogStreamID]types.GLSN),
+		timeout:     time.Minute,
+		listener: func(event LogStreamEvent) {
+			events = append(events, event)
+		},
+		logger: zap.NewNop(),
+	}
+
+	// The last log entry of log stream 1 is known.
+	s1 := newTestSubscriber()
+	p.subscribers[1] = s1
+	p.draining[1] = types.GLSN(12)
+
+	// The last log entry of log stream 2 is unknown.
+	s2 := newTestSubscriber()
+	p.subscribers[2] = s2
+	p.draining[2] = types.MaxGLSN
+
+	p.drain()
+	require.Empty(t, events)
+	require.Len(t, p.subscribers, 2)
+
+	p.wanted = types.GLSN(13)
+	p.drain()
+	require.Equal(t, []LogStreamEvent{
+		{Type: LogStreamEventDrained, TopicID: tpid, LogStreamID: 1, GLSN: 13},
+	}, events)
+	require.True(t, s1.closed.Load())
+	require.NotContains(t, p.subscribers, types.LogStreamID(1))
+
+	// The idle subscriber is regarded as drained.
+	events = nil
+	s2.lastSubscribeAt.Store(time.Now().Add(-time.Hour))
+	p.drain()
+	require.Equal(t, []LogStreamEvent{
+		{Type: LogStreamEventDrained, TopicID: tpid, LogStreamID: 2, GLSN: 13},
+	}, events)
+	require.True(t, s2.closed.Load())
+	require.Empty(t, p.subscribers)
+	require.Empty(t, p.draining)
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Skip()
 
@@ -0,0 +1,152 @@
+package varlog
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/telemetry"
+)
+
+const (
+	instrumentationName = "github.com/kakao/varlog/pkg/varlog"
+)
+
+// clientTelemetry has the tracer and the instruments of the client. It uses
+// no-op providers unless the client is opened with WithTelemetry; hence, the
+// client always calls them without checking whether they are configured.
+type clientTelemetry struct {
+	tracer trace.Tracer
+
+	appendDuration metric.Int64Histogram
+	appendLogs     metric.Int64Counter
+	appendRetries  metric.Int64Counter
+
+	lsaAppendDuration metric.Int64Histogram
+	lsaAppendLogs     metric.Int64Counter
+
+	allowlistDenials metric.Int64Counter
+
+	metadataRefreshDuration metric.Int64Histogram
+	metadataRefreshFailures metric.Int64Counter
+
+	subscribeLogs metric.Int64Counter
+	subscribeLag  metric.Int64Histogram
+
+	trimDuration metric.Int64Histogram
+}
+
+func newClientTelemetry(meterProvider metric.MeterProvider, tracerProvider trace.TracerProvider) *clientTelemetry {
+	if meterProvider == nil {
+		meterProvider = noop.NewMeterProvider()
+	}
+	if tracerProvider == nil {
+		tracerProvider = trace.NewNoopTracerProvider()
+	}
+	meter := meterProvider.Meter(instrumentationName)
+	nopMeter := noop.NewMeterProvider().Meter(instrumentationName)
+
+	// A broken instrument is replaced with a no-op one so that it does not
+	// break the client.
+	int64Histogram := func(name, desc, unit string) metric.Int64Histogram {
+		h, err := meter.Int64Histogram(name, metric.WithDescription(desc), metric.WithUnit(unit))
+		if err != nil {
+			otel.Handle(err)
+			h, _ = nopMeter.Int64Histogram(name)
+		}
+		return h
+	}
+	int64Counter := func(name, desc string) metric.Int64Counter {
+		c, err := meter.Int64Counter(name, metric.WithDescription(desc))
+		if err != nil {
+			otel.Handle(err)
+			c, _ = nopMeter.Int64Counter(name)
+		}
+		return c
+	}
+
+	return &clientTelemetry{
+		tracer: tracerProvider.Tracer(instrumentationName),
+
+		appendDuration: int64Histogram("client.append.duration", "Time spent appending a batch by Append and AppendTo in microseconds", "us"),
+		appendLogs:     int64Counter("client.append.logs", "Number of logs appended by Append and AppendTo"),
+		appendRetries:  int64Counter("client.append.retries", "Number of retried append requests"),
+
+		lsaAppendDuration: int64Histogram("client.logstreamappender.append.duration", "Time spent appending a batch by LogStreamAppender in microseconds", "us"),
+		lsaAppendLogs:     int64Counter("client.logstreamappender.append.logs", "Number of logs appended by LogStreamAppender"),
+
+		allowlistDenials: int64Counter("client.allowlist.denials", "Number of log streams denied for appends"),
+
+		metadataRefreshDuration: int64Histogram("client.metadata.refresh.duration", "Time spent refreshing metadata in microseconds", "us"),
+		metadataRefreshFailures: int64Counter("client.metadata.refresh.failures", "Number of failed metadata refreshes"),
+
+		subscribeLogs: int64Counter("client.subscribe.logs", "Number of logs delivered to subscribers"),
+		subscribeLag:  int64Histogram("client.subscribe.lag", "Number of logs received from storage nodes but not yet delivered to the subscriber", "{log}"),
+
+		trimDuration: int64Histogram("client.trim.duration", "Time spent trimming a topic in microseconds", "us"),
+	}
+}
+
+// startSpan starts a client span named by the argument name.
+func (ct *clientTelemetry) startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return ct.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// endSpan ends the span, recording the error if it is not nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (ct *clientTelemetry) recordAppend(ctx context.Context, tpid types.TopicID, start time.Time, logs int) {
+	attrs := metric.WithAttributes(telemetry.TopicID(tpid))
+	ct.appendDuration.Record(ctx, time.Since(start).Microseconds(), attrs)
+	if logs > 0 {
+		ct.appendLogs.Add(ctx, int64(logs), attrs)
+	}
+}
+
+func (ct *clientTelemetry) recordAppendRetry(ctx context.Context, tpid types.TopicID) {
+	ct.appendRetries.Add(ctx, 1, metric.WithAttributes(telemetry.TopicID(tpid)))
+}
+
+func (ct *clientTelemetry) recordLogStreamAppend(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, start time.Time, logs int) {
+	attrs := metric.WithAttributes(telemetry.TopicID(tpid), telemetry.LogStreamID(lsid))
+	ct.lsaAppendDuration.Record(ctx, time.Since(start).Microseconds(), attrs)
+	if logs > 0 {
+		ct.lsaAppendLogs.Add(ctx, int64(logs), attrs)
+	}
+}
+
+func (ct *clientTelemetry) recordDenial(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) {
+	ct.allowlistDenials.Add(ctx, 1, metric.WithAttributes(telemetry.TopicID(tpid), telemetry.LogStreamID(lsid)))
+}
+
+func (ct *clientTelemetry) recordMetadataRefresh(ctx context.Context, start time.Time, err error) {
+	ct.metadataRefreshDuration.Record(ctx, time.Since(start).Microseconds())
+	if err != nil {
+		ct.metadataRefreshFailures.Add(ctx, 1)
+	}
+}
+
+// recordSubscribe records a log entry delivered to the subscriber. The
+// argument lag is the number of log entries waiting for delivery behind it.
+func (ct *clientTelemetry) recordSubscribe(ctx context.Context, lag int, attrs ...attribute.KeyValue) {
+	opt := metric.WithAttributes(attrs...)
+	ct.subscribeLogs.Add(ctx, 1, opt)
+	ct.subscribeLag.Record(ctx, int64(lag), opt)
+}
+
+func (ct *clientTelemetry) recordTrim(ctx context.Context, tpid types.TopicID, start time.Time) {
+	ct.trimDuration.Record(ctx, time.Since(start).Microseconds(), metric.WithAttributes(telemetry.TopicID(tpid)))
+}
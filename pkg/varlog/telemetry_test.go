@@ -0,0 +1,82 @@
+package varlog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/kakao/varlog/pkg/util/telemetry"
+)
+
+func TestClientTelemetry(t *testing.T) {
+	const (
+		tpid = 1
+		lsid = 2
+	)
+
+	// Telemetry without providers does nothing.
+	ct := newClientTelemetry(nil, nil)
+	ctx, span := ct.startSpan(context.Background(), "test")
+	ct.recordAppend(ctx, tpid, time.Now(), 1)
+	endSpan(span, errors.New("error"))
+	require.False(t, span.SpanContext().IsValid())
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() {
+		require.NoError(t, mp.Shutdown(context.Background()))
+	}()
+
+	ct = newClientTelemetry(mp, nil)
+	ctx = context.Background()
+	start := time.Now()
+	ct.recordAppend(ctx, tpid, start, 2)
+	ct.recordAppend(ctx, tpid, start, 0)
+	ct.recordAppendRetry(ctx, tpid)
+	ct.recordLogStreamAppend(ctx, tpid, lsid, start, 3)
+	ct.recordDenial(ctx, tpid, lsid)
+	ct.recordMetadataRefresh(ctx, start, nil)
+	ct.recordMetadataRefresh(ctx, start, errors.New("error"))
+	ct.recordSubscribe(ctx, 5, telemetry.TopicID(tpid))
+	ct.recordTrim(ctx, tpid, start)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Equal(t, instrumentationName, rm.ScopeMetrics[0].Scope.Name)
+
+	counters := make(map[string]int64)
+	histograms := make(map[string]uint64)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		switch data := m.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, dp := range data.DataPoints {
+				counters[m.Name] += dp.Value
+			}
+		case metricdata.Histogram[int64]:
+			for _, dp := range data.DataPoints {
+				histograms[m.Name] += dp.Count
+			}
+		}
+	}
+	require.Equal(t, map[string]int64{
+		"client.append.logs":                   2,
+		"client.append.retries":                1,
+		"client.logstreamappender.append.logs": 3,
+		"client.allowlist.denials":             1,
+		"client.metadata.refresh.failures":     1,
+		"client.subscribe.logs":                1,
+	}, counters)
+	require.Equal(t, map[string]uint64{
+		"client.append.duration":                   2,
+		"client.logstreamappender.append.duration": 1,
+		"client.metadata.refresh.duration":         2,
+		"client.subscribe.lag":                     1,
+		"client.trim.duration":                     1,
+	}, histograms)
+}
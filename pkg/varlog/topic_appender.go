@@ -0,0 +1,290 @@
+package varlog
+
+//go:generate mockgen -package varlog -destination topic_appender_mock.go . TopicAppender
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/puzpuzpuz/xsync/v2"
+
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// TopicAppender is a client to append to a topic asynchronously. It spreads
+// batches across appendable log streams of the topic by using a
+// LogStreamAppender for each log stream. When a log stream is sealed or fails,
+// the TopicAppender denies it and moves batches in flight on it to another
+// log stream.
+type TopicAppender interface {
+	// AppendBatch appends dataBatch to one of the appendable log streams of
+	// the topic asynchronously. Users can call this method without being
+	// blocked until the pipeline of the TopicAppender is full. The pipeline
+	// size of the TopicAppender is the pipeline size set by
+	// WithPipelineSize multiplied by the number of appendable log streams
+	// when it is created. A long block duration with a configured
+	// WithCallTimeout can cause ErrCallTimeout to occur.
+	//
+	// On completion of AppendBatch, the argument callback provided by users
+	// will be invoked. All callback functions registered to the same
+	// TopicAppender will be called by the same goroutine sequentially, as
+	// the LogStreamAppender does. Moreover, they are called in the order of
+	// AppendBatch calls even if the batches are appended to different log
+	// streams or moved to another log stream. Therefore, the callback should
+	// be lightweight.
+	//
+	// If appending a batch fails, it is retried to another log stream up to
+	// the count set by WithRetryCount or WithRetryPolicy. A batch whose log stream breaks
+	// after the batch is appended can be appended again; hence, the
+	// TopicAppender appends a batch at least once rather than exactly once.
+	// It returns an ErrClosed when the TopicAppender is closed and an
+	// ErrCallTimeout when the call timeout expires.
+	//
+	// It is safe to have multiple goroutines calling AppendBatch
+	// simultaneously, but the order between them is not guaranteed.
+	//
+	// Attributes of log entries can be set by WithLogEntryAttributes, and
+	// the retry count can be set by WithRetryCount. Other AppendOptions are
+	// ignored.
+	AppendBatch(dataBatch [][]byte, callback BatchCallback, opts ...AppendOption) error
+
+	// Close closes the TopicAppender client. Once the client is closed,
+	// calling AppendBatch will fail immediately. If AppendBatch still waits
+	// for room of pipeline, Close will be blocked. It also waits for all
+	// pending callbacks to be called, and then, closes the
+	// LogStreamAppenders.
+	Close()
+}
+
+// topicAppenderEntry is a batch appended by the TopicAppender.
+type topicAppenderEntry struct {
+	data        [][]byte
+	opts        []AppendOption
+	cb          BatchCallback
+	meta        []varlogpb.LogEntryMeta
+	err         error
+	attempts    int
+	maxAttempts int
+	done        chan struct{}
+}
+
+func (te *topicAppenderEntry) complete(meta []varlogpb.LogEntryMeta, err error) {
+	te.meta = meta
+	te.err = err
+	close(te.done)
+}
+
+type topicAppender struct {
+	logStreamAppenderConfig
+	v       *logImpl
+	lsaOpts []LogStreamAppenderOption
+
+	// cq has batches in the order of AppendBatch calls. Its capacity is the
+	// pipeline size of the TopicAppender.
+	cq chan *topicAppenderEntry
+	// rq has batches to be appended again to another log stream.
+	rq chan *topicAppenderEntry
+
+	mu   sync.Mutex
+	lsas map[types.LogStreamID]LogStreamAppender
+
+	closed struct {
+		xsync.RBMutex
+		value bool
+	}
+	cbwg sync.WaitGroup
+	wg   sync.WaitGroup
+}
+
+var _ TopicAppender = (*topicAppender)(nil)
+
+func (v *logImpl) newTopicAppender(tpid types.TopicID, opts ...LogStreamAppenderOption) (TopicAppender, error) {
+	numLogStreams := len(v.lsSelector.GetAll(tpid))
+	if numLogStreams == 0 {
+		return nil, fmt.Errorf("client: no usable log stream in topic %d", tpid)
+	}
+
+	cfg := newLogStreamAppenderConfig(opts)
+	cfg.tpid = tpid
+	size := cfg.pipelineSize * numLogStreams
+	ta := &topicAppender{
+		logStreamAppenderConfig: cfg,
+		v:                       v,
+		lsaOpts:                 opts,
+		cq:                      make(chan *topicAppenderEntry, size),
+		// A batch is either in the cq or held by the callbackLoop;
+		// hence, the rq never becomes full.
+		rq:   make(chan *topicAppenderEntry, size+1),
+		lsas: make(map[types.LogStreamID]LogStreamAppender, numLogStreams),
+	}
+	ta.cbwg.Add(1)
+	_, err := v.runner.Run(func(context.Context) {
+		defer ta.cbwg.Done()
+		ta.callbackLoop()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("client: %w", err)
+	}
+	ta.wg.Add(1)
+	go ta.retryLoop()
+	return ta, nil
+}
+
+func (ta *topicAppender) AppendBatch(dataBatch [][]byte, callback BatchCallback, opts ...AppendOption) error {
+	rt := ta.closed.RLock()
+	defer ta.closed.RUnlock(rt)
+	if ta.closed.value {
+		return ErrClosed
+	}
+
+	appendOpts := defaultAppendOptions()
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	if len(appendOpts.attrs) > 0 && len(appendOpts.attrs) != len(dataBatch) {
+		return fmt.Errorf("client: %d attributes for %d data: %w", len(appendOpts.attrs), len(dataBatch), verrors.ErrInvalid)
+	}
+
+	te := &topicAppenderEntry{
+		data:        dataBatch,
+		opts:        opts,
+		cb:          callback,
+		maxAttempts: appendOpts.retryPolicy.MaxRetries + 1,
+		done:        make(chan struct{}),
+	}
+	if ta.callTimeout > 0 {
+		timer := time.NewTimer(ta.callTimeout)
+		defer timer.Stop()
+		select {
+		case ta.cq <- te:
+		case <-timer.C:
+			return ErrCallTimeout
+		}
+	} else {
+		ta.cq <- te
+	}
+	ta.submit(te)
+	return nil
+}
+
+func (ta *topicAppender) Close() {
+	ta.closed.Lock()
+	defer ta.closed.Unlock()
+	if ta.closed.value {
+		return
+	}
+	ta.closed.value = true
+
+	close(ta.cq)
+	ta.cbwg.Wait()
+
+	// Every batch has been completed; hence, no batch will be retried.
+	close(ta.rq)
+	ta.mu.Lock()
+	lsas := ta.lsas
+	ta.lsas = make(map[types.LogStreamID]LogStreamAppender)
+	ta.mu.Unlock()
+	for _, lsa := range lsas {
+		lsa.Close()
+	}
+	ta.wg.Wait()
+}
+
+// submit appends the batch to a log stream chosen by the LogStreamSelector.
+func (ta *topicAppender) submit(te *topicAppenderEntry) {
+	te.attempts++
+	lsid, ok := ta.v.lsSelector.Select(ta.tpid)
+	if !ok {
+		te.complete(nil, fmt.Errorf("client: no usable log stream in topic %d", ta.tpid))
+		return
+	}
+	lsa, err := ta.logStreamAppender(lsid)
+	if err != nil {
+		ta.fail(te, lsid, nil, err)
+		return
+	}
+
+	start := time.Now()
+	err = lsa.AppendBatch(te.data, func(meta []varlogpb.LogEntryMeta, err error) {
+		if ta.v.loadAwareSelector != nil {
+			ta.v.loadAwareSelector.observe(ta.tpid, lsid, time.Since(start), err != nil)
+		}
+		if err != nil {
+			ta.fail(te, lsid, lsa, err)
+			return
+		}
+		te.complete(meta, nil)
+	}, te.opts...)
+	if err != nil {
+		ta.fail(te, lsid, lsa, err)
+	}
+}
+
+// fail handles the batch that could not be appended to the log stream. It
+// denies the log stream and discards its LogStreamAppender since the stream
+// of the LogStreamAppender is broken. Then, the batch is retried if it has
+// attempts left.
+func (ta *topicAppender) fail(te *topicAppenderEntry, lsid types.LogStreamID, lsa LogStreamAppender, err error) {
+	ta.mu.Lock()
+	if lsa == nil || ta.lsas[lsid] == lsa {
+		ta.v.deny(context.Background(), ta.tpid, lsid)
+	}
+	if lsa != nil && ta.lsas[lsid] == lsa {
+		delete(ta.lsas, lsid)
+		// It can be called by the callback of the LogStreamAppender,
+		// which cannot close the LogStreamAppender synchronously.
+		ta.wg.Add(1)
+		go func() {
+			defer ta.wg.Done()
+			lsa.Close()
+		}()
+	}
+	ta.mu.Unlock()
+
+	if te.attempts >= te.maxAttempts {
+		te.complete(nil, err)
+		return
+	}
+	ta.v.telemetry.recordAppendRetry(context.Background(), ta.tpid)
+	ta.rq <- te
+}
+
+// logStreamAppender returns the LogStreamAppender for the log stream, creating
+// it if it does not exist.
+func (ta *topicAppender) logStreamAppender(lsid types.LogStreamID) (LogStreamAppender, error) {
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	if lsa, ok := ta.lsas[lsid]; ok {
+		return lsa, nil
+	}
+	lsa, err := ta.v.newLogStreamAppender(context.Background(), ta.tpid, lsid, ta.lsaOpts...)
+	if err != nil {
+		return nil, err
+	}
+	ta.lsas[lsid] = lsa
+	return lsa, nil
+}
+
+func (ta *topicAppender) retryLoop() {
+	defer ta.wg.Done()
+	for te := range ta.rq {
+		ta.submit(te)
+	}
+}
+
+func (ta *topicAppender) callbackLoop() {
+	for te := range ta.cq {
+		<-te.done
+		cb := te.cb
+		if cb == nil {
+			cb = ta.defaultBatchCallback
+		}
+		if cb != nil {
+			cb(te.meta, te.err)
+		}
+	}
+}
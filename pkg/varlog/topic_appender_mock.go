@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/kakao/varlog/pkg/varlog (interfaces: TopicAppender)
+
+// Package varlog is a generated GoMock package.
+package varlog
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTopicAppender is a mock of TopicAppender interface.
+type MockTopicAppender struct {
+	ctrl     *gomock.Controller
+	recorder *MockTopicAppenderMockRecorder
+}
+
+// MockTopicAppenderMockRecorder is the mock recorder for MockTopicAppender.
+type MockTopicAppenderMockRecorder struct {
+	mock *MockTopicAppender
+}
+
+// NewMockTopicAppender creates a new mock instance.
+func NewMockTopicAppender(ctrl *gomock.Controller) *MockTopicAppender {
+	mock := &MockTopicAppender{ctrl: ctrl}
+	mock.recorder = &MockTopicAppenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopicAppender) EXPECT() *MockTopicAppenderMockRecorder {
+	return m.recorder
+}
+
+// AppendBatch mocks base method.
+func (m *MockTopicAppender) AppendBatch(arg0 [][]byte, arg1 BatchCallback, arg2 ...AppendOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AppendBatch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendBatch indicates an expected call of AppendBatch.
+func (mr *MockTopicAppenderMockRecorder) AppendBatch(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendBatch", reflect.TypeOf((*MockTopicAppender)(nil).AppendBatch), varargs...)
+}
+
+// Close mocks base method.
+func (m *MockTopicAppender) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockTopicAppenderMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTopicAppender)(nil).Close))
+}
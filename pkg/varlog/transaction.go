@@ -0,0 +1,277 @@
+package varlog
+
+//go:generate mockgen -package varlog -destination transaction_mock.go . Transaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/kakao/varlog/internal/compress"
+	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/varlogpb"
+)
+
+// Transaction appends log entries to several log streams atomically. Log
+// entries appended to the transaction become visible in all log streams by a
+// single commit of the metadata repository, or in none of them. A log
+// stream stages one transaction at a time.
+//
+// Transaction is not safe for concurrent use. It cannot be reused once it is
+// committed or aborted.
+type Transaction interface {
+	// Append buffers data to be appended to the log stream specified by
+	// the arguments tpid and lsid. Nothing is sent until Commit is called.
+	// Only WithLogEntryAttributes among AppendOptions is applicable.
+	// It returns an error wrapping verrors.ErrInvalid if the number of
+	// attributes does not match the data, or the transaction has already
+	// finished.
+	Append(tpid types.TopicID, lsid types.LogStreamID, data [][]byte, opts ...AppendOption) error
+
+	// Commit stages the buffered log entries in all participating log
+	// streams and waits for the metadata repository to commit them at
+	// once. The returned AppendResult has metadata of all log entries in
+	// the order of the participating log streams first appended and the
+	// data.
+	// If the transaction fails, Commit aborts the staged log entries, and
+	// the returned AppendResult has no metadata. The log streams that have
+	// staged the aborted transaction are sealed to discard it, hence, they
+	// should be unsealed before appending. The metadata repository also
+	// discards the transaction if it is not committed in time.
+	Commit(ctx context.Context) AppendResult
+
+	// Abort discards the buffered log entries.
+	Abort(ctx context.Context) error
+}
+
+type transactionBatch struct {
+	tpid  types.TopicID
+	lsid  types.LogStreamID
+	data  [][]byte
+	attrs []varlogpb.LogEntryAttributes
+}
+
+type transaction struct {
+	v        *logImpl
+	id       uint64
+	batches  []*transactionBatch
+	finished bool
+}
+
+var _ Transaction = (*transaction)(nil)
+
+func (v *logImpl) beginTransaction() (Transaction, error) {
+	if v.closed.Load() {
+		return nil, verrors.ErrClosed
+	}
+	id, err := newRandomID()
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	return &transaction{v: v, id: id}, nil
+}
+
+func (txn *transaction) Append(tpid types.TopicID, lsid types.LogStreamID, data [][]byte, opts ...AppendOption) error {
+	if txn.finished {
+		return fmt.Errorf("transaction: already finished: %w", verrors.ErrInvalid)
+	}
+
+	appendOpts := defaultAppendOptions()
+	for _, opt := range opts {
+		opt.apply(&appendOpts)
+	}
+	if len(appendOpts.attrs) > 0 && len(appendOpts.attrs) != len(data) {
+		return fmt.Errorf("transaction: %d attributes for %d data: %w", len(appendOpts.attrs), len(data), verrors.ErrInvalid)
+	}
+	data, attrs, err := compress.CompressBatch(txn.v.opts.compressionCodec, data, appendOpts.attrs)
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+	data, attrs, err = txn.v.payloadCipher.encryptBatch(context.Background(), data, attrs)
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+	appendOpts.attrs = attrs
+
+	var batch *transactionBatch
+	for _, b := range txn.batches {
+		if b.tpid == tpid && b.lsid == lsid {
+			batch = b
+			break
+		}
+	}
+	if batch == nil {
+		batch = &transactionBatch{tpid: tpid, lsid: lsid}
+		txn.batches = append(txn.batches, batch)
+	}
+
+	// Attributes should be either empty or as many as data in a request.
+	switch {
+	case len(appendOpts.attrs) > 0 && len(batch.attrs) < len(batch.data):
+		batch.attrs = append(batch.attrs, make([]varlogpb.LogEntryAttributes, len(batch.data)-len(batch.attrs))...)
+	case len(appendOpts.attrs) == 0 && len(batch.attrs) > 0:
+		appendOpts.attrs = make([]varlogpb.LogEntryAttributes, len(data))
+	}
+	batch.data = append(batch.data, data...)
+	batch.attrs = append(batch.attrs, appendOpts.attrs...)
+	return nil
+}
+
+func (txn *transaction) Commit(ctx context.Context) (result AppendResult) {
+	if txn.finished {
+		result.Err = fmt.Errorf("transaction: already finished: %w", verrors.ErrInvalid)
+		return result
+	}
+	txn.finished = true
+	if len(txn.batches) == 0 {
+		return result
+	}
+
+	participants := make([]varlogpb.TopicLogStream, 0, len(txn.batches))
+	for _, b := range txn.batches {
+		participants = append(participants, varlogpb.TopicLogStream{TopicID: b.tpid, LogStreamID: b.lsid})
+	}
+	if err := txn.v.commitTransaction(ctx, txn.id, participants); err != nil {
+		result.Err = err
+		return result
+	}
+
+	var (
+		wg        sync.WaitGroup
+		abortOnce sync.Once
+		metas     = make([][]varlogpb.LogEntryMeta, len(txn.batches))
+		errs      = make([]error, len(txn.batches))
+	)
+	wg.Add(len(txn.batches))
+	for i := range txn.batches {
+		go func(i int) {
+			defer wg.Done()
+			b := txn.batches[i]
+			metas[i], errs[i] = txn.v.appendInTransaction(ctx, b.tpid, b.lsid, txn.id, b.data, b.attrs)
+			if errs[i] != nil {
+				// Staged log entries in the other log streams cannot be
+				// committed anymore. Aborting them wakes up the appends
+				// waiting for the commit.
+				abortOnce.Do(func() {
+					txn.abortParticipants(ctx)
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// The transaction is committed if any participant succeeds since all
+	// participants are committed at once. Errors of the others come from
+	// lost responses.
+	committed := false
+	for i := range errs {
+		if errs[i] == nil {
+			committed = true
+			result.Metadata = append(result.Metadata, metas[i]...)
+		}
+	}
+	if err := multierr.Combine(errs...); err != nil {
+		if !committed {
+			result.Err = fmt.Errorf("transaction: %w", err)
+		} else {
+			result.Err = fmt.Errorf("transaction: committed, but some responses are lost: %w", err)
+		}
+	}
+	return result
+}
+
+func (txn *transaction) Abort(context.Context) error {
+	if txn.finished {
+		return fmt.Errorf("transaction: already finished: %w", verrors.ErrInvalid)
+	}
+	txn.finished = true
+	txn.batches = nil
+	return nil
+}
+
+// abortParticipants discards the staged transaction in all participants. It
+// ignores errors since the metadata repository eventually discards the
+// transaction.
+func (txn *transaction) abortParticipants(ctx context.Context) {
+	for _, b := range txn.batches {
+		if err := txn.v.abortTransaction(ctx, b.tpid, b.lsid, txn.id); err != nil {
+			txn.v.logger.Debug("could not abort transaction",
+				zap.Uint64("txid", txn.id),
+				zap.Int32("tpid", int32(b.tpid)),
+				zap.Int32("lsid", int32(b.lsid)),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (v *logImpl) commitTransaction(ctx context.Context, transactionID uint64, participants []varlogpb.TopicLogStream) error {
+	client, err := v.mrConnector.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+	if err := client.CommitTransaction(ctx, transactionID, participants); err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.AlreadyExists:
+			return fmt.Errorf("transaction: %s: %w", err.Error(), verrors.ErrInvalid)
+		case codes.NotFound:
+			return fmt.Errorf("transaction: %s: %w", err.Error(), verrors.ErrNotExist)
+		}
+		return fmt.Errorf("transaction: %w", multierr.Append(err, client.Close()))
+	}
+	return nil
+}
+
+// appendInTransaction sends data staged in the transaction to the primary
+// replica of the log stream, and waits for the transaction to be committed.
+func (v *logImpl) appendInTransaction(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, transactionID uint64, data [][]byte, attrs []varlogpb.LogEntryAttributes) ([]varlogpb.LogEntryMeta, error) {
+	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
+	if !ok {
+		return nil, fmt.Errorf("log stream %d of topic %d: %w", lsid, tpid, errNoLogStream)
+	}
+	cl, err := v.logCLManager.GetOrConnect(ctx, replicas[0].StorageNodeID, replicas[0].Address)
+	if err != nil {
+		return nil, fmt.Errorf("log stream %d of topic %d: %w", lsid, tpid, err)
+	}
+
+	res, err := cl.AppendInTransaction(ctx, tpid, lsid, transactionID, data, attrs...)
+	if err != nil {
+		if strings.Contains(err.Error(), "sealed") {
+			err = fmt.Errorf("%s: %w", err.Error(), verrors.ErrSealed)
+		}
+		return nil, fmt.Errorf("log stream %d of topic %d: %w", lsid, tpid, err)
+	}
+
+	metas := make([]varlogpb.LogEntryMeta, 0, len(res))
+	for idx := range res {
+		if len(res[idx].Error) > 0 {
+			err := errors.New(res[idx].Error)
+			if strings.Contains(res[idx].Error, "sealed") {
+				err = fmt.Errorf("%s: %w", res[idx].Error, verrors.ErrSealed)
+			}
+			return nil, fmt.Errorf("log stream %d of topic %d: %w", lsid, tpid, err)
+		}
+		metas = append(metas, res[idx].Meta)
+	}
+	return metas, nil
+}
+
+func (v *logImpl) abortTransaction(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID, transactionID uint64) error {
+	replicas, ok := v.replicasRetriever.Retrieve(tpid, lsid)
+	if !ok {
+		return errNoLogStream
+	}
+	cl, err := v.logCLManager.GetOrConnect(ctx, replicas[0].StorageNodeID, replicas[0].Address)
+	if err != nil {
+		return err
+	}
+	return cl.AbortTransaction(ctx, tpid, lsid, transactionID)
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 
 	"github.com/kakao/varlog/pkg/types"
+	"github.com/kakao/varlog/pkg/util/telemetry"
 	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -21,7 +24,16 @@ type trimArgument struct {
 	err           error
 }
 
-func (v *logImpl) trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts TrimOption) error {
+func (v *logImpl) trim(ctx context.Context, topicID types.TopicID, until types.GLSN, opts TrimOption) (err error) {
+	ctx, span := v.telemetry.startSpan(ctx, "varlog.Trim",
+		telemetry.TopicID(topicID),
+		attribute.Int64("varlog.trim.until", int64(until)),
+	)
+	defer func(start time.Time) {
+		v.telemetry.recordTrim(ctx, topicID, start)
+		endSpan(span, err)
+	}(time.Now())
+
 	trimArgs := createTrimArguments(v.replicasRetriever.All(topicID))
 	if len(trimArgs) == 0 {
 		return errors.New("no storage node")
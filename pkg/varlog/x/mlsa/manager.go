@@ -18,8 +18,8 @@ type managedLSA struct {
 
 var _ varlog.LogStreamAppender = (*managedLSA)(nil)
 
-func (m *managedLSA) AppendBatch(dataBatch [][]byte, callback varlog.BatchCallback) error {
-	return m.lsa.AppendBatch(dataBatch, callback)
+func (m *managedLSA) AppendBatch(dataBatch [][]byte, callback varlog.BatchCallback, opts ...varlog.AppendOption) error {
+	return m.lsa.AppendBatch(dataBatch, callback, opts...)
 }
 
 func (m *managedLSA) Close() {
@@ -3,6 +3,7 @@ package varlogtest
 import (
 	"context"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -145,6 +146,25 @@ func (c *testAdmin) GetTopic(ctx context.Context, tpid types.TopicID, opts ...va
 	panic("not implemented")
 }
 
+func (c *testAdmin) GetTopicByName(_ context.Context, name string, _ ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	if len(name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty name")
+	}
+
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	for tpid := range c.vt.topics {
+		topicDesc := c.vt.topics[tpid]
+		if topicDesc.Name == name && !topicDesc.Status.Deleted() {
+			return proto.Clone(&topicDesc).(*varlogpb.TopicDescriptor), nil
+		}
+	}
+	return nil, errors.WithMessagef(verrors.ErrNotExist, "topic %q", name)
+}
+
 func (c *testAdmin) ListTopics(ctx context.Context, opts ...varlog.AdminCallOption) ([]varlogpb.TopicDescriptor, error) {
 	panic("not implemented")
 }
@@ -164,22 +184,50 @@ func (c *testAdmin) Topics(ctx context.Context, opts ...varlog.AdminCallOption)
 }
 
 func (c *testAdmin) AddTopic(ctx context.Context, opts ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	return c.addTopic("", varlogpb.TopicConfig{})
+}
+
+func (c *testAdmin) AddNamedTopic(_ context.Context, name string, config varlogpb.TopicConfig, _ ...varlog.AdminCallOption) (*varlogpb.TopicDescriptor, error) {
+	if err := varlogpb.ValidateTopicName(name); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := config.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if config.ReplicationFactor > 0 && int(config.ReplicationFactor) < c.vt.replicationFactor {
+		return nil, status.Errorf(codes.InvalidArgument, "replication factor %d is less than that of the cluster %d", config.ReplicationFactor, c.vt.replicationFactor)
+	}
+	return c.addTopic(name, config)
+}
+
+func (c *testAdmin) addTopic(name string, config varlogpb.TopicConfig) (*varlogpb.TopicDescriptor, error) {
 	if err := c.lock(); err != nil {
 		return nil, err
 	}
 	defer c.unlock()
 
+	if len(name) > 0 {
+		for tpid, topicDesc := range c.vt.topics {
+			if topicDesc.Name == name && !topicDesc.Status.Deleted() {
+				return nil, status.Errorf(codes.AlreadyExists, "topic name %q is used by topic %d", name, tpid)
+			}
+		}
+	}
+
 	topicID := c.vt.generateTopicID()
 	topicDesc := varlogpb.TopicDescriptor{
 		TopicID:    topicID,
 		Status:     varlogpb.TopicStatusRunning,
 		LogStreams: nil,
+		Name:       name,
+		Config:     config,
 	}
 	c.vt.topics[topicID] = topicDesc
 	c.vt.trimGLSNs[topicID] = types.InvalidGLSN
 
 	invalidLogEntry := varlogpb.InvalidLogEntry()
 	c.vt.globalLogEntries[topicID] = []*varlogpb.LogEntry{&invalidLogEntry}
+	c.vt.commitTimes[topicID] = []time.Time{{}}
 
 	return proto.Clone(&topicDesc).(*varlogpb.TopicDescriptor), nil
 }
@@ -232,7 +280,15 @@ func (c *testAdmin) AddLogStream(_ context.Context, topicID types.TopicID, logSt
 		return nil, errors.New("no such topic")
 	}
 
-	if len(c.vt.storageNodes) < c.vt.replicationFactor {
+	if maxLogStreams := int(topicDesc.Config.MaxLogStreams); maxLogStreams > 0 && len(topicDesc.LogStreams) >= maxLogStreams {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many log streams in topic %d, limits %d", topicID, maxLogStreams)
+	}
+
+	replicationFactor := c.vt.replicationFactor
+	if topicDesc.Config.ReplicationFactor > 0 {
+		replicationFactor = int(topicDesc.Config.ReplicationFactor)
+	}
+	if len(c.vt.storageNodes) < replicationFactor {
 		return nil, errors.New("not enough storage nodes")
 	}
 
@@ -241,12 +297,12 @@ func (c *testAdmin) AddLogStream(_ context.Context, topicID types.TopicID, logSt
 		LogStreamID: logStreamID,
 		TopicID:     topicID,
 		Status:      varlogpb.LogStreamStatusRunning,
-		Replicas:    make([]*varlogpb.ReplicaDescriptor, c.vt.replicationFactor),
+		Replicas:    make([]*varlogpb.ReplicaDescriptor, replicationFactor),
 	}
 
 	if logStreamReplicas == nil {
 		snids := c.vt.storageNodeIDs()
-		for i, j := range c.vt.rng.Perm(len(snids))[:c.vt.replicationFactor] {
+		for i, j := range c.vt.rng.Perm(len(snids))[:replicationFactor] {
 			snid := snids[j]
 			snpath := c.vt.storageNodes[snid].Storages[0].Path
 			dataPath := filepath.Join(snpath, volume.LogStreamDirName(topicID, logStreamID))
@@ -493,6 +549,103 @@ func (c *testAdmin) RemoveMRPeer(ctx context.Context, raftURL string, opts ...va
 	panic("not implemented")
 }
 
+func (c *testAdmin) ListConsumerGroups(context.Context, ...varlog.AdminCallOption) ([]admpb.ConsumerGroupMetadata, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	ret := make([]admpb.ConsumerGroupMetadata, 0, len(c.vt.consumerGroups))
+	for _, cgd := range c.vt.consumerGroups {
+		ret = append(ret, c.vt.consumerGroupMetadata(cgd))
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+func (c *testAdmin) GetConsumerGroup(_ context.Context, group string, _ ...varlog.AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	cgd, ok := c.vt.consumerGroups[group]
+	if !ok {
+		return nil, errors.WithStack(verrors.ErrNotExist)
+	}
+	cgm := c.vt.consumerGroupMetadata(cgd)
+	return &cgm, nil
+}
+
+func (c *testAdmin) ResetConsumerGroupOffset(_ context.Context, group string, offset varlogpb.ConsumerGroupOffset, _ ...varlog.AdminCallOption) (*admpb.ConsumerGroupMetadata, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	if err := c.vt.commitConsumerGroupOffset(group, offset); err != nil {
+		return nil, err
+	}
+	cgm := c.vt.consumerGroupMetadata(c.vt.consumerGroups[group])
+	return &cgm, nil
+}
+
+func (c *testAdmin) DeleteConsumerGroup(_ context.Context, group string, _ ...varlog.AdminCallOption) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	if _, ok := c.vt.consumerGroups[group]; !ok {
+		return errors.WithStack(verrors.ErrNotExist)
+	}
+	delete(c.vt.consumerGroups, group)
+	return nil
+}
+
+func (c *testAdmin) RegisterSchema(_ context.Context, tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility, _ ...varlog.AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	return c.vt.registerSchema(tpid, typ, definition, compat)
+}
+
+func (c *testAdmin) GetSchema(_ context.Context, schemaID types.SchemaID, _ ...varlog.AdminCallOption) (*varlogpb.SchemaDescriptor, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	sd, ok := c.vt.lookupSchema(schemaID)
+	if !ok {
+		return nil, errors.WithStack(verrors.ErrNotExist)
+	}
+	return sd, nil
+}
+
+func (c *testAdmin) ListSchemas(_ context.Context, tpid types.TopicID, _ ...varlog.AdminCallOption) ([]varlogpb.SchemaDescriptor, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	topicDesc, ok := c.vt.topics[tpid]
+	if !ok || topicDesc.Status.Deleted() {
+		return nil, errors.WithStack(verrors.ErrNotExist)
+	}
+	ret := []varlogpb.SchemaDescriptor{}
+	for _, sd := range c.vt.schemas {
+		if sd.TopicID == tpid {
+			ret = append(ret, *proto.Clone(sd).(*varlogpb.SchemaDescriptor))
+		}
+	}
+	return ret, nil
+}
+
 func (c *testAdmin) Close() error {
 	c.vt.cond.L.Lock()
 	defer c.vt.cond.L.Unlock()
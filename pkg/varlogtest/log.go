@@ -3,7 +3,9 @@ package varlogtest
 import (
 	"context"
 	"io"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -53,7 +55,11 @@ func (c *testLog) Append(ctx context.Context, topicID types.TopicID, dataBatch [
 		return res
 	}
 	logStreamID := topicDesc.LogStreams[c.vt.rng.Intn(len(topicDesc.LogStreams))]
-	return c.appendTo(topicID, logStreamID, dataBatch)
+	if key, ok := varlog.SelectionKeyOf(opts...); ok {
+		// It neither waits for nor remaps a sealed log stream.
+		logStreamID = c.vt.lsSelector.SelectByKey(topicID, key, topicDesc.LogStreams)[0]
+	}
+	return c.appendTo(topicID, logStreamID, dataBatch, varlog.LogEntryAttributesOf(opts...))
 }
 
 func (c *testLog) AppendTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte, opts ...varlog.AppendOption) (res varlog.AppendResult) {
@@ -63,10 +69,15 @@ func (c *testLog) AppendTo(ctx context.Context, topicID types.TopicID, logStream
 	}
 	defer c.unlock()
 
-	return c.appendTo(topicID, logStreamID, dataBatch)
+	return c.appendTo(topicID, logStreamID, dataBatch, varlog.LogEntryAttributesOf(opts...))
 }
 
-func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte) (res varlog.AppendResult) {
+func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID, dataBatch [][]byte, attrs []varlogpb.LogEntryAttributes) (res varlog.AppendResult) {
+	if len(attrs) > 0 && len(attrs) != len(dataBatch) {
+		res.Err = errors.Wrapf(verrors.ErrInvalid, "%d attributes for %d data", len(attrs), len(dataBatch))
+		return res
+	}
+
 	logStreamDesc, err := c.vt.logStreamDescriptor(topicID, logStreamID)
 	if err != nil {
 		res.Err = err
@@ -88,7 +99,8 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 	_, tail := c.vt.peek(topicID, logStreamID)
 	lastLLSN := tail.LLSN
 
-	for _, data := range dataBatch {
+	commitTime := time.Now()
+	for i, data := range dataBatch {
 		lastGLSN++
 		lastLLSN++
 		logEntry := &varlogpb.LogEntry{
@@ -101,8 +113,12 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 			Data: make([]byte, len(data)),
 		}
 		copy(logEntry.Data, data)
+		if len(attrs) > 0 {
+			logEntry.LogEntryAttributes = attrs[i].Clone()
+		}
 
 		c.vt.globalLogEntries[topicID] = append(c.vt.globalLogEntries[topicID], logEntry)
+		c.vt.commitTimes[topicID] = append(c.vt.commitTimes[topicID], commitTime)
 		c.vt.localLogEntries[logStreamID] = append(c.vt.localLogEntries[logStreamID], logEntry)
 		res.Metadata = append(res.Metadata, logEntry.LogEntryMeta)
 	}
@@ -111,32 +127,86 @@ func (c *testLog) appendTo(topicID types.TopicID, logStreamID types.LogStreamID,
 	return res
 }
 
+// Read returns the data of the log entry at the glsn. It is the same as ReadAt
+// except that it returns only the data.
 func (c *testLog) Read(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, glsn types.GLSN) ([]byte, error) {
-	if err := c.lock(); err != nil {
+	le, err := c.ReadAt(ctx, topicID, logStreamID, glsn)
+	if err != nil {
 		return nil, err
 	}
+	return le.Data, nil
+}
+
+func (c *testLog) ReadAt(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, glsn types.GLSN) (le varlogpb.LogEntry, err error) {
+	if err := c.lock(); err != nil {
+		return le, err
+	}
 	defer c.unlock()
 
 	topicDesc, err := c.vt.topicDescriptor(topicID)
 	if err != nil {
-		return nil, err
+		return le, err
 	}
 	if !topicDesc.HasLogStream(logStreamID) {
-		return nil, errors.New("no such log stream in the topic")
+		return le, errors.New("no such log stream in the topic")
 	}
 
-	n := len(c.vt.globalLogEntries[topicID])
-	if c.vt.globalLogEntries[topicID][n-1].GLSN < glsn {
-		// NOTE: This differs from the real varlog.
-		return nil, errors.New("no such log entry")
+	if glsn.Invalid() {
+		return le, errors.Wrapf(verrors.ErrInvalid, "invalid glsn %d", glsn)
 	}
-	data := make([]byte, len(c.vt.globalLogEntries[topicID][glsn].Data))
-	copy(data, c.vt.globalLogEntries[topicID][glsn].Data)
-	return data, nil
+	if glsn <= c.vt.trimGLSNs[topicID] {
+		return le, errors.WithStack(verrors.ErrTrimmed)
+	}
+
+	logEntries := c.vt.globalLogEntries[topicID]
+	n := len(logEntries)
+	if logEntries[n-1].GLSN < glsn || logEntries[glsn].LogStreamID != logStreamID {
+		return le, errors.WithStack(verrors.ErrNoEntry)
+	}
+	logEntry := logEntries[glsn]
+	le = varlogpb.LogEntry{
+		LogEntryMeta:       logEntry.LogEntryMeta,
+		Data:               make([]byte, len(logEntry.Data)),
+		LogEntryAttributes: logEntry.LogEntryAttributes.Clone(),
+	}
+	copy(le.Data, logEntry.Data)
+	return le, nil
 }
 
 func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin types.GLSN, end types.GLSN, onNextFunc varlog.OnNext, opts ...varlog.SubscribeOption) (varlog.SubscribeCloser, error) {
-	if begin >= end {
+	copiedLogEntries, err := c.copyGlobalLogEntries(topicID, begin, end, varlog.StartPositionOf(opts...), varlog.FilterOf(opts...))
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, logEntry := range copiedLogEntries {
+			onNextFunc(logEntry, nil)
+		}
+		onNextFunc(varlogpb.InvalidLogEntry(), io.EOF)
+	}()
+
+	return func() {
+		wg.Wait()
+	}, nil
+}
+
+func (c *testLog) SubscribeIterator(ctx context.Context, topicID types.TopicID, begin, end types.GLSN, opts ...varlog.SubscribeOption) (varlog.TopicIterator, error) {
+	copiedLogEntries, err := c.copyGlobalLogEntries(topicID, begin, end, varlog.StartPositionOf(opts...), varlog.FilterOf(opts...))
+	if err != nil {
+		return nil, err
+	}
+	return &topicIterator{logEntries: copiedLogEntries}, nil
+}
+
+// copyGlobalLogEntries returns copies of log entries of the topic in the range
+// [begin, end) that match the filter. The range is clipped by the last log
+// entry. If the argument pos is set, it decides the argument begin.
+func (c *testLog) copyGlobalLogEntries(topicID types.TopicID, begin, end types.GLSN, pos varlog.StartPosition, filter *varlogpb.LogEntryFilter) ([]varlogpb.LogEntry, error) {
+	if pos == 0 && begin >= end {
 		return nil, errors.New("invalid range")
 	}
 
@@ -150,12 +220,27 @@ func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin ty
 		return nil, err
 	}
 
+	logEntries := c.vt.globalLogEntries[topicID]
+	n := len(logEntries)
+	switch pos {
+	case 0:
+	case varlog.StartPositionEarliest:
+		begin = c.vt.trimGLSNs[topicID] + 1
+	case varlog.StartPositionLatest:
+		begin = logEntries[n-1].GLSN + 1
+	default:
+		return nil, errors.Wrapf(verrors.ErrInvalid, "invalid start position %d", pos)
+	}
+	if pos != 0 && (begin >= end || logEntries[n-1].GLSN < begin) {
+		// Since the subscription is a snapshot of the topic, there is
+		// nothing to subscribe to after the last log entry.
+		return nil, nil
+	}
+
 	if c.vt.trimGLSNs[topicID] >= begin {
 		return nil, errors.New("trimmed")
 	}
 
-	logEntries := c.vt.globalLogEntries[topicID]
-	n := len(logEntries)
 	if logEntries[n-1].GLSN < begin {
 		// NOTE: This differs from the real varlog.
 		return nil, errors.New("no such log entry")
@@ -170,6 +255,9 @@ func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin ty
 
 	copiedLogEntries := make([]varlogpb.LogEntry, 0, end-begin)
 	for glsn := begin; glsn < end; glsn++ {
+		if !filter.Match(logEntries[glsn].LogEntryAttributes) {
+			continue
+		}
 		logEntry := varlogpb.LogEntry{
 			LogEntryMeta: varlogpb.LogEntryMeta{
 				TopicID:     logEntries[glsn].TopicID,
@@ -180,26 +268,20 @@ func (c *testLog) Subscribe(ctx context.Context, topicID types.TopicID, begin ty
 			Data: make([]byte, len(logEntries[glsn].Data)),
 		}
 		copy(logEntry.Data, logEntries[glsn].Data)
+		logEntry.LogEntryAttributes = logEntries[glsn].LogEntryAttributes.Clone()
 		copiedLogEntries = append(copiedLogEntries, logEntry)
 	}
-
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for _, logEntry := range copiedLogEntries {
-			onNextFunc(logEntry, nil)
-		}
-		onNextFunc(varlogpb.InvalidLogEntry(), io.EOF)
-	}()
-
-	return func() {
-		wg.Wait()
-	}, nil
+	return copiedLogEntries, nil
 }
 
 func (c *testLog) SubscribeTo(ctx context.Context, topicID types.TopicID, logStreamID types.LogStreamID, begin, end types.LLSN, opts ...varlog.SubscribeOption) varlog.Subscriber {
-	if begin >= end {
+	pos := varlog.StartPositionOf(opts...)
+	if pos != 0 {
+		// It is resolved below.
+		begin = types.MinLLSN
+	}
+
+	if pos == 0 && begin >= end {
 		return newErrSubscriber(errors.New("invalid range: begin should be greater than end"))
 	}
 
@@ -226,6 +308,22 @@ func (c *testLog) SubscribeTo(ctx context.Context, topicID types.TopicID, logStr
 		return newErrSubscriber(errors.New("no such log stream"))
 	}
 
+	switch pos {
+	case 0:
+	case varlog.StartPositionEarliest:
+		// The first log entry is invalid.
+		begin = types.LLSN(sort.Search(len(localLogEntries)-1, func(i int) bool {
+			return localLogEntries[i+1].GLSN > c.vt.trimGLSNs[topicID]
+		}) + 1)
+	case varlog.StartPositionLatest:
+		begin = types.LLSN(len(localLogEntries))
+	default:
+		return newErrSubscriber(errors.Wrapf(verrors.ErrInvalid, "invalid start position %d", pos))
+	}
+	if begin >= end {
+		return newErrSubscriber(io.EOF)
+	}
+
 	if len(localLogEntries) > int(begin) && localLogEntries[begin].GLSN <= c.vt.trimGLSNs[topicID] {
 		return newErrSubscriber(verrors.ErrTrimmed)
 	}
@@ -234,6 +332,7 @@ func (c *testLog) SubscribeTo(ctx context.Context, topicID types.TopicID, logStr
 		quit:   make(chan struct{}),
 		end:    end,
 		cursor: begin,
+		filter: varlog.FilterOf(opts...),
 	}
 	s.contextError = func() error {
 		return ctx.Err()
@@ -269,6 +368,30 @@ func (c *testLog) Trim(ctx context.Context, topicID types.TopicID, until types.G
 	panic("not implemented")
 }
 
+func (c *testLog) LookupGLSNByTime(_ context.Context, tpid types.TopicID, t time.Time) (types.GLSN, error) {
+	if err := c.lock(); err != nil {
+		return types.InvalidGLSN, err
+	}
+	defer c.unlock()
+
+	if _, err := c.vt.topicDescriptor(tpid); err != nil {
+		return types.InvalidGLSN, err
+	}
+
+	// Log entries up to the trimmed GLSN cannot be found.
+	commitTimes := c.vt.commitTimes[tpid]
+	begin := int(c.vt.trimGLSNs[tpid]) + 1
+	if begin < len(commitTimes) {
+		idx := begin + sort.Search(len(commitTimes)-begin, func(i int) bool {
+			return !commitTimes[begin+i].Before(t)
+		})
+		if idx < len(commitTimes) {
+			return c.vt.globalLogEntries[tpid][idx].GLSN, nil
+		}
+	}
+	return types.InvalidGLSN, errors.WithStack(verrors.ErrNoEntry)
+}
+
 func (c *testLog) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid types.LogStreamID) (first varlogpb.LogSequenceNumber, last varlogpb.LogSequenceNumber, err error) {
 	if err = c.lock(); err != nil {
 		return first, last, err
@@ -297,6 +420,37 @@ func (c *testLog) PeekLogStream(ctx context.Context, tpid types.TopicID, lsid ty
 
 }
 
+func (c *testLog) PeekTopic(ctx context.Context, tpid types.TopicID) (varlog.TopicBoundary, error) {
+	if err := c.lock(); err != nil {
+		return varlog.TopicBoundary{}, err
+	}
+	defer c.unlock()
+
+	topicDesc, ok := c.vt.topics[tpid]
+	if !ok || topicDesc.Status.Deleted() {
+		return varlog.TopicBoundary{}, errors.Wrap(verrors.ErrNotExist, "no such topic")
+	}
+
+	tb := varlog.TopicBoundary{
+		GlobalHighWatermark: c.vt.globalHighWatermark(tpid),
+		LogStreams:          make(map[types.LogStreamID]varlog.LogStreamBoundary, len(topicDesc.LogStreams)),
+	}
+	for _, lsid := range topicDesc.LogStreams {
+		head, tail := c.vt.peek(tpid, lsid)
+		tb.LogStreams[lsid] = varlog.LogStreamBoundary{
+			First: varlogpb.LogSequenceNumber{LLSN: head.LLSN, GLSN: head.GLSN},
+			Last:  varlogpb.LogSequenceNumber{LLSN: tail.LLSN, GLSN: tail.GLSN},
+		}
+		if !head.GLSN.Invalid() && (tb.First.Invalid() || head.GLSN < tb.First) {
+			tb.First = head.GLSN
+		}
+		if tb.Last < tail.GLSN {
+			tb.Last = tail.GLSN
+		}
+	}
+	return tb, nil
+}
+
 func (c *testLog) AppendableLogStreams(tpid types.TopicID) map[types.LogStreamID]struct{} {
 	if err := c.lock(); err != nil {
 		return nil
@@ -315,6 +469,41 @@ func (c *testLog) AppendableLogStreams(tpid types.TopicID) map[types.LogStreamID
 	return ret
 }
 
+func (c *testLog) CommitOffset(_ context.Context, group string, offset varlogpb.ConsumerGroupOffset) error {
+	if err := c.lock(); err != nil {
+		return err
+	}
+	defer c.unlock()
+
+	return c.vt.commitConsumerGroupOffset(group, offset)
+}
+
+func (c *testLog) FetchOffset(_ context.Context, group string, tpid types.TopicID, lsid types.LogStreamID) (varlogpb.ConsumerGroupOffset, error) {
+	if err := c.lock(); err != nil {
+		return varlogpb.ConsumerGroupOffset{}, err
+	}
+	defer c.unlock()
+
+	offset, ok := c.vt.consumerGroups[group].GetOffset(tpid, lsid)
+	if !ok {
+		return varlogpb.ConsumerGroupOffset{}, errors.WithStack(verrors.ErrNotExist)
+	}
+	return offset, nil
+}
+
+func (c *testLog) GetSchema(_ context.Context, schemaID types.SchemaID) (varlogpb.SchemaDescriptor, error) {
+	if err := c.lock(); err != nil {
+		return varlogpb.SchemaDescriptor{}, err
+	}
+	defer c.unlock()
+
+	sd, ok := c.vt.lookupSchema(schemaID)
+	if !ok {
+		return varlogpb.SchemaDescriptor{}, errors.WithStack(verrors.ErrNotExist)
+	}
+	return *sd, nil
+}
+
 // NewLogStreamAppender returns a new fake LogStreamAppender for testing. It
 // ignores options; the pipeline size is five, and the default callback has no
 // operation.
@@ -331,10 +520,64 @@ func (c *testLog) NewLogStreamAppender(tpid types.TopicID, lsid types.LogStreamI
 		return nil, err
 	}
 
+	lsa := c.newLogStreamAppender(pipelineSize, func(dataBatch [][]byte, opts ...varlog.AppendOption) varlog.AppendResult {
+		return c.AppendTo(context.Background(), tpid, lsid, dataBatch, opts...)
+	})
+	return lsa, nil
+}
+
+// NewTopicAppender returns a new fake TopicAppender for testing. It ignores
+// options; the pipeline size is five, and the default callback has no
+// operation. It appends each batch to one of the log streams of the topic
+// chosen at random, skipping sealed ones.
+func (c *testLog) NewTopicAppender(tpid types.TopicID, _ ...varlog.LogStreamAppenderOption) (varlog.TopicAppender, error) {
+	const pipelineSize = 5
+
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	_, err := c.vt.topicDescriptor(tpid)
+	if err != nil {
+		return nil, err
+	}
+
+	ta := c.newLogStreamAppender(pipelineSize, func(dataBatch [][]byte, opts ...varlog.AppendOption) varlog.AppendResult {
+		return c.appendToTopic(tpid, dataBatch, opts...)
+	})
+	return ta, nil
+}
+
+func (c *testLog) appendToTopic(tpid types.TopicID, dataBatch [][]byte, opts ...varlog.AppendOption) (res varlog.AppendResult) {
+	if err := c.lock(); err != nil {
+		res.Err = err
+		return res
+	}
+	defer c.unlock()
+
+	topicDesc, err := c.vt.topicDescriptor(tpid)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	lsids := append([]types.LogStreamID(nil), topicDesc.LogStreams...)
+	c.vt.rng.Shuffle(len(lsids), func(i, j int) {
+		lsids[i], lsids[j] = lsids[j], lsids[i]
+	})
+	res.Err = errors.Errorf("no log stream in topic %d", tpid)
+	for _, lsid := range lsids {
+		res = c.appendTo(tpid, lsid, dataBatch, varlog.LogEntryAttributesOf(opts...))
+		if !errors.Is(res.Err, verrors.ErrSealed) {
+			break
+		}
+	}
+	return res
+}
+
+func (c *testLog) newLogStreamAppender(pipelineSize int, appendFunc func([][]byte, ...varlog.AppendOption) varlog.AppendResult) *logStreamAppender {
 	lsa := &logStreamAppender{
-		c:               c,
-		tpid:            tpid,
-		lsid:            lsid,
+		appendFunc:      appendFunc,
 		pipelineSize:    pipelineSize,
 		defaultCallback: func([]varlogpb.LogEntryMeta, error) {},
 	}
@@ -345,14 +588,13 @@ func (c *testLog) NewLogStreamAppender(tpid types.TopicID, lsid types.LogStreamI
 	go func() {
 		defer lsa.wg.Done()
 		for qe := range lsa.queue.ch {
-			qe.callback(qe.result.Metadata, nil)
+			qe.callback(qe.result.Metadata, qe.result.Err)
 			lsa.queue.cv.L.Lock()
 			lsa.queue.cv.Broadcast()
 			lsa.queue.cv.L.Unlock()
 		}
 	}()
-
-	return lsa, nil
+	return lsa
 }
 
 type queueEntry struct {
@@ -360,10 +602,11 @@ type queueEntry struct {
 	result   varlog.AppendResult
 }
 
+// logStreamAppender is a fake LogStreamAppender and TopicAppender. It
+// appends batches by using appendFunc synchronously and calls callbacks
+// asynchronously.
 type logStreamAppender struct {
-	c               *testLog
-	tpid            types.TopicID
-	lsid            types.LogStreamID
+	appendFunc      func([][]byte, ...varlog.AppendOption) varlog.AppendResult
 	pipelineSize    int
 	defaultCallback varlog.BatchCallback
 
@@ -380,9 +623,12 @@ type logStreamAppender struct {
 	wg sync.WaitGroup
 }
 
-var _ varlog.LogStreamAppender = (*logStreamAppender)(nil)
+var (
+	_ varlog.LogStreamAppender = (*logStreamAppender)(nil)
+	_ varlog.TopicAppender     = (*logStreamAppender)(nil)
+)
 
-func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback varlog.BatchCallback) error {
+func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback varlog.BatchCallback, opts ...varlog.AppendOption) error {
 	lsa.closed.Lock()
 	defer lsa.closed.Unlock()
 
@@ -390,6 +636,10 @@ func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback varlog.Ba
 		return varlog.ErrClosed
 	}
 
+	if attrs := varlog.LogEntryAttributesOf(opts...); len(attrs) > 0 && len(attrs) != len(dataBatch) {
+		return errors.Wrapf(verrors.ErrInvalid, "%d attributes for %d data", len(attrs), len(dataBatch))
+	}
+
 	lsa.queue.cv.L.Lock()
 	defer lsa.queue.cv.L.Unlock()
 
@@ -400,7 +650,7 @@ func (lsa *logStreamAppender) AppendBatch(dataBatch [][]byte, callback varlog.Ba
 	qe := &queueEntry{
 		callback: callback,
 	}
-	qe.result = lsa.c.AppendTo(context.Background(), lsa.tpid, lsa.lsid, dataBatch)
+	qe.result = lsa.appendFunc(dataBatch, opts...)
 	if qe.callback == nil {
 		qe.callback = lsa.defaultCallback
 	}
@@ -419,6 +669,106 @@ func (lsa *logStreamAppender) Close() {
 	lsa.wg.Wait()
 }
 
+// BeginTransaction returns a new fake Transaction for testing. It appends all
+// buffered log entries at once under the lock of the fake cluster when
+// committed.
+func (c *testLog) BeginTransaction() (varlog.Transaction, error) {
+	if err := c.lock(); err != nil {
+		return nil, err
+	}
+	defer c.unlock()
+
+	return &transaction{c: c}, nil
+}
+
+type transactionBatch struct {
+	tpid  types.TopicID
+	lsid  types.LogStreamID
+	data  [][]byte
+	attrs []varlogpb.LogEntryAttributes
+}
+
+type transaction struct {
+	c        *testLog
+	batches  []*transactionBatch
+	finished bool
+}
+
+var _ varlog.Transaction = (*transaction)(nil)
+
+func (txn *transaction) Append(tpid types.TopicID, lsid types.LogStreamID, data [][]byte, opts ...varlog.AppendOption) error {
+	if txn.finished {
+		return errors.Wrap(verrors.ErrInvalid, "transaction already finished")
+	}
+	attrs := varlog.LogEntryAttributesOf(opts...)
+	if len(attrs) > 0 && len(attrs) != len(data) {
+		return errors.Wrapf(verrors.ErrInvalid, "%d attributes for %d data", len(attrs), len(data))
+	}
+
+	var batch *transactionBatch
+	for _, b := range txn.batches {
+		if b.tpid == tpid && b.lsid == lsid {
+			batch = b
+			break
+		}
+	}
+	if batch == nil {
+		batch = &transactionBatch{tpid: tpid, lsid: lsid}
+		txn.batches = append(txn.batches, batch)
+	}
+	switch {
+	case len(attrs) > 0 && len(batch.attrs) < len(batch.data):
+		batch.attrs = append(batch.attrs, make([]varlogpb.LogEntryAttributes, len(batch.data)-len(batch.attrs))...)
+	case len(attrs) == 0 && len(batch.attrs) > 0:
+		attrs = make([]varlogpb.LogEntryAttributes, len(data))
+	}
+	batch.data = append(batch.data, data...)
+	batch.attrs = append(batch.attrs, attrs...)
+	return nil
+}
+
+func (txn *transaction) Commit(context.Context) (res varlog.AppendResult) {
+	if txn.finished {
+		res.Err = errors.Wrap(verrors.ErrInvalid, "transaction already finished")
+		return res
+	}
+	txn.finished = true
+
+	if err := txn.c.lock(); err != nil {
+		res.Err = err
+		return res
+	}
+	defer txn.c.unlock()
+
+	// All participants are checked before appending anything so that
+	// either all log entries are appended or none of them.
+	for _, b := range txn.batches {
+		lsd, err := txn.c.vt.logStreamDescriptor(b.tpid, b.lsid)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		if lsd.Status.Sealed() {
+			res.Err = errors.Wrap(verrors.ErrSealed, "could not commit transaction")
+			return res
+		}
+	}
+	for _, b := range txn.batches {
+		r := txn.c.appendTo(b.tpid, b.lsid, b.data, b.attrs)
+		res.Metadata = append(res.Metadata, r.Metadata...)
+	}
+	return res
+}
+
+func (txn *transaction) Abort(context.Context) error {
+	if txn.finished {
+		return errors.Wrap(verrors.ErrInvalid, "transaction already finished")
+	}
+	txn.finished = true
+	txn.batches = nil
+	return nil
+}
+
 type errSubscriber struct {
 	err error
 }
@@ -435,9 +785,45 @@ func (s errSubscriber) Close() error {
 	return s.err
 }
 
+type topicIterator struct {
+	logEntries []varlogpb.LogEntry
+	cursor     int
+
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ varlog.TopicIterator = (*topicIterator)(nil)
+
+func (it *topicIterator) Next(ctx context.Context) (varlogpb.LogEntry, error) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+
+	if it.closed {
+		return varlogpb.InvalidLogEntry(), verrors.ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		return varlogpb.InvalidLogEntry(), err
+	}
+	if it.cursor == len(it.logEntries) {
+		return varlogpb.InvalidLogEntry(), io.EOF
+	}
+	logEntry := it.logEntries[it.cursor]
+	it.cursor++
+	return logEntry, nil
+}
+
+func (it *topicIterator) Close() error {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.closed = true
+	return nil
+}
+
 type subscriberImpl struct {
 	end    types.LLSN
 	cursor types.LLSN
+	filter *varlogpb.LogEntryFilter
 
 	quit         chan struct{}
 	contextError func() error
@@ -458,15 +844,23 @@ type subscriberImpl struct {
 }
 
 func (s *subscriberImpl) Next() (varlogpb.LogEntry, error) {
-	logEntry, err := s.next()
-	if err != nil {
-		s.setErr(err)
-		return varlogpb.InvalidLogEntry(), err
-	}
-	if s.cursor == s.end {
-		s.setErr(io.EOF)
+	for {
+		logEntry, err := s.next()
+		if err != nil {
+			s.setErr(err)
+			return varlogpb.InvalidLogEntry(), err
+		}
+		matched := s.filter.Match(logEntry.LogEntryAttributes)
+		if s.cursor == s.end {
+			s.setErr(io.EOF)
+			if !matched {
+				return varlogpb.InvalidLogEntry(), io.EOF
+			}
+		}
+		if matched {
+			return logEntry, nil
+		}
 	}
-	return logEntry, nil
 }
 
 func (s *subscriberImpl) Close() error {
@@ -522,6 +916,7 @@ func (s *subscriberImpl) next() (logEntry varlogpb.LogEntry, err error) {
 		Data: make([]byte, len(logEntries[s.cursor].Data)),
 	}
 	copy(logEntry.Data, logEntries[s.cursor].Data)
+	logEntry.LogEntryAttributes = logEntries[s.cursor].LogEntryAttributes.Clone()
 	s.cursor++
 	return logEntry, nil
 }
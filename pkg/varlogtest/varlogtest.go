@@ -1,15 +1,22 @@
 package varlogtest
 
 import (
+	"bytes"
 	"math/rand"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 
+	"github.com/kakao/varlog/internal/schema"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/varlog"
+	"github.com/kakao/varlog/pkg/verrors"
+	"github.com/kakao/varlog/proto/admpb"
 	"github.com/kakao/varlog/proto/snpb"
 	"github.com/kakao/varlog/proto/varlogpb"
 )
@@ -21,7 +28,8 @@ type VarlogTest struct {
 	clusterID         types.ClusterID
 	replicationFactor int
 
-	rng *rand.Rand
+	rng        *rand.Rand
+	lsSelector varlog.KeyedLogStreamSelector
 
 	mu               sync.Mutex
 	cond             *sync.Cond
@@ -30,8 +38,12 @@ type VarlogTest struct {
 	topics           map[types.TopicID]varlogpb.TopicDescriptor
 	globalLogEntries map[types.TopicID][]*varlogpb.LogEntry
 	localLogEntries  map[types.LogStreamID][]*varlogpb.LogEntry
+	commitTimes      map[types.TopicID][]time.Time
 	version          types.Version
 	trimGLSNs        map[types.TopicID]types.GLSN
+	consumerGroups   map[string]*varlogpb.ConsumerGroupDescriptor
+	// schemas are indexed by their identifiers minus one.
+	schemas []*varlogpb.SchemaDescriptor
 
 	nextTopicID       types.TopicID
 	nextStorageNodeID types.StorageNodeID
@@ -46,12 +58,15 @@ func New(clusterID types.ClusterID, replicationFactor int) *VarlogTest {
 		clusterID:         clusterID,
 		replicationFactor: replicationFactor,
 		rng:               rand.New(rand.NewSource(time.Now().UnixMilli())),
+		lsSelector:        varlog.NewConsistentHashSelector(0),
 		storageNodes:      make(map[types.StorageNodeID]snpb.StorageNodeMetadataDescriptor),
 		logStreams:        make(map[types.LogStreamID]varlogpb.LogStreamDescriptor),
 		topics:            make(map[types.TopicID]varlogpb.TopicDescriptor),
 		globalLogEntries:  make(map[types.TopicID][]*varlogpb.LogEntry),
 		localLogEntries:   make(map[types.LogStreamID][]*varlogpb.LogEntry),
+		commitTimes:       make(map[types.TopicID][]time.Time),
 		trimGLSNs:         make(map[types.TopicID]types.GLSN),
+		consumerGroups:    make(map[string]*varlogpb.ConsumerGroupDescriptor),
 	}
 	vt.cond = sync.NewCond(&vt.mu)
 	vt.admin = &testAdmin{vt: vt}
@@ -136,11 +151,16 @@ func (vt *VarlogTest) peek(topicID types.TopicID, logStreamID types.LogStreamID)
 		idx++
 	}
 
-	head.GLSN = vt.localLogEntries[logStreamID][idx].GLSN
-	head.LLSN = vt.localLogEntries[logStreamID][idx].LLSN
 	lastIdx := len(vt.localLogEntries[logStreamID]) - 1
 	tail.GLSN = vt.localLogEntries[logStreamID][lastIdx].GLSN
 	tail.LLSN = vt.localLogEntries[logStreamID][lastIdx].LLSN
+	// All log entries in the log stream are trimmed.
+	if idx > lastIdx {
+		return head, tail
+	}
+
+	head.GLSN = vt.localLogEntries[logStreamID][idx].GLSN
+	head.LLSN = vt.localLogEntries[logStreamID][idx].LLSN
 	return head, tail
 }
 
@@ -148,3 +168,103 @@ func (vt *VarlogTest) globalHighWatermark(topicID types.TopicID) types.GLSN {
 	lastIdx := len(vt.globalLogEntries[topicID]) - 1
 	return vt.globalLogEntries[topicID][lastIdx].GLSN
 }
+
+func (vt *VarlogTest) commitConsumerGroupOffset(group string, offset varlogpb.ConsumerGroupOffset) error {
+	if group == "" {
+		return errors.Wrap(verrors.ErrInvalid, "empty consumer group")
+	}
+	if err := offset.Validate(); err != nil {
+		return errors.Wrap(verrors.ErrInvalid, err.Error())
+	}
+	topicDesc, ok := vt.topics[offset.TopicID]
+	if !ok || topicDesc.Status.Deleted() {
+		return errors.Wrap(verrors.ErrNotExist, "no such topic")
+	}
+	if !offset.TopicLevel() && !topicDesc.HasLogStream(offset.LogStreamID) {
+		return errors.Wrap(verrors.ErrNotExist, "no such log stream in the topic")
+	}
+
+	cgd, ok := vt.consumerGroups[group]
+	if !ok {
+		cgd = &varlogpb.ConsumerGroupDescriptor{Name: group}
+		vt.consumerGroups[group] = cgd
+	}
+	offset.UpdateTime = time.Now().UTC()
+	cgd.UpsertOffset(offset)
+	return nil
+}
+
+func (vt *VarlogTest) consumerGroupMetadata(cgd *varlogpb.ConsumerGroupDescriptor) admpb.ConsumerGroupMetadata {
+	cgm := admpb.ConsumerGroupMetadata{
+		Name:    cgd.Name,
+		Offsets: make([]admpb.ConsumerGroupOffsetLag, 0, len(cgd.Offsets)),
+	}
+	for _, offset := range cgd.Offsets {
+		var hwm, committed uint64
+		if offset.TopicLevel() {
+			hwm, committed = uint64(vt.globalHighWatermark(offset.TopicID)), uint64(offset.GLSN)
+		} else {
+			_, tail := vt.peek(offset.TopicID, offset.LogStreamID)
+			hwm, committed = uint64(tail.LLSN), uint64(offset.LLSN)
+		}
+		lag := uint64(0)
+		if hwm > committed {
+			lag = hwm - committed
+		}
+		cgm.Offsets = append(cgm.Offsets, admpb.ConsumerGroupOffsetLag{
+			Offset:        offset,
+			HighWatermark: hwm,
+			Lag:           lag,
+		})
+	}
+	return cgm
+}
+
+func (vt *VarlogTest) registerSchema(tpid types.TopicID, typ varlogpb.SchemaType, definition []byte, compat varlogpb.SchemaCompatibility) (*varlogpb.SchemaDescriptor, error) {
+	if err := schema.Validate(typ, definition); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "register schema: %s", err.Error())
+	}
+	topicDesc, ok := vt.topics[tpid]
+	if !ok || topicDesc.Status.Deleted() {
+		return nil, errors.Wrap(verrors.ErrNotExist, "no such topic")
+	}
+
+	var latest *varlogpb.SchemaDescriptor
+	for _, sd := range vt.schemas {
+		if sd.TopicID != tpid {
+			continue
+		}
+		if sd.Type == typ && bytes.Equal(sd.Definition, definition) {
+			return proto.Clone(sd).(*varlogpb.SchemaDescriptor), nil
+		}
+		latest = sd
+	}
+
+	sd := &varlogpb.SchemaDescriptor{
+		SchemaID:   types.SchemaID(len(vt.schemas) + 1),
+		TopicID:    tpid,
+		Version:    1,
+		Type:       typ,
+		Definition: definition,
+		CreateTime: time.Now().UTC(),
+	}
+	if latest != nil {
+		if err := schema.CheckCompatibility(compat, latest, sd); err != nil {
+			code := codes.InvalidArgument
+			if errors.Is(err, schema.ErrIncompatible) {
+				code = codes.FailedPrecondition
+			}
+			return nil, status.Errorf(code, "register schema: %s", err.Error())
+		}
+		sd.Version = latest.Version + 1
+	}
+	vt.schemas = append(vt.schemas, sd)
+	return proto.Clone(sd).(*varlogpb.SchemaDescriptor), nil
+}
+
+func (vt *VarlogTest) lookupSchema(schemaID types.SchemaID) (*varlogpb.SchemaDescriptor, bool) {
+	if schemaID.Invalid() || int(schemaID) > len(vt.schemas) {
+		return nil, false
+	}
+	return proto.Clone(vt.schemas[schemaID-1]).(*varlogpb.SchemaDescriptor), true
+}
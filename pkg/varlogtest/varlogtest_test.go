@@ -9,9 +9,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"google.golang.org/grpc/codes"
 
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/container/set"
@@ -638,6 +640,20 @@ func TestVarlogTest_Trim(t *testing.T) {
 		assert.Error(t, subscriber.Close())
 	}
 
+	_, err = vlg.ReadAt(context.Background(), td.TopicID, lsds[0].LogStreamID, types.InvalidGLSN)
+	assert.ErrorIs(t, err, verrors.ErrInvalid)
+	_, err = vlg.ReadAt(context.Background(), td.TopicID, lsds[0].LogStreamID, trimGLSN)
+	assert.ErrorIs(t, err, verrors.ErrTrimmed)
+	_, err = vlg.ReadAt(context.Background(), td.TopicID, lsds[1].LogStreamID, types.GLSN(5))
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	_, err = vlg.ReadAt(context.Background(), td.TopicID, lsds[0].LogStreamID, lastGLSN+1)
+	assert.ErrorIs(t, err, verrors.ErrNoEntry)
+	le, err := vlg.ReadAt(context.Background(), td.TopicID, lsds[0].LogStreamID, types.GLSN(5))
+	assert.NoError(t, err)
+	assert.Equal(t, lsds[0].LogStreamID, le.LogStreamID)
+	assert.Equal(t, types.LLSN(3), le.LLSN)
+	assert.Equal(t, types.GLSN(5), le.GLSN)
+
 	first, last, err := vlg.PeekLogStream(context.Background(), td.TopicID, lsds[0].LogStreamID)
 	assert.NoError(t, err)
 	assert.Equal(t, varlogpb.LogSequenceNumber{LLSN: 3, GLSN: 5}, first)
@@ -648,6 +664,26 @@ func TestVarlogTest_Trim(t *testing.T) {
 	assert.Equal(t, varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 4}, first)
 	assert.Equal(t, varlogpb.LogSequenceNumber{LLSN: 5, GLSN: 10}, last)
 
+	tb, err := vlg.PeekTopic(context.Background(), td.TopicID)
+	assert.NoError(t, err)
+	assert.Equal(t, varlog.TopicBoundary{
+		First:               types.GLSN(4),
+		Last:                types.GLSN(10),
+		GlobalHighWatermark: types.GLSN(10),
+		LogStreams: map[types.LogStreamID]varlog.LogStreamBoundary{
+			lsds[0].LogStreamID: {
+				First: varlogpb.LogSequenceNumber{LLSN: 3, GLSN: 5},
+				Last:  varlogpb.LogSequenceNumber{LLSN: 5, GLSN: 9},
+			},
+			lsds[1].LogStreamID: {
+				First: varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 4},
+				Last:  varlogpb.LogSequenceNumber{LLSN: 5, GLSN: 10},
+			},
+		},
+	}, tb)
+	_, err = vlg.PeekTopic(context.Background(), td.TopicID+1)
+	assert.ErrorIs(t, err, verrors.ErrNotExist)
+
 	subscriber := vlg.SubscribeTo(context.Background(), td.TopicID, lsds[0].LogStreamID, types.LLSN(3), types.LLSN(6))
 	expectedLLSN := types.LLSN(3)
 	for i := 0; i < 3; i++ {
@@ -669,6 +705,720 @@ func TestVarlogTest_Trim(t *testing.T) {
 	assert.NoError(t, subscriber.Close())
 }
 
+func TestVarlogTest_LogEntryAttributes(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	lsd, err := adm.AddLogStream(context.Background(), td.TopicID, nil)
+	require.NoError(t, err)
+	tpid, lsid := td.TopicID, lsd.LogStreamID
+
+	ts := time.Unix(1700000000, 0).UTC()
+	attrs := []varlogpb.LogEntryAttributes{
+		{Key: []byte("k1"), Timestamp: &ts},
+		{},
+		{Headers: []varlogpb.LogEntryHeader{{Key: "h1", Value: []byte("v1")}}},
+	}
+	dataBatch := [][]byte{[]byte("1"), []byte("2"), []byte("3")}
+
+	// mismatched length
+	res := vlg.Append(context.Background(), tpid, dataBatch[:1], varlog.WithLogEntryAttributes(attrs))
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+	res = vlg.AppendTo(context.Background(), tpid, lsid, dataBatch[:1], varlog.WithLogEntryAttributes(attrs))
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	lsa, err := vlg.NewLogStreamAppender(tpid, lsid)
+	require.NoError(t, err)
+	err = lsa.AppendBatch(dataBatch[:1], nil, varlog.WithLogEntryAttributes(attrs))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// GLSN: 1, 2, 3
+	res = vlg.Append(context.Background(), tpid, dataBatch, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, res.Err)
+	// GLSN: 4, 5, 6
+	res = vlg.AppendTo(context.Background(), tpid, lsid, dataBatch, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, res.Err)
+	// GLSN: 7, 8, 9
+	var wg sync.WaitGroup
+	wg.Add(1)
+	err = lsa.AppendBatch(dataBatch, func(_ []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.NoError(t, err)
+	}, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, err)
+	wg.Wait()
+	lsa.Close()
+
+	// Modifying the appended attributes does not affect the stored ones.
+	attrs[0].Key[0] = 'x'
+	expected := []varlogpb.LogEntryAttributes{
+		{Key: []byte("k1"), Timestamp: &ts},
+		{},
+		{Headers: []varlogpb.LogEntryHeader{{Key: "h1", Value: []byte("v1")}}},
+	}
+
+	var les []varlogpb.LogEntry
+	closer, err := vlg.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(10), func(le varlogpb.LogEntry, err error) {
+		if err == nil {
+			les = append(les, le)
+		}
+	})
+	require.NoError(t, err)
+	closer()
+	require.Len(t, les, 9)
+	for i, le := range les {
+		require.Equal(t, dataBatch[i%3], le.Data)
+		require.True(t, expected[i%3].Equal(le.LogEntryAttributes))
+	}
+
+	subscriber := vlg.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(10))
+	for i := 0; i < 9; i++ {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, dataBatch[i%3], le.Data)
+		require.True(t, expected[i%3].Equal(le.LogEntryAttributes))
+	}
+	require.NoError(t, subscriber.Close())
+
+	for i := 0; i < 9; i++ {
+		le, err := vlg.ReadAt(context.Background(), tpid, lsid, types.GLSN(i+1))
+		require.NoError(t, err)
+		require.Equal(t, dataBatch[i%3], le.Data)
+		require.True(t, expected[i%3].Equal(le.LogEntryAttributes))
+	}
+}
+
+func TestVarlogTest_SelectionKey(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogStreams     = 3
+		numLogs           = 10
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	for i := 0; i < numLogStreams; i++ {
+		_, err := adm.AddLogStream(context.Background(), td.TopicID, nil)
+		require.NoError(t, err)
+	}
+
+	var owner types.LogStreamID
+	for i := 0; i < numLogs; i++ {
+		res := vlg.Append(context.Background(), td.TopicID, [][]byte{[]byte("foo")}, varlog.WithSelectionKey([]byte("key")))
+		require.NoError(t, res.Err)
+		if i == 0 {
+			owner = res.Metadata[0].LogStreamID
+		}
+		require.Equal(t, owner, res.Metadata[0].LogStreamID)
+	}
+
+	_, err = adm.Seal(context.Background(), td.TopicID, owner)
+	require.NoError(t, err)
+	res := vlg.Append(context.Background(), td.TopicID, [][]byte{[]byte("foo")}, varlog.WithSelectionKey([]byte("key")))
+	require.ErrorIs(t, res.Err, verrors.ErrSealed)
+}
+
+func TestVarlogTest_TopicAppender(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogStreams     = 3
+		numLogs           = 10
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	_, err := vlg.NewTopicAppender(types.TopicID(1))
+	require.Error(t, err)
+
+	_, err = adm.AddStorageNode(context.Background(), types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(context.Background())
+	require.NoError(t, err)
+	var lsids []types.LogStreamID
+	for i := 0; i < numLogStreams; i++ {
+		lsd, err := adm.AddLogStream(context.Background(), td.TopicID, nil)
+		require.NoError(t, err)
+		lsids = append(lsids, lsd.LogStreamID)
+	}
+	for _, lsid := range lsids[1:] {
+		_, err := adm.Seal(context.Background(), td.TopicID, lsid)
+		require.NoError(t, err)
+	}
+
+	ta, err := vlg.NewTopicAppender(td.TopicID)
+	require.NoError(t, err)
+
+	// Batches are appended to the log stream that is not sealed, and their
+	// callbacks are called in order.
+	var wg sync.WaitGroup
+	var glsn types.GLSN
+	wg.Add(numLogs)
+	for i := 0; i < numLogs; i++ {
+		err := ta.AppendBatch([][]byte{[]byte("foo")}, func(metas []varlogpb.LogEntryMeta, err error) {
+			defer wg.Done()
+			assert.NoError(t, err)
+			assert.Equal(t, lsids[0], metas[0].LogStreamID)
+			assert.Less(t, glsn, metas[0].GLSN)
+			glsn = metas[0].GLSN
+		})
+		require.NoError(t, err)
+	}
+	wg.Wait()
+
+	_, err = adm.Seal(context.Background(), td.TopicID, lsids[0])
+	require.NoError(t, err)
+	wg.Add(1)
+	err = ta.AppendBatch([][]byte{[]byte("foo")}, func(_ []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.ErrorIs(t, err, verrors.ErrSealed)
+	})
+	require.NoError(t, err)
+	wg.Wait()
+
+	ta.Close()
+	err = ta.AppendBatch([][]byte{[]byte("foo")}, nil)
+	require.Equal(t, varlog.ErrClosed, err)
+}
+
+func TestVarlogTest_Transaction(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	lsd1, err := adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+	lsd2, err := adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	txn, err := vlg.BeginTransaction()
+	require.NoError(t, err)
+	require.NoError(t, txn.Append(td.TopicID, lsd1.LogStreamID, [][]byte{[]byte("foo")}))
+	require.NoError(t, txn.Append(td.TopicID, lsd2.LogStreamID, [][]byte{[]byte("bar")}))
+	err = txn.Append(td.TopicID, lsd2.LogStreamID, [][]byte{[]byte("bar")}, varlog.WithLogEntryAttributes([]varlogpb.LogEntryAttributes{{}, {}}))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	res := txn.Commit(ctx)
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, 2)
+	require.Equal(t, lsd1.LogStreamID, res.Metadata[0].LogStreamID)
+	require.Equal(t, lsd2.LogStreamID, res.Metadata[1].LogStreamID)
+	require.ErrorIs(t, txn.Commit(ctx).Err, verrors.ErrInvalid)
+
+	// Nothing is appended if any participant is sealed.
+	_, err = adm.Seal(ctx, td.TopicID, lsd2.LogStreamID)
+	require.NoError(t, err)
+	txn, err = vlg.BeginTransaction()
+	require.NoError(t, err)
+	require.NoError(t, txn.Append(td.TopicID, lsd1.LogStreamID, [][]byte{[]byte("foo")}))
+	require.NoError(t, txn.Append(td.TopicID, lsd2.LogStreamID, [][]byte{[]byte("bar")}))
+	res = txn.Commit(ctx)
+	require.ErrorIs(t, res.Err, verrors.ErrSealed)
+	_, last, err := vlg.PeekLogStream(ctx, td.TopicID, lsd1.LogStreamID)
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(1), last.LLSN)
+
+	txn, err = vlg.BeginTransaction()
+	require.NoError(t, err)
+	require.NoError(t, txn.Abort(ctx))
+	require.ErrorIs(t, txn.Abort(ctx), verrors.ErrInvalid)
+}
+
+func TestVarlogTest_SubscribeIterator(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	_, err = adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	res := vlg.Append(ctx, td.TopicID, [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+
+	_, err = vlg.SubscribeIterator(ctx, td.TopicID, types.GLSN(2), types.GLSN(1))
+	require.Error(t, err)
+
+	iter, err := vlg.SubscribeIterator(ctx, td.TopicID, types.MinGLSN, types.MaxGLSN)
+	require.NoError(t, err)
+	for i, expected := range []string{"foo", "bar"} {
+		le, err := iter.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, types.GLSN(i+1), le.GLSN)
+		require.Equal(t, expected, string(le.Data))
+	}
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, iter.Close())
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, verrors.ErrClosed)
+}
+
+func TestVarlogTest_StartPosition(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	lsd, err := adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	res := vlg.Append(ctx, td.TopicID, [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")})
+	require.NoError(t, res.Err)
+	_, err = adm.Trim(ctx, td.TopicID, types.MinGLSN)
+	require.NoError(t, err)
+
+	// LLSN: X 2 3
+	// GLSN: X 2 3
+
+	iter, err := vlg.SubscribeIterator(ctx, td.TopicID, types.MinGLSN, types.MaxGLSN, varlog.WithStartPosition(varlog.StartPositionEarliest))
+	require.NoError(t, err)
+	for _, expected := range []string{"bar", "baz"} {
+		le, err := iter.Next(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expected, string(le.Data))
+	}
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, iter.Close())
+
+	iter, err = vlg.SubscribeIterator(ctx, td.TopicID, types.MinGLSN, types.MaxGLSN, varlog.WithStartPosition(varlog.StartPositionLatest))
+	require.NoError(t, err)
+	_, err = iter.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, iter.Close())
+
+	subscriber := vlg.SubscribeTo(ctx, td.TopicID, lsd.LogStreamID, types.InvalidLLSN, types.MaxLLSN, varlog.WithStartPosition(varlog.StartPositionEarliest))
+	for _, expected := range []types.LLSN{2, 3} {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, le.LLSN)
+	}
+	require.NoError(t, subscriber.Close())
+
+	// The subscription from the latest position receives only log entries
+	// appended after it starts.
+	subscriber = vlg.SubscribeTo(ctx, td.TopicID, lsd.LogStreamID, types.InvalidLLSN, types.MaxLLSN, varlog.WithStartPosition(varlog.StartPositionLatest))
+	res = vlg.Append(ctx, td.TopicID, [][]byte{[]byte("qux")})
+	require.NoError(t, res.Err)
+	le, err := subscriber.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(4), le.LLSN)
+	require.Equal(t, "qux", string(le.Data))
+	require.NoError(t, subscriber.Close())
+
+	subscriber = vlg.SubscribeTo(ctx, td.TopicID, lsd.LogStreamID, types.InvalidLLSN, types.MaxLLSN, varlog.WithStartPosition(varlog.StartPosition(0xff)))
+	_, err = subscriber.Next()
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+}
+
+func TestVarlogTest_LookupGLSNByTime(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	_, err = adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	before := time.Now()
+	res := vlg.Append(ctx, td.TopicID, [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+	time.Sleep(10 * time.Millisecond)
+	middle := time.Now()
+	res = vlg.Append(ctx, td.TopicID, [][]byte{[]byte("baz")})
+	require.NoError(t, res.Err)
+
+	glsn, err := vlg.LookupGLSNByTime(ctx, td.TopicID, before)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(1), glsn)
+
+	glsn, err = vlg.LookupGLSNByTime(ctx, td.TopicID, middle)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(3), glsn)
+
+	_, err = vlg.LookupGLSNByTime(ctx, td.TopicID, time.Now().Add(time.Second))
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	_, err = adm.Trim(ctx, td.TopicID, types.GLSN(1))
+	require.NoError(t, err)
+	glsn, err = vlg.LookupGLSNByTime(ctx, td.TopicID, before)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2), glsn)
+
+	_, err = vlg.LookupGLSNByTime(ctx, td.TopicID+1, before)
+	require.Error(t, err)
+}
+
+func TestVarlogTest_SubscribeWithFilter(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	lsd, err := adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	dataBatch := [][]byte{[]byte("foo"), []byte("bar"), []byte("baz"), []byte("qux")}
+	attrs := []varlogpb.LogEntryAttributes{
+		{Headers: []varlogpb.LogEntryHeader{{Key: "type", Value: []byte("a")}}},
+		{Headers: []varlogpb.LogEntryHeader{{Key: "type", Value: []byte("b")}}},
+		{Headers: []varlogpb.LogEntryHeader{{Key: "type", Value: []byte("a")}}},
+		{},
+	}
+	res := vlg.Append(ctx, td.TopicID, dataBatch, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, res.Err)
+
+	filter := &varlogpb.LogEntryFilter{
+		Headers: []varlogpb.LogEntryHeader{{Key: "type", Value: []byte("a")}},
+	}
+	opt := varlog.WithFilter(filter)
+
+	var glsns []types.GLSN
+	onNext := func(le varlogpb.LogEntry, err error) {
+		if err == nil {
+			glsns = append(glsns, le.GLSN)
+		}
+	}
+	closer, err := vlg.Subscribe(ctx, td.TopicID, types.MinGLSN, types.GLSN(len(dataBatch)+1), onNext, opt)
+	require.NoError(t, err)
+	closer()
+	require.Equal(t, []types.GLSN{1, 3}, glsns)
+
+	it, err := vlg.SubscribeIterator(ctx, td.TopicID, types.MinGLSN, types.GLSN(len(dataBatch)+1), opt)
+	require.NoError(t, err)
+	le, err := it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(1), le.GLSN)
+	le, err = it.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(3), le.GLSN)
+	_, err = it.Next(ctx)
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, it.Close())
+
+	// The last log entry does not match the filter.
+	subscriber := vlg.SubscribeTo(ctx, td.TopicID, lsd.LogStreamID, types.MinLLSN, types.LLSN(len(dataBatch)+1), opt)
+	le, err = subscriber.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(1), le.LLSN)
+	le, err = subscriber.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(3), le.LLSN)
+	_, err = subscriber.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, subscriber.Close())
+}
+
+func TestVarlogTest_ConsumerGroup(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+		numLogs           = 10
+		group             = "cg"
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	_, err = adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	lsd, err := adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < numLogs; i++ {
+		res := vlg.Append(ctx, td.TopicID, [][]byte{nil})
+		require.NoError(t, res.Err)
+	}
+
+	_, err = vlg.FetchOffset(ctx, group, td.TopicID, 0)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	_, err = adm.GetConsumerGroup(ctx, group)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	err = vlg.CommitOffset(ctx, "", varlogpb.ConsumerGroupOffset{TopicID: td.TopicID, GLSN: 1})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	err = vlg.CommitOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: td.TopicID + 1, GLSN: 1})
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	err = vlg.CommitOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: td.TopicID, LogStreamID: lsd.LogStreamID + 1, LLSN: 1})
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	err = vlg.CommitOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: td.TopicID, GLSN: 4})
+	require.NoError(t, err)
+	err = vlg.CommitOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: td.TopicID, LogStreamID: lsd.LogStreamID, LLSN: 7})
+	require.NoError(t, err)
+
+	offset, err := vlg.FetchOffset(ctx, group, td.TopicID, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(4), offset.GLSN)
+	require.False(t, offset.UpdateTime.IsZero())
+
+	cgm, err := adm.GetConsumerGroup(ctx, group)
+	require.NoError(t, err)
+	require.Equal(t, group, cgm.Name)
+	require.Len(t, cgm.Offsets, 2)
+	require.EqualValues(t, numLogs, cgm.Offsets[0].HighWatermark)
+	require.EqualValues(t, numLogs-4, cgm.Offsets[0].Lag)
+	require.EqualValues(t, numLogs, cgm.Offsets[1].HighWatermark)
+	require.EqualValues(t, numLogs-7, cgm.Offsets[1].Lag)
+
+	// reset to the beginning
+	cgm, err = adm.ResetConsumerGroupOffset(ctx, group, varlogpb.ConsumerGroupOffset{TopicID: td.TopicID})
+	require.NoError(t, err)
+	require.EqualValues(t, numLogs, cgm.Offsets[0].Lag)
+
+	cgms, err := adm.ListConsumerGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, cgms, 1)
+
+	require.NoError(t, adm.DeleteConsumerGroup(ctx, group))
+	require.ErrorIs(t, adm.DeleteConsumerGroup(ctx, group), verrors.ErrNotExist)
+	_, err = vlg.FetchOffset(ctx, group, td.TopicID, 0)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+}
+
+func TestVarlogTest_Schema(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	const (
+		clusterID         = types.ClusterID(1)
+		replicationFactor = 1
+	)
+
+	type event struct {
+		ID   int    `json:"id"`
+		Name string `json:"name,omitempty"`
+	}
+
+	var (
+		v1 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}}`)
+		v2 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}`)
+		v3 = []byte(`{"type": "object", "properties": {"id": {"type": "integer"}}, "required": ["id"]}`)
+	)
+
+	vt := varlogtest.New(clusterID, replicationFactor)
+	adm := vt.Admin()
+	vlg := vt.Log()
+	defer func() {
+		require.NoError(t, vlg.Close())
+		require.NoError(t, adm.Close())
+	}()
+
+	ctx := context.Background()
+
+	_, err := adm.AddStorageNode(ctx, types.StorageNodeID(1), "sn-1")
+	require.NoError(t, err)
+	td, err := adm.AddTopic(ctx)
+	require.NoError(t, err)
+	_, err = adm.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+
+	_, err = adm.RegisterSchema(ctx, td.TopicID+1, varlogpb.SchemaTypeJSON, v1, varlogpb.SchemaCompatibilityBackward)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	_, err = adm.RegisterSchema(ctx, td.TopicID, varlogpb.SchemaTypeJSON, []byte("malformed"), varlogpb.SchemaCompatibilityBackward)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	sd1, err := adm.RegisterSchema(ctx, td.TopicID, varlogpb.SchemaTypeJSON, v1, varlogpb.SchemaCompatibilityBackward)
+	require.NoError(t, err)
+	require.Equal(t, types.SchemaID(1), sd1.SchemaID)
+	require.EqualValues(t, 1, sd1.Version)
+
+	// Registering the same definition returns the existing schema.
+	sd, err := adm.RegisterSchema(ctx, td.TopicID, varlogpb.SchemaTypeJSON, v1, varlogpb.SchemaCompatibilityBackward)
+	require.NoError(t, err)
+	require.Equal(t, sd1.SchemaID, sd.SchemaID)
+
+	_, err = adm.RegisterSchema(ctx, td.TopicID, varlogpb.SchemaTypeJSON, v3, varlogpb.SchemaCompatibilityBackward)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	sd2, err := adm.RegisterSchema(ctx, td.TopicID, varlogpb.SchemaTypeJSON, v2, varlogpb.SchemaCompatibilityBackward)
+	require.NoError(t, err)
+	require.Equal(t, types.SchemaID(2), sd2.SchemaID)
+	require.EqualValues(t, 2, sd2.Version)
+
+	sds, err := adm.ListSchemas(ctx, td.TopicID)
+	require.NoError(t, err)
+	require.Len(t, sds, 2)
+
+	_, err = adm.GetSchema(ctx, sd2.SchemaID+1)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+	_, err = vlg.GetSchema(ctx, sd2.SchemaID+1)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	var codec varlog.Codec[event] = varlog.JSONCodec[event]{}
+	res := varlog.AppendValues(ctx, vlg, td.TopicID, sd1.SchemaID, codec, []event{{ID: 1}})
+	require.NoError(t, res.Err)
+	res = varlog.AppendValues(ctx, vlg, td.TopicID, sd2.SchemaID, codec, []event{{ID: 2, Name: "foo"}})
+	require.NoError(t, res.Err)
+	res = varlog.AppendValues(ctx, vlg, td.TopicID+1, sd2.SchemaID, codec, []event{{ID: 3}})
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	// A log entry without a schema cannot be decoded.
+	res = vlg.Append(ctx, td.TopicID, [][]byte{[]byte("raw")})
+	require.NoError(t, res.Err)
+
+	var (
+		events     []event
+		versions   []int32
+		decodeErrs int
+	)
+	closer, err := varlog.SubscribeValues(ctx, vlg, td.TopicID, types.MinGLSN, types.GLSN(4), codec, func(_ varlogpb.LogEntry, value event, err error) {
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			assert.ErrorIs(t, err, varlog.ErrDecode)
+			decodeErrs++
+			return
+		}
+		events = append(events, value)
+	})
+	require.NoError(t, err)
+	closer()
+	require.Equal(t, []event{{ID: 1}, {ID: 2, Name: "foo"}}, events)
+	require.Equal(t, 1, decodeErrs)
+
+	it, err := vlg.SubscribeIterator(ctx, td.TopicID, types.MinGLSN, types.GLSN(3))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		logEntry, err := it.Next(ctx)
+		require.NoError(t, err)
+		_, sd, err := varlog.DecodeValue(ctx, vlg, codec, logEntry)
+		require.NoError(t, err)
+		versions = append(versions, sd.Version)
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, []int32{1, 2}, versions)
+}
+
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
@@ -26,6 +26,9 @@ var (
 	ErrCorruptLogStream = errors.New("logstream: corrupt")
 	ErrSealed           = errors.New("sealed")
 	ErrUnordered        = errors.New("logstream: unordered scanner")
+	// ErrDuplicate means that an idempotent producer has already appended
+	// the batch, or a prefix of it, with the same sequence numbers.
+	ErrDuplicate = errors.New("logstream: duplicate sequence")
 )
 
 var (
@@ -80,7 +83,7 @@ func init() {
 		ErrNoEntry, ErrCorruptStorage,
 
 		// logstream
-		ErrTrimmed, ErrUndecidable, ErrCorruptLogStream, ErrSealed, ErrUnordered,
+		ErrTrimmed, ErrUndecidable, ErrCorruptLogStream, ErrSealed, ErrUnordered, ErrDuplicate,
 
 		ErrInvalidArgument, ErrAlreadyExists, ErrNotExist,
 
@@ -700,15 +700,108 @@ func (m *ListTopicsResponse) GetTopics() []varlogpb.TopicDescriptor {
 	return nil
 }
 
+type GetTopicByNameRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *GetTopicByNameRequest) Reset()         { *m = GetTopicByNameRequest{} }
+func (m *GetTopicByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTopicByNameRequest) ProtoMessage()    {}
+func (*GetTopicByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{15}
+}
+func (m *GetTopicByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTopicByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTopicByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTopicByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopicByNameRequest.Merge(m, src)
+}
+func (m *GetTopicByNameRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetTopicByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopicByNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopicByNameRequest proto.InternalMessageInfo
+
+func (m *GetTopicByNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetTopicByNameResponse struct {
+	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
+}
+
+func (m *GetTopicByNameResponse) Reset()         { *m = GetTopicByNameResponse{} }
+func (m *GetTopicByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTopicByNameResponse) ProtoMessage()    {}
+func (*GetTopicByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{16}
+}
+func (m *GetTopicByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTopicByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTopicByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTopicByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopicByNameResponse.Merge(m, src)
+}
+func (m *GetTopicByNameResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetTopicByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopicByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopicByNameResponse proto.InternalMessageInfo
+
+func (m *GetTopicByNameResponse) GetTopic() *varlogpb.TopicDescriptor {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
 // AddTopicRequest represents a request to add a topic to the cluster.
 type AddTopicRequest struct {
+	// Name is the name of the new topic. It is optional, but should be unique
+	// in the cluster if it is given.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Config is the configuration of the new topic.
+	Config varlogpb.TopicConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
 }
 
 func (m *AddTopicRequest) Reset()         { *m = AddTopicRequest{} }
 func (m *AddTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*AddTopicRequest) ProtoMessage()    {}
 func (*AddTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{15}
+	return fileDescriptor_acd58c06882c23f8, []int{17}
 }
 func (m *AddTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,6 +830,20 @@ func (m *AddTopicRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AddTopicRequest proto.InternalMessageInfo
 
+func (m *AddTopicRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddTopicRequest) GetConfig() varlogpb.TopicConfig {
+	if m != nil {
+		return m.Config
+	}
+	return varlogpb.TopicConfig{}
+}
+
 // AddTopicResponse represents a response of AddTopicRequest.
 type AddTopicResponse struct {
 	Topic *varlogpb.TopicDescriptor `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic"`
@@ -746,7 +853,7 @@ func (m *AddTopicResponse) Reset()         { *m = AddTopicResponse{} }
 func (m *AddTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*AddTopicResponse) ProtoMessage()    {}
 func (*AddTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{16}
+	return fileDescriptor_acd58c06882c23f8, []int{18}
 }
 func (m *AddTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +897,7 @@ func (m *UnregisterTopicRequest) Reset()         { *m = UnregisterTopicRequest{}
 func (m *UnregisterTopicRequest) String() string { return proto.CompactTextString(m) }
 func (*UnregisterTopicRequest) ProtoMessage()    {}
 func (*UnregisterTopicRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{17}
+	return fileDescriptor_acd58c06882c23f8, []int{19}
 }
 func (m *UnregisterTopicRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +940,7 @@ func (m *UnregisterTopicResponse) Reset()         { *m = UnregisterTopicResponse
 func (m *UnregisterTopicResponse) String() string { return proto.CompactTextString(m) }
 func (*UnregisterTopicResponse) ProtoMessage()    {}
 func (*UnregisterTopicResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{18}
+	return fileDescriptor_acd58c06882c23f8, []int{20}
 }
 func (m *UnregisterTopicResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +978,7 @@ func (m *GetLogStreamRequest) Reset()         { *m = GetLogStreamRequest{} }
 func (m *GetLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogStreamRequest) ProtoMessage()    {}
 func (*GetLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{19}
+	return fileDescriptor_acd58c06882c23f8, []int{21}
 }
 func (m *GetLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1029,7 @@ func (m *GetLogStreamResponse) Reset()         { *m = GetLogStreamResponse{} }
 func (m *GetLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLogStreamResponse) ProtoMessage()    {}
 func (*GetLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{20}
+	return fileDescriptor_acd58c06882c23f8, []int{22}
 }
 func (m *GetLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1073,7 @@ func (m *ListLogStreamsRequest) Reset()         { *m = ListLogStreamsRequest{} }
 func (m *ListLogStreamsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListLogStreamsRequest) ProtoMessage()    {}
 func (*ListLogStreamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{21}
+	return fileDescriptor_acd58c06882c23f8, []int{23}
 }
 func (m *ListLogStreamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1117,7 @@ func (m *ListLogStreamsResponse) Reset()         { *m = ListLogStreamsResponse{}
 func (m *ListLogStreamsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListLogStreamsResponse) ProtoMessage()    {}
 func (*ListLogStreamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{22}
+	return fileDescriptor_acd58c06882c23f8, []int{24}
 }
 func (m *ListLogStreamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1163,7 @@ func (m *AddLogStreamRequest) Reset()         { *m = AddLogStreamRequest{} }
 func (m *AddLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*AddLogStreamRequest) ProtoMessage()    {}
 func (*AddLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{23}
+	return fileDescriptor_acd58c06882c23f8, []int{25}
 }
 func (m *AddLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1214,7 @@ func (m *AddLogStreamResponse) Reset()         { *m = AddLogStreamResponse{} }
 func (m *AddLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*AddLogStreamResponse) ProtoMessage()    {}
 func (*AddLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{24}
+	return fileDescriptor_acd58c06882c23f8, []int{26}
 }
 func (m *AddLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1269,7 @@ func (m *UpdateLogStreamRequest) Reset()         { *m = UpdateLogStreamRequest{}
 func (m *UpdateLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateLogStreamRequest) ProtoMessage()    {}
 func (*UpdateLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{25}
+	return fileDescriptor_acd58c06882c23f8, []int{27}
 }
 func (m *UpdateLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1334,7 @@ func (m *UpdateLogStreamResponse) Reset()         { *m = UpdateLogStreamResponse
 func (m *UpdateLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateLogStreamResponse) ProtoMessage()    {}
 func (*UpdateLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{26}
+	return fileDescriptor_acd58c06882c23f8, []int{28}
 }
 func (m *UpdateLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1272,7 +1379,7 @@ func (m *UnregisterLogStreamRequest) Reset()         { *m = UnregisterLogStreamR
 func (m *UnregisterLogStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*UnregisterLogStreamRequest) ProtoMessage()    {}
 func (*UnregisterLogStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{27}
+	return fileDescriptor_acd58c06882c23f8, []int{29}
 }
 func (m *UnregisterLogStreamRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +1429,7 @@ func (m *UnregisterLogStreamResponse) Reset()         { *m = UnregisterLogStream
 func (m *UnregisterLogStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*UnregisterLogStreamResponse) ProtoMessage()    {}
 func (*UnregisterLogStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{28}
+	return fileDescriptor_acd58c06882c23f8, []int{30}
 }
 func (m *UnregisterLogStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1468,7 @@ func (m *RemoveLogStreamReplicaRequest) Reset()         { *m = RemoveLogStreamRe
 func (m *RemoveLogStreamReplicaRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveLogStreamReplicaRequest) ProtoMessage()    {}
 func (*RemoveLogStreamReplicaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{29}
+	return fileDescriptor_acd58c06882c23f8, []int{31}
 }
 func (m *RemoveLogStreamReplicaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1525,7 @@ func (m *RemoveLogStreamReplicaResponse) Reset()         { *m = RemoveLogStreamR
 func (m *RemoveLogStreamReplicaResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveLogStreamReplicaResponse) ProtoMessage()    {}
 func (*RemoveLogStreamReplicaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{30}
+	return fileDescriptor_acd58c06882c23f8, []int{32}
 }
 func (m *RemoveLogStreamReplicaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1563,7 @@ func (m *SealRequest) Reset()         { *m = SealRequest{} }
 func (m *SealRequest) String() string { return proto.CompactTextString(m) }
 func (*SealRequest) ProtoMessage()    {}
 func (*SealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{31}
+	return fileDescriptor_acd58c06882c23f8, []int{33}
 }
 func (m *SealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1508,7 +1615,7 @@ func (m *SealResponse) Reset()         { *m = SealResponse{} }
 func (m *SealResponse) String() string { return proto.CompactTextString(m) }
 func (*SealResponse) ProtoMessage()    {}
 func (*SealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{32}
+	return fileDescriptor_acd58c06882c23f8, []int{34}
 }
 func (m *SealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,7 +1667,7 @@ func (m *UnsealRequest) Reset()         { *m = UnsealRequest{} }
 func (m *UnsealRequest) String() string { return proto.CompactTextString(m) }
 func (*UnsealRequest) ProtoMessage()    {}
 func (*UnsealRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{33}
+	return fileDescriptor_acd58c06882c23f8, []int{35}
 }
 func (m *UnsealRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1611,7 +1718,7 @@ func (m *UnsealResponse) Reset()         { *m = UnsealResponse{} }
 func (m *UnsealResponse) String() string { return proto.CompactTextString(m) }
 func (*UnsealResponse) ProtoMessage()    {}
 func (*UnsealResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{34}
+	return fileDescriptor_acd58c06882c23f8, []int{36}
 }
 func (m *UnsealResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1658,7 +1765,7 @@ func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
 func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncRequest) ProtoMessage()    {}
 func (*SyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{35}
+	return fileDescriptor_acd58c06882c23f8, []int{37}
 }
 func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1723,7 +1830,7 @@ func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
 func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncResponse) ProtoMessage()    {}
 func (*SyncResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{36}
+	return fileDescriptor_acd58c06882c23f8, []int{38}
 }
 func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +1875,7 @@ func (m *TrimRequest) Reset()         { *m = TrimRequest{} }
 func (m *TrimRequest) String() string { return proto.CompactTextString(m) }
 func (*TrimRequest) ProtoMessage()    {}
 func (*TrimRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{37}
+	return fileDescriptor_acd58c06882c23f8, []int{39}
 }
 func (m *TrimRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1821,7 +1928,7 @@ func (m *TrimResult) Reset()         { *m = TrimResult{} }
 func (m *TrimResult) String() string { return proto.CompactTextString(m) }
 func (*TrimResult) ProtoMessage()    {}
 func (*TrimResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{38}
+	return fileDescriptor_acd58c06882c23f8, []int{40}
 }
 func (m *TrimResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1879,7 +1986,7 @@ func (m *TrimResponse) Reset()         { *m = TrimResponse{} }
 func (m *TrimResponse) String() string { return proto.CompactTextString(m) }
 func (*TrimResponse) ProtoMessage()    {}
 func (*TrimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{39}
+	return fileDescriptor_acd58c06882c23f8, []int{41}
 }
 func (m *TrimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2030,7 @@ func (m *GetMetadataRepositoryNodeRequest) Reset()         { *m = GetMetadataRep
 func (m *GetMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMetadataRepositoryNodeRequest) ProtoMessage()    {}
 func (*GetMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{40}
+	return fileDescriptor_acd58c06882c23f8, []int{42}
 }
 func (m *GetMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1967,7 +2074,7 @@ func (m *GetMetadataRepositoryNodeResponse) Reset()         { *m = GetMetadataRe
 func (m *GetMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMetadataRepositoryNodeResponse) ProtoMessage()    {}
 func (*GetMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{41}
+	return fileDescriptor_acd58c06882c23f8, []int{43}
 }
 func (m *GetMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2117,7 @@ func (m *ListMetadataRepositoryNodesRequest) Reset()         { *m = ListMetadata
 func (m *ListMetadataRepositoryNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMetadataRepositoryNodesRequest) ProtoMessage()    {}
 func (*ListMetadataRepositoryNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{42}
+	return fileDescriptor_acd58c06882c23f8, []int{44}
 }
 func (m *ListMetadataRepositoryNodesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2047,7 +2154,7 @@ func (m *ListMetadataRepositoryNodesResponse) Reset()         { *m = ListMetadat
 func (m *ListMetadataRepositoryNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMetadataRepositoryNodesResponse) ProtoMessage()    {}
 func (*ListMetadataRepositoryNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{43}
+	return fileDescriptor_acd58c06882c23f8, []int{45}
 }
 func (m *ListMetadataRepositoryNodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2200,7 @@ func (m *GetMRMembersResponse) Reset()         { *m = GetMRMembersResponse{} }
 func (m *GetMRMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMRMembersResponse) ProtoMessage()    {}
 func (*GetMRMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{44}
+	return fileDescriptor_acd58c06882c23f8, []int{46}
 }
 func (m *GetMRMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2152,7 +2259,7 @@ func (m *AddMetadataRepositoryNodeRequest) Reset()         { *m = AddMetadataRep
 func (m *AddMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*AddMetadataRepositoryNodeRequest) ProtoMessage()    {}
 func (*AddMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{45}
+	return fileDescriptor_acd58c06882c23f8, []int{47}
 }
 func (m *AddMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2310,7 @@ func (m *AddMetadataRepositoryNodeResponse) Reset()         { *m = AddMetadataRe
 func (m *AddMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*AddMetadataRepositoryNodeResponse) ProtoMessage()    {}
 func (*AddMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{46}
+	return fileDescriptor_acd58c06882c23f8, []int{48}
 }
 func (m *AddMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,7 +2355,7 @@ func (m *AddMRPeerRequest) Reset()         { *m = AddMRPeerRequest{} }
 func (m *AddMRPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*AddMRPeerRequest) ProtoMessage()    {}
 func (*AddMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{47}
+	return fileDescriptor_acd58c06882c23f8, []int{49}
 }
 func (m *AddMRPeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2299,7 +2406,7 @@ func (m *AddMRPeerResponse) Reset()         { *m = AddMRPeerResponse{} }
 func (m *AddMRPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*AddMRPeerResponse) ProtoMessage()    {}
 func (*AddMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{48}
+	return fileDescriptor_acd58c06882c23f8, []int{50}
 }
 func (m *AddMRPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2450,7 @@ func (m *DeleteMetadataRepositoryNodeRequest) Reset()         { *m = DeleteMetad
 func (m *DeleteMetadataRepositoryNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetadataRepositoryNodeRequest) ProtoMessage()    {}
 func (*DeleteMetadataRepositoryNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{49}
+	return fileDescriptor_acd58c06882c23f8, []int{51}
 }
 func (m *DeleteMetadataRepositoryNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2386,7 +2493,7 @@ func (m *DeleteMetadataRepositoryNodeResponse) Reset()         { *m = DeleteMeta
 func (m *DeleteMetadataRepositoryNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteMetadataRepositoryNodeResponse) ProtoMessage()    {}
 func (*DeleteMetadataRepositoryNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{50}
+	return fileDescriptor_acd58c06882c23f8, []int{52}
 }
 func (m *DeleteMetadataRepositoryNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2423,7 +2530,7 @@ func (m *RemoveMRPeerRequest) Reset()         { *m = RemoveMRPeerRequest{} }
 func (m *RemoveMRPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveMRPeerRequest) ProtoMessage()    {}
 func (*RemoveMRPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{51}
+	return fileDescriptor_acd58c06882c23f8, []int{53}
 }
 func (m *RemoveMRPeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2466,7 +2573,7 @@ func (m *RemoveMRPeerResponse) Reset()         { *m = RemoveMRPeerResponse{} }
 func (m *RemoveMRPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveMRPeerResponse) ProtoMessage()    {}
 func (*RemoveMRPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acd58c06882c23f8, []int{52}
+	return fileDescriptor_acd58c06882c23f8, []int{54}
 }
 func (m *RemoveMRPeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2495,656 +2602,1115 @@ func (m *RemoveMRPeerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveMRPeerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.admpb.StorageNodeMetadata")
-	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.admpb.GetStorageNodeRequest")
-	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.admpb.GetStorageNodeResponse")
-	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.admpb.ListStorageNodesRequest")
-	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.admpb.ListStorageNodesResponse")
-	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.admpb.AddStorageNodeRequest")
-	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.admpb.AddStorageNodeResponse")
-	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.admpb.UnregisterStorageNodeRequest")
-	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.admpb.UnregisterStorageNodeResponse")
-	proto.RegisterType((*GetTopicRequest)(nil), "varlog.admpb.GetTopicRequest")
-	proto.RegisterType((*GetTopicResponse)(nil), "varlog.admpb.GetTopicResponse")
-	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.admpb.DescribeTopicRequest")
-	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.admpb.DescribeTopicResponse")
-	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.admpb.ListTopicsRequest")
-	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.admpb.ListTopicsResponse")
-	proto.RegisterType((*AddTopicRequest)(nil), "varlog.admpb.AddTopicRequest")
-	proto.RegisterType((*AddTopicResponse)(nil), "varlog.admpb.AddTopicResponse")
-	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.admpb.UnregisterTopicRequest")
-	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.admpb.UnregisterTopicResponse")
-	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.admpb.GetLogStreamRequest")
-	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.admpb.GetLogStreamResponse")
-	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.admpb.ListLogStreamsRequest")
-	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.admpb.ListLogStreamsResponse")
-	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.admpb.AddLogStreamRequest")
-	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.admpb.AddLogStreamResponse")
-	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.admpb.UpdateLogStreamRequest")
-	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.admpb.UpdateLogStreamResponse")
-	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.admpb.UnregisterLogStreamRequest")
-	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.admpb.UnregisterLogStreamResponse")
-	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.admpb.RemoveLogStreamReplicaRequest")
-	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.admpb.RemoveLogStreamReplicaResponse")
-	proto.RegisterType((*SealRequest)(nil), "varlog.admpb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.admpb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.admpb.UnsealRequest")
-	proto.RegisterType((*UnsealResponse)(nil), "varlog.admpb.UnsealResponse")
-	proto.RegisterType((*SyncRequest)(nil), "varlog.admpb.SyncRequest")
-	proto.RegisterType((*SyncResponse)(nil), "varlog.admpb.SyncResponse")
-	proto.RegisterType((*TrimRequest)(nil), "varlog.admpb.TrimRequest")
-	proto.RegisterType((*TrimResult)(nil), "varlog.admpb.TrimResult")
-	proto.RegisterType((*TrimResponse)(nil), "varlog.admpb.TrimResponse")
-	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.admpb.GetMetadataRepositoryNodeRequest")
-	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.admpb.GetMetadataRepositoryNodeResponse")
-	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.admpb.ListMetadataRepositoryNodesRequest")
-	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.admpb.ListMetadataRepositoryNodesResponse")
-	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.admpb.GetMRMembersResponse")
-	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.admpb.GetMRMembersResponse.MembersEntry")
-	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.admpb.AddMetadataRepositoryNodeRequest")
-	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.admpb.AddMetadataRepositoryNodeResponse")
-	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.admpb.AddMRPeerRequest")
-	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.admpb.AddMRPeerResponse")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeRequest")
-	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeResponse")
-	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.admpb.RemoveMRPeerRequest")
-	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.admpb.RemoveMRPeerResponse")
+// ConsumerGroupOffsetLag represents the committed offset of a consumer group
+// and how far it is behind the high watermark.
+type ConsumerGroupOffsetLag struct {
+	Offset varlogpb.ConsumerGroupOffset `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset"`
+	// HighWatermark is the GLSN of the last committed log entry in the topic if
+	// the offset is a topic-level one. Otherwise, it is the LLSN of the last
+	// committed log entry in the log stream. It is computed from the reports of
+	// the storage nodes, thus it can be stale.
+	HighWatermark uint64 `protobuf:"varint,2,opt,name=high_watermark,json=highWatermark,proto3" json:"highWatermark"`
+	// Lag is the number of log entries that are committed but not processed by
+	// the consumer group yet.
+	Lag uint64 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag"`
+}
+
+func (m *ConsumerGroupOffsetLag) Reset()         { *m = ConsumerGroupOffsetLag{} }
+func (m *ConsumerGroupOffsetLag) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffsetLag) ProtoMessage()    {}
+func (*ConsumerGroupOffsetLag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{55}
+}
+func (m *ConsumerGroupOffsetLag) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupOffsetLag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffsetLag.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupOffsetLag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffsetLag.Merge(m, src)
+}
+func (m *ConsumerGroupOffsetLag) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupOffsetLag) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffsetLag.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("proto/admpb/admin.proto", fileDescriptor_acd58c06882c23f8) }
+var xxx_messageInfo_ConsumerGroupOffsetLag proto.InternalMessageInfo
 
-var fileDescriptor_acd58c06882c23f8 = []byte{
-	// 2161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4b, 0x73, 0xdb, 0xc6,
-	0x99, 0xa0, 0x64, 0x3d, 0x3e, 0xea, 0xe5, 0xa5, 0x5e, 0x86, 0x2c, 0x41, 0x81, 0x14, 0xd7, 0x4e,
-	0x1d, 0xb2, 0x71, 0x67, 0x3a, 0x1e, 0xb7, 0x99, 0x44, 0xb4, 0x1c, 0xd9, 0x8d, 0x64, 0xa7, 0xa0,
-	0x35, 0x9d, 0x24, 0x8d, 0x19, 0x90, 0x58, 0xd1, 0xac, 0x40, 0x02, 0xc5, 0x82, 0x6e, 0x74, 0x68,
-	0xa7, 0xd3, 0x69, 0xa7, 0x97, 0x1e, 0xf2, 0x13, 0x32, 0xbd, 0xf6, 0xd2, 0x63, 0x0e, 0xfd, 0x01,
-	0x9e, 0x1e, 0x3a, 0xbe, 0xb5, 0x97, 0x22, 0x53, 0xf9, 0xd2, 0xe1, 0xbd, 0x97, 0x9c, 0x3a, 0xd8,
-	0x5d, 0x00, 0x8b, 0x07, 0x49, 0x49, 0xb1, 0x9a, 0x19, 0x5d, 0x44, 0x00, 0xfb, 0xbd, 0x5f, 0xfb,
-	0xed, 0xb7, 0x82, 0x25, 0xdb, 0xb1, 0x5c, 0xab, 0xac, 0x1b, 0x6d, 0xbb, 0xee, 0xff, 0x6d, 0x75,
-	0x4a, 0xf4, 0x0b, 0x9a, 0x7a, 0xa6, 0x3b, 0xa6, 0xd5, 0x2c, 0xd1, 0x15, 0xf9, 0xcd, 0x66, 0xcb,
-	0x7d, 0xda, 0xad, 0x97, 0x1a, 0x56, 0xbb, 0xdc, 0xb4, 0x9a, 0x56, 0x99, 0x02, 0xd5, 0xbb, 0x07,
-	0xf4, 0x8d, 0xd1, 0xf0, 0x9f, 0x18, 0xb2, 0xac, 0x34, 0x2d, 0xab, 0x69, 0xe2, 0x08, 0xca, 0x6d,
-	0xb5, 0x31, 0x71, 0xf5, 0xb6, 0xcd, 0x01, 0x56, 0x92, 0x00, 0xb8, 0x6d, 0xbb, 0x47, 0x7c, 0x71,
-	0x89, 0xb1, 0xb6, 0xeb, 0xe5, 0x36, 0x76, 0x75, 0x43, 0x77, 0x75, 0xbe, 0xb0, 0x40, 0x3a, 0x76,
-	0xbd, 0xec, 0x60, 0xdb, 0x6c, 0x35, 0x74, 0xd7, 0x72, 0xf8, 0xe7, 0x22, 0xfd, 0x1c, 0x87, 0x55,
-	0xbf, 0xcc, 0x43, 0xb1, 0xea, 0x5a, 0x8e, 0xde, 0xc4, 0x0f, 0x2d, 0x03, 0xef, 0xf1, 0x55, 0xf4,
-	0x31, 0x4c, 0x11, 0xf6, 0xb9, 0xd6, 0xb1, 0x0c, 0xbc, 0x2c, 0xad, 0x4b, 0xd7, 0x0b, 0xb7, 0xde,
-	0x28, 0x71, 0x75, 0x7d, 0x52, 0xa5, 0x0c, 0xbc, 0x6d, 0x4c, 0x1a, 0x4e, 0xcb, 0x76, 0x2d, 0xa7,
-	0x32, 0xf5, 0xdc, 0x53, 0x72, 0x2f, 0x3c, 0x45, 0xea, 0x79, 0x4a, 0x4e, 0x2b, 0x90, 0x08, 0x18,
-	0x55, 0xa1, 0xd0, 0x70, 0xb0, 0xee, 0xe2, 0x9a, 0xaf, 0xf0, 0x72, 0x9e, 0xd2, 0x96, 0x4b, 0x4c,
-	0xd9, 0x52, 0xa0, 0x6c, 0xe9, 0x71, 0x60, 0x8d, 0xca, 0xa2, 0x4f, 0xab, 0xe7, 0x29, 0xc0, 0xd0,
-	0xfc, 0x85, 0xcf, 0xbf, 0x52, 0x24, 0x4d, 0x78, 0x47, 0x2d, 0x28, 0x9a, 0x3a, 0x71, 0x6b, 0x4f,
-	0xb1, 0xee, 0xb8, 0x75, 0xac, 0xbb, 0x8c, 0xf8, 0xc8, 0x50, 0xe2, 0xab, 0x9c, 0xf8, 0x65, 0x1f,
-	0xfd, 0x7e, 0x80, 0x1d, 0xf2, 0x48, 0x7f, 0xbe, 0x33, 0xfa, 0x9f, 0x2f, 0x14, 0x49, 0xfd, 0xbd,
-	0x04, 0x0b, 0x3b, 0xd8, 0x15, 0xac, 0xa0, 0xe1, 0x5f, 0x74, 0x31, 0x71, 0x91, 0x09, 0xb3, 0xa2,
-	0xf1, 0x6a, 0x2d, 0x83, 0xda, 0xef, 0x52, 0x65, 0xfb, 0xd8, 0x53, 0xa6, 0x05, 0x84, 0x07, 0xdb,
-	0x5f, 0x7b, 0x4a, 0x59, 0x08, 0x9a, 0x43, 0xfd, 0x50, 0xb7, 0xca, 0xcc, 0xc8, 0x65, 0xfb, 0xb0,
-	0x59, 0x76, 0x8f, 0x6c, 0x4c, 0x4a, 0x31, 0x14, 0x6d, 0x5a, 0xb0, 0xe5, 0x03, 0x43, 0xb5, 0x60,
-	0x31, 0x29, 0x06, 0xb1, 0xad, 0x0e, 0xc1, 0x68, 0x3f, 0xd3, 0x89, 0xaf, 0x95, 0xc4, 0x98, 0xcd,
-	0xf2, 0x62, 0x65, 0xb6, 0xe7, 0x29, 0xa2, 0xc7, 0x62, 0xee, 0x53, 0xaf, 0xc0, 0xd2, 0x6e, 0x8b,
-	0x88, 0x1c, 0x09, 0xd7, 0x5c, 0xfd, 0x0c, 0x96, 0xd3, 0x4b, 0x5c, 0x9a, 0x9f, 0xc1, 0xb4, 0x28,
-	0x0d, 0x59, 0x96, 0xd6, 0x47, 0x4e, 0x26, 0xce, 0x3c, 0xf7, 0xd0, 0x14, 0x11, 0xe9, 0xc6, 0xde,
-	0xd4, 0x27, 0xb0, 0xb0, 0x65, 0x18, 0x19, 0xce, 0xb8, 0x97, 0x69, 0x84, 0xab, 0x01, 0xd7, 0x20,
-	0x89, 0x44, 0xc6, 0x95, 0xd1, 0xe7, 0xc9, 0x98, 0xf5, 0xad, 0x9c, 0xa4, 0x7f, 0xbe, 0x56, 0xfe,
-	0xa3, 0x04, 0x57, 0xf7, 0x3b, 0x0e, 0x6e, 0xb6, 0x88, 0x8b, 0x9d, 0x6f, 0x3d, 0xca, 0x14, 0x58,
-	0xed, 0x23, 0x0d, 0x33, 0x83, 0x7a, 0x00, 0xb3, 0x3b, 0xd8, 0x7d, 0x6c, 0xd9, 0xad, 0x46, 0x20,
-	0x61, 0x15, 0x26, 0x5c, 0xff, 0x3d, 0x12, 0xed, 0xf6, 0xb1, 0xa7, 0x8c, 0x53, 0x18, 0x2a, 0xd4,
-	0x8d, 0xe1, 0x42, 0x71, 0x60, 0x6d, 0x9c, 0x52, 0x7a, 0x60, 0xa8, 0xfb, 0x30, 0x17, 0xf1, 0xe1,
-	0x2e, 0xd8, 0x82, 0x4b, 0x74, 0x99, 0xdb, 0x7e, 0x3d, 0xe5, 0x5c, 0x0a, 0x2e, 0x14, 0xa7, 0xc9,
-	0x9e, 0xa7, 0x30, 0x14, 0x8d, 0xfd, 0xa8, 0x87, 0x30, 0xcf, 0xd6, 0xeb, 0xf8, 0xfc, 0x75, 0xf8,
-	0x93, 0x04, 0x0b, 0x09, 0x6e, 0x5c, 0x93, 0x1f, 0x9d, 0x56, 0x13, 0x16, 0xaa, 0x0c, 0x09, 0xbd,
-	0x0f, 0x05, 0xd3, 0x6a, 0xd6, 0x88, 0xeb, 0x60, 0xbd, 0x4d, 0x96, 0xf3, 0x34, 0xc1, 0x36, 0x53,
-	0x34, 0x76, 0xad, 0x66, 0x95, 0x82, 0xa4, 0xe8, 0x80, 0x19, 0x2c, 0x11, 0xb5, 0x08, 0x97, 0xfd,
-	0x5c, 0xa6, 0x0c, 0xc3, 0x04, 0x7f, 0x02, 0x48, 0xfc, 0xc8, 0xa5, 0xbe, 0x0f, 0x63, 0x54, 0x80,
-	0x20, 0xa7, 0x87, 0x8b, 0x3d, 0xc3, 0x53, 0x9a, 0xe3, 0x69, 0xfc, 0x57, 0xbd, 0x0c, 0xb3, 0x5b,
-	0x86, 0x21, 0x7a, 0xc0, 0x77, 0x78, 0xf4, 0xe9, 0xd5, 0x39, 0xbc, 0x0d, 0x8b, 0x51, 0x40, 0x9f,
-	0xbf, 0xcb, 0xaf, 0xc0, 0x52, 0x8a, 0x1d, 0xcf, 0x9c, 0x17, 0x12, 0x14, 0x77, 0xb0, 0x1b, 0x7a,
-	0xe5, 0x3c, 0xe5, 0x40, 0x06, 0x4c, 0x47, 0x21, 0xe2, 0x53, 0xce, 0x53, 0xca, 0xef, 0x1e, 0x7b,
-	0x4a, 0x21, 0x94, 0x80, 0x52, 0x7f, 0x73, 0x38, 0x75, 0x01, 0x41, 0x2b, 0x84, 0xa1, 0xf3, 0xc0,
-	0x50, 0x7f, 0x0e, 0xf3, 0x71, 0x8d, 0xb8, 0xdf, 0x34, 0x80, 0x88, 0x3b, 0x77, 0xde, 0xc9, 0xe2,
-	0x73, 0xba, 0xe7, 0x29, 0x93, 0x21, 0x0b, 0x2d, 0x7a, 0x54, 0x4d, 0x58, 0xf0, 0x43, 0x32, 0x44,
-	0x22, 0xe7, 0xea, 0x47, 0x02, 0x8b, 0x49, 0x6e, 0x5c, 0xb7, 0x0f, 0xe3, 0xc9, 0x27, 0x9d, 0x22,
-	0xf9, 0x50, 0xd0, 0xdf, 0x44, 0xe9, 0x17, 0x4b, 0xc5, 0xbf, 0x48, 0x50, 0xdc, 0x32, 0x8c, 0xff,
-	0x4f, 0x84, 0x6c, 0xc3, 0x04, 0xef, 0x1d, 0x83, 0x0a, 0xa2, 0xa6, 0x94, 0xd0, 0x18, 0x40, 0xa2,
-	0x7e, 0x48, 0x5a, 0x88, 0xa9, 0x7e, 0x0c, 0xf3, 0x71, 0x89, 0xb9, 0x95, 0xee, 0x9e, 0x35, 0x02,
-	0x44, 0x97, 0xff, 0x37, 0x0f, 0x8b, 0xfb, 0xb6, 0xa1, 0xbb, 0xf8, 0x02, 0x25, 0x0d, 0x7a, 0x04,
-	0x33, 0xb6, 0x65, 0xdb, 0xd8, 0xa8, 0x71, 0x2b, 0xf2, 0xe6, 0xf5, 0xa4, 0xe6, 0xcf, 0x69, 0xd3,
-	0x0c, 0x9f, 0x2f, 0x53, 0x82, 0x5d, 0xf2, 0x54, 0x20, 0x38, 0x7a, 0x6a, 0x82, 0x14, 0x9f, 0x2f,
-	0xab, 0x4f, 0x60, 0x29, 0x65, 0xf6, 0x57, 0xe9, 0xd7, 0x7f, 0x48, 0x20, 0x47, 0x55, 0xf2, 0x22,
-	0x15, 0xc4, 0x55, 0x58, 0xc9, 0x54, 0x8c, 0x6f, 0x01, 0xcf, 0xf3, 0xb0, 0xaa, 0xe1, 0xb6, 0xf5,
-	0x4c, 0xb4, 0x2c, 0xb5, 0xf9, 0xb7, 0xd2, 0xed, 0xc5, 0x2c, 0x9d, 0x3f, 0x37, 0x4b, 0x8f, 0x9c,
-	0x87, 0xa5, 0xd7, 0x61, 0xad, 0x9f, 0x25, 0x03, 0x63, 0x4b, 0x50, 0xa8, 0x62, 0xdd, 0xbc, 0x00,
-	0x61, 0xf5, 0x2f, 0x09, 0xa6, 0x98, 0x2a, 0x3c, 0x0d, 0x8d, 0xac, 0x4d, 0xa8, 0x1c, 0x3b, 0xb6,
-	0x27, 0xed, 0x92, 0x71, 0x76, 0x1f, 0xb2, 0x1f, 0xa1, 0x26, 0x14, 0x08, 0xd6, 0x4d, 0x6c, 0xd4,
-	0x9a, 0x26, 0xe9, 0x50, 0xd5, 0x46, 0x2b, 0xef, 0x1d, 0x7b, 0x0a, 0x54, 0xe9, 0xe7, 0x9d, 0xdd,
-	0xea, 0x43, 0x1f, 0x9d, 0x84, 0x6f, 0x5f, 0x7b, 0xca, 0xb5, 0xe1, 0x7a, 0xfa, 0x90, 0x5a, 0x80,
-	0x65, 0x92, 0x8e, 0xfa, 0x37, 0x09, 0xa6, 0xf7, 0x3b, 0xe4, 0x62, 0x38, 0xcb, 0x80, 0x99, 0x40,
-	0x97, 0x73, 0x6c, 0x87, 0xbe, 0x1c, 0x81, 0x42, 0xf5, 0xa8, 0xd3, 0xb8, 0x00, 0x1b, 0xe2, 0x33,
-	0x28, 0x12, 0xa7, 0x51, 0x4b, 0xd6, 0x3d, 0x56, 0x36, 0x76, 0x8e, 0x3d, 0x65, 0xae, 0xea, 0x34,
-	0xbe, 0x71, 0xe9, 0x9b, 0x23, 0x71, 0x22, 0x94, 0xaf, 0x41, 0xdc, 0x14, 0xdf, 0xd1, 0x88, 0xef,
-	0x76, 0x6c, 0xc6, 0x71, 0x46, 0xbe, 0x46, 0x9c, 0x88, 0xa1, 0xbe, 0x03, 0x53, 0xcc, 0x73, 0x3c,
-	0x3c, 0xca, 0x30, 0x46, 0x5c, 0xdd, 0xed, 0x12, 0x1e, 0x1a, 0x4b, 0xf1, 0xf1, 0xdb, 0x51, 0xa7,
-	0x51, 0xa5, 0xcb, 0x1a, 0x07, 0x53, 0xff, 0x2e, 0x41, 0xe1, 0xb1, 0xd3, 0x0a, 0x37, 0xcc, 0x27,
-	0x29, 0xdf, 0xdf, 0x15, 0x7c, 0xdf, 0xf3, 0x94, 0xc0, 0xa1, 0x67, 0x0c, 0x83, 0x1a, 0x4c, 0xd2,
-	0x99, 0x9b, 0x50, 0x05, 0x2a, 0xc7, 0x9e, 0x32, 0xb1, 0xab, 0x13, 0x97, 0xd7, 0x80, 0x09, 0x93,
-	0x3f, 0x9f, 0xa2, 0x02, 0x30, 0x1c, 0x3f, 0xff, 0xff, 0x9c, 0x07, 0x60, 0x0a, 0x91, 0xae, 0xe9,
-	0xa2, 0x5f, 0xf5, 0xdb, 0x04, 0xf7, 0x53, 0x9b, 0x60, 0xcf, 0x53, 0xe2, 0x7b, 0xda, 0x2b, 0xd8,
-	0x15, 0x49, 0x76, 0xd4, 0x3f, 0x4a, 0x44, 0x7d, 0xcf, 0x53, 0xc4, 0x30, 0xfe, 0x86, 0x49, 0x70,
-	0x03, 0x2e, 0x61, 0xc7, 0xb1, 0x1c, 0x1a, 0xf6, 0x93, 0x95, 0x62, 0xcf, 0x53, 0x66, 0xe9, 0x87,
-	0x9b, 0x56, 0xbb, 0xe5, 0xd2, 0x81, 0xb0, 0xc6, 0x20, 0xd4, 0xfb, 0x30, 0xc5, 0x8d, 0xc5, 0xe2,
-	0xe7, 0x36, 0x8c, 0x3b, 0xd4, 0x70, 0xc1, 0x46, 0xb0, 0x1c, 0x1f, 0x4a, 0x45, 0x96, 0xe5, 0xed,
-	0x5e, 0x00, 0xae, 0x12, 0x58, 0xdf, 0xc1, 0x6e, 0xb0, 0x33, 0x68, 0xd8, 0xb6, 0x48, 0xcb, 0xb5,
-	0x9c, 0x23, 0x71, 0xfe, 0xf4, 0x08, 0xc6, 0x45, 0x27, 0x8c, 0x56, 0x7e, 0x70, 0xec, 0x29, 0x63,
-	0x61, 0x3e, 0x5c, 0x1f, 0xae, 0x33, 0xb7, 0xf2, 0x58, 0x87, 0x85, 0xff, 0xa7, 0xf0, 0xda, 0x00,
-	0xa6, 0x5c, 0xa7, 0x1f, 0xc2, 0xa8, 0x30, 0x65, 0xfb, 0x4e, 0xaa, 0x58, 0xf6, 0x41, 0xa7, 0x48,
-	0xea, 0x26, 0xa8, 0xfe, 0xe1, 0x2d, 0x1b, 0x26, 0x9c, 0x71, 0x10, 0xd8, 0x18, 0x08, 0xc5, 0x25,
-	0xd9, 0x85, 0x4b, 0xe2, 0x1c, 0xf3, 0xa4, 0xa2, 0x54, 0xa6, 0xf9, 0xe6, 0xca, 0xb0, 0x35, 0xf6,
-	0xa3, 0xfe, 0x3b, 0x4f, 0x8f, 0xcc, 0x7b, 0xda, 0x1e, 0x6e, 0xd7, 0xb1, 0x13, 0xb1, 0xd9, 0x86,
-	0x31, 0x13, 0xeb, 0x06, 0x76, 0xb8, 0x95, 0x6f, 0x9e, 0xce, 0xb6, 0x0c, 0x17, 0x3d, 0x04, 0x14,
-	0x5c, 0x08, 0xb4, 0xac, 0x4e, 0xed, 0x40, 0x6f, 0xb8, 0x96, 0xc3, 0xe3, 0x57, 0xe9, 0x79, 0xca,
-	0x8a, 0xb0, 0xfa, 0x1e, 0x5d, 0x14, 0xc2, 0xeb, 0x72, 0x6a, 0x11, 0xfd, 0x12, 0xc6, 0xdb, 0x4c,
-	0xd0, 0xe5, 0x91, 0x78, 0x8f, 0xc1, 0x42, 0x2b, 0x4b, 0x95, 0x12, 0x7f, 0xbf, 0xd7, 0x71, 0x9d,
-	0xa3, 0xca, 0xcd, 0xdf, 0x7e, 0x75, 0x0a, 0x3d, 0x02, 0x6e, 0xf2, 0x1d, 0x98, 0x12, 0xc9, 0xa0,
-	0x39, 0x18, 0x39, 0xc4, 0x47, 0xcc, 0x36, 0x9a, 0xff, 0x88, 0xe6, 0xe1, 0xd2, 0x33, 0xdd, 0xec,
-	0xb2, 0x7b, 0x85, 0x49, 0x8d, 0xbd, 0xdc, 0xc9, 0xdf, 0x96, 0x54, 0x07, 0xd6, 0xb7, 0x0c, 0x63,
-	0x70, 0x54, 0x5f, 0x83, 0x09, 0x47, 0x3f, 0x70, 0x6b, 0x5d, 0xc7, 0xa4, 0x44, 0x27, 0x2b, 0x05,
-	0xbf, 0x64, 0x6a, 0xfa, 0x81, 0xbb, 0xaf, 0xed, 0x6a, 0xe3, 0xfe, 0xe2, 0xbe, 0x63, 0x52, 0x38,
-	0xbb, 0x51, 0xd3, 0x0d, 0x83, 0x99, 0x31, 0x80, 0xfb, 0xe0, 0xee, 0x96, 0x61, 0x38, 0xda, 0xb8,
-	0x63, 0x37, 0xfc, 0x07, 0x3f, 0xa8, 0x07, 0xf0, 0x7c, 0x15, 0x41, 0x5d, 0xa7, 0xf3, 0xb1, 0x3d,
-	0xed, 0x03, 0x8c, 0x9d, 0xf3, 0xd2, 0xe2, 0x33, 0xb8, 0x2c, 0xf0, 0xe0, 0x52, 0x37, 0x92, 0x05,
-	0xe0, 0xc7, 0x51, 0x01, 0xe8, 0x79, 0xca, 0x1c, 0x4b, 0xeb, 0x28, 0x8e, 0xce, 0x54, 0x14, 0x7e,
-	0x23, 0xc1, 0xc6, 0x36, 0x36, 0xb1, 0x8b, 0x07, 0xfb, 0xed, 0xc3, 0xa4, 0x30, 0xef, 0xc6, 0x84,
-	0xe1, 0xe4, 0xce, 0x24, 0xc2, 0x35, 0xd8, 0x1c, 0x2c, 0x01, 0x3f, 0x57, 0xbc, 0x0d, 0x45, 0x76,
-	0xf2, 0x38, 0x93, 0x2f, 0xd4, 0x45, 0x98, 0x8f, 0xa3, 0x33, 0xb2, 0xb7, 0xfe, 0x5a, 0x84, 0x99,
-	0xbb, 0x66, 0xd7, 0x3f, 0x38, 0xee, 0xe9, 0x1d, 0xbd, 0x89, 0x1d, 0xf4, 0x09, 0xcc, 0xc4, 0xaf,
-	0x7c, 0xd0, 0x46, 0x2a, 0xfd, 0xd2, 0x37, 0x06, 0xf2, 0xe6, 0x60, 0x20, 0xae, 0x46, 0x0e, 0x35,
-	0x60, 0x2e, 0x79, 0x8b, 0x83, 0x5e, 0x8f, 0xe3, 0xf6, 0xb9, 0x00, 0x92, 0xaf, 0x0d, 0x03, 0x0b,
-	0x99, 0x7c, 0x02, 0x33, 0xf1, 0x0b, 0x95, 0xa4, 0x0e, 0x99, 0xd7, 0x39, 0x49, 0x1d, 0xb2, 0xef,
-	0x64, 0xd4, 0x1c, 0x72, 0x60, 0x21, 0xf3, 0xbe, 0x02, 0xbd, 0x11, 0x27, 0x30, 0xe8, 0x8a, 0x45,
-	0xfe, 0xee, 0x89, 0x60, 0x43, 0x9e, 0xef, 0xc3, 0x44, 0x70, 0x35, 0x81, 0x56, 0x53, 0xb6, 0x16,
-	0x67, 0xcc, 0xf2, 0x5a, 0xbf, 0xe5, 0x90, 0xd8, 0x47, 0x30, 0x1d, 0xbb, 0x22, 0x40, 0x6a, 0x1c,
-	0x25, 0xeb, 0xb6, 0x42, 0xde, 0x18, 0x08, 0x13, 0xd2, 0xfe, 0x09, 0x40, 0x34, 0xc5, 0x47, 0x4a,
-	0xda, 0x67, 0xb1, 0xa1, 0xbf, 0xbc, 0xde, 0x1f, 0x40, 0xd4, 0x3d, 0x98, 0xd2, 0x27, 0x75, 0x4f,
-	0x0c, 0xf4, 0x93, 0xba, 0x27, 0x87, 0xfb, 0x6a, 0x0e, 0x7d, 0x0a, 0xb3, 0x89, 0x61, 0x39, 0xda,
-	0xec, 0xe7, 0x8a, 0x18, 0xe9, 0xd7, 0x87, 0x40, 0x85, 0x1c, 0x7e, 0x0a, 0x53, 0xe2, 0x80, 0x1a,
-	0xbd, 0x96, 0xf2, 0x47, 0x72, 0xfa, 0x24, 0xab, 0x83, 0x40, 0xc4, 0xb0, 0x8e, 0xcf, 0x87, 0x93,
-	0x61, 0x9d, 0x39, 0xab, 0x4e, 0x86, 0x75, 0xf6, 0x88, 0x99, 0xc9, 0x2d, 0x8e, 0x55, 0x93, 0x72,
-	0x67, 0x0c, 0x89, 0x93, 0x72, 0x67, 0x4d, 0x65, 0xb9, 0xc9, 0xe3, 0xa3, 0xbd, 0x94, 0xc9, 0x33,
-	0x07, 0xae, 0x29, 0x93, 0x67, 0xcf, 0x07, 0xd5, 0x1c, 0x32, 0xa1, 0x98, 0x31, 0x02, 0x43, 0xd7,
-	0xfb, 0xb9, 0x2c, 0xc5, 0xe9, 0xc6, 0x09, 0x20, 0x43, 0x6e, 0x5d, 0x58, 0xcc, 0x1e, 0x03, 0xa1,
-	0x44, 0x52, 0x0f, 0x1c, 0xbb, 0xc9, 0x37, 0x4f, 0x06, 0x1c, 0xb2, 0x7d, 0x07, 0x46, 0xab, 0x58,
-	0x37, 0xd1, 0x95, 0xc4, 0xf5, 0x6f, 0x34, 0xc1, 0x90, 0xe5, 0xac, 0xa5, 0x90, 0xc0, 0x3d, 0x18,
-	0x63, 0x43, 0x02, 0xb4, 0x92, 0x54, 0x57, 0x18, 0x83, 0xc8, 0x57, 0xb3, 0x17, 0x63, 0x72, 0x1c,
-	0x75, 0x1a, 0x29, 0x39, 0xa2, 0xc1, 0x40, 0x4a, 0x0e, 0xe1, 0xe4, 0xc9, 0x08, 0xf8, 0xc7, 0x83,
-	0x24, 0x01, 0xe1, 0x74, 0x99, 0x24, 0x20, 0x1e, 0x3d, 0xd4, 0x1c, 0xfa, 0x35, 0x5c, 0xe9, 0xdb,
-	0xcd, 0xa3, 0x52, 0xba, 0x5b, 0x1c, 0xb4, 0xbb, 0xcb, 0xe5, 0x13, 0xc3, 0x87, 0xfc, 0x7f, 0x27,
-	0xc1, 0xca, 0x80, 0x36, 0x1e, 0x7d, 0x2f, 0x9d, 0x71, 0x83, 0xcf, 0x05, 0xf2, 0x5b, 0xa7, 0xc0,
-	0x08, 0xc5, 0xd8, 0xa5, 0x85, 0x26, 0xec, 0x85, 0xd1, 0x62, 0xea, 0x3f, 0x51, 0xee, 0xf9, 0xbd,
-	0x51, 0x46, 0x75, 0x49, 0xf5, 0xcf, 0xcc, 0xa8, 0x7d, 0xbb, 0xc9, 0xa4, 0x51, 0x87, 0xb5, 0xba,
-	0x49, 0xa3, 0x0e, 0x6d, 0x53, 0xd5, 0x1c, 0x7a, 0x08, 0x93, 0x61, 0x1f, 0x88, 0xd2, 0x75, 0x3c,
-	0xd6, 0xf8, 0xc8, 0x4a, 0xdf, 0xf5, 0x90, 0xde, 0x1f, 0x24, 0xb8, 0x3a, 0xa8, 0xb7, 0x42, 0x6f,
-	0x25, 0x37, 0xb4, 0xa1, 0x9d, 0xa0, 0x7c, 0xeb, 0x34, 0x28, 0x62, 0x61, 0x15, 0xbb, 0xaf, 0x64,
-	0x61, 0xcd, 0x68, 0xec, 0x92, 0x2e, 0xcb, 0x6a, 0xde, 0xd4, 0x5c, 0xe5, 0xed, 0xe7, 0xc7, 0x6b,
-	0xd2, 0x8b, 0xe3, 0x35, 0xe9, 0xf3, 0x97, 0x6b, 0xb9, 0x2f, 0x5e, 0xae, 0x49, 0x2f, 0x5e, 0xae,
-	0xe5, 0xfe, 0xf9, 0x72, 0x2d, 0xf7, 0xd1, 0x46, 0xdf, 0x4e, 0x34, 0xfa, 0x67, 0xb3, 0xfa, 0x18,
-	0x7d, 0xf9, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x72, 0xa8, 0x94, 0x14, 0x82, 0x26, 0x00,
-	0x00,
+func (m *ConsumerGroupOffsetLag) GetOffset() varlogpb.ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
+	}
+	return varlogpb.ConsumerGroupOffset{}
 }
 
-func (this *StorageNodeMetadata) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ConsumerGroupOffsetLag) GetHighWatermark() uint64 {
+	if m != nil {
+		return m.HighWatermark
 	}
+	return 0
+}
 
-	that1, ok := that.(*StorageNodeMetadata)
-	if !ok {
-		that2, ok := that.(StorageNodeMetadata)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
-		return false
-	}
-	if !this.CreateTime.Equal(that1.CreateTime) {
-		return false
-	}
-	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
-		return false
+func (m *ConsumerGroupOffsetLag) GetLag() uint64 {
+	if m != nil {
+		return m.Lag
 	}
-	return true
+	return 0
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// ConsumerGroupMetadata represents a consumer group and the lags of its
+// offsets.
+type ConsumerGroupMetadata struct {
+	Name    string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Offsets []ConsumerGroupOffsetLag `protobuf:"bytes,2,rep,name=offsets,proto3" json:"offsets"`
+}
 
-// ClusterManagerClient is the client API for ClusterManager service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ClusterManagerClient interface {
-	// GetStorageNode returns the metadata of the storage node requested.
-	// It produces a gRPC NotFound error if the storage node does not exist. If
-	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
-	// error. In this case, clients can retry with proper backoff to fix it.
-	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes.
-	// If the metadata repository cannot be reachable, it returns a gRPC
-	// Unavailable error. In this case, clients can retry with proper backoff to
-	// fix it. If the metadata fetched from the metadata repository is
-	// inconsistent, it returns a gRPC Internal error.
-	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
-	// adding an already added storage node is okay.
-	// Note that if the admin server cannot refresh the storage node list in the
-	// memory, it returns a gRPC Unavailable error. However, the storage node
-	// could have been added, so users should call this RPC with the same
-	// parameter again.
-	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
-	// argument snid. If users try to unregister an already non-exist node, it
-	// returns okay.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - FailedPrecondition: The storage node still has valid log stream replicas.
-	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
-	// GetTopic returns the topic specified by the request.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic doesn't exist.
-	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
-	// DescribeTopic returns the topic specified by the request.
-	// Deprecated: Use GetTopic.
-	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
-	// ListTopics returns a list of topics in the cluster.
-	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
-	// AddTopic adds a new topic and returns its metadata.
-	// It produces a gRPC Internal error if the metadata rejects the request.
-	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
-	// UnregisterTopic unregisters the topic specified by the argument tpid.
-	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
-	// the metadata repository.
-	// TODO: Its behavior is unclear if the topic has already been removed.
-	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
-	// even if some may be important.
-	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
-	// GetLogStream returns the metadata of the log stream specified by the
-	// arguments tpid and lsid. The metadata is the type stored in the metadata
-	// repository.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: Either the topic or the log stream does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
-	// ListLogStreams returns all log streams belonging to the topic specified by
-	// the argument tpid.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - NotFound: The topic does not exist.
-	// - Internal: The TopicID in the log stream metadata doesn't match.
-	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
-	// AddLogStream adds a new log stream to the cluster.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - ResourceExhausted: The number of log streams is reached the upper limit.
-	// - FailedPrecondition: Replicas information in the request is invalid; for
-	// example, the number of log stream replicas in the request does not equal
-	// the replication factor, or the storage node for the replica does not exist.
-	//
-	// TODO: Not all errors are codified.
-	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
-	// UpdateLogStream changes the configuration of replicas in a log stream.
-	//
-	// Its codes are defines as followings:
-	// - InvalidArgument: The client tries to swap the same replica.
-	// - Unavailable: The cluster metadata cannot be fetched from the metadata
-	// repository transiently.
-	// - NotFound: The target log stream does not exist.
-	// - FailedPrecondition: Either the target log stream is not sealed, the
-	// target log stream doesn't have a victim replica, or the target log stream
-	// already has both victim and new replica. Note that clients should not retry
-	// without any action.
-	// - Unknown: Either storage node or metadata repository returns an error.
-	//
-	// TODO: Moving the data directory within the same node is not supported yet.
-	// TODO: We will define codes for errors returned from storage nodes and
-	// metadata repository soon.
-	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
-	// UnregisterLogStream unregisters the log stream specified by the arguments
-	// tpid and lsid.
-	// TODO: It is not tested.
-	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
-	// RemoveLogStreamReplica removes the log stream replica specified by the
-	// arguments snid, tpid, and lsid.
-	//
-	// It returns the following gRPC errors:
-	// - Unavailable: The metadata cannot be fetched from the metadata repository.
-	// - FailedPrecondition: The log stream of the log stream replica can't be
-	// removable, e.g., clients still can append logs to the log stream.
-	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
-	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
-	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
+func (m *ConsumerGroupMetadata) Reset()         { *m = ConsumerGroupMetadata{} }
+func (m *ConsumerGroupMetadata) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupMetadata) ProtoMessage()    {}
+func (*ConsumerGroupMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{56}
 }
-
-type clusterManagerClient struct {
-	cc *grpc.ClientConn
+func (m *ConsumerGroupMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
-	return &clusterManagerClient{cc}
+func (m *ConsumerGroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupMetadata.Merge(m, src)
+}
+func (m *ConsumerGroupMetadata) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupMetadata.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
-	out := new(GetStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerGroupMetadata proto.InternalMessageInfo
+
+func (m *ConsumerGroupMetadata) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
-	out := new(ListStorageNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListStorageNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ConsumerGroupMetadata) GetOffsets() []ConsumerGroupOffsetLag {
+	if m != nil {
+		return m.Offsets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
-	out := new(AddStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListConsumerGroupsRequest struct {
 }
 
-func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
-	out := new(UnregisterStorageNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListConsumerGroupsRequest) Reset()         { *m = ListConsumerGroupsRequest{} }
+func (m *ListConsumerGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListConsumerGroupsRequest) ProtoMessage()    {}
+func (*ListConsumerGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{57}
+}
+func (m *ListConsumerGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConsumerGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConsumerGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListConsumerGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConsumerGroupsRequest.Merge(m, src)
+}
+func (m *ListConsumerGroupsRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListConsumerGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConsumerGroupsRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
-	out := new(GetTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ListConsumerGroupsRequest proto.InternalMessageInfo
+
+type ListConsumerGroupsResponse struct {
+	ConsumerGroups []ConsumerGroupMetadata `protobuf:"bytes,1,rep,name=consumer_groups,json=consumerGroups,proto3" json:"consumerGroups"`
 }
 
-func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
-	out := new(DescribeTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DescribeTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListConsumerGroupsResponse) Reset()         { *m = ListConsumerGroupsResponse{} }
+func (m *ListConsumerGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConsumerGroupsResponse) ProtoMessage()    {}
+func (*ListConsumerGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{58}
+}
+func (m *ListConsumerGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConsumerGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConsumerGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListConsumerGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConsumerGroupsResponse.Merge(m, src)
+}
+func (m *ListConsumerGroupsResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListConsumerGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConsumerGroupsResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
-	out := new(ListTopicsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListTopics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListConsumerGroupsResponse proto.InternalMessageInfo
+
+func (m *ListConsumerGroupsResponse) GetConsumerGroups() []ConsumerGroupMetadata {
+	if m != nil {
+		return m.ConsumerGroups
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
-	out := new(AddTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetConsumerGroupRequest struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
-	out := new(UnregisterTopicResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetConsumerGroupRequest) Reset()         { *m = GetConsumerGroupRequest{} }
+func (m *GetConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConsumerGroupRequest) ProtoMessage()    {}
+func (*GetConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{59}
+}
+func (m *GetConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConsumerGroupRequest.Merge(m, src)
+}
+func (m *GetConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConsumerGroupRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
-	out := new(GetLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *GetConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
-	out := new(ListLogStreamsResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListLogStreams", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetConsumerGroupResponse struct {
+	ConsumerGroup *ConsumerGroupMetadata `protobuf:"bytes,1,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumerGroup"`
+}
+
+func (m *GetConsumerGroupResponse) Reset()         { *m = GetConsumerGroupResponse{} }
+func (m *GetConsumerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GetConsumerGroupResponse) ProtoMessage()    {}
+func (*GetConsumerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{60}
+}
+func (m *GetConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetConsumerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetConsumerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConsumerGroupResponse.Merge(m, src)
+}
+func (m *GetConsumerGroupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetConsumerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConsumerGroupResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
-	out := new(AddLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetConsumerGroupResponse proto.InternalMessageInfo
+
+func (m *GetConsumerGroupResponse) GetConsumerGroup() *ConsumerGroupMetadata {
+	if m != nil {
+		return m.ConsumerGroup
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
-	out := new(UpdateLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UpdateLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ResetConsumerGroupOffsetRequest struct {
+	Group  string                       `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Offset varlogpb.ConsumerGroupOffset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset"`
+}
+
+func (m *ResetConsumerGroupOffsetRequest) Reset()         { *m = ResetConsumerGroupOffsetRequest{} }
+func (m *ResetConsumerGroupOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetConsumerGroupOffsetRequest) ProtoMessage()    {}
+func (*ResetConsumerGroupOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{61}
+}
+func (m *ResetConsumerGroupOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetConsumerGroupOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetConsumerGroupOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ResetConsumerGroupOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetConsumerGroupOffsetRequest.Merge(m, src)
+}
+func (m *ResetConsumerGroupOffsetRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ResetConsumerGroupOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetConsumerGroupOffsetRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
-	out := new(UnregisterLogStreamResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResetConsumerGroupOffsetRequest proto.InternalMessageInfo
+
+func (m *ResetConsumerGroupOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
-	out := new(RemoveLogStreamReplicaResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ResetConsumerGroupOffsetRequest) GetOffset() varlogpb.ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return varlogpb.ConsumerGroupOffset{}
 }
 
-func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ResetConsumerGroupOffsetResponse struct {
+	ConsumerGroup *ConsumerGroupMetadata `protobuf:"bytes,1,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumerGroup"`
+}
+
+func (m *ResetConsumerGroupOffsetResponse) Reset()         { *m = ResetConsumerGroupOffsetResponse{} }
+func (m *ResetConsumerGroupOffsetResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetConsumerGroupOffsetResponse) ProtoMessage()    {}
+func (*ResetConsumerGroupOffsetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{62}
+}
+func (m *ResetConsumerGroupOffsetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetConsumerGroupOffsetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetConsumerGroupOffsetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ResetConsumerGroupOffsetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetConsumerGroupOffsetResponse.Merge(m, src)
+}
+func (m *ResetConsumerGroupOffsetResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ResetConsumerGroupOffsetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetConsumerGroupOffsetResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
-	out := new(UnsealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResetConsumerGroupOffsetResponse proto.InternalMessageInfo
+
+func (m *ResetConsumerGroupOffsetResponse) GetConsumerGroup() *ConsumerGroupMetadata {
+	if m != nil {
+		return m.ConsumerGroup
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
-	out := new(SyncResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
+type DeleteConsumerGroupRequest struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (m *DeleteConsumerGroupRequest) Reset()         { *m = DeleteConsumerGroupRequest{} }
+func (m *DeleteConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroupRequest) ProtoMessage()    {}
+func (*DeleteConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{63}
+}
+func (m *DeleteConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroupRequest.Merge(m, src)
+}
+func (m *DeleteConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroupRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
-	out := new(TrimResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Trim", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *DeleteConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
-	out := new(GetMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+type DeleteConsumerGroupResponse struct {
+}
+
+func (m *DeleteConsumerGroupResponse) Reset()         { *m = DeleteConsumerGroupResponse{} }
+func (m *DeleteConsumerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroupResponse) ProtoMessage()    {}
+func (*DeleteConsumerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{64}
+}
+func (m *DeleteConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DeleteConsumerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroupResponse.Merge(m, src)
+}
+func (m *DeleteConsumerGroupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteConsumerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroupResponse.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
-	out := new(ListMetadataRepositoryNodesResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteConsumerGroupResponse proto.InternalMessageInfo
+
+type RegisterSchemaRequest struct {
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	Type          varlogpb.SchemaType                       `protobuf:"varint,2,opt,name=type,proto3,enum=varlog.varlogpb.SchemaType" json:"type,omitempty"`
+	Definition    []byte                                    `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	Compatibility varlogpb.SchemaCompatibility              `protobuf:"varint,4,opt,name=compatibility,proto3,enum=varlog.varlogpb.SchemaCompatibility" json:"compatibility,omitempty"`
+}
+
+func (m *RegisterSchemaRequest) Reset()         { *m = RegisterSchemaRequest{} }
+func (m *RegisterSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterSchemaRequest) ProtoMessage()    {}
+func (*RegisterSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{65}
+}
+func (m *RegisterSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RegisterSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterSchemaRequest.Merge(m, src)
+}
+func (m *RegisterSchemaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RegisterSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterSchemaRequest.DiscardUnknown(m)
 }
 
-func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
-	out := new(GetMRMembersResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMRMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RegisterSchemaRequest proto.InternalMessageInfo
+
+func (m *RegisterSchemaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
-	out := new(AddMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RegisterSchemaRequest) GetType() varlogpb.SchemaType {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return varlogpb.SchemaTypeUnspecified
 }
 
-func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
-	out := new(AddMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RegisterSchemaRequest) GetDefinition() []byte {
+	if m != nil {
+		return m.Definition
 	}
-	return out, nil
+	return nil
 }
 
-func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
-	out := new(DeleteMetadataRepositoryNodeResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RegisterSchemaRequest) GetCompatibility() varlogpb.SchemaCompatibility {
+	if m != nil {
+		return m.Compatibility
 	}
-	return out, nil
+	return varlogpb.SchemaCompatibilityBackward
 }
 
-func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
-	out := new(RemoveMRPeerResponse)
-	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveMRPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+type RegisterSchemaResponse struct {
+	Schema *varlogpb.SchemaDescriptor `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+}
+
+func (m *RegisterSchemaResponse) Reset()         { *m = RegisterSchemaResponse{} }
+func (m *RegisterSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterSchemaResponse) ProtoMessage()    {}
+func (*RegisterSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{66}
+}
+func (m *RegisterSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RegisterSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterSchemaResponse.Merge(m, src)
+}
+func (m *RegisterSchemaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RegisterSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterSchemaResponse.DiscardUnknown(m)
 }
 
-// ClusterManagerServer is the server API for ClusterManager service.
-type ClusterManagerServer interface {
-	// GetStorageNode returns the metadata of the storage node requested.
-	// It produces a gRPC NotFound error if the storage node does not exist. If
-	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
-	// error. In this case, clients can retry with proper backoff to fix it.
-	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
-	// ListStorageNodes returns a list of storage nodes.
-	// If the metadata repository cannot be reachable, it returns a gRPC
-	// Unavailable error. In this case, clients can retry with proper backoff to
-	// fix it. If the metadata fetched from the metadata repository is
-	// inconsistent, it returns a gRPC Internal error.
-	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
-	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
-	// adding an already added storage node is okay.
-	// Note that if the admin server cannot refresh the storage node list in the
-	// memory, it returns a gRPC Unavailable error. However, the storage node
-	// could have been added, so users should call this RPC with the same
-	// parameter again.
-	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
-	// UnregisterStorageNode unregisters the storage node specified by the
+var xxx_messageInfo_RegisterSchemaResponse proto.InternalMessageInfo
+
+func (m *RegisterSchemaResponse) GetSchema() *varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type GetSchemaRequest struct {
+	SchemaID github_com_kakao_varlog_pkg_types.SchemaID `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3,casttype=github.com/kakao/varlog/pkg/types.SchemaID" json:"schema_id,omitempty"`
+}
+
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{67}
+}
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(m, src)
+}
+func (m *GetSchemaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetSchemaID() github_com_kakao_varlog_pkg_types.SchemaID {
+	if m != nil {
+		return m.SchemaID
+	}
+	return 0
+}
+
+type GetSchemaResponse struct {
+	Schema *varlogpb.SchemaDescriptor `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+}
+
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{68}
+}
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(m, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetSchema() *varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type ListSchemasRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+}
+
+func (m *ListSchemasRequest) Reset()         { *m = ListSchemasRequest{} }
+func (m *ListSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasRequest) ProtoMessage()    {}
+func (*ListSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{69}
+}
+func (m *ListSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasRequest.Merge(m, src)
+}
+func (m *ListSchemasRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchemasRequest proto.InternalMessageInfo
+
+func (m *ListSchemasRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+type ListSchemasResponse struct {
+	Schemas []varlogpb.SchemaDescriptor `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas"`
+}
+
+func (m *ListSchemasResponse) Reset()         { *m = ListSchemasResponse{} }
+func (m *ListSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasResponse) ProtoMessage()    {}
+func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd58c06882c23f8, []int{70}
+}
+func (m *ListSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasResponse.Merge(m, src)
+}
+func (m *ListSchemasResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSchemasResponse proto.InternalMessageInfo
+
+func (m *ListSchemasResponse) GetSchemas() []varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StorageNodeMetadata)(nil), "varlog.admpb.StorageNodeMetadata")
+	proto.RegisterType((*GetStorageNodeRequest)(nil), "varlog.admpb.GetStorageNodeRequest")
+	proto.RegisterType((*GetStorageNodeResponse)(nil), "varlog.admpb.GetStorageNodeResponse")
+	proto.RegisterType((*ListStorageNodesRequest)(nil), "varlog.admpb.ListStorageNodesRequest")
+	proto.RegisterType((*ListStorageNodesResponse)(nil), "varlog.admpb.ListStorageNodesResponse")
+	proto.RegisterType((*AddStorageNodeRequest)(nil), "varlog.admpb.AddStorageNodeRequest")
+	proto.RegisterType((*AddStorageNodeResponse)(nil), "varlog.admpb.AddStorageNodeResponse")
+	proto.RegisterType((*UnregisterStorageNodeRequest)(nil), "varlog.admpb.UnregisterStorageNodeRequest")
+	proto.RegisterType((*UnregisterStorageNodeResponse)(nil), "varlog.admpb.UnregisterStorageNodeResponse")
+	proto.RegisterType((*GetTopicRequest)(nil), "varlog.admpb.GetTopicRequest")
+	proto.RegisterType((*GetTopicResponse)(nil), "varlog.admpb.GetTopicResponse")
+	proto.RegisterType((*DescribeTopicRequest)(nil), "varlog.admpb.DescribeTopicRequest")
+	proto.RegisterType((*DescribeTopicResponse)(nil), "varlog.admpb.DescribeTopicResponse")
+	proto.RegisterType((*ListTopicsRequest)(nil), "varlog.admpb.ListTopicsRequest")
+	proto.RegisterType((*ListTopicsResponse)(nil), "varlog.admpb.ListTopicsResponse")
+	proto.RegisterType((*GetTopicByNameRequest)(nil), "varlog.admpb.GetTopicByNameRequest")
+	proto.RegisterType((*GetTopicByNameResponse)(nil), "varlog.admpb.GetTopicByNameResponse")
+	proto.RegisterType((*AddTopicRequest)(nil), "varlog.admpb.AddTopicRequest")
+	proto.RegisterType((*AddTopicResponse)(nil), "varlog.admpb.AddTopicResponse")
+	proto.RegisterType((*UnregisterTopicRequest)(nil), "varlog.admpb.UnregisterTopicRequest")
+	proto.RegisterType((*UnregisterTopicResponse)(nil), "varlog.admpb.UnregisterTopicResponse")
+	proto.RegisterType((*GetLogStreamRequest)(nil), "varlog.admpb.GetLogStreamRequest")
+	proto.RegisterType((*GetLogStreamResponse)(nil), "varlog.admpb.GetLogStreamResponse")
+	proto.RegisterType((*ListLogStreamsRequest)(nil), "varlog.admpb.ListLogStreamsRequest")
+	proto.RegisterType((*ListLogStreamsResponse)(nil), "varlog.admpb.ListLogStreamsResponse")
+	proto.RegisterType((*AddLogStreamRequest)(nil), "varlog.admpb.AddLogStreamRequest")
+	proto.RegisterType((*AddLogStreamResponse)(nil), "varlog.admpb.AddLogStreamResponse")
+	proto.RegisterType((*UpdateLogStreamRequest)(nil), "varlog.admpb.UpdateLogStreamRequest")
+	proto.RegisterType((*UpdateLogStreamResponse)(nil), "varlog.admpb.UpdateLogStreamResponse")
+	proto.RegisterType((*UnregisterLogStreamRequest)(nil), "varlog.admpb.UnregisterLogStreamRequest")
+	proto.RegisterType((*UnregisterLogStreamResponse)(nil), "varlog.admpb.UnregisterLogStreamResponse")
+	proto.RegisterType((*RemoveLogStreamReplicaRequest)(nil), "varlog.admpb.RemoveLogStreamReplicaRequest")
+	proto.RegisterType((*RemoveLogStreamReplicaResponse)(nil), "varlog.admpb.RemoveLogStreamReplicaResponse")
+	proto.RegisterType((*SealRequest)(nil), "varlog.admpb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.admpb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.admpb.UnsealRequest")
+	proto.RegisterType((*UnsealResponse)(nil), "varlog.admpb.UnsealResponse")
+	proto.RegisterType((*SyncRequest)(nil), "varlog.admpb.SyncRequest")
+	proto.RegisterType((*SyncResponse)(nil), "varlog.admpb.SyncResponse")
+	proto.RegisterType((*TrimRequest)(nil), "varlog.admpb.TrimRequest")
+	proto.RegisterType((*TrimResult)(nil), "varlog.admpb.TrimResult")
+	proto.RegisterType((*TrimResponse)(nil), "varlog.admpb.TrimResponse")
+	proto.RegisterType((*GetMetadataRepositoryNodeRequest)(nil), "varlog.admpb.GetMetadataRepositoryNodeRequest")
+	proto.RegisterType((*GetMetadataRepositoryNodeResponse)(nil), "varlog.admpb.GetMetadataRepositoryNodeResponse")
+	proto.RegisterType((*ListMetadataRepositoryNodesRequest)(nil), "varlog.admpb.ListMetadataRepositoryNodesRequest")
+	proto.RegisterType((*ListMetadataRepositoryNodesResponse)(nil), "varlog.admpb.ListMetadataRepositoryNodesResponse")
+	proto.RegisterType((*GetMRMembersResponse)(nil), "varlog.admpb.GetMRMembersResponse")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.admpb.GetMRMembersResponse.MembersEntry")
+	proto.RegisterType((*AddMetadataRepositoryNodeRequest)(nil), "varlog.admpb.AddMetadataRepositoryNodeRequest")
+	proto.RegisterType((*AddMetadataRepositoryNodeResponse)(nil), "varlog.admpb.AddMetadataRepositoryNodeResponse")
+	proto.RegisterType((*AddMRPeerRequest)(nil), "varlog.admpb.AddMRPeerRequest")
+	proto.RegisterType((*AddMRPeerResponse)(nil), "varlog.admpb.AddMRPeerResponse")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeRequest)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeRequest")
+	proto.RegisterType((*DeleteMetadataRepositoryNodeResponse)(nil), "varlog.admpb.DeleteMetadataRepositoryNodeResponse")
+	proto.RegisterType((*RemoveMRPeerRequest)(nil), "varlog.admpb.RemoveMRPeerRequest")
+	proto.RegisterType((*RemoveMRPeerResponse)(nil), "varlog.admpb.RemoveMRPeerResponse")
+	proto.RegisterType((*ConsumerGroupOffsetLag)(nil), "varlog.admpb.ConsumerGroupOffsetLag")
+	proto.RegisterType((*ConsumerGroupMetadata)(nil), "varlog.admpb.ConsumerGroupMetadata")
+	proto.RegisterType((*ListConsumerGroupsRequest)(nil), "varlog.admpb.ListConsumerGroupsRequest")
+	proto.RegisterType((*ListConsumerGroupsResponse)(nil), "varlog.admpb.ListConsumerGroupsResponse")
+	proto.RegisterType((*GetConsumerGroupRequest)(nil), "varlog.admpb.GetConsumerGroupRequest")
+	proto.RegisterType((*GetConsumerGroupResponse)(nil), "varlog.admpb.GetConsumerGroupResponse")
+	proto.RegisterType((*ResetConsumerGroupOffsetRequest)(nil), "varlog.admpb.ResetConsumerGroupOffsetRequest")
+	proto.RegisterType((*ResetConsumerGroupOffsetResponse)(nil), "varlog.admpb.ResetConsumerGroupOffsetResponse")
+	proto.RegisterType((*DeleteConsumerGroupRequest)(nil), "varlog.admpb.DeleteConsumerGroupRequest")
+	proto.RegisterType((*DeleteConsumerGroupResponse)(nil), "varlog.admpb.DeleteConsumerGroupResponse")
+	proto.RegisterType((*RegisterSchemaRequest)(nil), "varlog.admpb.RegisterSchemaRequest")
+	proto.RegisterType((*RegisterSchemaResponse)(nil), "varlog.admpb.RegisterSchemaResponse")
+	proto.RegisterType((*GetSchemaRequest)(nil), "varlog.admpb.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "varlog.admpb.GetSchemaResponse")
+	proto.RegisterType((*ListSchemasRequest)(nil), "varlog.admpb.ListSchemasRequest")
+	proto.RegisterType((*ListSchemasResponse)(nil), "varlog.admpb.ListSchemasResponse")
+}
+
+func init() { proto.RegisterFile("proto/admpb/admin.proto", fileDescriptor_acd58c06882c23f8) }
+
+var fileDescriptor_acd58c06882c23f8 = []byte{
+	// 2763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xf5, 0x5a, 0xea, 0xfb, 0x51, 0x9f, 0xa3, 0xef, 0x95, 0xa5, 0x95, 0x57, 0x8a, 0x63, 0x27, 0x0e,
+	0xf9, 0x8b, 0x7e, 0x40, 0x61, 0xb8, 0x0d, 0x12, 0x51, 0x72, 0x14, 0x27, 0xb2, 0x9d, 0xae, 0x2c,
+	0x04, 0x89, 0x1b, 0x33, 0x2b, 0xee, 0x88, 0x62, 0x45, 0x72, 0xd9, 0xdd, 0xa5, 0x13, 0x22, 0x68,
+	0xd1, 0x06, 0xfd, 0x40, 0x81, 0x1e, 0xf2, 0x27, 0x04, 0xbd, 0xf6, 0xd2, 0x63, 0x80, 0xfe, 0x03,
+	0x46, 0x0f, 0x85, 0x6f, 0x2d, 0x50, 0x74, 0x83, 0xca, 0x97, 0x82, 0xf7, 0x5e, 0x72, 0x2a, 0x76,
+	0x66, 0x76, 0x39, 0xb3, 0x5f, 0xa4, 0x6c, 0x29, 0x01, 0x7c, 0x11, 0x77, 0xf6, 0x7d, 0xce, 0x7b,
+	0x6f, 0xde, 0xcc, 0xbc, 0xb7, 0x82, 0x85, 0x86, 0x65, 0x3a, 0x66, 0x5e, 0x37, 0x6a, 0x8d, 0x43,
+	0xef, 0x6f, 0xa5, 0x9e, 0x23, 0x6f, 0xd0, 0xd8, 0x23, 0xdd, 0xaa, 0x9a, 0xe5, 0x1c, 0x81, 0xc8,
+	0xaf, 0x95, 0x2b, 0xce, 0x71, 0xf3, 0x30, 0x57, 0x32, 0x6b, 0xf9, 0xb2, 0x59, 0x36, 0xf3, 0x04,
+	0xe9, 0xb0, 0x79, 0x44, 0x46, 0x94, 0x87, 0xf7, 0x44, 0x89, 0x65, 0xa5, 0x6c, 0x9a, 0xe5, 0x2a,
+	0xee, 0x60, 0x39, 0x95, 0x1a, 0xb6, 0x1d, 0xbd, 0xd6, 0x60, 0x08, 0xcb, 0x61, 0x04, 0x5c, 0x6b,
+	0x38, 0x2d, 0x06, 0x5c, 0xa0, 0xa2, 0x1b, 0x87, 0xf9, 0x1a, 0x76, 0x74, 0x43, 0x77, 0x74, 0x06,
+	0x98, 0xb3, 0xeb, 0x8d, 0xc3, 0xbc, 0x85, 0x1b, 0xd5, 0x4a, 0x49, 0x77, 0x4c, 0x8b, 0xbd, 0x9e,
+	0xb1, 0xeb, 0x11, 0x5c, 0xf5, 0xeb, 0x0c, 0xcc, 0xec, 0x3b, 0xa6, 0xa5, 0x97, 0xf1, 0x5d, 0xd3,
+	0xc0, 0x77, 0x18, 0x14, 0x3d, 0x80, 0x31, 0x9b, 0xbe, 0x2e, 0xd6, 0x4d, 0x03, 0x2f, 0x4a, 0x6b,
+	0xd2, 0xd5, 0xec, 0xe6, 0x2b, 0x39, 0x36, 0x5d, 0x8f, 0x55, 0x2e, 0x86, 0x6e, 0x07, 0xdb, 0x25,
+	0xab, 0xd2, 0x70, 0x4c, 0xab, 0x30, 0xf6, 0xd8, 0x55, 0xfa, 0x9e, 0xb8, 0x8a, 0xd4, 0x76, 0x95,
+	0x3e, 0x2d, 0x6b, 0x77, 0x90, 0xd1, 0x3e, 0x64, 0x4b, 0x16, 0xd6, 0x1d, 0x5c, 0xf4, 0x26, 0xbc,
+	0x98, 0x21, 0xbc, 0xe5, 0x1c, 0x9d, 0x6c, 0xce, 0x9f, 0x6c, 0xee, 0xbe, 0x6f, 0x8d, 0xc2, 0xbc,
+	0xc7, 0xab, 0xed, 0x2a, 0x40, 0xc9, 0x3c, 0xc0, 0x97, 0xdf, 0x28, 0x92, 0xc6, 0x8d, 0x51, 0x05,
+	0x66, 0xaa, 0xba, 0xed, 0x14, 0x8f, 0xb1, 0x6e, 0x39, 0x87, 0x58, 0x77, 0x28, 0xf3, 0xfe, 0xae,
+	0xcc, 0x57, 0x18, 0xf3, 0x69, 0x8f, 0xfc, 0x1d, 0x9f, 0x3a, 0x90, 0x11, 0x7d, 0x7d, 0x73, 0xe0,
+	0x3f, 0x5f, 0x29, 0x92, 0xfa, 0x1b, 0x09, 0xe6, 0x76, 0xb1, 0xc3, 0x59, 0x41, 0xc3, 0x3f, 0x6b,
+	0x62, 0xdb, 0x41, 0x55, 0x98, 0xe4, 0x8d, 0x57, 0xac, 0x18, 0xc4, 0x7e, 0x83, 0x85, 0x9d, 0x53,
+	0x57, 0x19, 0xe7, 0x08, 0x6e, 0xef, 0x7c, 0xeb, 0x2a, 0x79, 0x2e, 0x68, 0x4e, 0xf4, 0x13, 0xdd,
+	0xcc, 0x53, 0x23, 0xe7, 0x1b, 0x27, 0xe5, 0xbc, 0xd3, 0x6a, 0x60, 0x3b, 0x27, 0x90, 0x68, 0xe3,
+	0x9c, 0x2d, 0x6f, 0x1b, 0xaa, 0x09, 0xf3, 0x61, 0x35, 0xec, 0x86, 0x59, 0xb7, 0x31, 0x3a, 0x88,
+	0x75, 0xe2, 0xe5, 0x1c, 0x1f, 0xb3, 0x71, 0x5e, 0x2c, 0x4c, 0xb6, 0x5d, 0x85, 0xf7, 0x98, 0xe0,
+	0x3e, 0x75, 0x09, 0x16, 0xf6, 0x2a, 0x36, 0x2f, 0xd1, 0x66, 0x33, 0x57, 0x3f, 0x83, 0xc5, 0x28,
+	0x88, 0x69, 0xf3, 0x13, 0x18, 0xe7, 0xb5, 0xb1, 0x17, 0xa5, 0xb5, 0xfe, 0xde, 0xd4, 0x99, 0x65,
+	0x1e, 0x1a, 0xb3, 0x79, 0xbe, 0xc2, 0x48, 0x7d, 0x08, 0x73, 0x5b, 0x86, 0x11, 0xe3, 0x8c, 0x5b,
+	0xb1, 0x46, 0xb8, 0xe4, 0x4b, 0xf5, 0x17, 0x11, 0x2f, 0xb8, 0x30, 0xf0, 0x38, 0x1c, 0xb3, 0x9e,
+	0x95, 0xc3, 0xfc, 0x2f, 0xd6, 0xca, 0x7f, 0x90, 0xe0, 0xd2, 0x41, 0xdd, 0xc2, 0xe5, 0x8a, 0xed,
+	0x60, 0xeb, 0x7b, 0x8f, 0x32, 0x05, 0x56, 0x12, 0xb4, 0xa1, 0x66, 0x50, 0x8f, 0x60, 0x72, 0x17,
+	0x3b, 0xf7, 0xcd, 0x46, 0xa5, 0xe4, 0x6b, 0xb8, 0x0f, 0x23, 0x8e, 0x37, 0xee, 0xa8, 0x76, 0xe3,
+	0xd4, 0x55, 0x86, 0x09, 0x0e, 0x51, 0xea, 0x5a, 0x77, 0xa5, 0x18, 0xb2, 0x36, 0x4c, 0x38, 0xdd,
+	0x36, 0xd4, 0x03, 0x98, 0xea, 0xc8, 0x61, 0x2e, 0xd8, 0x82, 0x41, 0x02, 0x66, 0xb6, 0x5f, 0x8b,
+	0x38, 0x97, 0xa0, 0x73, 0xc9, 0x69, 0xb4, 0xed, 0x2a, 0x94, 0x44, 0xa3, 0x3f, 0xea, 0x09, 0xcc,
+	0x52, 0xf8, 0x21, 0xbe, 0xf8, 0x39, 0xfc, 0x51, 0x82, 0xb9, 0x90, 0x34, 0x36, 0x93, 0x1f, 0x9d,
+	0x75, 0x26, 0x34, 0x54, 0x29, 0x11, 0x7a, 0x0f, 0xb2, 0x55, 0xb3, 0x5c, 0xb4, 0x1d, 0x0b, 0xeb,
+	0x35, 0x7b, 0x31, 0x43, 0x16, 0xd8, 0x46, 0x84, 0xc7, 0x9e, 0x59, 0xde, 0x27, 0x28, 0x11, 0x3e,
+	0x50, 0xf5, 0x41, 0xb6, 0x3a, 0x03, 0xd3, 0xde, 0x5a, 0x26, 0x02, 0x83, 0x05, 0xfe, 0x10, 0x10,
+	0xff, 0x92, 0x69, 0xfd, 0x0e, 0x0c, 0x11, 0x05, 0xfc, 0x35, 0xdd, 0x5d, 0xed, 0x09, 0xb6, 0xa4,
+	0x19, 0x9d, 0xc6, 0x7e, 0xd5, 0x57, 0x49, 0x4e, 0x25, 0xd8, 0x85, 0xd6, 0x5d, 0xbd, 0x16, 0x44,
+	0x3b, 0x82, 0x81, 0xba, 0x5e, 0xa3, 0xab, 0x6b, 0x54, 0x23, 0xcf, 0xea, 0x03, 0x98, 0x0f, 0x23,
+	0x9f, 0x5f, 0x40, 0xe8, 0x30, 0xb9, 0x65, 0x18, 0x42, 0x2c, 0xc4, 0xe8, 0x80, 0x6e, 0xc2, 0x50,
+	0xc9, 0xac, 0x1f, 0x55, 0xca, 0x8b, 0x99, 0x84, 0xc4, 0x42, 0x58, 0x6c, 0x13, 0x1c, 0x66, 0x65,
+	0x46, 0xe1, 0x85, 0x72, 0x47, 0xc4, 0xf9, 0x69, 0x5e, 0x83, 0xf9, 0xce, 0x52, 0xbd, 0xf8, 0x60,
+	0x5e, 0x82, 0x85, 0x88, 0x38, 0x96, 0x13, 0x9e, 0x48, 0x30, 0xb3, 0x8b, 0x9d, 0x20, 0xde, 0x2e,
+	0x52, 0x0f, 0x64, 0xc0, 0x78, 0x27, 0xf8, 0x3d, 0xce, 0x19, 0xc2, 0xf9, 0xad, 0x53, 0x57, 0xc9,
+	0x06, 0x1a, 0x10, 0xee, 0xaf, 0x75, 0xe7, 0xce, 0x11, 0x68, 0xd9, 0x60, 0x51, 0xdc, 0x36, 0xd4,
+	0x9f, 0xc2, 0xac, 0x38, 0x23, 0xe6, 0x37, 0x0d, 0xa0, 0x23, 0x9d, 0x39, 0xaf, 0xb7, 0x95, 0x37,
+	0xde, 0x76, 0x95, 0xd1, 0x40, 0x84, 0xd6, 0x79, 0x54, 0xab, 0x30, 0xe7, 0x2d, 0xb6, 0x80, 0xc8,
+	0xbe, 0x50, 0x3f, 0xda, 0x30, 0x1f, 0x96, 0xc6, 0xe6, 0xf6, 0xa1, 0x98, 0x56, 0xa4, 0x33, 0xa4,
+	0x15, 0xe4, 0x9f, 0xdc, 0x3a, 0x89, 0x45, 0x48, 0x32, 0x7f, 0x96, 0x60, 0x66, 0xcb, 0x30, 0xbe,
+	0x9b, 0x08, 0xd9, 0x81, 0x11, 0x76, 0x2a, 0xf6, 0x73, 0xa3, 0x1a, 0x99, 0x84, 0x46, 0x11, 0x42,
+	0x99, 0x51, 0xd2, 0x02, 0x4a, 0xf5, 0x01, 0xcc, 0x8a, 0x1a, 0x33, 0x2b, 0x6d, 0x3f, 0x6b, 0x04,
+	0xf0, 0x2e, 0xff, 0x6f, 0x06, 0xe6, 0x0f, 0x1a, 0x86, 0xee, 0xe0, 0x17, 0x68, 0xd1, 0xa0, 0x7b,
+	0x30, 0xd1, 0x30, 0x1b, 0x0d, 0x6c, 0x14, 0x99, 0x15, 0xd9, 0xb1, 0xbc, 0x57, 0xf3, 0xf7, 0x69,
+	0xe3, 0x94, 0x9e, 0x81, 0x09, 0xc3, 0xa6, 0x7d, 0xcc, 0x31, 0x1c, 0x38, 0x33, 0x43, 0x42, 0xcf,
+	0xc0, 0xea, 0x43, 0x58, 0x88, 0x98, 0xfd, 0x3c, 0xfd, 0xfa, 0x77, 0x09, 0xe4, 0x4e, 0x96, 0x7c,
+	0x91, 0x12, 0xe2, 0x0a, 0x2c, 0xc7, 0x4e, 0x8c, 0x6d, 0x01, 0x8f, 0x33, 0xb0, 0xa2, 0xe1, 0x9a,
+	0xf9, 0x88, 0xb7, 0x2c, 0xb1, 0xf9, 0xf7, 0x72, 0x8e, 0x15, 0x2c, 0x9d, 0xb9, 0x30, 0x4b, 0xf7,
+	0x5f, 0x84, 0xa5, 0xd7, 0x60, 0x35, 0xc9, 0x92, 0xbe, 0xb1, 0x25, 0xc8, 0xee, 0x63, 0xbd, 0xfa,
+	0x02, 0x84, 0xd5, 0xbf, 0x24, 0x18, 0xa3, 0x53, 0x61, 0xcb, 0xd0, 0x88, 0xdb, 0x84, 0xf2, 0x42,
+	0x41, 0x22, 0x6c, 0x97, 0x98, 0xaa, 0x44, 0x97, 0xfd, 0x08, 0x95, 0x21, 0x6b, 0x63, 0xbd, 0x8a,
+	0x8d, 0x62, 0xb9, 0x6a, 0xd7, 0xc9, 0xd4, 0x06, 0x0a, 0x6f, 0x9f, 0xba, 0x0a, 0xec, 0x93, 0xd7,
+	0xbb, 0x7b, 0xfb, 0x77, 0x3d, 0x72, 0x3b, 0x18, 0x7d, 0xeb, 0x2a, 0x57, 0xba, 0xcf, 0xd3, 0xc3,
+	0xd4, 0x7c, 0xaa, 0xaa, 0x5d, 0x57, 0xff, 0x2a, 0xc1, 0xf8, 0x41, 0xdd, 0x7e, 0x31, 0x9c, 0x65,
+	0xc0, 0x84, 0x3f, 0x97, 0x0b, 0x3c, 0x0e, 0x7d, 0xdd, 0x0f, 0xd9, 0xfd, 0x56, 0xbd, 0xf4, 0x02,
+	0x6c, 0x88, 0x8f, 0x60, 0xc6, 0xb6, 0x4a, 0xc5, 0x70, 0xde, 0xa3, 0x69, 0x63, 0xf7, 0xd4, 0x55,
+	0xa6, 0xf6, 0xad, 0xd2, 0x73, 0xa7, 0xbe, 0x29, 0x5b, 0x64, 0x42, 0xe4, 0x1a, 0xb6, 0x13, 0x91,
+	0x3b, 0xd0, 0x91, 0xbb, 0x63, 0x3b, 0xcf, 0x2f, 0xd7, 0x10, 0x99, 0x18, 0xea, 0x9b, 0x30, 0x46,
+	0x3d, 0xc7, 0xc2, 0x23, 0x0f, 0x43, 0xb6, 0xa3, 0x3b, 0x4d, 0x9b, 0x85, 0xc6, 0x82, 0x58, 0x58,
+	0x6c, 0xd5, 0x4b, 0xfb, 0x04, 0xac, 0x31, 0x34, 0xf5, 0x6f, 0x12, 0x64, 0xef, 0x5b, 0x95, 0x60,
+	0xc3, 0x7c, 0x18, 0xf1, 0xfd, 0x36, 0xe7, 0xfb, 0xb6, 0xab, 0xf8, 0x0e, 0x7d, 0xc6, 0x30, 0x28,
+	0xc2, 0x28, 0xa9, 0x26, 0x72, 0x59, 0xa0, 0x70, 0xea, 0x2a, 0x23, 0x7b, 0xba, 0xed, 0xb0, 0x1c,
+	0x30, 0x52, 0x65, 0xcf, 0x67, 0xc8, 0x00, 0x94, 0xc6, 0x5b, 0xff, 0x7f, 0xca, 0x00, 0xd0, 0x09,
+	0xd9, 0xcd, 0xaa, 0x83, 0x7e, 0x9e, 0xb4, 0x09, 0x1e, 0x44, 0x36, 0xc1, 0xb6, 0xab, 0x88, 0x7b,
+	0xda, 0x39, 0xec, 0x8a, 0x76, 0x7c, 0xd4, 0xdf, 0x0b, 0x45, 0xbd, 0x57, 0xb0, 0xe2, 0xc2, 0xf8,
+	0x39, 0x17, 0xc1, 0x35, 0x18, 0xc4, 0x96, 0x65, 0x5a, 0x24, 0xec, 0x47, 0x0b, 0x33, 0x6d, 0x57,
+	0x99, 0x24, 0x2f, 0xae, 0x9b, 0xb5, 0x8a, 0x43, 0x4a, 0xdd, 0x1a, 0xc5, 0x50, 0xdf, 0x81, 0x31,
+	0x66, 0x2c, 0x1a, 0x3f, 0x37, 0x60, 0xd8, 0x22, 0x86, 0xf3, 0x37, 0x82, 0x45, 0xb1, 0xdc, 0xd6,
+	0xb1, 0x2c, 0x3b, 0xee, 0xf9, 0xe8, 0xaa, 0x0d, 0x6b, 0xbb, 0xd8, 0xf1, 0x77, 0x06, 0x0d, 0x37,
+	0x4c, 0xbb, 0xe2, 0x98, 0x56, 0x8b, 0xaf, 0xac, 0xdd, 0x83, 0x61, 0xde, 0x09, 0x03, 0x85, 0x1f,
+	0x9c, 0xba, 0xca, 0x50, 0xb0, 0x1e, 0xae, 0x76, 0x9f, 0x33, 0xb3, 0xf2, 0x50, 0x9d, 0x86, 0xff,
+	0x27, 0x70, 0x39, 0x45, 0x28, 0x9b, 0xd3, 0x0f, 0x61, 0x80, 0xab, 0x1f, 0xbe, 0x1c, 0x49, 0x96,
+	0x09, 0xe4, 0x84, 0x48, 0xdd, 0x00, 0xd5, 0xbb, 0xbc, 0xc5, 0xe3, 0x04, 0xd5, 0x1b, 0x1b, 0xd6,
+	0x53, 0xb1, 0x98, 0x26, 0x7b, 0x30, 0xc8, 0x57, 0x68, 0x7b, 0x55, 0xa5, 0x30, 0xce, 0x36, 0x57,
+	0x4a, 0xad, 0xd1, 0x1f, 0xf5, 0xdf, 0x19, 0x72, 0x65, 0xbe, 0xa3, 0xdd, 0xc1, 0xb5, 0x43, 0x6c,
+	0x75, 0xc4, 0xec, 0xc0, 0x50, 0x15, 0xeb, 0x06, 0xb6, 0x98, 0x95, 0xaf, 0x9f, 0xcd, 0xb6, 0x94,
+	0x16, 0xdd, 0x05, 0xe4, 0xb7, 0x3a, 0x2a, 0x66, 0xbd, 0x78, 0xa4, 0x97, 0x1c, 0xd3, 0x62, 0xf1,
+	0xab, 0xb4, 0x5d, 0x65, 0x99, 0x83, 0xbe, 0x4d, 0x80, 0x5c, 0x78, 0x4d, 0x47, 0x80, 0xe8, 0x53,
+	0x18, 0xae, 0x51, 0x45, 0x17, 0xfb, 0xc5, 0x33, 0x06, 0x0d, 0xad, 0xb8, 0xa9, 0xe4, 0xd8, 0xf8,
+	0x56, 0xdd, 0xb1, 0x5a, 0x85, 0xeb, 0x5f, 0x7c, 0x73, 0x86, 0x79, 0xf8, 0xd2, 0xe4, 0x9b, 0x30,
+	0xc6, 0xb3, 0x41, 0x53, 0xd0, 0x7f, 0x82, 0x5b, 0xd4, 0x36, 0x9a, 0xf7, 0x88, 0x66, 0x61, 0xf0,
+	0x91, 0x5e, 0x6d, 0xd2, 0x8e, 0xc9, 0xa8, 0x46, 0x07, 0x37, 0x33, 0x37, 0x24, 0xd5, 0x82, 0xb5,
+	0x2d, 0xc3, 0x48, 0x8f, 0xea, 0x2b, 0x30, 0x62, 0xe9, 0x47, 0x4e, 0xb1, 0x69, 0x55, 0x69, 0x05,
+	0xab, 0x90, 0xf5, 0x52, 0xa6, 0xa6, 0x1f, 0x39, 0x07, 0xda, 0x9e, 0x36, 0xec, 0x01, 0x0f, 0xac,
+	0x2a, 0xc1, 0x6b, 0x94, 0x8a, 0xba, 0x61, 0x50, 0x33, 0xfa, 0x78, 0xef, 0x6f, 0x6f, 0x19, 0x86,
+	0xa5, 0x0d, 0x5b, 0x8d, 0x92, 0xf7, 0xe0, 0x05, 0x75, 0x8a, 0xcc, 0xf3, 0x08, 0xea, 0x43, 0x52,
+	0x1f, 0xbb, 0xa3, 0xbd, 0x8f, 0xb1, 0x75, 0x51, 0xb3, 0xf8, 0x0c, 0xa6, 0x39, 0x19, 0x4c, 0xeb,
+	0x52, 0x38, 0x01, 0xbc, 0xdb, 0x49, 0x00, 0x6d, 0x57, 0x99, 0xa2, 0xcb, 0xba, 0x13, 0x47, 0xcf,
+	0x94, 0x14, 0x7e, 0x29, 0xc1, 0xfa, 0x0e, 0xae, 0x62, 0x07, 0xa7, 0xfb, 0xed, 0xc3, 0xb0, 0x32,
+	0x6f, 0x09, 0xca, 0x30, 0x76, 0xcf, 0xa4, 0xc2, 0x15, 0xd8, 0x48, 0xd7, 0x80, 0xdd, 0x2b, 0xde,
+	0x80, 0x19, 0x7a, 0xf3, 0x78, 0x26, 0x5f, 0xa8, 0xf3, 0x30, 0x2b, 0x92, 0x33, 0xb6, 0x7f, 0x91,
+	0x60, 0x7e, 0xdb, 0xac, 0xdb, 0xcd, 0x1a, 0xb6, 0x76, 0x2d, 0xb3, 0xd9, 0xb8, 0x77, 0x74, 0x64,
+	0x63, 0x67, 0x4f, 0x2f, 0xa3, 0x3d, 0x18, 0x32, 0xc9, 0x20, 0xf1, 0xec, 0x18, 0x43, 0xd8, 0xa9,
+	0x2a, 0x53, 0x5a, 0x8d, 0xfd, 0xa2, 0x1b, 0x30, 0x71, 0x5c, 0x29, 0x1f, 0x17, 0x3f, 0xd5, 0x1d,
+	0x6c, 0xd5, 0x74, 0xeb, 0x84, 0x6d, 0xe9, 0xd3, 0xde, 0x5e, 0xea, 0x41, 0x3e, 0xf0, 0x01, 0x9a,
+	0x38, 0x44, 0x4b, 0xd0, 0x5f, 0xd5, 0xcb, 0x64, 0x87, 0x1a, 0x28, 0x0c, 0xb7, 0x5d, 0xc5, 0x1b,
+	0x6a, 0xde, 0x1f, 0xf5, 0xb7, 0x12, 0xcc, 0x09, 0x4a, 0x04, 0xcd, 0xd3, 0x4b, 0x7c, 0x9d, 0xb8,
+	0x30, 0xd2, 0x76, 0x15, 0x32, 0x66, 0x15, 0xe3, 0x7b, 0x30, 0x4c, 0xd5, 0x8a, 0x14, 0xe8, 0x69,
+	0x82, 0x89, 0xb7, 0x48, 0x61, 0x92, 0xcd, 0xcd, 0x27, 0xd6, 0xfc, 0x07, 0x75, 0x19, 0x96, 0xbc,
+	0xac, 0x2e, 0xd0, 0x05, 0x29, 0xff, 0x0b, 0x09, 0xe4, 0x38, 0x68, 0x70, 0xab, 0x9a, 0x2c, 0x31,
+	0x48, 0xb1, 0x4c, 0x40, 0x2c, 0xe9, 0xaf, 0xa7, 0x28, 0x15, 0x74, 0xb0, 0xfc, 0xbe, 0xec, 0x44,
+	0x49, 0xe4, 0x1e, 0x1a, 0xab, 0x79, 0x58, 0xd8, 0xc5, 0xa2, 0x0a, 0x7e, 0x0c, 0xcd, 0xc2, 0x20,
+	0x91, 0xcb, 0x8a, 0xea, 0x74, 0xa0, 0xb6, 0x60, 0x31, 0x4a, 0xc0, 0x54, 0xfe, 0x18, 0x26, 0x44,
+	0x95, 0x59, 0x88, 0xf4, 0xa4, 0x31, 0xf1, 0xb8, 0xa0, 0x9d, 0x26, 0x0e, 0xd5, 0xcf, 0x41, 0xd1,
+	0xb0, 0x8d, 0x9d, 0x18, 0x37, 0xa4, 0xea, 0x8c, 0x0a, 0x41, 0xc8, 0x66, 0xce, 0x10, 0xb2, 0xac,
+	0x23, 0x40, 0x29, 0xd5, 0x5f, 0x49, 0xb0, 0x96, 0x2c, 0xfd, 0xbb, 0x31, 0xc0, 0x26, 0xc8, 0x34,
+	0x29, 0x9c, 0xc1, 0x5f, 0x2b, 0xb0, 0x1c, 0x4b, 0xc3, 0x16, 0xfa, 0xef, 0x33, 0x30, 0xa7, 0xf9,
+	0xbd, 0xc3, 0xd2, 0x31, 0xae, 0xe9, 0x17, 0x7a, 0x87, 0xcb, 0xc3, 0x80, 0x07, 0x21, 0x7e, 0x98,
+	0xd8, 0x5c, 0x8e, 0xb6, 0x7a, 0x89, 0x0a, 0xf7, 0x5b, 0x0d, 0xac, 0x11, 0x44, 0xb4, 0x0a, 0x60,
+	0xe0, 0xa3, 0x4a, 0xbd, 0xe2, 0x9d, 0x03, 0xc8, 0x62, 0x1f, 0xd3, 0xb8, 0x37, 0xe8, 0x5d, 0x18,
+	0x2f, 0x99, 0xb5, 0x86, 0xee, 0x54, 0x0e, 0x2b, 0xd5, 0x8a, 0xd3, 0x22, 0x17, 0xa6, 0x89, 0xcd,
+	0x8d, 0x04, 0xce, 0xdb, 0x3c, 0xae, 0x26, 0x92, 0xaa, 0x45, 0x98, 0x0f, 0x9b, 0x82, 0xf9, 0xf5,
+	0x16, 0x0c, 0xd9, 0xe4, 0x4d, 0xb8, 0x85, 0x1c, 0x62, 0xcf, 0x5d, 0x96, 0xc1, 0x4b, 0x76, 0x94,
+	0x48, 0x63, 0xbf, 0xea, 0x09, 0x69, 0x90, 0x8a, 0x66, 0xfe, 0x00, 0x46, 0x29, 0xb4, 0x63, 0xe7,
+	0x9b, 0xde, 0x75, 0x86, 0x62, 0x11, 0x43, 0xbf, 0xd2, 0xc3, 0x15, 0x82, 0x61, 0x6b, 0x23, 0x94,
+	0xd9, 0x6d, 0x43, 0xfd, 0x08, 0xa6, 0x39, 0x61, 0xe7, 0x3b, 0x91, 0x0a, 0xed, 0x35, 0x52, 0xdc,
+	0x8b, 0xed, 0x7d, 0x94, 0x60, 0x46, 0x10, 0x15, 0x1c, 0x84, 0x87, 0xa9, 0x2e, 0x91, 0x8f, 0x15,
+	0x92, 0x67, 0x12, 0xe4, 0x69, 0x46, 0xa9, 0xf9, 0x0f, 0x9b, 0xff, 0x5c, 0x82, 0x89, 0xed, 0x6a,
+	0xd3, 0xf3, 0xfc, 0x1d, 0xbd, 0xae, 0x97, 0xb1, 0xe5, 0x2d, 0x65, 0xf1, 0xdb, 0x0d, 0xb4, 0x1e,
+	0x39, 0x6d, 0x46, 0x5b, 0xff, 0xf2, 0x46, 0x3a, 0x12, 0x5b, 0x75, 0x7d, 0xa8, 0x04, 0x53, 0xe1,
+	0xcf, 0x31, 0xd0, 0x4b, 0x22, 0x6d, 0xc2, 0x97, 0x1c, 0xf2, 0x95, 0x6e, 0x68, 0x81, 0x90, 0x8f,
+	0x61, 0x42, 0xfc, 0x32, 0x22, 0x3c, 0x87, 0xd8, 0xef, 0x32, 0xe4, 0x8d, 0x74, 0xa4, 0x80, 0xbd,
+	0x05, 0x73, 0xb1, 0x1f, 0x1e, 0xa0, 0x57, 0x44, 0x06, 0x69, 0xdf, 0x4a, 0xc8, 0xaf, 0xf6, 0x84,
+	0x1b, 0xc8, 0x7c, 0x0f, 0x46, 0xfc, 0xc6, 0x32, 0x5a, 0x89, 0xd8, 0x9a, 0x6f, 0xa9, 0xca, 0xab,
+	0x49, 0x60, 0xde, 0x3e, 0x62, 0x97, 0x3a, 0xc6, 0xc7, 0xd1, 0x86, 0xb7, 0xbc, 0x91, 0x8e, 0x14,
+	0xb0, 0xff, 0x08, 0xc6, 0x85, 0x4f, 0x09, 0x90, 0x2a, 0x12, 0xc6, 0x7d, 0xd5, 0x20, 0xaf, 0xa7,
+	0xe2, 0x04, 0xbc, 0x7f, 0x0c, 0xd0, 0xe9, 0xf6, 0x23, 0x25, 0x1a, 0x12, 0xc2, 0xc7, 0x01, 0xf2,
+	0x5a, 0x32, 0x02, 0x6f, 0x5a, 0xbf, 0xe7, 0x1d, 0x36, 0x6d, 0xa8, 0xdd, 0x2e, 0xaf, 0x26, 0x81,
+	0x03, 0x66, 0x9f, 0xc0, 0x64, 0xa8, 0xf5, 0x8c, 0x36, 0x92, 0x3c, 0x2d, 0xb0, 0x7e, 0xa9, 0x0b,
+	0x56, 0x20, 0xe1, 0x03, 0x18, 0xe3, 0xdb, 0xbd, 0xe8, 0x72, 0xc4, 0x2b, 0xe1, 0x5e, 0x8e, 0xac,
+	0xa6, 0xa1, 0xf0, 0x51, 0x21, 0x76, 0x5b, 0xc3, 0x51, 0x11, 0xdb, 0xf9, 0x95, 0x37, 0xd2, 0x91,
+	0x78, 0xbd, 0xf9, 0x26, 0x65, 0x58, 0xef, 0x98, 0x96, 0xab, 0xac, 0xa6, 0xa1, 0x08, 0x26, 0x17,
+	0x1b, 0x65, 0x11, 0x93, 0xc7, 0xb6, 0x2f, 0xe5, 0x97, 0xba, 0x60, 0x05, 0x12, 0xaa, 0x30, 0x13,
+	0xd3, 0x50, 0x42, 0x57, 0x93, 0x5c, 0x16, 0x91, 0x74, 0xad, 0x07, 0xcc, 0x40, 0x5a, 0x13, 0xe6,
+	0xe9, 0xdd, 0x24, 0xdc, 0x3c, 0x40, 0xa1, 0x9c, 0x91, 0xda, 0xc4, 0x92, 0xaf, 0xf7, 0x86, 0x1c,
+	0x88, 0x7d, 0x13, 0x06, 0xbc, 0x86, 0x02, 0x5a, 0x12, 0xe9, 0xb8, 0xe6, 0x8d, 0x2c, 0xc7, 0x81,
+	0x02, 0x06, 0xb7, 0x60, 0x88, 0x96, 0xdc, 0xd1, 0x72, 0x78, 0xba, 0x5c, 0x53, 0x41, 0xbe, 0x14,
+	0x0f, 0x14, 0xf4, 0x68, 0xd5, 0x4b, 0x11, 0x3d, 0x3a, 0x65, 0x76, 0x59, 0x8e, 0x03, 0xf1, 0x0c,
+	0xbc, 0x62, 0x5b, 0x98, 0x01, 0x57, 0xab, 0x95, 0xe5, 0x38, 0x50, 0xc0, 0xe0, 0x17, 0xb0, 0x94,
+	0x58, 0x1b, 0x43, 0xb9, 0x68, 0xed, 0x25, 0xed, 0xae, 0x2c, 0xe7, 0x7b, 0xc6, 0x0f, 0xe4, 0xff,
+	0x5a, 0x82, 0xe5, 0x94, 0xa2, 0x18, 0xfa, 0xbf, 0xe8, 0x8a, 0x4b, 0xaf, 0xb2, 0xc9, 0xaf, 0x9f,
+	0x81, 0x22, 0x50, 0x63, 0x0f, 0xc6, 0xf8, 0xca, 0x12, 0x9a, 0x8f, 0x7c, 0xb1, 0x7a, 0xcb, 0xab,
+	0x34, 0xc4, 0x64, 0x97, 0x48, 0x35, 0x8a, 0x1a, 0x35, 0xb1, 0x36, 0x13, 0x36, 0x6a, 0xb7, 0xc2,
+	0x91, 0x9c, 0xef, 0x19, 0x3f, 0x90, 0x7f, 0x17, 0x46, 0x83, 0xaa, 0x0a, 0x8a, 0xe6, 0x71, 0xa1,
+	0x8c, 0x20, 0x2b, 0x89, 0xf0, 0x80, 0xdf, 0xef, 0x24, 0xb8, 0x94, 0x56, 0xa9, 0x40, 0xaf, 0x87,
+	0x37, 0xb4, 0xae, 0x75, 0x15, 0x79, 0xf3, 0x2c, 0x24, 0x7c, 0x62, 0xe5, 0x6b, 0x19, 0xe1, 0xc4,
+	0x1a, 0x53, 0x26, 0x91, 0xd5, 0x34, 0x94, 0x80, 0x31, 0x3b, 0xed, 0x8a, 0xf7, 0x74, 0xf4, 0x72,
+	0x34, 0x96, 0x62, 0xef, 0xf9, 0xf2, 0xd5, 0xee, 0x88, 0xfc, 0xb1, 0x30, 0x7c, 0xbb, 0x0e, 0x1f,
+	0x0b, 0x13, 0xae, 0xeb, 0xf2, 0x95, 0x6e, 0x68, 0x81, 0x90, 0xcf, 0x61, 0x31, 0xe9, 0x26, 0x8b,
+	0x5e, 0x0b, 0x5b, 0x24, 0xf5, 0xbe, 0x2d, 0xe7, 0x7a, 0x45, 0xe7, 0xf7, 0x90, 0x98, 0xfb, 0x68,
+	0x78, 0x0f, 0x49, 0xbe, 0xe6, 0xca, 0xd7, 0x7a, 0xc0, 0xe4, 0xf7, 0x72, 0xf1, 0x4a, 0x17, 0xde,
+	0xcb, 0x63, 0xef, 0xbe, 0xf2, 0x46, 0x3a, 0x12, 0xbf, 0x98, 0x82, 0x3b, 0x16, 0x8a, 0x9e, 0x37,
+	0x45, 0xa6, 0x4a, 0x22, 0x3c, 0xe0, 0x77, 0x1f, 0xb2, 0xdc, 0x65, 0x07, 0xc5, 0x9c, 0xda, 0xc4,
+	0x2b, 0x97, 0x7c, 0x39, 0x05, 0xc3, 0xe7, 0x5a, 0x78, 0xe3, 0xf1, 0xe9, 0xaa, 0xf4, 0xe4, 0x74,
+	0x55, 0xfa, 0xf2, 0xe9, 0x6a, 0xdf, 0x57, 0x4f, 0x57, 0xa5, 0x27, 0x4f, 0x57, 0xfb, 0xfe, 0xf1,
+	0x74, 0xb5, 0xef, 0xa3, 0xf5, 0xc4, 0x0b, 0x59, 0xe7, 0x9f, 0x2a, 0x0e, 0x87, 0xc8, 0xe0, 0xff,
+	0xff, 0x37, 0x00, 0x0a, 0x15, 0xa5, 0x46, 0x6a, 0x31, 0x00, 0x00,
+}
+
+func (this *StorageNodeMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageNodeMetadata)
+	if !ok {
+		that2, ok := that.(StorageNodeMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StorageNodeMetadataDescriptor.Equal(&that1.StorageNodeMetadataDescriptor) {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterManagerClient is the client API for ClusterManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterManagerClient interface {
+	// GetStorageNode returns the metadata of the storage node requested.
+	// It produces a gRPC NotFound error if the storage node does not exist. If
+	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
+	// error. In this case, clients can retry with proper backoff to fix it.
+	GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes.
+	// If the metadata repository cannot be reachable, it returns a gRPC
+	// Unavailable error. In this case, clients can retry with proper backoff to
+	// fix it. If the metadata fetched from the metadata repository is
+	// inconsistent, it returns a gRPC Internal error.
+	ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
+	// adding an already added storage node is okay.
+	// Note that if the admin server cannot refresh the storage node list in the
+	// memory, it returns a gRPC Unavailable error. However, the storage node
+	// could have been added, so users should call this RPC with the same
+	// parameter again.
+	AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
 	// argument snid. If users try to unregister an already non-exist node, it
 	// returns okay.
 	//
 	// It returns the following gRPC errors:
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - FailedPrecondition: The storage node still has valid log stream replicas.
-	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
+	UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error)
 	// GetTopic returns the topic specified by the request.
 	//
 	// It returns the following gRPC errors:
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - NotFound: The topic doesn't exist.
-	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error)
+	// GetTopicByName returns the topic whose name is the same as the request.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name is empty.
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic doesn't exist.
+	GetTopicByName(ctx context.Context, in *GetTopicByNameRequest, opts ...grpc.CallOption) (*GetTopicByNameResponse, error)
 	// DescribeTopic returns the topic specified by the request.
 	// Deprecated: Use GetTopic.
-	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
+	DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error)
 	// ListTopics returns a list of topics in the cluster.
-	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error)
 	// AddTopic adds a new topic and returns its metadata.
-	// It produces a gRPC Internal error if the metadata rejects the request.
-	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name or configuration of the topic is invalid.
+	// - AlreadyExists: Another topic has the same name.
+	// - Internal: The metadata repository rejects the request.
+	AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error)
 	// UnregisterTopic unregisters the topic specified by the argument tpid.
 	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
 	// the metadata repository.
 	// TODO: Its behavior is unclear if the topic has already been removed.
 	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
 	// even if some may be important.
-	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
+	UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error)
 	// GetLogStream returns the metadata of the log stream specified by the
 	// arguments tpid and lsid. The metadata is the type stored in the metadata
 	// repository.
@@ -3153,7 +3719,7 @@ type ClusterManagerServer interface {
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - NotFound: Either the topic or the log stream does not exist.
 	// - Internal: The TopicID in the log stream metadata doesn't match.
-	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
+	GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error)
 	// ListLogStreams returns all log streams belonging to the topic specified by
 	// the argument tpid.
 	//
@@ -3161,7 +3727,7 @@ type ClusterManagerServer interface {
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - NotFound: The topic does not exist.
 	// - Internal: The TopicID in the log stream metadata doesn't match.
-	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
+	ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error)
 	// AddLogStream adds a new log stream to the cluster.
 	//
 	// It returns the following gRPC errors:
@@ -3172,7 +3738,7 @@ type ClusterManagerServer interface {
 	// the replication factor, or the storage node for the replica does not exist.
 	//
 	// TODO: Not all errors are codified.
-	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
+	AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error)
 	// UpdateLogStream changes the configuration of replicas in a log stream.
 	//
 	// Its codes are defines as followings:
@@ -3189,11 +3755,11 @@ type ClusterManagerServer interface {
 	// TODO: Moving the data directory within the same node is not supported yet.
 	// TODO: We will define codes for errors returned from storage nodes and
 	// metadata repository soon.
-	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
+	UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error)
 	// UnregisterLogStream unregisters the log stream specified by the arguments
 	// tpid and lsid.
 	// TODO: It is not tested.
-	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
+	UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error)
 	// RemoveLogStreamReplica removes the log stream replica specified by the
 	// arguments snid, tpid, and lsid.
 	//
@@ -3201,1287 +3767,1429 @@ type ClusterManagerServer interface {
 	// - Unavailable: The metadata cannot be fetched from the metadata repository.
 	// - FailedPrecondition: The log stream of the log stream replica can't be
 	// removable, e.g., clients still can append logs to the log stream.
-	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
-	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
-	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
-	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
-	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
-	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
-	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
-	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
-	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
-	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
-}
-
-// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
-type UnimplementedClusterManagerServer struct {
+	RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error)
+	GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error)
+	// ListConsumerGroups returns all consumer groups sorted by their names,
+	// including the lags of their offsets.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	ListConsumerGroups(ctx context.Context, in *ListConsumerGroupsRequest, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error)
+	// GetConsumerGroup returns the consumer group specified by the argument
+	// group, including the lags of its offsets.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The consumer group does not exist.
+	GetConsumerGroup(ctx context.Context, in *GetConsumerGroupRequest, opts ...grpc.CallOption) (*GetConsumerGroupResponse, error)
+	// ResetConsumerGroupOffset overwrites the offset of the consumer group. The
+	// new offset can be behind the old one. If the consumer group does not
+	// exist, it is created.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name of the consumer group or the offset is
+	// invalid.
+	// - NotFound: The topic or the log stream does not exist.
+	ResetConsumerGroupOffset(ctx context.Context, in *ResetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*ResetConsumerGroupOffsetResponse, error)
+	// DeleteConsumerGroup deletes the consumer group and its offsets.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The consumer group does not exist.
+	DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*DeleteConsumerGroupResponse, error)
+	// RegisterSchema registers a new version of the schema of the topic and
+	// returns it. The new version is checked against the latest version of the
+	// topic according to the compatibility in the request. Registering the
+	// definition of an existing version returns that version.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The type or the definition of the schema is invalid.
+	// - NotFound: The topic does not exist.
+	// - FailedPrecondition: The schema is incompatible with the latest version.
+	RegisterSchema(ctx context.Context, in *RegisterSchemaRequest, opts ...grpc.CallOption) (*RegisterSchemaResponse, error)
+	// GetSchema returns the schema specified by the argument schema_id.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The schema does not exist.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
+	// ListSchemas returns all versions of the schema of the topic sorted by
+	// their versions.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The topic does not exist.
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
 }
 
-func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
-}
-func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
-}
-func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
-}
-func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
-}
-func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
-}
-func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
-}
-func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
-}
-func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
-}
-func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+type clusterManagerClient struct {
+	cc *grpc.ClientConn
 }
 
-func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
-	s.RegisterService(&_ClusterManager_serviceDesc, srv)
+func NewClusterManagerClient(cc *grpc.ClientConn) ClusterManagerClient {
+	return &clusterManagerClient{cc}
 }
 
-func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetStorageNode(ctx context.Context, in *GetStorageNodeRequest, opts ...grpc.CallOption) (*GetStorageNodeResponse, error) {
+	out := new(GetStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetStorageNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListStorageNodesRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListStorageNodes(ctx context.Context, in *ListStorageNodesRequest, opts ...grpc.CallOption) (*ListStorageNodesResponse, error) {
+	out := new(ListStorageNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListStorageNodes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListStorageNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddStorageNode(ctx context.Context, in *AddStorageNodeRequest, opts ...grpc.CallOption) (*AddStorageNodeResponse, error) {
+	out := new(AddStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddStorageNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterStorageNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterStorageNode(ctx context.Context, in *UnregisterStorageNodeRequest, opts ...grpc.CallOption) (*UnregisterStorageNodeResponse, error) {
+	out := new(UnregisterStorageNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterStorageNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetTopic(ctx context.Context, in *GetTopicRequest, opts ...grpc.CallOption) (*GetTopicResponse, error) {
+	out := new(GetTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetTopicByName(ctx context.Context, in *GetTopicByNameRequest, opts ...grpc.CallOption) (*GetTopicByNameResponse, error) {
+	out := new(GetTopicByNameResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetTopicByName", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/DescribeTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTopicsRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) DescribeTopic(ctx context.Context, in *DescribeTopicRequest, opts ...grpc.CallOption) (*DescribeTopicResponse, error) {
+	out := new(DescribeTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DescribeTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListTopics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListTopics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListTopics(ctx context.Context, in *ListTopicsRequest, opts ...grpc.CallOption) (*ListTopicsResponse, error) {
+	out := new(ListTopicsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListTopics", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterTopicRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddTopic(ctx context.Context, in *AddTopicRequest, opts ...grpc.CallOption) (*AddTopicResponse, error) {
+	out := new(AddTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterTopic(ctx context.Context, in *UnregisterTopicRequest, opts ...grpc.CallOption) (*UnregisterTopicResponse, error) {
+	out := new(UnregisterTopicResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterTopic", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLogStreamsRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetLogStream(ctx context.Context, in *GetLogStreamRequest, opts ...grpc.CallOption) (*GetLogStreamResponse, error) {
+	out := new(GetLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListLogStreams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListLogStreams(ctx context.Context, in *ListLogStreamsRequest, opts ...grpc.CallOption) (*ListLogStreamsResponse, error) {
+	out := new(ListLogStreamsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListLogStreams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddLogStream(ctx context.Context, in *AddLogStreamRequest, opts ...grpc.CallOption) (*AddLogStreamResponse, error) {
+	out := new(AddLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UpdateLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnregisterLogStreamRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UpdateLogStream(ctx context.Context, in *UpdateLogStreamRequest, opts ...grpc.CallOption) (*UpdateLogStreamResponse, error) {
+	out := new(UpdateLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UpdateLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/UnregisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveLogStreamReplicaRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) UnregisterLogStream(ctx context.Context, in *UnregisterLogStreamRequest, opts ...grpc.CallOption) (*UnregisterLogStreamResponse, error) {
+	out := new(UnregisterLogStreamResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/UnregisterLogStream", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/RemoveLogStreamReplica",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) RemoveLogStreamReplica(ctx context.Context, in *RemoveLogStreamReplicaRequest, opts ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
+	out := new(RemoveLogStreamReplicaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveLogStreamReplica", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Seal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Seal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Seal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Unseal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Unseal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Unseal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TrimRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Sync", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).Trim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/Trim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) Trim(ctx context.Context, in *TrimRequest, opts ...grpc.CallOption) (*TrimResponse, error) {
+	out := new(TrimResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/Trim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
+	return out, nil
+}
+
+func (c *clusterManagerClient) GetMetadataRepositoryNode(ctx context.Context, in *GetMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*GetMetadataRepositoryNodeResponse, error) {
+	out := new(GetMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMetadataRepositoryNodesRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) ListMetadataRepositoryNodes(ctx context.Context, in *ListMetadataRepositoryNodesRequest, opts ...grpc.CallOption) (*ListMetadataRepositoryNodesResponse, error) {
+	out := new(ListMetadataRepositoryNodesResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) GetMRMembers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GetMRMembersResponse, error) {
+	out := new(GetMRMembersResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetMRMembers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/GetMRMembers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddMetadataRepositoryNode(ctx context.Context, in *AddMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*AddMetadataRepositoryNodeResponse, error) {
+	out := new(AddMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMRPeerRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) AddMRPeer(ctx context.Context, in *AddMRPeerRequest, opts ...grpc.CallOption) (*AddMRPeerResponse, error) {
+	out := new(AddMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/AddMRPeer", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/AddMRPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMetadataRepositoryNodeRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) DeleteMetadataRepositoryNode(ctx context.Context, in *DeleteMetadataRepositoryNodeRequest, opts ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
+	out := new(DeleteMetadataRepositoryNodeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveMRPeerRequest)
-	if err := dec(in); err != nil {
+func (c *clusterManagerClient) RemoveMRPeer(ctx context.Context, in *RemoveMRPeerRequest, opts ...grpc.CallOption) (*RemoveMRPeerResponse, error) {
+	out := new(RemoveMRPeerResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RemoveMRPeer", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.admpb.ClusterManager/RemoveMRPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
+	return out, nil
+}
+
+func (c *clusterManagerClient) ListConsumerGroups(ctx context.Context, in *ListConsumerGroupsRequest, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error) {
+	out := new(ListConsumerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListConsumerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _ClusterManager_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.admpb.ClusterManager",
-	HandlerType: (*ClusterManagerServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetStorageNode",
-			Handler:    _ClusterManager_GetStorageNode_Handler,
-		},
-		{
-			MethodName: "ListStorageNodes",
-			Handler:    _ClusterManager_ListStorageNodes_Handler,
-		},
-		{
-			MethodName: "AddStorageNode",
-			Handler:    _ClusterManager_AddStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "GetTopic",
-			Handler:    _ClusterManager_GetTopic_Handler,
-		},
-		{
-			MethodName: "DescribeTopic",
-			Handler:    _ClusterManager_DescribeTopic_Handler,
-		},
-		{
-			MethodName: "ListTopics",
-			Handler:    _ClusterManager_ListTopics_Handler,
-		},
-		{
-			MethodName: "AddTopic",
-			Handler:    _ClusterManager_AddTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _ClusterManager_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "GetLogStream",
-			Handler:    _ClusterManager_GetLogStream_Handler,
-		},
-		{
-			MethodName: "ListLogStreams",
-			Handler:    _ClusterManager_ListLogStreams_Handler,
-		},
-		{
-			MethodName: "AddLogStream",
-			Handler:    _ClusterManager_AddLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
-			Handler:    _ClusterManager_UpdateLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _ClusterManager_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "RemoveLogStreamReplica",
-			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _ClusterManager_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _ClusterManager_Unseal_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _ClusterManager_Sync_Handler,
-		},
-		{
-			MethodName: "Trim",
-			Handler:    _ClusterManager_Trim_Handler,
-		},
-		{
-			MethodName: "GetMetadataRepositoryNode",
-			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "ListMetadataRepositoryNodes",
-			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
-		},
-		{
-			MethodName: "GetMRMembers",
-			Handler:    _ClusterManager_GetMRMembers_Handler,
-		},
-		{
-			MethodName: "AddMetadataRepositoryNode",
-			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "AddMRPeer",
-			Handler:    _ClusterManager_AddMRPeer_Handler,
-		},
-		{
-			MethodName: "DeleteMetadataRepositoryNode",
-			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
-		},
-		{
-			MethodName: "RemoveMRPeer",
-			Handler:    _ClusterManager_RemoveMRPeer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/admpb/admin.proto",
+func (c *clusterManagerClient) GetConsumerGroup(ctx context.Context, in *GetConsumerGroupRequest, opts ...grpc.CallOption) (*GetConsumerGroupResponse, error) {
+	out := new(GetConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ResetConsumerGroupOffset(ctx context.Context, in *ResetConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*ResetConsumerGroupOffsetResponse, error) {
+	out := new(ResetConsumerGroupOffsetResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ResetConsumerGroupOffset", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*DeleteConsumerGroupResponse, error) {
+	out := new(DeleteConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/DeleteConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintAdmin(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintAdmin(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) RegisterSchema(ctx context.Context, in *RegisterSchemaRequest, opts ...grpc.CallOption) (*RegisterSchemaResponse, error) {
+	out := new(RegisterSchemaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/RegisterSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/GetSchema", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterManagerClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, "/varlog.admpb.ClusterManager/ListSchemas", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClusterManagerServer is the server API for ClusterManager service.
+type ClusterManagerServer interface {
+	// GetStorageNode returns the metadata of the storage node requested.
+	// It produces a gRPC NotFound error if the storage node does not exist. If
+	// the metadata repository cannot be reachable, it returns a gRPC Unavailable
+	// error. In this case, clients can retry with proper backoff to fix it.
+	GetStorageNode(context.Context, *GetStorageNodeRequest) (*GetStorageNodeResponse, error)
+	// ListStorageNodes returns a list of storage nodes.
+	// If the metadata repository cannot be reachable, it returns a gRPC
+	// Unavailable error. In this case, clients can retry with proper backoff to
+	// fix it. If the metadata fetched from the metadata repository is
+	// inconsistent, it returns a gRPC Internal error.
+	ListStorageNodes(context.Context, *ListStorageNodesRequest) (*ListStorageNodesResponse, error)
+	// AddStorageNode adds a new storage node to the cluster. It is idempotent;
+	// adding an already added storage node is okay.
+	// Note that if the admin server cannot refresh the storage node list in the
+	// memory, it returns a gRPC Unavailable error. However, the storage node
+	// could have been added, so users should call this RPC with the same
+	// parameter again.
+	AddStorageNode(context.Context, *AddStorageNodeRequest) (*AddStorageNodeResponse, error)
+	// UnregisterStorageNode unregisters the storage node specified by the
+	// argument snid. If users try to unregister an already non-exist node, it
+	// returns okay.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - FailedPrecondition: The storage node still has valid log stream replicas.
+	UnregisterStorageNode(context.Context, *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error)
+	// GetTopic returns the topic specified by the request.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic doesn't exist.
+	GetTopic(context.Context, *GetTopicRequest) (*GetTopicResponse, error)
+	// GetTopicByName returns the topic whose name is the same as the request.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name is empty.
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic doesn't exist.
+	GetTopicByName(context.Context, *GetTopicByNameRequest) (*GetTopicByNameResponse, error)
+	// DescribeTopic returns the topic specified by the request.
+	// Deprecated: Use GetTopic.
+	DescribeTopic(context.Context, *DescribeTopicRequest) (*DescribeTopicResponse, error)
+	// ListTopics returns a list of topics in the cluster.
+	ListTopics(context.Context, *ListTopicsRequest) (*ListTopicsResponse, error)
+	// AddTopic adds a new topic and returns its metadata.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name or configuration of the topic is invalid.
+	// - AlreadyExists: Another topic has the same name.
+	// - Internal: The metadata repository rejects the request.
+	AddTopic(context.Context, *AddTopicRequest) (*AddTopicResponse, error)
+	// UnregisterTopic unregisters the topic specified by the argument tpid.
+	// It returns a gRPC Unavailable error if the metadata cannot be fetched from
+	// the metadata repository.
+	// TODO: Its behavior is unclear if the topic has already been removed.
+	// FIXME: It overwrites the gRPC errors returned from the metadata repository,
+	// even if some may be important.
+	UnregisterTopic(context.Context, *UnregisterTopicRequest) (*UnregisterTopicResponse, error)
+	// GetLogStream returns the metadata of the log stream specified by the
+	// arguments tpid and lsid. The metadata is the type stored in the metadata
+	// repository.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: Either the topic or the log stream does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	GetLogStream(context.Context, *GetLogStreamRequest) (*GetLogStreamResponse, error)
+	// ListLogStreams returns all log streams belonging to the topic specified by
+	// the argument tpid.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The topic does not exist.
+	// - Internal: The TopicID in the log stream metadata doesn't match.
+	ListLogStreams(context.Context, *ListLogStreamsRequest) (*ListLogStreamsResponse, error)
+	// AddLogStream adds a new log stream to the cluster.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - ResourceExhausted: The number of log streams is reached the upper limit.
+	// - FailedPrecondition: Replicas information in the request is invalid; for
+	// example, the number of log stream replicas in the request does not equal
+	// the replication factor, or the storage node for the replica does not exist.
+	//
+	// TODO: Not all errors are codified.
+	AddLogStream(context.Context, *AddLogStreamRequest) (*AddLogStreamResponse, error)
+	// UpdateLogStream changes the configuration of replicas in a log stream.
+	//
+	// Its codes are defines as followings:
+	// - InvalidArgument: The client tries to swap the same replica.
+	// - Unavailable: The cluster metadata cannot be fetched from the metadata
+	// repository transiently.
+	// - NotFound: The target log stream does not exist.
+	// - FailedPrecondition: Either the target log stream is not sealed, the
+	// target log stream doesn't have a victim replica, or the target log stream
+	// already has both victim and new replica. Note that clients should not retry
+	// without any action.
+	// - Unknown: Either storage node or metadata repository returns an error.
+	//
+	// TODO: Moving the data directory within the same node is not supported yet.
+	// TODO: We will define codes for errors returned from storage nodes and
+	// metadata repository soon.
+	UpdateLogStream(context.Context, *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error)
+	// UnregisterLogStream unregisters the log stream specified by the arguments
+	// tpid and lsid.
+	// TODO: It is not tested.
+	UnregisterLogStream(context.Context, *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error)
+	// RemoveLogStreamReplica removes the log stream replica specified by the
+	// arguments snid, tpid, and lsid.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - FailedPrecondition: The log stream of the log stream replica can't be
+	// removable, e.g., clients still can append logs to the log stream.
+	RemoveLogStreamReplica(context.Context, *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error)
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	Trim(context.Context, *TrimRequest) (*TrimResponse, error)
+	GetMetadataRepositoryNode(context.Context, *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error)
+	ListMetadataRepositoryNodes(context.Context, *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error)
+	GetMRMembers(context.Context, *types.Empty) (*GetMRMembersResponse, error)
+	AddMetadataRepositoryNode(context.Context, *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error)
+	AddMRPeer(context.Context, *AddMRPeerRequest) (*AddMRPeerResponse, error)
+	DeleteMetadataRepositoryNode(context.Context, *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error)
+	RemoveMRPeer(context.Context, *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error)
+	// ListConsumerGroups returns all consumer groups sorted by their names,
+	// including the lags of their offsets.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	ListConsumerGroups(context.Context, *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error)
+	// GetConsumerGroup returns the consumer group specified by the argument
+	// group, including the lags of its offsets.
+	//
+	// It returns the following gRPC errors:
+	// - Unavailable: The metadata cannot be fetched from the metadata repository.
+	// - NotFound: The consumer group does not exist.
+	GetConsumerGroup(context.Context, *GetConsumerGroupRequest) (*GetConsumerGroupResponse, error)
+	// ResetConsumerGroupOffset overwrites the offset of the consumer group. The
+	// new offset can be behind the old one. If the consumer group does not
+	// exist, it is created.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The name of the consumer group or the offset is
+	// invalid.
+	// - NotFound: The topic or the log stream does not exist.
+	ResetConsumerGroupOffset(context.Context, *ResetConsumerGroupOffsetRequest) (*ResetConsumerGroupOffsetResponse, error)
+	// DeleteConsumerGroup deletes the consumer group and its offsets.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The consumer group does not exist.
+	DeleteConsumerGroup(context.Context, *DeleteConsumerGroupRequest) (*DeleteConsumerGroupResponse, error)
+	// RegisterSchema registers a new version of the schema of the topic and
+	// returns it. The new version is checked against the latest version of the
+	// topic according to the compatibility in the request. Registering the
+	// definition of an existing version returns that version.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: The type or the definition of the schema is invalid.
+	// - NotFound: The topic does not exist.
+	// - FailedPrecondition: The schema is incompatible with the latest version.
+	RegisterSchema(context.Context, *RegisterSchemaRequest) (*RegisterSchemaResponse, error)
+	// GetSchema returns the schema specified by the argument schema_id.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The schema does not exist.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
+	// ListSchemas returns all versions of the schema of the topic sorted by
+	// their versions.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The topic does not exist.
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
 }
 
-func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedClusterManagerServer can be embedded to have forward compatible implementations.
+type UnimplementedClusterManagerServer struct {
 }
 
-func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) GetStorageNode(ctx context.Context, req *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageNode not implemented")
 }
-
-func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedClusterManagerServer) ListStorageNodes(ctx context.Context, req *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStorageNodes not implemented")
 }
-
-func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StorageNodes) > 0 {
-		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedClusterManagerServer) AddStorageNode(ctx context.Context, req *AddStorageNodeRequest) (*AddStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStorageNode not implemented")
 }
-
-func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterManagerServer) UnregisterStorageNode(ctx context.Context, req *UnregisterStorageNodeRequest) (*UnregisterStorageNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetTopic(ctx context.Context, req *GetTopicRequest) (*GetTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetTopicByName(ctx context.Context, req *GetTopicByNameRequest) (*GetTopicByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopicByName not implemented")
+}
+func (*UnimplementedClusterManagerServer) DescribeTopic(ctx context.Context, req *DescribeTopicRequest) (*DescribeTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListTopics(ctx context.Context, req *ListTopicsRequest) (*ListTopicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopics not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddTopic(ctx context.Context, req *AddTopicRequest) (*AddTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterTopic(ctx context.Context, req *UnregisterTopicRequest) (*UnregisterTopicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetLogStream(ctx context.Context, req *GetLogStreamRequest) (*GetLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListLogStreams(ctx context.Context, req *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogStreams not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddLogStream(ctx context.Context, req *AddLogStreamRequest) (*AddLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UpdateLogStream(ctx context.Context, req *UpdateLogStreamRequest) (*UpdateLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) UnregisterLogStream(ctx context.Context, req *UnregisterLogStreamRequest) (*UnregisterLogStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveLogStreamReplica(ctx context.Context, req *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLogStreamReplica not implemented")
+}
+func (*UnimplementedClusterManagerServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedClusterManagerServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedClusterManagerServer) Trim(ctx context.Context, req *TrimRequest) (*TrimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trim not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMetadataRepositoryNode(ctx context.Context, req *GetMetadataRepositoryNodeRequest) (*GetMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListMetadataRepositoryNodes(ctx context.Context, req *ListMetadataRepositoryNodesRequest) (*ListMetadataRepositoryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataRepositoryNodes not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetMRMembers(ctx context.Context, req *types.Empty) (*GetMRMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMRMembers not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMetadataRepositoryNode(ctx context.Context, req *AddMetadataRepositoryNodeRequest) (*AddMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) AddMRPeer(ctx context.Context, req *AddMRPeerRequest) (*AddMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteMetadataRepositoryNode(ctx context.Context, req *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMetadataRepositoryNode not implemented")
+}
+func (*UnimplementedClusterManagerServer) RemoveMRPeer(ctx context.Context, req *RemoveMRPeerRequest) (*RemoveMRPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMRPeer not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListConsumerGroups(ctx context.Context, req *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsumerGroups not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetConsumerGroup(ctx context.Context, req *GetConsumerGroupRequest) (*GetConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerGroup not implemented")
+}
+func (*UnimplementedClusterManagerServer) ResetConsumerGroupOffset(ctx context.Context, req *ResetConsumerGroupOffsetRequest) (*ResetConsumerGroupOffsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetConsumerGroupOffset not implemented")
+}
+func (*UnimplementedClusterManagerServer) DeleteConsumerGroup(ctx context.Context, req *DeleteConsumerGroupRequest) (*DeleteConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumerGroup not implemented")
+}
+func (*UnimplementedClusterManagerServer) RegisterSchema(ctx context.Context, req *RegisterSchemaRequest) (*RegisterSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSchema not implemented")
+}
+func (*UnimplementedClusterManagerServer) GetSchema(ctx context.Context, req *GetSchemaRequest) (*GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (*UnimplementedClusterManagerServer) ListSchemas(ctx context.Context, req *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
 }
 
-func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterClusterManagerServer(s *grpc.Server, srv ClusterManagerServer) {
+	s.RegisterService(&_ClusterManager_serviceDesc, srv)
 }
 
-func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+func _ClusterManager_GetStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetStorageNode(ctx, req.(*GetStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListStorageNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageNodesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListStorageNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListStorageNodes(ctx, req.(*ListStorageNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_AddStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddStorageNode(ctx, req.(*AddStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterStorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterStorageNode(ctx, req.(*UnregisterStorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetTopic(ctx, req.(*GetTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_GetTopicByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopicByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetTopicByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetTopicByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetTopicByName(ctx, req.(*GetTopicByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_DescribeTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/DescribeTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DescribeTopic(ctx, req.(*DescribeTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListTopics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopicsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListTopics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListTopics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListTopics(ctx, req.(*ListTopicsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_AddTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddTopic(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddTopic(ctx, req.(*AddTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterTopicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterTopic(ctx, req.(*UnregisterTopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_GetLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetLogStream(ctx, req.(*GetLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ListLogStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogStreamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListLogStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListLogStreams(ctx, req.(*ListLogStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_AddLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddLogStream(ctx, req.(*AddLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UpdateLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UpdateLogStream(ctx, req.(*UpdateLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterLogStreamRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/UnregisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).UnregisterLogStream(ctx, req.(*UnregisterLogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_RemoveLogStreamReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLogStreamReplicaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/RemoveLogStreamReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveLogStreamReplica(ctx, req.(*RemoveLogStreamReplicaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func _ClusterManager_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Seal(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _ClusterManager_Trim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).Trim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/Trim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).Trim(ctx, req.(*TrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMetadataRepositoryNode(ctx, req.(*GetMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_ListMetadataRepositoryNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataRepositoryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListMetadataRepositoryNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListMetadataRepositoryNodes(ctx, req.(*ListMetadataRepositoryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _ClusterManager_GetMRMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetMRMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetMRMembers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_AddMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMetadataRepositoryNode(ctx, req.(*AddMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_AddMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMRPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/AddMRPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).AddMRPeer(ctx, req.(*AddMRPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _ClusterManager_DeleteMetadataRepositoryNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMetadataRepositoryNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/DeleteMetadataRepositoryNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteMetadataRepositoryNode(ctx, req.(*DeleteMetadataRepositoryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_RemoveMRPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMRPeerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/RemoveMRPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RemoveMRPeer(ctx, req.(*RemoveMRPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _ClusterManager_ListConsumerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsumerGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListConsumerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListConsumerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListConsumerGroups(ctx, req.(*ListConsumerGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Topic != nil {
-		{
-			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _ClusterManager_GetConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetConsumerGroup(ctx, req.(*GetConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_ResetConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ResetConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ResetConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ResetConsumerGroupOffset(ctx, req.(*ResetConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).DeleteConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/DeleteConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).DeleteConsumerGroup(ctx, req.(*DeleteConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func _ClusterManager_RegisterSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).RegisterSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/RegisterSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).RegisterSchema(ctx, req.(*RegisterSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ClusterManager_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ClusterManager_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterManagerServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.admpb.ClusterManager/ListSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterManagerServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _ClusterManager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.admpb.ClusterManager",
+	HandlerType: (*ClusterManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStorageNode",
+			Handler:    _ClusterManager_GetStorageNode_Handler,
+		},
+		{
+			MethodName: "ListStorageNodes",
+			Handler:    _ClusterManager_ListStorageNodes_Handler,
+		},
+		{
+			MethodName: "AddStorageNode",
+			Handler:    _ClusterManager_AddStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _ClusterManager_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "GetTopic",
+			Handler:    _ClusterManager_GetTopic_Handler,
+		},
+		{
+			MethodName: "GetTopicByName",
+			Handler:    _ClusterManager_GetTopicByName_Handler,
+		},
+		{
+			MethodName: "DescribeTopic",
+			Handler:    _ClusterManager_DescribeTopic_Handler,
+		},
+		{
+			MethodName: "ListTopics",
+			Handler:    _ClusterManager_ListTopics_Handler,
+		},
+		{
+			MethodName: "AddTopic",
+			Handler:    _ClusterManager_AddTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _ClusterManager_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "GetLogStream",
+			Handler:    _ClusterManager_GetLogStream_Handler,
+		},
+		{
+			MethodName: "ListLogStreams",
+			Handler:    _ClusterManager_ListLogStreams_Handler,
+		},
+		{
+			MethodName: "AddLogStream",
+			Handler:    _ClusterManager_AddLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
+			Handler:    _ClusterManager_UpdateLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _ClusterManager_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "RemoveLogStreamReplica",
+			Handler:    _ClusterManager_RemoveLogStreamReplica_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _ClusterManager_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _ClusterManager_Unseal_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _ClusterManager_Sync_Handler,
+		},
+		{
+			MethodName: "Trim",
+			Handler:    _ClusterManager_Trim_Handler,
+		},
+		{
+			MethodName: "GetMetadataRepositoryNode",
+			Handler:    _ClusterManager_GetMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "ListMetadataRepositoryNodes",
+			Handler:    _ClusterManager_ListMetadataRepositoryNodes_Handler,
+		},
+		{
+			MethodName: "GetMRMembers",
+			Handler:    _ClusterManager_GetMRMembers_Handler,
+		},
+		{
+			MethodName: "AddMetadataRepositoryNode",
+			Handler:    _ClusterManager_AddMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "AddMRPeer",
+			Handler:    _ClusterManager_AddMRPeer_Handler,
+		},
+		{
+			MethodName: "DeleteMetadataRepositoryNode",
+			Handler:    _ClusterManager_DeleteMetadataRepositoryNode_Handler,
+		},
+		{
+			MethodName: "RemoveMRPeer",
+			Handler:    _ClusterManager_RemoveMRPeer_Handler,
+		},
+		{
+			MethodName: "ListConsumerGroups",
+			Handler:    _ClusterManager_ListConsumerGroups_Handler,
+		},
+		{
+			MethodName: "GetConsumerGroup",
+			Handler:    _ClusterManager_GetConsumerGroup_Handler,
+		},
+		{
+			MethodName: "ResetConsumerGroupOffset",
+			Handler:    _ClusterManager_ResetConsumerGroupOffset_Handler,
+		},
+		{
+			MethodName: "DeleteConsumerGroup",
+			Handler:    _ClusterManager_DeleteConsumerGroup_Handler,
+		},
+		{
+			MethodName: "RegisterSchema",
+			Handler:    _ClusterManager_RegisterSchema_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _ClusterManager_GetSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _ClusterManager_ListSchemas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/admpb/admin.proto",
 }
 
-func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *StorageNodeMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4491,30 +5199,46 @@ func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageNodeMetadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StorageNodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime):])
+	if err1 != nil {
+		return 0, err1
 	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+	i -= n1
+	i = encodeVarintAdmin(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAdmin(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StorageNodeMetadataDescriptor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4524,32 +5248,25 @@ func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
-		}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4559,25 +5276,32 @@ func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListStorageNodesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4587,34 +5311,20 @@ func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListStorageNodesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListStorageNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListStorageNodesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4624,20 +5334,20 @@ func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListStorageNodesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListStorageNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Replicas) > 0 {
-		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4645,53 +5355,13 @@ func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4701,28 +5371,18 @@ func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAdmin(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4730,21 +5390,11 @@ func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4754,19 +5404,19 @@ func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
+	if m.StorageNode != nil {
 		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4779,7 +5429,7 @@ func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterStorageNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4789,30 +5439,25 @@ func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterStorageNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4822,12 +5467,12 @@ func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterStorageNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4835,7 +5480,7 @@ func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4845,35 +5490,25 @@ func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x18
-	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
-		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4883,20 +5518,32 @@ func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4906,21 +5553,16 @@ func (m *SealRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.TopicID != 0 {
 		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
@@ -4929,7 +5571,7 @@ func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4939,21 +5581,16 @@ func (m *SealResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SealedGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.LogStreams) > 0 {
 		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4965,13 +5602,23 @@ func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListTopicsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4981,30 +5628,20 @@ func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTopicsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListTopicsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListTopicsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5014,32 +5651,34 @@ func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTopicsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListTopicsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintAdmin(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicByNameRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5049,40 +5688,27 @@ func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicByNameRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DstStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.SrcStorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTopicByNameResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5092,19 +5718,19 @@ func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTopicByNameResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTopicByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
+	if m.Topic != nil {
 		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5117,7 +5743,7 @@ func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
+func (m *AddTopicRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5127,30 +5753,37 @@ func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddTopicRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastGLSN != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
-		i--
-		dAtA[i] = 0x10
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
 	}
-	if m.TopicID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimResult) Marshal() (dAtA []byte, err error) {
+func (m *AddTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5160,37 +5793,60 @@ func (m *TrimResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+	if m.Topic != nil {
+		{
+			size, err := m.Topic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.LogStreamID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *UnregisterTopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.StorageNodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+	return dAtA[:n], nil
+}
+
+func (m *UnregisterTopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnregisterTopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterTopicResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5200,34 +5856,20 @@ func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterTopicResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterTopicResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5237,25 +5879,30 @@ func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5265,19 +5912,19 @@ func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
+	if m.LogStream != nil {
 		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5290,7 +5937,7 @@ func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListLogStreamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5300,20 +5947,25 @@ func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListLogStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListLogStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListLogStreamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5323,20 +5975,20 @@ func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListLogStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListLogStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5350,7 +6002,7 @@ func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5360,47 +6012,74 @@ func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
-			i = encodeVarintAdmin(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
 		}
 	}
-	if m.ReplicationFactor != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	if m.Leader != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
+	return len(dAtA) - i, nil
+}
+
+func (m *AddLogStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *UpdateLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5410,34 +6089,50 @@ func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+	{
+		size, err := m.PushedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PoppedReplica.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *UpdateLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5447,19 +6142,19 @@ func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
+	if m.LogStream != nil {
 		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5472,7 +6167,7 @@ func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterLogStreamRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5482,34 +6177,30 @@ func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RPCAddr) > 0 {
-		i -= len(m.RPCAddr)
-		copy(dAtA[i:], m.RPCAddr)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *UnregisterLogStreamResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5519,25 +6210,20 @@ func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnregisterLogStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *RemoveLogStreamReplicaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5547,25 +6233,35 @@ func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveLogStreamReplicaResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5575,12 +6271,12 @@ func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveLogStreamReplicaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5588,7 +6284,7 @@ func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5598,27 +6294,30 @@ func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RaftURL) > 0 {
-		i -= len(m.RaftURL)
-		copy(dAtA[i:], m.RaftURL)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5628,754 +6327,3615 @@ func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.SealedGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SealedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StorageNodeMetadata) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StorageNodeMetadataDescriptor.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListStorageNodesRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListStorageNodesResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.StorageNodes) > 0 {
-		for _, e := range m.StorageNodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StorageNode.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	if m.DstStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DstStorageNodeID))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.SrcStorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SrcStorageNodeID))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GetTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.LogStreamID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
 	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *SyncResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	return n
+func (m *SyncResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Topic.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListTopicsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *TrimRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListTopicsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+func (m *TrimRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TrimRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *AddTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+	if m.LastGLSN != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LastGLSN))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Topic != nil {
-		l = m.Topic.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterTopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func (m *TrimResult) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnregisterTopicResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *TrimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TrimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	return n
-}
-
-func (m *GetLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+		i = encodeVarintAdmin(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.StorageNodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.StorageNodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListLogStreamsRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
+func (m *TrimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListLogStreamsResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+func (m *TrimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AddLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TrimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if len(m.Replicas) > 0 {
-		for _, e := range m.Replicas {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *GetMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	l = m.PoppedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	l = m.PushedReplica.ProtoSize()
-	n += 1 + l + sovAdmin(uint64(l))
-	return n
+func (m *GetMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+func (m *GetMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
-	}
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListMetadataRepositoryNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
-	}
-	return n
+func (m *ListMetadataRepositoryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListMetadataRepositoryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	if m.SealedGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.SealedGLSN))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+func (m *ListMetadataRepositoryNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UnsealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ListMetadataRepositoryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListMetadataRepositoryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *GetMRMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMRMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMRMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintAdmin(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.SrcStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DstStorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	if m.Leader != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Leader))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SyncResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+func (m *AddMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TrimRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovAdmin(uint64(m.TopicID))
-	}
-	if m.LastGLSN != 0 {
-		n += 1 + sovAdmin(uint64(m.LastGLSN))
-	}
-	return n
+func (m *AddMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrimResult) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StorageNodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.StorageNodeID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TrimResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
+func (m *AddMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
-	}
-	return n
+func (m *AddMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Node != nil {
-		l = m.Node.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovAdmin(uint64(l))
-		}
-	}
-	return n
+func (m *AddMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetMRMembersResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Leader != 0 {
-		n += 1 + sovAdmin(uint64(m.Leader))
-	}
-	if m.ReplicationFactor != 0 {
-		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
+	if len(m.RPCAddr) > 0 {
+		i -= len(m.RPCAddr)
+		copy(dAtA[i:], m.RPCAddr)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RPCAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
-			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
-		}
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AddMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteMetadataRepositoryNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Node != nil {
-		l = m.Node.ProtoSize()
-		n += 1 + l + sovAdmin(uint64(l))
+	if m.NodeID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.NodeID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteMetadataRepositoryNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.RPCAddr)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddMRPeerResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveMRPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
+	if len(m.RaftURL) > 0 {
+		i -= len(m.RaftURL)
+		copy(dAtA[i:], m.RaftURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.RaftURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveMRPeerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMRPeerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMRPeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NodeID != 0 {
-		n += 1 + sovAdmin(uint64(m.NodeID))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerGroupOffsetLag) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupOffsetLag) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupOffsetLag) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Lag != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HighWatermark != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HighWatermark))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerGroupMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RaftURL)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Offsets) > 0 {
+		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *ListConsumerGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListConsumerGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListConsumerGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListConsumerGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListConsumerGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListConsumerGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerGroups) > 0 {
+		for iNdEx := len(m.ConsumerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerGroup != nil {
+		{
+			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetConsumerGroupOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetConsumerGroupOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetConsumerGroupOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetConsumerGroupOffsetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetConsumerGroupOffsetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetConsumerGroupOffsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerGroup != nil {
+		{
+			size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compatibility != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Compatibility))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SchemaID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SchemaID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StorageNodeMetadata) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageNodeMetadataDescriptor.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeatTime)
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *GetStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	return n
+}
+
+func (m *GetStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListStorageNodesRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListStorageNodesResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StorageNodes) > 0 {
+		for _, e := range m.StorageNodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StorageNode.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *AddStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterStorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	return n
+}
+
+func (m *UnregisterStorageNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *GetTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *DescribeTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Topic.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListTopicsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTopicsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTopicByNameRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTopicByNameResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Config.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *AddTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != nil {
+		l = m.Topic.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterTopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *UnregisterTopicResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *GetLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLogStreamsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *ListLogStreamsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = m.PoppedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = m.PushedReplica.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *UpdateLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnregisterLogStreamResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveLogStreamReplicaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *RemoveLogStreamReplicaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.SealedGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.SealedGLSN))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnsealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	if m.SrcStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.SrcStorageNodeID))
+	}
+	if m.DstStorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.DstStorageNodeID))
+	}
+	return n
+}
+
+func (m *SyncResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.LastGLSN != 0 {
+		n += 1 + sovAdmin(uint64(m.LastGLSN))
+	}
+	return n
+}
+
+func (m *TrimResult) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.StorageNodeID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovAdmin(uint64(m.LogStreamID))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *TrimResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *GetMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListMetadataRepositoryNodesResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMRMembersResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leader != 0 {
+		n += 1 + sovAdmin(uint64(m.Leader))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovAdmin(uint64(m.ReplicationFactor))
+	}
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovAdmin(uint64(k)) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.RPCAddr)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *AddMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeID != 0 {
+		n += 1 + sovAdmin(uint64(m.NodeID))
+	}
+	return n
+}
+
+func (m *DeleteMetadataRepositoryNodeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoveMRPeerRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RaftURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveMRPeerResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ConsumerGroupOffsetLag) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	if m.HighWatermark != 0 {
+		n += 1 + sovAdmin(uint64(m.HighWatermark))
+	}
+	if m.Lag != 0 {
+		n += 1 + sovAdmin(uint64(m.Lag))
+	}
+	return n
+}
+
+func (m *ConsumerGroupMetadata) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListConsumerGroupsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListConsumerGroupsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerGroups) > 0 {
+		for _, e := range m.ConsumerGroups {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetConsumerGroupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetConsumerGroupOffsetRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *ResetConsumerGroupOffsetResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerGroup != nil {
+		l = m.ConsumerGroup.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteConsumerGroupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RegisterSchemaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAdmin(uint64(m.Type))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Compatibility != 0 {
+		n += 1 + sovAdmin(uint64(m.Compatibility))
+	}
+	return n
+}
+
+func (m *RegisterSchemaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetSchemaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchemaID != 0 {
+		n += 1 + sovAdmin(uint64(m.SchemaID))
+	}
+	return n
+}
+
+func (m *GetSchemaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.ProtoSize()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListSchemasRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovAdmin(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *ListSchemasResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.ProtoSize()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
+			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &StorageNodeMetadata{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTopicByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTopicByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTopicByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
+func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6398,17 +9958,17 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeMetadataDescriptor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6418,61 +9978,27 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNodeMetadataDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6499,7 +10025,7 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6524,7 +10050,7 @@ func (m *StorageNodeMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6547,17 +10073,17 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			m.StorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6567,11 +10093,28 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Topic == nil {
+				m.Topic = &varlogpb.TopicDescriptor{}
+			}
+			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6593,7 +10136,7 @@ func (m *GetStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6616,17 +10159,17 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6636,28 +10179,11 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
-			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6679,7 +10205,7 @@ func (m *GetStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6702,10 +10228,10 @@ func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6729,7 +10255,7 @@ func (m *ListStorageNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6752,17 +10278,17 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListStorageNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6772,26 +10298,30 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.StorageNodes = append(m.StorageNodes, StorageNodeMetadata{})
-			if err := m.StorageNodes[len(m.StorageNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6813,7 +10343,7 @@ func (m *ListStorageNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6836,15 +10366,15 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6871,7 +10401,10 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6896,7 +10429,7 @@ func (m *AddStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6919,17 +10452,17 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -6939,28 +10472,11 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StorageNode == nil {
-				m.StorageNode = &StorageNodeMetadata{}
-			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -6982,7 +10498,7 @@ func (m *AddStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7005,17 +10521,17 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
-			m.StorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7025,11 +10541,26 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7051,7 +10582,7 @@ func (m *UnregisterStorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7074,12 +10605,65 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterStorageNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7101,7 +10685,7 @@ func (m *UnregisterStorageNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7124,17 +10708,17 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7144,11 +10728,28 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7170,7 +10771,7 @@ func (m *GetTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7193,15 +10794,53 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7228,10 +10867,40 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
+			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7256,7 +10925,7 @@ func (m *GetTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7279,17 +10948,17 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7299,11 +10968,28 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7325,7 +11011,7 @@ func (m *DescribeTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7348,17 +11034,17 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7368,30 +11054,16 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			var msglen int
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7400,27 +11072,12 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7442,7 +11099,7 @@ func (m *DescribeTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
+func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7465,10 +11122,10 @@ func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7492,7 +11149,7 @@ func (m *ListTopicsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7515,17 +11172,17 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTopicsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTopicsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			var msglen int
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -7535,26 +11192,99 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, varlogpb.TopicDescriptor{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7576,7 +11306,7 @@ func (m *ListTopicsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7599,12 +11329,50 @@ func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7626,7 +11394,7 @@ func (m *AddTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7649,15 +11417,15 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7684,13 +11452,30 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Topic == nil {
-				m.Topic = &varlogpb.TopicDescriptor{}
-			}
-			if err := m.Topic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+			}
+			m.SealedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7712,7 +11497,7 @@ func (m *AddTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7735,10 +11520,10 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7760,6 +11545,25 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7781,7 +11585,7 @@ func (m *UnregisterTopicRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7804,12 +11608,48 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterTopicResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7831,7 +11671,7 @@ func (m *UnregisterTopicResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7854,10 +11694,10 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7898,6 +11738,44 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
+			}
+			m.SrcStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
+			}
+			m.DstStorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -7919,7 +11797,7 @@ func (m *GetLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7942,15 +11820,15 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7977,10 +11855,10 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			if m.Status == nil {
+				m.Status = &snpb.SyncStatus{}
 			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8005,7 +11883,7 @@ func (m *GetLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8028,10 +11906,10 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8053,61 +11931,11 @@ func (m *ListLogStreamsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLogStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
 			}
-			var msglen int
+			m.LastGLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8117,26 +11945,11 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LogStreams = append(m.LogStreams, varlogpb.LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8158,7 +11971,7 @@ func (m *ListLogStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8181,17 +11994,17 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
 			}
-			m.TopicID = 0
+			m.StorageNodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8201,16 +12014,35 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8220,25 +12052,23 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, &varlogpb.ReplicaDescriptor{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8261,7 +12091,7 @@ func (m *AddLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8284,15 +12114,15 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8319,10 +12149,8 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, TrimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8347,7 +12175,7 @@ func (m *AddLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8370,17 +12198,17 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.TopicID = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8390,33 +12218,64 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
 			}
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoppedReplica", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8443,43 +12302,63 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoppedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Node == nil {
+				m.Node = &varlogpb.MetadataRepositoryNode{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PushedReplica", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PushedReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8501,7 +12380,7 @@ func (m *UpdateLogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8524,15 +12403,15 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8559,10 +12438,8 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, varlogpb.MetadataRepositoryNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8587,7 +12464,7 @@ func (m *UpdateLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8610,17 +12487,17 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMRMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMRMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.TopicID = 0
+			m.Leader = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8630,16 +12507,16 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.Leader |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
 			}
-			m.LogStreamID = 0
+			m.ReplicationFactor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8649,61 +12526,124 @@ func (m *UnregisterLogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.ReplicationFactor |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Members == nil {
+				m.Members = make(map[github_com_kakao_varlog_pkg_types.NodeID]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnregisterLogStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Members[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8725,7 +12665,7 @@ func (m *UnregisterLogStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
+func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8748,17 +12688,17 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			m.StorageNodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8768,35 +12708,29 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LogStreamID = 0
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8806,61 +12740,24 @@ func (m *RemoveLogStreamReplicaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveLogStreamReplicaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.RPCAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8882,7 +12779,7 @@ func (m *RemoveLogStreamReplicaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8905,17 +12802,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -8925,30 +12822,28 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &varlogpb.MetadataRepositoryNode{}
 			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -8970,7 +12865,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,17 +12888,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMRPeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9013,31 +12908,29 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, snpb.LogStreamReplicaMetadataDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealedGLSN", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
 			}
-			m.SealedGLSN = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9047,11 +12940,24 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SealedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RPCAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9073,7 +12979,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9096,36 +13002,17 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddMRPeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
-			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			m.LogStreamID = 0
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9135,7 +13022,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9161,7 +13048,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9184,17 +13071,17 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
-			var msglen int
+			m.NodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9204,28 +13091,11 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9247,7 +13117,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9270,88 +13140,12 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
-			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
-			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcStorageNodeID", wireType)
-			}
-			m.SrcStorageNodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SrcStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstStorageNodeID", wireType)
-			}
-			m.DstStorageNodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DstStorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9373,7 +13167,7 @@ func (m *SyncRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncResponse) Unmarshal(dAtA []byte) error {
+func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9396,17 +13190,17 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveMRPeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9416,27 +13210,23 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &snpb.SyncStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9459,7 +13249,7 @@ func (m *SyncResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9482,50 +13272,12 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveMRPeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
-			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastGLSN", wireType)
-			}
-			m.LastGLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9547,7 +13299,7 @@ func (m *TrimRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResult) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffsetLag) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9570,17 +13322,17 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffsetLag: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffsetLag: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.StorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9590,16 +13342,30 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			m.LogStreamID = 0
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9609,16 +13375,16 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.HighWatermark |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
-			var stringLen uint64
+			m.Lag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -9628,24 +13394,11 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Lag |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9667,7 +13420,7 @@ func (m *TrimResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9686,19 +13439,51 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TrimResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerGroupMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerGroupMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9725,8 +13510,8 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, TrimResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offsets = append(m.Offsets, ConsumerGroupOffsetLag{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9751,7 +13536,7 @@ func (m *TrimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ListConsumerGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9774,31 +13559,12 @@ func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListConsumerGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListConsumerGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
-			}
-			m.NodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9820,7 +13586,7 @@ func (m *GetMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *ListConsumerGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9843,15 +13609,15 @@ func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListConsumerGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListConsumerGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9878,10 +13644,8 @@ func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Node == nil {
-				m.Node = &varlogpb.MetadataRepositoryNode{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerGroups = append(m.ConsumerGroups, ConsumerGroupMetadata{})
+			if err := m.ConsumerGroups[len(m.ConsumerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9906,7 +13670,7 @@ func (m *GetMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetConsumerGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9929,12 +13693,44 @@ func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetConsumerGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -9956,7 +13752,7 @@ func (m *ListMetadataRepositoryNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetConsumerGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9979,15 +13775,15 @@ func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetConsumerGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListMetadataRepositoryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10014,8 +13810,10 @@ func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, varlogpb.MetadataRepositoryNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &ConsumerGroupMetadata{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10040,7 +13838,7 @@ func (m *ListMetadataRepositoryNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetConsumerGroupOffsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10063,17 +13861,17 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMRMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetConsumerGroupOffsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMRMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetConsumerGroupOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.Leader = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10083,33 +13881,27 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Leader |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
 			}
-			m.ReplicationFactor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReplicationFactor |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10136,89 +13928,9 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Members == nil {
-				m.Members = make(map[github_com_kakao_varlog_pkg_types.NodeID]string)
-			}
-			var mapkey uint64
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowAdmin
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdmin
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowAdmin
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipAdmin(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthAdmin
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Members[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10241,7 +13953,7 @@ func (m *GetMRMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetConsumerGroupOffsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10264,49 +13976,17 @@ func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetConsumerGroupOffsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetConsumerGroupOffsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAdmin
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10316,23 +13996,27 @@ func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPCAddr = string(dAtA[iNdEx:postIndex])
+			if m.ConsumerGroup == nil {
+				m.ConsumerGroup = &ConsumerGroupMetadata{}
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10355,7 +14039,7 @@ func (m *AddMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *DeleteConsumerGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10378,17 +14062,17 @@ func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10398,28 +14082,74 @@ func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Node == nil {
-				m.Node = &varlogpb.MetadataRepositoryNode{}
-			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteConsumerGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteConsumerGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10441,7 +14171,7 @@ func (m *AddMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10464,17 +14194,17 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMRPeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var stringLen uint64
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10484,29 +14214,35 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= varlogpb.SchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10516,24 +14252,45 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPCAddr = string(dAtA[iNdEx:postIndex])
+			m.Definition = append(m.Definition[:0], dAtA[iNdEx:postIndex]...)
+			if m.Definition == nil {
+				m.Definition = []byte{}
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compatibility", wireType)
+			}
+			m.Compatibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compatibility |= varlogpb.SchemaCompatibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10555,7 +14312,7 @@ func (m *AddMRPeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *RegisterSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10578,17 +14335,17 @@ func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddMRPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.NodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10598,11 +14355,28 @@ func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &varlogpb.SchemaDescriptor{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10624,7 +14398,7 @@ func (m *AddMRPeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10647,17 +14421,17 @@ func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaID", wireType)
 			}
-			m.NodeID = 0
+			m.SchemaID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10667,7 +14441,7 @@ func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				m.SchemaID |= github_com_kakao_varlog_pkg_types.SchemaID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10693,7 +14467,7 @@ func (m *DeleteMetadataRepositoryNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10716,12 +14490,48 @@ func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteMetadataRepositoryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &varlogpb.SchemaDescriptor{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10743,7 +14553,7 @@ func (m *DeleteMetadataRepositoryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10766,17 +14576,17 @@ func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveMRPeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveMRPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var stringLen uint64
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -10786,24 +14596,11 @@ func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -10825,7 +14622,7 @@ func (m *RemoveMRPeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10848,12 +14645,46 @@ func (m *RemoveMRPeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveMRPeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveMRPeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, varlogpb.SchemaDescriptor{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
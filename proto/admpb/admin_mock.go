@@ -136,6 +136,26 @@ func (mr *MockClusterManagerClientMockRecorder) AddTopic(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).AddTopic), varargs...)
 }
 
+// DeleteConsumerGroup mocks base method.
+func (m *MockClusterManagerClient) DeleteConsumerGroup(arg0 context.Context, arg1 *DeleteConsumerGroupRequest, arg2 ...grpc.CallOption) (*DeleteConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*DeleteConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockClusterManagerClientMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockClusterManagerClient)(nil).DeleteConsumerGroup), varargs...)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerClient) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest, arg2 ...grpc.CallOption) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +196,26 @@ func (mr *MockClusterManagerClientMockRecorder) DescribeTopic(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).DescribeTopic), varargs...)
 }
 
+// GetConsumerGroup mocks base method.
+func (m *MockClusterManagerClient) GetConsumerGroup(arg0 context.Context, arg1 *GetConsumerGroupRequest, arg2 ...grpc.CallOption) (*GetConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*GetConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockClusterManagerClientMockRecorder) GetConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockClusterManagerClient)(nil).GetConsumerGroup), varargs...)
+}
+
 // GetLogStream mocks base method.
 func (m *MockClusterManagerClient) GetLogStream(arg0 context.Context, arg1 *GetLogStreamRequest, arg2 ...grpc.CallOption) (*GetLogStreamResponse, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +276,26 @@ func (mr *MockClusterManagerClientMockRecorder) GetMetadataRepositoryNode(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockClusterManagerClient)(nil).GetMetadataRepositoryNode), varargs...)
 }
 
+// GetSchema mocks base method.
+func (m *MockClusterManagerClient) GetSchema(arg0 context.Context, arg1 *GetSchemaRequest, arg2 ...grpc.CallOption) (*GetSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSchema", varargs...)
+	ret0, _ := ret[0].(*GetSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockClusterManagerClientMockRecorder) GetSchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockClusterManagerClient)(nil).GetSchema), varargs...)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockClusterManagerClient) GetStorageNode(arg0 context.Context, arg1 *GetStorageNodeRequest, arg2 ...grpc.CallOption) (*GetStorageNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +336,46 @@ func (mr *MockClusterManagerClientMockRecorder) GetTopic(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockClusterManagerClient)(nil).GetTopic), varargs...)
 }
 
+// GetTopicByName mocks base method.
+func (m *MockClusterManagerClient) GetTopicByName(arg0 context.Context, arg1 *GetTopicByNameRequest, arg2 ...grpc.CallOption) (*GetTopicByNameResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTopicByName", varargs...)
+	ret0, _ := ret[0].(*GetTopicByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopicByName indicates an expected call of GetTopicByName.
+func (mr *MockClusterManagerClientMockRecorder) GetTopicByName(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopicByName", reflect.TypeOf((*MockClusterManagerClient)(nil).GetTopicByName), varargs...)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockClusterManagerClient) ListConsumerGroups(arg0 context.Context, arg1 *ListConsumerGroupsRequest, arg2 ...grpc.CallOption) (*ListConsumerGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConsumerGroups", varargs...)
+	ret0, _ := ret[0].(*ListConsumerGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockClusterManagerClientMockRecorder) ListConsumerGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockClusterManagerClient)(nil).ListConsumerGroups), varargs...)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockClusterManagerClient) ListLogStreams(arg0 context.Context, arg1 *ListLogStreamsRequest, arg2 ...grpc.CallOption) (*ListLogStreamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +416,26 @@ func (mr *MockClusterManagerClientMockRecorder) ListMetadataRepositoryNodes(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockClusterManagerClient)(nil).ListMetadataRepositoryNodes), varargs...)
 }
 
+// ListSchemas mocks base method.
+func (m *MockClusterManagerClient) ListSchemas(arg0 context.Context, arg1 *ListSchemasRequest, arg2 ...grpc.CallOption) (*ListSchemasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchemas", varargs...)
+	ret0, _ := ret[0].(*ListSchemasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockClusterManagerClientMockRecorder) ListSchemas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockClusterManagerClient)(nil).ListSchemas), varargs...)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockClusterManagerClient) ListStorageNodes(arg0 context.Context, arg1 *ListStorageNodesRequest, arg2 ...grpc.CallOption) (*ListStorageNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -356,6 +476,26 @@ func (mr *MockClusterManagerClientMockRecorder) ListTopics(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockClusterManagerClient)(nil).ListTopics), varargs...)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockClusterManagerClient) RegisterSchema(arg0 context.Context, arg1 *RegisterSchemaRequest, arg2 ...grpc.CallOption) (*RegisterSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSchema", varargs...)
+	ret0, _ := ret[0].(*RegisterSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockClusterManagerClientMockRecorder) RegisterSchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockClusterManagerClient)(nil).RegisterSchema), varargs...)
+}
+
 // RemoveLogStreamReplica mocks base method.
 func (m *MockClusterManagerClient) RemoveLogStreamReplica(arg0 context.Context, arg1 *RemoveLogStreamReplicaRequest, arg2 ...grpc.CallOption) (*RemoveLogStreamReplicaResponse, error) {
 	m.ctrl.T.Helper()
@@ -396,6 +536,26 @@ func (mr *MockClusterManagerClientMockRecorder) RemoveMRPeer(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMRPeer", reflect.TypeOf((*MockClusterManagerClient)(nil).RemoveMRPeer), varargs...)
 }
 
+// ResetConsumerGroupOffset mocks base method.
+func (m *MockClusterManagerClient) ResetConsumerGroupOffset(arg0 context.Context, arg1 *ResetConsumerGroupOffsetRequest, arg2 ...grpc.CallOption) (*ResetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*ResetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConsumerGroupOffset indicates an expected call of ResetConsumerGroupOffset.
+func (mr *MockClusterManagerClientMockRecorder) ResetConsumerGroupOffset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConsumerGroupOffset", reflect.TypeOf((*MockClusterManagerClient)(nil).ResetConsumerGroupOffset), varargs...)
+}
+
 // Seal mocks base method.
 func (m *MockClusterManagerClient) Seal(arg0 context.Context, arg1 *SealRequest, arg2 ...grpc.CallOption) (*SealResponse, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +814,21 @@ func (mr *MockClusterManagerServerMockRecorder) AddTopic(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).AddTopic), arg0, arg1)
 }
 
+// DeleteConsumerGroup mocks base method.
+func (m *MockClusterManagerServer) DeleteConsumerGroup(arg0 context.Context, arg1 *DeleteConsumerGroupRequest) (*DeleteConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*DeleteConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockClusterManagerServerMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockClusterManagerServer)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
 // DeleteMetadataRepositoryNode mocks base method.
 func (m *MockClusterManagerServer) DeleteMetadataRepositoryNode(arg0 context.Context, arg1 *DeleteMetadataRepositoryNodeRequest) (*DeleteMetadataRepositoryNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -684,6 +859,21 @@ func (mr *MockClusterManagerServerMockRecorder) DescribeTopic(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).DescribeTopic), arg0, arg1)
 }
 
+// GetConsumerGroup mocks base method.
+func (m *MockClusterManagerServer) GetConsumerGroup(arg0 context.Context, arg1 *GetConsumerGroupRequest) (*GetConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*GetConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockClusterManagerServerMockRecorder) GetConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockClusterManagerServer)(nil).GetConsumerGroup), arg0, arg1)
+}
+
 // GetLogStream mocks base method.
 func (m *MockClusterManagerServer) GetLogStream(arg0 context.Context, arg1 *GetLogStreamRequest) (*GetLogStreamResponse, error) {
 	m.ctrl.T.Helper()
@@ -729,6 +919,21 @@ func (mr *MockClusterManagerServerMockRecorder) GetMetadataRepositoryNode(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadataRepositoryNode", reflect.TypeOf((*MockClusterManagerServer)(nil).GetMetadataRepositoryNode), arg0, arg1)
 }
 
+// GetSchema mocks base method.
+func (m *MockClusterManagerServer) GetSchema(arg0 context.Context, arg1 *GetSchemaRequest) (*GetSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*GetSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockClusterManagerServerMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockClusterManagerServer)(nil).GetSchema), arg0, arg1)
+}
+
 // GetStorageNode mocks base method.
 func (m *MockClusterManagerServer) GetStorageNode(arg0 context.Context, arg1 *GetStorageNodeRequest) (*GetStorageNodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -759,6 +964,36 @@ func (mr *MockClusterManagerServerMockRecorder) GetTopic(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*MockClusterManagerServer)(nil).GetTopic), arg0, arg1)
 }
 
+// GetTopicByName mocks base method.
+func (m *MockClusterManagerServer) GetTopicByName(arg0 context.Context, arg1 *GetTopicByNameRequest) (*GetTopicByNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopicByName", arg0, arg1)
+	ret0, _ := ret[0].(*GetTopicByNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopicByName indicates an expected call of GetTopicByName.
+func (mr *MockClusterManagerServerMockRecorder) GetTopicByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopicByName", reflect.TypeOf((*MockClusterManagerServer)(nil).GetTopicByName), arg0, arg1)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockClusterManagerServer) ListConsumerGroups(arg0 context.Context, arg1 *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumerGroups", arg0, arg1)
+	ret0, _ := ret[0].(*ListConsumerGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockClusterManagerServerMockRecorder) ListConsumerGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockClusterManagerServer)(nil).ListConsumerGroups), arg0, arg1)
+}
+
 // ListLogStreams mocks base method.
 func (m *MockClusterManagerServer) ListLogStreams(arg0 context.Context, arg1 *ListLogStreamsRequest) (*ListLogStreamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -789,6 +1024,21 @@ func (mr *MockClusterManagerServerMockRecorder) ListMetadataRepositoryNodes(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetadataRepositoryNodes", reflect.TypeOf((*MockClusterManagerServer)(nil).ListMetadataRepositoryNodes), arg0, arg1)
 }
 
+// ListSchemas mocks base method.
+func (m *MockClusterManagerServer) ListSchemas(arg0 context.Context, arg1 *ListSchemasRequest) (*ListSchemasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchemas", arg0, arg1)
+	ret0, _ := ret[0].(*ListSchemasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockClusterManagerServerMockRecorder) ListSchemas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockClusterManagerServer)(nil).ListSchemas), arg0, arg1)
+}
+
 // ListStorageNodes mocks base method.
 func (m *MockClusterManagerServer) ListStorageNodes(arg0 context.Context, arg1 *ListStorageNodesRequest) (*ListStorageNodesResponse, error) {
 	m.ctrl.T.Helper()
@@ -819,6 +1069,21 @@ func (mr *MockClusterManagerServerMockRecorder) ListTopics(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockClusterManagerServer)(nil).ListTopics), arg0, arg1)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockClusterManagerServer) RegisterSchema(arg0 context.Context, arg1 *RegisterSchemaRequest) (*RegisterSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSchema", arg0, arg1)
+	ret0, _ := ret[0].(*RegisterSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockClusterManagerServerMockRecorder) RegisterSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockClusterManagerServer)(nil).RegisterSchema), arg0, arg1)
+}
+
 // RemoveLogStreamReplica mocks base method.
 func (m *MockClusterManagerServer) RemoveLogStreamReplica(arg0 context.Context, arg1 *RemoveLogStreamReplicaRequest) (*RemoveLogStreamReplicaResponse, error) {
 	m.ctrl.T.Helper()
@@ -849,6 +1114,21 @@ func (mr *MockClusterManagerServerMockRecorder) RemoveMRPeer(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMRPeer", reflect.TypeOf((*MockClusterManagerServer)(nil).RemoveMRPeer), arg0, arg1)
 }
 
+// ResetConsumerGroupOffset mocks base method.
+func (m *MockClusterManagerServer) ResetConsumerGroupOffset(arg0 context.Context, arg1 *ResetConsumerGroupOffsetRequest) (*ResetConsumerGroupOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*ResetConsumerGroupOffsetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConsumerGroupOffset indicates an expected call of ResetConsumerGroupOffset.
+func (mr *MockClusterManagerServerMockRecorder) ResetConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConsumerGroupOffset", reflect.TypeOf((*MockClusterManagerServer)(nil).ResetConsumerGroupOffset), arg0, arg1)
+}
+
 // Seal mocks base method.
 func (m *MockClusterManagerServer) Seal(arg0 context.Context, arg1 *SealRequest) (*SealResponse, error) {
 	m.ctrl.T.Helper()
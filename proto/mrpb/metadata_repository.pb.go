@@ -18,6 +18,7 @@ import (
 	status "google.golang.org/grpc/status"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
+	snpb "github.com/kakao/varlog/proto/snpb"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
 )
 
@@ -402,6 +403,9 @@ func (m *UnsealResponse) GetStatus() varlogpb.LogStreamStatus {
 
 type TopicRequest struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	// Name and Config are used only by RegisterTopic.
+	Name   string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Config varlogpb.TopicConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
 func (m *TopicRequest) Reset()         { *m = TopicRequest{} }
@@ -444,893 +448,4052 @@ func (m *TopicRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.mrpb.GetMetadataRequest")
-	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.mrpb.GetMetadataResponse")
-	proto.RegisterType((*StorageNodeRequest)(nil), "varlog.mrpb.StorageNodeRequest")
-	proto.RegisterType((*LogStreamRequest)(nil), "varlog.mrpb.LogStreamRequest")
-	proto.RegisterType((*SealRequest)(nil), "varlog.mrpb.SealRequest")
-	proto.RegisterType((*SealResponse)(nil), "varlog.mrpb.SealResponse")
-	proto.RegisterType((*UnsealRequest)(nil), "varlog.mrpb.UnsealRequest")
-	proto.RegisterType((*UnsealResponse)(nil), "varlog.mrpb.UnsealResponse")
-	proto.RegisterType((*TopicRequest)(nil), "varlog.mrpb.TopicRequest")
+func (m *TopicRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("proto/mrpb/metadata_repository.proto", fileDescriptor_0ffe516e0fdff161)
+func (m *TopicRequest) GetConfig() varlogpb.TopicConfig {
+	if m != nil {
+		return m.Config
+	}
+	return varlogpb.TopicConfig{}
 }
 
-var fileDescriptor_0ffe516e0fdff161 = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x96, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0xe3, 0x0a, 0x02, 0x4c, 0x08, 0x94, 0x4d, 0x3f, 0xc0, 0xa8, 0x71, 0xe4, 0x22, 0x44,
-	0x55, 0xe1, 0x48, 0xf4, 0xc2, 0x81, 0x8a, 0x2a, 0xd0, 0xa2, 0xa0, 0x94, 0x56, 0x4e, 0xe9, 0xa1,
-	0x55, 0x15, 0x39, 0xf1, 0xd6, 0xb5, 0xb0, 0xb3, 0xae, 0x77, 0x83, 0xc4, 0x5b, 0xb4, 0x6f, 0xd0,
-	0xd7, 0xe8, 0x1b, 0x70, 0x44, 0x3d, 0xf5, 0xe4, 0x43, 0xf2, 0x16, 0x9c, 0x2a, 0xaf, 0xbd, 0xfe,
-	0x20, 0x7c, 0x1c, 0xe0, 0xd4, 0x9b, 0xbd, 0x33, 0xf3, 0x9b, 0xff, 0xee, 0x64, 0xff, 0x0e, 0xac,
-	0x78, 0x3e, 0x61, 0xa4, 0xee, 0xfa, 0x5e, 0xb7, 0xee, 0x62, 0x66, 0x98, 0x06, 0x33, 0x3a, 0x3e,
-	0xf6, 0x08, 0xb5, 0x19, 0xf1, 0x4f, 0x34, 0x1e, 0x46, 0xa5, 0x63, 0xc3, 0x77, 0x88, 0xa5, 0x85,
-	0x69, 0xf2, 0xba, 0x65, 0xb3, 0x6f, 0x83, 0xae, 0xd6, 0x23, 0x6e, 0xdd, 0x22, 0x16, 0xa9, 0xf3,
-	0x9c, 0xee, 0xe0, 0x2b, 0x7f, 0x8b, 0x78, 0xe1, 0x53, 0x54, 0x2b, 0x2f, 0x5b, 0x84, 0x58, 0x0e,
-	0x4e, 0xb3, 0xb0, 0xeb, 0xb1, 0x18, 0x2c, 0x3f, 0x8e, 0xc0, 0x99, 0xe6, 0x51, 0x40, 0x7d, 0x00,
-	0x68, 0x0f, 0xb3, 0xb7, 0xf1, 0xa2, 0x8e, 0xbf, 0x0f, 0x30, 0x65, 0xea, 0x47, 0xa8, 0xe4, 0x56,
-	0xa9, 0x47, 0xfa, 0x14, 0xa3, 0x6d, 0x98, 0x16, 0xe5, 0x8b, 0x52, 0x4d, 0x5a, 0x2b, 0x6d, 0x3c,
-	0xd5, 0x62, 0xc5, 0x82, 0xaf, 0x89, 0xa2, 0x5d, 0x4c, 0x7b, 0xbe, 0xed, 0x31, 0xe2, 0xeb, 0x49,
-	0x91, 0x8a, 0x01, 0xb5, 0x19, 0xf1, 0x0d, 0x0b, 0x1f, 0x10, 0x13, 0xc7, 0xdd, 0xd0, 0x3b, 0x98,
-	0xa5, 0xd1, 0x6a, 0xa7, 0x4f, 0x4c, 0x1c, 0xa3, 0x57, 0xc7, 0xd0, 0x99, 0xd2, 0x94, 0xde, 0x98,
-	0x38, 0x0d, 0x14, 0x49, 0x2f, 0xd1, 0x34, 0xa8, 0x7e, 0x81, 0xfb, 0x2d, 0x62, 0xb5, 0x99, 0x8f,
-	0x0d, 0x57, 0x34, 0x69, 0x02, 0x38, 0xc4, 0xea, 0x50, 0xbe, 0x18, 0xb7, 0x58, 0x19, 0x6b, 0x91,
-	0x94, 0x8d, 0x35, 0x98, 0x71, 0x44, 0x48, 0x3d, 0x93, 0xa0, 0xd4, 0xc6, 0x86, 0x23, 0xd0, 0x9f,
-	0x01, 0x7a, 0xce, 0x80, 0x32, 0xec, 0x77, 0x6c, 0x93, 0xa3, 0xcb, 0x8d, 0xad, 0x61, 0xa0, 0xcc,
-	0xec, 0x44, 0xab, 0xcd, 0xdd, 0xf3, 0x40, 0x79, 0x9e, 0x99, 0xe6, 0x91, 0x71, 0x64, 0x90, 0x7a,
-	0xd4, 0xb4, 0xee, 0x1d, 0x59, 0x75, 0x76, 0xe2, 0x61, 0xaa, 0x25, 0xe9, 0xfa, 0x4c, 0xcc, 0x6b,
-	0x9a, 0xc8, 0x84, 0x72, 0xaa, 0x3b, 0xe4, 0xdf, 0xab, 0x49, 0x6b, 0x93, 0x8d, 0x57, 0xc3, 0x40,
-	0x29, 0x25, 0x6a, 0x79, 0x87, 0xf5, 0x9b, 0x3b, 0x64, 0x0a, 0xf4, 0x52, 0xb2, 0xa1, 0xa6, 0xa9,
-	0xfe, 0x96, 0x60, 0x36, 0xda, 0x52, 0x3c, 0xea, 0x4d, 0x28, 0x52, 0x66, 0xb0, 0x01, 0xe5, 0xfb,
-	0x99, 0xdb, 0xa8, 0x5d, 0x7d, 0x54, 0x6d, 0x9e, 0xa7, 0xc7, 0xf9, 0x88, 0x40, 0xc5, 0x31, 0x28,
-	0xeb, 0xf4, 0x88, 0xeb, 0xda, 0x8c, 0x61, 0xb3, 0x63, 0x39, 0xb4, 0xcf, 0x65, 0x4f, 0x34, 0xb6,
-	0x87, 0x81, 0xb2, 0xd0, 0x32, 0x28, 0xdb, 0x11, 0xd1, 0xbd, 0x56, 0xfb, 0xe0, 0x3c, 0x50, 0x56,
-	0x6f, 0x16, 0x1f, 0x66, 0xea, 0x0b, 0x4e, 0xae, 0xd8, 0xa1, 0x7d, 0xf5, 0x8f, 0x04, 0xe5, 0xc3,
-	0x3e, 0xfd, 0xbf, 0x06, 0xb2, 0x0f, 0x73, 0x62, 0x4f, 0xb7, 0x9d, 0x88, 0xda, 0x83, 0xd9, 0x0f,
-	0xc4, 0xb3, 0x7b, 0xe2, 0x78, 0xda, 0x30, 0xcd, 0xc2, 0x77, 0x71, 0x38, 0x93, 0x8d, 0xcd, 0x61,
-	0xa0, 0x4c, 0xf1, 0x1c, 0x2e, 0xfc, 0xd9, 0xcd, 0xc2, 0xe3, 0x64, 0x7d, 0x8a, 0x93, 0x9a, 0xe6,
-	0xc6, 0xcf, 0x22, 0x2c, 0xa5, 0x86, 0x21, 0x7c, 0xad, 0x8d, 0xfd, 0x63, 0xbb, 0x87, 0xd1, 0x7b,
-	0xa8, 0xe8, 0xd8, 0xb2, 0xc3, 0x23, 0xcc, 0xdc, 0x62, 0xa4, 0x68, 0x19, 0xc3, 0xd3, 0xc6, 0xad,
-	0x41, 0x7e, 0xa4, 0x45, 0xae, 0xa6, 0x09, 0x57, 0xd3, 0x5e, 0x87, 0xae, 0xa6, 0x16, 0x90, 0x0e,
-	0x0f, 0x0f, 0xfb, 0xfe, 0xdd, 0x32, 0x77, 0xa1, 0x2c, 0x54, 0xf2, 0xfd, 0xa1, 0xa5, 0x1c, 0x2b,
-	0x7b, 0x88, 0xd7, 0x50, 0xde, 0xc0, 0x7c, 0xaa, 0xec, 0x16, 0x9c, 0x16, 0x2c, 0x08, 0x35, 0xc9,
-	0x64, 0xd1, 0x93, 0x1c, 0xe9, 0xa2, 0xcb, 0x5d, 0x43, 0x3b, 0x80, 0x4a, 0xaa, 0xea, 0x0e, 0x78,
-	0xfb, 0x30, 0x7f, 0xe8, 0x99, 0x06, 0xc3, 0x77, 0xc0, 0xd2, 0xa1, 0x94, 0xf9, 0xdc, 0x5c, 0x98,
-	0xe0, 0xf8, 0xe7, 0x49, 0xae, 0x5d, 0x9d, 0x10, 0x5d, 0x16, 0xb5, 0x80, 0x5e, 0xc2, 0x44, 0x68,
-	0x68, 0x68, 0x31, 0xff, 0x73, 0x48, 0x5d, 0x42, 0x5e, 0xba, 0x24, 0x92, 0x94, 0xef, 0x40, 0x31,
-	0xba, 0x7f, 0x48, 0xce, 0xa5, 0xe5, 0x8c, 0x46, 0x5e, 0xbe, 0x34, 0x26, 0x20, 0x8d, 0xad, 0xd3,
-	0x61, 0x55, 0x3a, 0x1b, 0x56, 0xa5, 0x1f, 0xa3, 0x6a, 0xe1, 0xd7, 0xa8, 0x2a, 0x9d, 0x8d, 0xaa,
-	0x85, 0xbf, 0xa3, 0x6a, 0xe1, 0x93, 0x7a, 0xe5, 0x0d, 0x4b, 0xfe, 0x26, 0x74, 0x8b, 0xfc, 0xf9,
-	0xc5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x84, 0x18, 0x7e, 0x3b, 0x08, 0x00, 0x00,
+type CommitConsumerGroupOffsetRequest struct {
+	Group  string                       `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Offset varlogpb.ConsumerGroupOffset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CommitConsumerGroupOffsetRequest) Reset()         { *m = CommitConsumerGroupOffsetRequest{} }
+func (m *CommitConsumerGroupOffsetRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitConsumerGroupOffsetRequest) ProtoMessage()    {}
+func (*CommitConsumerGroupOffsetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{9}
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitConsumerGroupOffsetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitConsumerGroupOffsetRequest.Merge(m, src)
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitConsumerGroupOffsetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitConsumerGroupOffsetRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CommitConsumerGroupOffsetRequest proto.InternalMessageInfo
 
-// MetadataRepositoryServiceClient is the client API for MetadataRepositoryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MetadataRepositoryServiceClient interface {
-	RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
-	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
-	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+func (m *CommitConsumerGroupOffsetRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
 }
 
-type metadataRepositoryServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CommitConsumerGroupOffsetRequest) GetOffset() varlogpb.ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
+	}
+	return varlogpb.ConsumerGroupOffset{}
 }
 
-func NewMetadataRepositoryServiceClient(cc *grpc.ClientConn) MetadataRepositoryServiceClient {
-	return &metadataRepositoryServiceClient{cc}
+type GetConsumerGroupRequest struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-func (c *metadataRepositoryServiceClient) RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetConsumerGroupRequest) Reset()         { *m = GetConsumerGroupRequest{} }
+func (m *GetConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConsumerGroupRequest) ProtoMessage()    {}
+func (*GetConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{10}
+}
+func (m *GetConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConsumerGroupRequest.Merge(m, src)
+}
+func (m *GetConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConsumerGroupRequest.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *GetConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *metadataRepositoryServiceClient) RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetConsumerGroupResponse struct {
+	ConsumerGroup varlogpb.ConsumerGroupDescriptor `protobuf:"bytes,1,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group"`
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetConsumerGroupResponse) Reset()         { *m = GetConsumerGroupResponse{} }
+func (m *GetConsumerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*GetConsumerGroupResponse) ProtoMessage()    {}
+func (*GetConsumerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{11}
+}
+func (m *GetConsumerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetConsumerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetConsumerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetConsumerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConsumerGroupResponse.Merge(m, src)
+}
+func (m *GetConsumerGroupResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetConsumerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConsumerGroupResponse.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetConsumerGroupResponse proto.InternalMessageInfo
+
+func (m *GetConsumerGroupResponse) GetConsumerGroup() varlogpb.ConsumerGroupDescriptor {
+	if m != nil {
+		return m.ConsumerGroup
 	}
-	return out, nil
+	return varlogpb.ConsumerGroupDescriptor{}
 }
 
-func (c *metadataRepositoryServiceClient) UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListConsumerGroupsRequest struct {
 }
 
-func (c *metadataRepositoryServiceClient) UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListConsumerGroupsRequest) Reset()         { *m = ListConsumerGroupsRequest{} }
+func (m *ListConsumerGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListConsumerGroupsRequest) ProtoMessage()    {}
+func (*ListConsumerGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{12}
+}
+func (m *ListConsumerGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConsumerGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConsumerGroupsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListConsumerGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConsumerGroupsRequest.Merge(m, src)
+}
+func (m *ListConsumerGroupsRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListConsumerGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConsumerGroupsRequest.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
-	out := new(GetMetadataResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_ListConsumerGroupsRequest proto.InternalMessageInfo
+
+type ListConsumerGroupsResponse struct {
+	ConsumerGroups []varlogpb.ConsumerGroupDescriptor `protobuf:"bytes,1,rep,name=consumer_groups,json=consumerGroups,proto3" json:"consumer_groups"`
 }
 
-func (c *metadataRepositoryServiceClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
-	out := new(SealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Seal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListConsumerGroupsResponse) Reset()         { *m = ListConsumerGroupsResponse{} }
+func (m *ListConsumerGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConsumerGroupsResponse) ProtoMessage()    {}
+func (*ListConsumerGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{13}
+}
+func (m *ListConsumerGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConsumerGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConsumerGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ListConsumerGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConsumerGroupsResponse.Merge(m, src)
+}
+func (m *ListConsumerGroupsResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListConsumerGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConsumerGroupsResponse.DiscardUnknown(m)
 }
 
-func (c *metadataRepositoryServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
-	out := new(UnsealResponse)
-	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Unseal", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListConsumerGroupsResponse proto.InternalMessageInfo
+
+func (m *ListConsumerGroupsResponse) GetConsumerGroups() []varlogpb.ConsumerGroupDescriptor {
+	if m != nil {
+		return m.ConsumerGroups
 	}
-	return out, nil
+	return nil
 }
 
-// MetadataRepositoryServiceServer is the server API for MetadataRepositoryService service.
-type MetadataRepositoryServiceServer interface {
-	RegisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
-	UnregisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
-	RegisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
-	UnregisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
-	RegisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	UnregisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	UpdateLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
-	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
-	Seal(context.Context, *SealRequest) (*SealResponse, error)
-	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+type DeleteConsumerGroupRequest struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 }
 
-// UnimplementedMetadataRepositoryServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedMetadataRepositoryServiceServer struct {
+func (m *DeleteConsumerGroupRequest) Reset()         { *m = DeleteConsumerGroupRequest{} }
+func (m *DeleteConsumerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroupRequest) ProtoMessage()    {}
+func (*DeleteConsumerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{14}
 }
-
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterStorageNode not implemented")
+func (m *DeleteConsumerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+func (m *DeleteConsumerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterTopic not implemented")
+func (m *DeleteConsumerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroupRequest.Merge(m, src)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+func (m *DeleteConsumerGroupRequest) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (*UnimplementedMetadataRepositoryServiceServer) RegisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLogStream not implemented")
+func (m *DeleteConsumerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroupRequest.DiscardUnknown(m)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UnregisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+
+var xxx_messageInfo_DeleteConsumerGroupRequest proto.InternalMessageInfo
+
+func (m *DeleteConsumerGroupRequest) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
 }
-func (*UnimplementedMetadataRepositoryServiceServer) UpdateLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+
+type CommitTransactionRequest struct {
+	TransactionID uint64                    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Participants  []varlogpb.TopicLogStream `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants"`
 }
-func (*UnimplementedMetadataRepositoryServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+
+func (m *CommitTransactionRequest) Reset()         { *m = CommitTransactionRequest{} }
+func (m *CommitTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitTransactionRequest) ProtoMessage()    {}
+func (*CommitTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{15}
 }
-func (*UnimplementedMetadataRepositoryServiceServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+func (m *CommitTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMetadataRepositoryServiceServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+func (m *CommitTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMetadataRepositoryServiceServer(s *grpc.Server, srv MetadataRepositoryServiceServer) {
-	s.RegisterService(&_MetadataRepositoryService_serviceDesc, srv)
+func (m *CommitTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTransactionRequest.Merge(m, src)
+}
+func (m *CommitTransactionRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTransactionRequest.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_RegisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, req.(*StorageNodeRequest))
+var xxx_messageInfo_CommitTransactionRequest proto.InternalMessageInfo
+
+func (m *CommitTransactionRequest) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MetadataRepositoryService_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StorageNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, req.(*StorageNodeRequest))
+func (m *CommitTransactionRequest) GetParticipants() []varlogpb.TopicLogStream {
+	if m != nil {
+		return m.Participants
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _MetadataRepositoryService_RegisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, req.(*TopicRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetCommitResultsRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
 
-func _MetadataRepositoryService_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TopicRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, req.(*TopicRequest))
+func (m *GetCommitResultsRequest) Reset()         { *m = GetCommitResultsRequest{} }
+func (m *GetCommitResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCommitResultsRequest) ProtoMessage()    {}
+func (*GetCommitResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{16}
+}
+func (m *GetCommitResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommitResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommitResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetCommitResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitResultsRequest.Merge(m, src)
+}
+func (m *GetCommitResultsRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetCommitResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitResultsRequest.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_RegisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, req.(*LogStreamRequest))
+var xxx_messageInfo_GetCommitResultsRequest proto.InternalMessageInfo
+
+func (m *GetCommitResultsRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MetadataRepositoryService_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, req.(*LogStreamRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetCommitResultsResponse struct {
+	// Version is the version of the last commit.
+	Version github_com_kakao_varlog_pkg_types.Version `protobuf:"varint,1,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version,omitempty"`
+	// CommitResults are the results of the log streams in the topic at the
+	// last commit.
+	CommitResults []snpb.LogStreamCommitResult `protobuf:"bytes,2,rep,name=commit_results,json=commitResults,proto3" json:"commit_results"`
 }
 
-func _MetadataRepositoryService_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogStreamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, req.(*LogStreamRequest))
+func (m *GetCommitResultsResponse) Reset()         { *m = GetCommitResultsResponse{} }
+func (m *GetCommitResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCommitResultsResponse) ProtoMessage()    {}
+func (*GetCommitResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{17}
+}
+func (m *GetCommitResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCommitResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCommitResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetCommitResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCommitResultsResponse.Merge(m, src)
+}
+func (m *GetCommitResultsResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetCommitResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCommitResultsResponse.DiscardUnknown(m)
 }
 
-func _MetadataRepositoryService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+var xxx_messageInfo_GetCommitResultsResponse proto.InternalMessageInfo
+
+func (m *GetCommitResultsResponse) GetVersion() github_com_kakao_varlog_pkg_types.Version {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _MetadataRepositoryService_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetCommitResultsResponse) GetCommitResults() []snpb.LogStreamCommitResult {
+	if m != nil {
+		return m.CommitResults
 	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).Seal(ctx, in)
+	return nil
+}
+
+type RegisterSchemaRequest struct {
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	Type          varlogpb.SchemaType                       `protobuf:"varint,2,opt,name=type,proto3,enum=varlog.varlogpb.SchemaType" json:"type,omitempty"`
+	Definition    []byte                                    `protobuf:"bytes,3,opt,name=definition,proto3" json:"definition,omitempty"`
+	Compatibility varlogpb.SchemaCompatibility              `protobuf:"varint,4,opt,name=compatibility,proto3,enum=varlog.varlogpb.SchemaCompatibility" json:"compatibility,omitempty"`
+}
+
+func (m *RegisterSchemaRequest) Reset()         { *m = RegisterSchemaRequest{} }
+func (m *RegisterSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterSchemaRequest) ProtoMessage()    {}
+func (*RegisterSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{18}
+}
+func (m *RegisterSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Seal",
+}
+func (m *RegisterSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterSchemaRequest.Merge(m, src)
+}
+func (m *RegisterSchemaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RegisterSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterSchemaRequest proto.InternalMessageInfo
+
+func (m *RegisterSchemaRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).Seal(ctx, req.(*SealRequest))
+	return 0
+}
+
+func (m *RegisterSchemaRequest) GetType() varlogpb.SchemaType {
+	if m != nil {
+		return m.Type
 	}
-	return interceptor(ctx, in, info, handler)
+	return varlogpb.SchemaTypeUnspecified
 }
 
-func _MetadataRepositoryService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnsealRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RegisterSchemaRequest) GetDefinition() []byte {
+	if m != nil {
+		return m.Definition
 	}
-	if interceptor == nil {
-		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, in)
+	return nil
+}
+
+func (m *RegisterSchemaRequest) GetCompatibility() varlogpb.SchemaCompatibility {
+	if m != nil {
+		return m.Compatibility
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Unseal",
+	return varlogpb.SchemaCompatibilityBackward
+}
+
+type RegisterSchemaResponse struct {
+	Schema varlogpb.SchemaDescriptor `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+}
+
+func (m *RegisterSchemaResponse) Reset()         { *m = RegisterSchemaResponse{} }
+func (m *RegisterSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterSchemaResponse) ProtoMessage()    {}
+func (*RegisterSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{19}
+}
+func (m *RegisterSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, req.(*UnsealRequest))
+}
+func (m *RegisterSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterSchemaResponse.Merge(m, src)
+}
+func (m *RegisterSchemaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RegisterSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterSchemaResponse proto.InternalMessageInfo
+
+func (m *RegisterSchemaResponse) GetSchema() varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schema
 	}
-	return interceptor(ctx, in, info, handler)
+	return varlogpb.SchemaDescriptor{}
 }
 
-var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.mrpb.MetadataRepositoryService",
-	HandlerType: (*MetadataRepositoryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterStorageNode",
-			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
-		},
-		{
-			MethodName: "UnregisterStorageNode",
-			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
-		},
-		{
-			MethodName: "RegisterTopic",
-			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
-		},
-		{
-			MethodName: "UnregisterTopic",
-			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
-		},
-		{
-			MethodName: "RegisterLogStream",
-			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
-		},
-		{
-			MethodName: "UnregisterLogStream",
-			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
-		},
-		{
-			MethodName: "UpdateLogStream",
-			Handler:    _MetadataRepositoryService_UpdateLogStream_Handler,
-		},
-		{
-			MethodName: "GetMetadata",
-			Handler:    _MetadataRepositoryService_GetMetadata_Handler,
-		},
-		{
-			MethodName: "Seal",
-			Handler:    _MetadataRepositoryService_Seal_Handler,
-		},
-		{
-			MethodName: "Unseal",
-			Handler:    _MetadataRepositoryService_Unseal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/mrpb/metadata_repository.proto",
+type GetSchemaRequest struct {
+	SchemaID github_com_kakao_varlog_pkg_types.SchemaID `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3,casttype=github.com/kakao/varlog/pkg/types.SchemaID" json:"schema_id,omitempty"`
 }
 
-func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
+func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaRequest) ProtoMessage()    {}
+func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{20}
+}
+func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaRequest.Merge(m, src)
 }
-
-func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetSchemaRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaRequest.DiscardUnknown(m)
 }
 
-func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSchemaRequest proto.InternalMessageInfo
+
+func (m *GetSchemaRequest) GetSchemaID() github_com_kakao_varlog_pkg_types.SchemaID {
+	if m != nil {
+		return m.SchemaID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetSchemaResponse struct {
+	Schema varlogpb.SchemaDescriptor `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
 }
 
-func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
+func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSchemaResponse) ProtoMessage()    {}
+func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{21}
+}
+func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSchemaResponse.Merge(m, src)
+}
+func (m *GetSchemaResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GetSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSchemaResponse.DiscardUnknown(m)
 }
 
-func (m *StorageNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetSchemaResponse proto.InternalMessageInfo
+
+func (m *GetSchemaResponse) GetSchema() varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schema
 	}
-	return dAtA[:n], nil
+	return varlogpb.SchemaDescriptor{}
 }
 
-func (m *StorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListSchemasRequest struct {
+	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
 
-func (m *StorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		{
-			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+func (m *ListSchemasRequest) Reset()         { *m = ListSchemasRequest{} }
+func (m *ListSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasRequest) ProtoMessage()    {}
+func (*ListSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{22}
+}
+func (m *ListSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasRequest.Merge(m, src)
+}
+func (m *ListSchemasRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasRequest.DiscardUnknown(m)
 }
 
-func (m *LogStreamRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListSchemasRequest proto.InternalMessageInfo
+
+func (m *ListSchemasRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListSchemasResponse struct {
+	// Schemas are sorted by their versions.
+	Schemas []varlogpb.SchemaDescriptor `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas"`
 }
 
-func (m *LogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		{
-			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+func (m *ListSchemasResponse) Reset()         { *m = ListSchemasResponse{} }
+func (m *ListSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSchemasResponse) ProtoMessage()    {}
+func (*ListSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ffe516e0fdff161, []int{23}
+}
+func (m *ListSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSchemasResponse.Merge(m, src)
 }
-
-func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSchemasResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ListSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSchemasResponse.DiscardUnknown(m)
 }
 
-func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClusterID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_ListSchemasResponse proto.InternalMessageInfo
+
+func (m *ListSchemasResponse) GetSchemas() []varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schemas
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*GetMetadataRequest)(nil), "varlog.mrpb.GetMetadataRequest")
+	proto.RegisterType((*GetMetadataResponse)(nil), "varlog.mrpb.GetMetadataResponse")
+	proto.RegisterType((*StorageNodeRequest)(nil), "varlog.mrpb.StorageNodeRequest")
+	proto.RegisterType((*LogStreamRequest)(nil), "varlog.mrpb.LogStreamRequest")
+	proto.RegisterType((*SealRequest)(nil), "varlog.mrpb.SealRequest")
+	proto.RegisterType((*SealResponse)(nil), "varlog.mrpb.SealResponse")
+	proto.RegisterType((*UnsealRequest)(nil), "varlog.mrpb.UnsealRequest")
+	proto.RegisterType((*UnsealResponse)(nil), "varlog.mrpb.UnsealResponse")
+	proto.RegisterType((*TopicRequest)(nil), "varlog.mrpb.TopicRequest")
+	proto.RegisterType((*CommitConsumerGroupOffsetRequest)(nil), "varlog.mrpb.CommitConsumerGroupOffsetRequest")
+	proto.RegisterType((*GetConsumerGroupRequest)(nil), "varlog.mrpb.GetConsumerGroupRequest")
+	proto.RegisterType((*GetConsumerGroupResponse)(nil), "varlog.mrpb.GetConsumerGroupResponse")
+	proto.RegisterType((*ListConsumerGroupsRequest)(nil), "varlog.mrpb.ListConsumerGroupsRequest")
+	proto.RegisterType((*ListConsumerGroupsResponse)(nil), "varlog.mrpb.ListConsumerGroupsResponse")
+	proto.RegisterType((*DeleteConsumerGroupRequest)(nil), "varlog.mrpb.DeleteConsumerGroupRequest")
+	proto.RegisterType((*CommitTransactionRequest)(nil), "varlog.mrpb.CommitTransactionRequest")
+	proto.RegisterType((*GetCommitResultsRequest)(nil), "varlog.mrpb.GetCommitResultsRequest")
+	proto.RegisterType((*GetCommitResultsResponse)(nil), "varlog.mrpb.GetCommitResultsResponse")
+	proto.RegisterType((*RegisterSchemaRequest)(nil), "varlog.mrpb.RegisterSchemaRequest")
+	proto.RegisterType((*RegisterSchemaResponse)(nil), "varlog.mrpb.RegisterSchemaResponse")
+	proto.RegisterType((*GetSchemaRequest)(nil), "varlog.mrpb.GetSchemaRequest")
+	proto.RegisterType((*GetSchemaResponse)(nil), "varlog.mrpb.GetSchemaResponse")
+	proto.RegisterType((*ListSchemasRequest)(nil), "varlog.mrpb.ListSchemasRequest")
+	proto.RegisterType((*ListSchemasResponse)(nil), "varlog.mrpb.ListSchemasResponse")
 }
 
-func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("proto/mrpb/metadata_repository.proto", fileDescriptor_0ffe516e0fdff161)
 }
 
-func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastCommittedGLSN != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LastCommittedGLSN))
-		i--
-		dAtA[i] = 0x10
+var fileDescriptor_0ffe516e0fdff161 = []byte{
+	// 1348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0x16, 0x1d, 0xc5, 0xb6, 0x8e, 0x2c, 0x25, 0x1e, 0xe7, 0x22, 0xd3, 0xff, 0x2f, 0xb9, 0x13,
+	0x27, 0x4d, 0x5b, 0x58, 0x02, 0xdc, 0x4d, 0x10, 0xa4, 0x48, 0x2b, 0xbb, 0x35, 0x14, 0xb8, 0x49,
+	0x41, 0xd9, 0x49, 0xda, 0xa0, 0x10, 0x28, 0x71, 0xcc, 0x10, 0xa6, 0x38, 0x0c, 0x67, 0x14, 0xc0,
+	0x40, 0x5f, 0xa0, 0xbb, 0xbe, 0x41, 0xfb, 0x0e, 0xed, 0xa6, 0x6f, 0x90, 0x65, 0x50, 0xa0, 0x40,
+	0x57, 0x5a, 0xc8, 0x6f, 0x91, 0x55, 0xc1, 0x21, 0x87, 0x17, 0x51, 0x97, 0xb4, 0x71, 0x36, 0xdd,
+	0x49, 0x73, 0xbe, 0xf3, 0x9d, 0xdb, 0x5c, 0x3e, 0x09, 0xb6, 0x5c, 0x8f, 0x72, 0xda, 0xe8, 0x7b,
+	0x6e, 0xb7, 0xd1, 0x27, 0x5c, 0x37, 0x74, 0xae, 0x77, 0x3c, 0xe2, 0x52, 0x66, 0x71, 0xea, 0x9d,
+	0xd6, 0x85, 0x19, 0x15, 0x5f, 0xea, 0x9e, 0x4d, 0xcd, 0xba, 0x0f, 0x53, 0xb7, 0x4d, 0x8b, 0x3f,
+	0x1f, 0x74, 0xeb, 0x3d, 0xda, 0x6f, 0x98, 0xd4, 0xa4, 0x0d, 0x81, 0xe9, 0x0e, 0x8e, 0xc5, 0xb7,
+	0x80, 0xcf, 0xff, 0x14, 0xf8, 0xaa, 0x1b, 0x26, 0xa5, 0xa6, 0x4d, 0x62, 0x14, 0xe9, 0xbb, 0x3c,
+	0x24, 0x56, 0xab, 0xcc, 0x71, 0xbb, 0x0d, 0x9b, 0x9a, 0x1d, 0xc6, 0x3d, 0xa2, 0xf7, 0x45, 0x68,
+	0x8f, 0x13, 0x2f, 0xb4, 0x5f, 0x0f, 0x02, 0x27, 0x92, 0x0b, 0x0c, 0xf8, 0x0a, 0xa0, 0x7d, 0xc2,
+	0xbf, 0x0e, 0x17, 0x35, 0xf2, 0x62, 0x40, 0x18, 0xc7, 0x8f, 0x61, 0x2d, 0xb5, 0xca, 0x5c, 0xea,
+	0x30, 0x82, 0xee, 0xc3, 0xb2, 0x74, 0xaf, 0x28, 0x9b, 0xca, 0xed, 0xe2, 0xce, 0x8d, 0x7a, 0x58,
+	0x91, 0xe4, 0xaf, 0x4b, 0xa7, 0x3d, 0xc2, 0x7a, 0x9e, 0xe5, 0x72, 0xea, 0x69, 0x91, 0x13, 0x26,
+	0x80, 0xda, 0x9c, 0x7a, 0xba, 0x49, 0x1e, 0x52, 0x83, 0x84, 0xd1, 0xd0, 0x23, 0x58, 0x61, 0xc1,
+	0x6a, 0xc7, 0xa1, 0x06, 0x09, 0xa9, 0x6f, 0x65, 0xa8, 0x13, 0xae, 0x31, 0x7b, 0x33, 0xff, 0x6a,
+	0x58, 0x53, 0xb4, 0x22, 0x8b, 0x8d, 0xf8, 0x7b, 0xb8, 0x7c, 0x40, 0xcd, 0xb6, 0xe8, 0x84, 0x0c,
+	0xd2, 0x02, 0x88, 0xdb, 0x13, 0x86, 0xd8, 0xca, 0x84, 0x88, 0xdc, 0x32, 0x01, 0x0a, 0xb6, 0x34,
+	0xe1, 0xd7, 0x0a, 0x14, 0xdb, 0x44, 0xb7, 0x25, 0xf5, 0x33, 0x80, 0x9e, 0x3d, 0x60, 0x9c, 0x78,
+	0x1d, 0xcb, 0x10, 0xd4, 0xa5, 0xe6, 0xbd, 0xd1, 0xb0, 0x56, 0xd8, 0x0d, 0x56, 0x5b, 0x7b, 0x6f,
+	0x86, 0xb5, 0x4f, 0x12, 0xd3, 0x3e, 0xd1, 0x4f, 0x74, 0xda, 0x08, 0x82, 0x36, 0xdc, 0x13, 0xb3,
+	0xc1, 0x4f, 0x5d, 0xc2, 0xea, 0x11, 0x5c, 0x2b, 0x84, 0x7c, 0x2d, 0x03, 0x19, 0x50, 0x4a, 0x8c,
+	0xd5, 0x32, 0x2a, 0x0b, 0x9b, 0xca, 0xed, 0x8b, 0xcd, 0xcf, 0x47, 0xc3, 0x5a, 0x31, 0xca, 0x56,
+	0x44, 0xd8, 0x9e, 0x1f, 0x21, 0xe1, 0xa0, 0x15, 0xa3, 0x82, 0x5a, 0x06, 0xfe, 0x5d, 0x81, 0x95,
+	0xa0, 0xa4, 0x70, 0xd4, 0x77, 0x60, 0x91, 0x71, 0x9d, 0x0f, 0x98, 0xa8, 0xa7, 0xbc, 0xb3, 0x39,
+	0xbd, 0x55, 0x6d, 0x81, 0xd3, 0x42, 0x3c, 0xa2, 0xb0, 0x66, 0xeb, 0x8c, 0x77, 0x7a, 0xb4, 0xdf,
+	0xb7, 0x38, 0x27, 0x46, 0xc7, 0xb4, 0x99, 0x23, 0xd2, 0xce, 0x37, 0xef, 0x8f, 0x86, 0xb5, 0xd5,
+	0x03, 0x9d, 0xf1, 0x5d, 0x69, 0xdd, 0x3f, 0x68, 0x3f, 0x7c, 0x33, 0xac, 0xdd, 0x9a, 0x9f, 0xbc,
+	0x8f, 0xd4, 0x56, 0xed, 0x94, 0xb3, 0xcd, 0x1c, 0xfc, 0x87, 0x02, 0xa5, 0x23, 0x87, 0xfd, 0xb7,
+	0x06, 0xf2, 0x00, 0xca, 0xb2, 0xa6, 0x77, 0x9d, 0x08, 0xfe, 0x55, 0x81, 0x95, 0x43, 0xea, 0x5a,
+	0x3d, 0xd9, 0x9f, 0x36, 0x2c, 0x73, 0xff, 0xbb, 0xec, 0xce, 0xc5, 0xe6, 0x9d, 0xd1, 0xb0, 0xb6,
+	0x24, 0x30, 0x22, 0xf3, 0x8f, 0xe6, 0x67, 0x1e, 0x82, 0xb5, 0x25, 0xc1, 0xd4, 0x32, 0x10, 0x82,
+	0xbc, 0xa3, 0xf7, 0x89, 0x68, 0x47, 0x41, 0x13, 0x9f, 0xd1, 0x5d, 0x58, 0xec, 0x51, 0xe7, 0xd8,
+	0x32, 0x2b, 0x17, 0xc4, 0x81, 0xfb, 0x5f, 0x26, 0x67, 0x41, 0xb3, 0x2b, 0x30, 0xe2, 0xa0, 0xe5,
+	0xb4, 0xd0, 0x03, 0xff, 0x00, 0x9b, 0xc1, 0x9c, 0x77, 0xa9, 0xc3, 0x06, 0x7d, 0xe2, 0xed, 0x7b,
+	0x74, 0xe0, 0x3e, 0x3a, 0x3e, 0x66, 0x84, 0xcb, 0x42, 0xae, 0xc0, 0x45, 0xd3, 0x5f, 0x15, 0x55,
+	0x14, 0xb4, 0xe0, 0x0b, 0x6a, 0xc2, 0x22, 0x15, 0xb0, 0xca, 0xc2, 0x94, 0x63, 0x3e, 0x81, 0x52,
+	0x46, 0x0f, 0x3c, 0x71, 0x03, 0xae, 0xef, 0x93, 0x74, 0xe8, 0x99, 0x41, 0xf1, 0x0b, 0xa8, 0x64,
+	0x1d, 0xc2, 0xd1, 0x1d, 0x41, 0xb9, 0x17, 0x1a, 0x3a, 0xb1, 0x6b, 0x71, 0xe7, 0xf6, 0xec, 0xc4,
+	0xc6, 0xee, 0xa0, 0x9c, 0x56, 0xea, 0x25, 0xcd, 0x78, 0x03, 0xd6, 0x0f, 0x2c, 0x96, 0x8e, 0xc9,
+	0xe4, 0x15, 0x3e, 0x00, 0x75, 0x92, 0x31, 0xcc, 0xe8, 0x09, 0x5c, 0x4a, 0x67, 0xe4, 0xef, 0xaa,
+	0x0b, 0xff, 0x22, 0xa5, 0x72, 0x2a, 0x25, 0x86, 0x77, 0x40, 0xdd, 0x23, 0x36, 0xe1, 0xe4, 0x1f,
+	0xb4, 0xee, 0x67, 0x05, 0x2a, 0xc1, 0xa8, 0x0f, 0x3d, 0xdd, 0x61, 0x7a, 0x8f, 0x5b, 0xd4, 0x91,
+	0x2e, 0x77, 0xa0, 0xcc, 0xe3, 0x55, 0xb9, 0x63, 0xf3, 0xcd, 0xd5, 0xd1, 0xb0, 0x56, 0x4a, 0xe0,
+	0x5b, 0x7b, 0x5a, 0x29, 0x01, 0x6c, 0x19, 0xa8, 0x05, 0x2b, 0xae, 0xee, 0x71, 0xab, 0x67, 0xb9,
+	0xba, 0xc3, 0x59, 0x65, 0x41, 0x14, 0x58, 0x9b, 0xbc, 0x05, 0xa3, 0xb3, 0x13, 0xd6, 0x95, 0x72,
+	0xc5, 0x4e, 0xb8, 0x1b, 0xfc, 0x1c, 0x35, 0xc2, 0x06, 0x36, 0x67, 0xef, 0xf3, 0x2c, 0xe1, 0xdf,
+	0x14, 0xa8, 0x64, 0x03, 0x86, 0xb3, 0xdb, 0x87, 0xa5, 0x97, 0xc4, 0x63, 0x16, 0x75, 0xc2, 0x56,
+	0x6c, 0xbf, 0x5d, 0x94, 0xc7, 0x81, 0x93, 0x26, 0xbd, 0xd1, 0x23, 0x7f, 0x5b, 0xfa, 0x11, 0x3a,
+	0x5e, 0x10, 0x22, 0x6c, 0x11, 0x96, 0x2d, 0x62, 0x4e, 0xf2, 0x56, 0x49, 0x66, 0x13, 0x6f, 0xc8,
+	0x78, 0x8d, 0xe1, 0x1f, 0x17, 0xe0, 0xaa, 0x46, 0x4c, 0x8b, 0x71, 0xe2, 0xb5, 0x7b, 0xcf, 0x49,
+	0x5f, 0x7f, 0xaf, 0x37, 0x4e, 0x03, 0xf2, 0xbe, 0x45, 0x9c, 0xf2, 0xf2, 0xce, 0x46, 0x56, 0x2f,
+	0x88, 0x14, 0x0e, 0x4f, 0x5d, 0xa2, 0x09, 0x20, 0xaa, 0x02, 0x18, 0xe4, 0xd8, 0x72, 0x2c, 0x7f,
+	0x87, 0x88, 0x2b, 0x69, 0x45, 0x4b, 0xac, 0xa0, 0x07, 0xe0, 0x17, 0xe4, 0xea, 0xdc, 0xea, 0x5a,
+	0xb6, 0xc5, 0x4f, 0x2b, 0x79, 0xc1, 0xbc, 0x35, 0x85, 0x79, 0x37, 0x89, 0xd5, 0xd2, 0xae, 0xf8,
+	0x5b, 0xb8, 0x36, 0xde, 0x8a, 0x48, 0x45, 0x2d, 0x32, 0xb1, 0x12, 0xde, 0x02, 0x1f, 0x4c, 0xa1,
+	0xcf, 0x9c, 0xb5, 0xd0, 0x0d, 0x9f, 0xc0, 0xe5, 0x7d, 0xc2, 0xd3, 0x0d, 0x7e, 0x02, 0x85, 0xc0,
+	0x1a, 0x77, 0xf8, 0xee, 0x68, 0x58, 0x5b, 0x0e, 0x50, 0xa2, 0xc5, 0x1f, 0xcf, 0x6f, 0xb1, 0x44,
+	0x6b, 0xcb, 0x01, 0x59, 0xcb, 0xc0, 0x87, 0xb0, 0x9a, 0x08, 0x76, 0x5e, 0x25, 0x58, 0x80, 0xfc,
+	0xdb, 0x29, 0x40, 0xbd, 0xdf, 0xb3, 0xf4, 0x14, 0xd6, 0x52, 0xa1, 0xc2, 0x12, 0xbe, 0x80, 0xa5,
+	0x20, 0x17, 0x79, 0xf3, 0xbd, 0x75, 0x0d, 0xd2, 0x6f, 0xe7, 0xcf, 0x15, 0x58, 0x8f, 0x35, 0xb2,
+	0x94, 0xfa, 0x6d, 0xe2, 0xbd, 0xb4, 0x7a, 0x04, 0x7d, 0x03, 0x6b, 0xd1, 0x06, 0x88, 0xb5, 0x29,
+	0xaa, 0xd5, 0x13, 0xbf, 0x01, 0xea, 0x59, 0x35, 0xac, 0x5e, 0xab, 0x07, 0x42, 0xbf, 0x2e, 0x85,
+	0x7e, 0xfd, 0x4b, 0x5f, 0xe8, 0xe3, 0x1c, 0xd2, 0xe0, 0xea, 0x91, 0xe3, 0x9d, 0x2f, 0xe7, 0x1e,
+	0x94, 0x64, 0x96, 0xa2, 0x73, 0x68, 0x3d, 0xc5, 0x95, 0x94, 0x0d, 0x33, 0x58, 0xbe, 0x82, 0x4b,
+	0x71, 0x66, 0xef, 0xc0, 0x73, 0x00, 0xab, 0x32, 0x9b, 0xe8, 0xda, 0x41, 0xff, 0x4f, 0x31, 0x8d,
+	0x0b, 0xfb, 0x19, 0x6c, 0x0f, 0x61, 0x2d, 0xce, 0xea, 0x1c, 0xf8, 0x1e, 0xc0, 0xa5, 0x23, 0xd7,
+	0xd0, 0x39, 0x39, 0x07, 0x2e, 0x0d, 0x8a, 0x89, 0x5f, 0x58, 0x63, 0x13, 0xcc, 0xfe, 0x22, 0x53,
+	0x37, 0xa7, 0x03, 0x82, 0x0d, 0x8d, 0x73, 0xe8, 0x33, 0xc8, 0xfb, 0x1a, 0x1e, 0x55, 0xd2, 0xdb,
+	0x21, 0x16, 0xc6, 0xea, 0xfa, 0x04, 0x4b, 0xe4, 0xbe, 0x0b, 0x8b, 0x81, 0xe4, 0x44, 0x6a, 0x0a,
+	0x96, 0xd2, 0xd6, 0xea, 0xc6, 0x44, 0x5b, 0x44, 0x62, 0xc0, 0xfa, 0x54, 0xd5, 0x86, 0xb6, 0x53,
+	0xbe, 0xf3, 0xd4, 0xdd, 0x8c, 0xee, 0xe9, 0xe2, 0x06, 0x4c, 0xb9, 0xa2, 0xad, 0xf1, 0x0e, 0x4d,
+	0x52, 0x20, 0xea, 0xcd, 0x39, 0xa8, 0xa8, 0x10, 0x33, 0xb8, 0xa1, 0x52, 0x66, 0x86, 0x6e, 0xa5,
+	0xe7, 0x3d, 0x4d, 0x7d, 0xa9, 0x1f, 0xce, 0xc5, 0x45, 0x81, 0x9e, 0xc2, 0xda, 0x04, 0xc5, 0x84,
+	0xd2, 0x0c, 0xd3, 0x35, 0xd5, 0x8c, 0x2e, 0x1d, 0xc2, 0x6a, 0x46, 0x56, 0xa1, 0x9b, 0x13, 0x66,
+	0x90, 0x95, 0x5d, 0x6f, 0xd1, 0xfb, 0xc4, 0xc3, 0x3f, 0xa9, 0xf7, 0x59, 0xa9, 0xa4, 0xde, 0x9c,
+	0x83, 0x8a, 0x5a, 0xf2, 0x0c, 0xca, 0xe9, 0xb7, 0x13, 0xe1, 0x94, 0xeb, 0x44, 0x8d, 0xa1, 0xde,
+	0x98, 0x89, 0x89, 0xc8, 0x0f, 0xa0, 0x10, 0x3d, 0x68, 0x63, 0xe7, 0x77, 0xfc, 0x55, 0x55, 0xab,
+	0xd3, 0xcc, 0x11, 0x9b, 0x06, 0xc5, 0xc4, 0xeb, 0x32, 0x76, 0x8e, 0xb3, 0x4f, 0x9c, 0xba, 0x39,
+	0x1d, 0x20, 0x39, 0x9b, 0xf7, 0x5e, 0x8d, 0xaa, 0xca, 0xeb, 0x51, 0x55, 0xf9, 0xe9, 0xac, 0x9a,
+	0xfb, 0xe5, 0xac, 0xaa, 0xbc, 0x3e, 0xab, 0xe6, 0xfe, 0x3a, 0xab, 0xe6, 0xbe, 0xc3, 0x53, 0xdf,
+	0xbf, 0xe8, 0xdf, 0xa7, 0xee, 0xa2, 0xf8, 0xfc, 0xe9, 0xdf, 0x03, 0x00, 0x66, 0x0c, 0x15, 0x12,
+	0x92, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MetadataRepositoryServiceClient is the client API for MetadataRepositoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MetadataRepositoryServiceClient interface {
+	RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error)
+	Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error)
+	Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error)
+	// CommitConsumerGroupOffset stores the offset of the consumer group. It
+	// creates the consumer group if it does not exist, and overwrites the
+	// previous offset of the same topic and log stream.
+	CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetConsumerGroup returns the offsets of the consumer group. It returns
+	// NotFound if the consumer group does not exist.
+	GetConsumerGroup(ctx context.Context, in *GetConsumerGroupRequest, opts ...grpc.CallOption) (*GetConsumerGroupResponse, error)
+	ListConsumerGroups(ctx context.Context, in *ListConsumerGroupsRequest, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error)
+	// DeleteConsumerGroup removes the consumer group and all of its offsets.
+	// It returns NotFound if the consumer group does not exist.
+	DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// CommitTransaction registers the transaction to be committed. The metadata
+	// repository commits log entries of the transaction staged in all
+	// participants by a single commit once all of them are replicated. The
+	// transaction is discarded if any participant is sealed or it is not
+	// committed in time. It returns InvalidArgument if the transaction has no
+	// participant, and NotFound if a participant does not exist.
+	CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetCommitResults returns the commit results of the log streams in the
+	// topic at the last commit. Log streams that have never been committed are
+	// omitted. It returns NotFound if the topic does not exist.
+	GetCommitResults(ctx context.Context, in *GetCommitResultsRequest, opts ...grpc.CallOption) (*GetCommitResultsResponse, error)
+	// RegisterSchema registers a new version of the schema of the topic after
+	// checking its compatibility with the latest version. If the definition is
+	// the same as a registered version of the topic, it returns that version
+	// rather than registering a new one. It returns InvalidArgument if the
+	// schema is malformed, NotFound if the topic does not exist, and
+	// FailedPrecondition if the schema is incompatible.
+	RegisterSchema(ctx context.Context, in *RegisterSchemaRequest, opts ...grpc.CallOption) (*RegisterSchemaResponse, error)
+	// GetSchema returns the schema. It returns NotFound if the schema does not
+	// exist.
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error)
+	// ListSchemas returns all versions of the schema of the topic. It returns
+	// NotFound if the topic does not exist.
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
+}
+
+type metadataRepositoryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMetadataRepositoryServiceClient(cc *grpc.ClientConn) MetadataRepositoryServiceClient {
+	return &metadataRepositoryServiceClient{cc}
+}
+
+func (c *metadataRepositoryServiceClient) RegisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *metadataRepositoryServiceClient) UnregisterStorageNode(ctx context.Context, in *StorageNodeRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) RegisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterTopic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UnregisterTopic(ctx context.Context, in *TopicRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogStreamID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
-		i--
-		dAtA[i] = 0x10
+func (c *metadataRepositoryServiceClient) RegisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ClusterID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *metadataRepositoryServiceClient) UnregisterLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) UpdateLogStream(ctx context.Context, in *LogStreamRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) GetMetadata(ctx context.Context, in *GetMetadataRequest, opts ...grpc.CallOption) (*GetMetadataResponse, error) {
+	out := new(GetMetadataResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) Seal(ctx context.Context, in *SealRequest, opts ...grpc.CallOption) (*SealResponse, error) {
+	out := new(SealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Seal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TopicRequest) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) Unseal(ctx context.Context, in *UnsealRequest, opts ...grpc.CallOption) (*UnsealResponse, error) {
+	out := new(UnsealResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/Unseal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *TopicRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *metadataRepositoryServiceClient) CommitConsumerGroupOffset(ctx context.Context, in *CommitConsumerGroupOffsetRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/CommitConsumerGroupOffset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *TopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
-		i--
-		dAtA[i] = 0x8
+func (c *metadataRepositoryServiceClient) GetConsumerGroup(ctx context.Context, in *GetConsumerGroupRequest, opts ...grpc.CallOption) (*GetConsumerGroupResponse, error) {
+	out := new(GetConsumerGroupResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintMetadataRepository(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMetadataRepository(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *metadataRepositoryServiceClient) ListConsumerGroups(ctx context.Context, in *ListConsumerGroupsRequest, opts ...grpc.CallOption) (*ListConsumerGroupsResponse, error) {
+	out := new(ListConsumerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/ListConsumerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *GetMetadataRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+
+func (c *metadataRepositoryServiceClient) DeleteConsumerGroup(ctx context.Context, in *DeleteConsumerGroupRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/DeleteConsumerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *GetMetadataResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (c *metadataRepositoryServiceClient) CommitTransaction(ctx context.Context, in *CommitTransactionRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/CommitTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+	return out, nil
+}
+
+func (c *metadataRepositoryServiceClient) GetCommitResults(ctx context.Context, in *GetCommitResultsRequest, opts ...grpc.CallOption) (*GetCommitResultsResponse, error) {
+	out := new(GetCommitResultsResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetCommitResults", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *StorageNodeRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (c *metadataRepositoryServiceClient) RegisterSchema(ctx context.Context, in *RegisterSchemaRequest, opts ...grpc.CallOption) (*RegisterSchemaResponse, error) {
+	out := new(RegisterSchemaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/RegisterSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.StorageNode != nil {
-		l = m.StorageNode.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+	return out, nil
+}
+
+func (c *metadataRepositoryServiceClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaResponse, error) {
+	out := new(GetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LogStreamRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (c *metadataRepositoryServiceClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, "/varlog.mrpb.MetadataRepositoryService/ListSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.LogStream != nil {
-		l = m.LogStream.ProtoSize()
-		n += 1 + l + sovMetadataRepository(uint64(l))
+	return out, nil
+}
+
+// MetadataRepositoryServiceServer is the server API for MetadataRepositoryService service.
+type MetadataRepositoryServiceServer interface {
+	RegisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
+	UnregisterStorageNode(context.Context, *StorageNodeRequest) (*types.Empty, error)
+	RegisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
+	UnregisterTopic(context.Context, *TopicRequest) (*types.Empty, error)
+	RegisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	UnregisterLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	UpdateLogStream(context.Context, *LogStreamRequest) (*types.Empty, error)
+	GetMetadata(context.Context, *GetMetadataRequest) (*GetMetadataResponse, error)
+	Seal(context.Context, *SealRequest) (*SealResponse, error)
+	Unseal(context.Context, *UnsealRequest) (*UnsealResponse, error)
+	// CommitConsumerGroupOffset stores the offset of the consumer group. It
+	// creates the consumer group if it does not exist, and overwrites the
+	// previous offset of the same topic and log stream.
+	CommitConsumerGroupOffset(context.Context, *CommitConsumerGroupOffsetRequest) (*types.Empty, error)
+	// GetConsumerGroup returns the offsets of the consumer group. It returns
+	// NotFound if the consumer group does not exist.
+	GetConsumerGroup(context.Context, *GetConsumerGroupRequest) (*GetConsumerGroupResponse, error)
+	ListConsumerGroups(context.Context, *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error)
+	// DeleteConsumerGroup removes the consumer group and all of its offsets.
+	// It returns NotFound if the consumer group does not exist.
+	DeleteConsumerGroup(context.Context, *DeleteConsumerGroupRequest) (*types.Empty, error)
+	// CommitTransaction registers the transaction to be committed. The metadata
+	// repository commits log entries of the transaction staged in all
+	// participants by a single commit once all of them are replicated. The
+	// transaction is discarded if any participant is sealed or it is not
+	// committed in time. It returns InvalidArgument if the transaction has no
+	// participant, and NotFound if a participant does not exist.
+	CommitTransaction(context.Context, *CommitTransactionRequest) (*types.Empty, error)
+	// GetCommitResults returns the commit results of the log streams in the
+	// topic at the last commit. Log streams that have never been committed are
+	// omitted. It returns NotFound if the topic does not exist.
+	GetCommitResults(context.Context, *GetCommitResultsRequest) (*GetCommitResultsResponse, error)
+	// RegisterSchema registers a new version of the schema of the topic after
+	// checking its compatibility with the latest version. If the definition is
+	// the same as a registered version of the topic, it returns that version
+	// rather than registering a new one. It returns InvalidArgument if the
+	// schema is malformed, NotFound if the topic does not exist, and
+	// FailedPrecondition if the schema is incompatible.
+	RegisterSchema(context.Context, *RegisterSchemaRequest) (*RegisterSchemaResponse, error)
+	// GetSchema returns the schema. It returns NotFound if the schema does not
+	// exist.
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaResponse, error)
+	// ListSchemas returns all versions of the schema of the topic. It returns
+	// NotFound if the topic does not exist.
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
+}
+
+// UnimplementedMetadataRepositoryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMetadataRepositoryServiceServer struct {
+}
+
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStorageNode not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterStorageNode(ctx context.Context, req *StorageNodeRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStorageNode not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTopic not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterTopic(ctx context.Context, req *TopicRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterTopic not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UnregisterLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) UpdateLogStream(ctx context.Context, req *LogStreamRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLogStream not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetMetadata(ctx context.Context, req *GetMetadataRequest) (*GetMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) Seal(ctx context.Context, req *SealRequest) (*SealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seal not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) Unseal(ctx context.Context, req *UnsealRequest) (*UnsealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unseal not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) CommitConsumerGroupOffset(ctx context.Context, req *CommitConsumerGroupOffsetRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConsumerGroupOffset not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetConsumerGroup(ctx context.Context, req *GetConsumerGroupRequest) (*GetConsumerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerGroup not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) ListConsumerGroups(ctx context.Context, req *ListConsumerGroupsRequest) (*ListConsumerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConsumerGroups not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) DeleteConsumerGroup(ctx context.Context, req *DeleteConsumerGroupRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumerGroup not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) CommitTransaction(ctx context.Context, req *CommitTransactionRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitTransaction not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetCommitResults(ctx context.Context, req *GetCommitResultsRequest) (*GetCommitResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitResults not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) RegisterSchema(ctx context.Context, req *RegisterSchemaRequest) (*RegisterSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSchema not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) GetSchema(ctx context.Context, req *GetSchemaRequest) (*GetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (*UnimplementedMetadataRepositoryServiceServer) ListSchemas(ctx context.Context, req *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
+
+func RegisterMetadataRepositoryServiceServer(s *grpc.Server, srv MetadataRepositoryServiceServer) {
+	s.RegisterService(&_MetadataRepositoryService_serviceDesc, srv)
+}
+
+func _MetadataRepositoryService_RegisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterStorageNode(ctx, req.(*StorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterStorageNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterStorageNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterStorageNode(ctx, req.(*StorageNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_RegisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterTopic(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterTopic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterTopic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterTopic(ctx, req.(*TopicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_RegisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UnregisterLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UnregisterLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UnregisterLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_UpdateLogStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/UpdateLogStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).UpdateLogStream(ctx, req.(*LogStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetMetadata(ctx, req.(*GetMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_Seal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).Seal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Seal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).Seal(ctx, req.(*SealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_Unseal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/Unseal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).Unseal(ctx, req.(*UnsealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_CommitConsumerGroupOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitConsumerGroupOffsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).CommitConsumerGroupOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/CommitConsumerGroupOffset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).CommitConsumerGroupOffset(ctx, req.(*CommitConsumerGroupOffsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetConsumerGroup(ctx, req.(*GetConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_ListConsumerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConsumerGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).ListConsumerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/ListConsumerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).ListConsumerGroups(ctx, req.(*ListConsumerGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_DeleteConsumerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConsumerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).DeleteConsumerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/DeleteConsumerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).DeleteConsumerGroup(ctx, req.(*DeleteConsumerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_CommitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).CommitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/CommitTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).CommitTransaction(ctx, req.(*CommitTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetCommitResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommitResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetCommitResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetCommitResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetCommitResults(ctx, req.(*GetCommitResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_RegisterSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).RegisterSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/RegisterSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).RegisterSchema(ctx, req.(*RegisterSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataRepositoryService_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataRepositoryServiceServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.mrpb.MetadataRepositoryService/ListSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataRepositoryServiceServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MetadataRepositoryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.mrpb.MetadataRepositoryService",
+	HandlerType: (*MetadataRepositoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterStorageNode",
+			Handler:    _MetadataRepositoryService_RegisterStorageNode_Handler,
+		},
+		{
+			MethodName: "UnregisterStorageNode",
+			Handler:    _MetadataRepositoryService_UnregisterStorageNode_Handler,
+		},
+		{
+			MethodName: "RegisterTopic",
+			Handler:    _MetadataRepositoryService_RegisterTopic_Handler,
+		},
+		{
+			MethodName: "UnregisterTopic",
+			Handler:    _MetadataRepositoryService_UnregisterTopic_Handler,
+		},
+		{
+			MethodName: "RegisterLogStream",
+			Handler:    _MetadataRepositoryService_RegisterLogStream_Handler,
+		},
+		{
+			MethodName: "UnregisterLogStream",
+			Handler:    _MetadataRepositoryService_UnregisterLogStream_Handler,
+		},
+		{
+			MethodName: "UpdateLogStream",
+			Handler:    _MetadataRepositoryService_UpdateLogStream_Handler,
+		},
+		{
+			MethodName: "GetMetadata",
+			Handler:    _MetadataRepositoryService_GetMetadata_Handler,
+		},
+		{
+			MethodName: "Seal",
+			Handler:    _MetadataRepositoryService_Seal_Handler,
+		},
+		{
+			MethodName: "Unseal",
+			Handler:    _MetadataRepositoryService_Unseal_Handler,
+		},
+		{
+			MethodName: "CommitConsumerGroupOffset",
+			Handler:    _MetadataRepositoryService_CommitConsumerGroupOffset_Handler,
+		},
+		{
+			MethodName: "GetConsumerGroup",
+			Handler:    _MetadataRepositoryService_GetConsumerGroup_Handler,
+		},
+		{
+			MethodName: "ListConsumerGroups",
+			Handler:    _MetadataRepositoryService_ListConsumerGroups_Handler,
+		},
+		{
+			MethodName: "DeleteConsumerGroup",
+			Handler:    _MetadataRepositoryService_DeleteConsumerGroup_Handler,
+		},
+		{
+			MethodName: "CommitTransaction",
+			Handler:    _MetadataRepositoryService_CommitTransaction_Handler,
+		},
+		{
+			MethodName: "GetCommitResults",
+			Handler:    _MetadataRepositoryService_GetCommitResults_Handler,
+		},
+		{
+			MethodName: "RegisterSchema",
+			Handler:    _MetadataRepositoryService_RegisterSchema_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _MetadataRepositoryService_GetSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _MetadataRepositoryService_ListSchemas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/mrpb/metadata_repository.proto",
+}
+
+func (m *GetMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		{
+			size, err := m.StorageNode.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		{
+			size, err := m.LogStream.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastCommittedGLSN != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LastCommittedGLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClusterID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.ClusterID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnsealResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnsealResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnsealResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitConsumerGroupOffsetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitConsumerGroupOffsetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitConsumerGroupOffsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetConsumerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetConsumerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetConsumerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListConsumerGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListConsumerGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListConsumerGroupsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ListConsumerGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListConsumerGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListConsumerGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerGroups) > 0 {
+		for iNdEx := len(m.ConsumerGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TransactionID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCommitResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommitResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommitResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCommitResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCommitResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCommitResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitResults) > 0 {
+		for iNdEx := len(m.CommitResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compatibility != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Compatibility))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SchemaID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.SchemaID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		i = encodeVarintMetadataRepository(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMetadataRepository(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetadataRepository(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetMetadataRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMetadataResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *StorageNodeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorageNode != nil {
+		l = m.StorageNode.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *LogStreamRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LogStream != nil {
+		l = m.LogStream.ProtoSize()
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *SealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *SealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Status))
+	}
+	if m.LastCommittedGLSN != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LastCommittedGLSN))
+	}
+	return n
+}
+
+func (m *UnsealRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClusterID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	}
+	return n
+}
+
+func (m *UnsealResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *TopicRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	l = m.Config.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *CommitConsumerGroupOffsetRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *GetConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *GetConsumerGroupResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsumerGroup.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *ListConsumerGroupsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListConsumerGroupsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerGroups) > 0 {
+		for _, e := range m.ConsumerGroups {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadataRepository(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteConsumerGroupRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitTransactionRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TransactionID))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadataRepository(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCommitResultsRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *GetCommitResultsResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Version))
+	}
+	if len(m.CommitResults) > 0 {
+		for _, e := range m.CommitResults {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadataRepository(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterSchemaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Type))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovMetadataRepository(uint64(l))
+	}
+	if m.Compatibility != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.Compatibility))
+	}
+	return n
+}
+
+func (m *RegisterSchemaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *GetSchemaRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchemaID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.SchemaID))
+	}
+	return n
+}
+
+func (m *GetSchemaResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.ProtoSize()
+	n += 1 + l + sovMetadataRepository(uint64(l))
+	return n
+}
+
+func (m *ListSchemasRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	}
+	return n
+}
+
+func (m *ListSchemasResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schemas) > 0 {
+		for _, e := range m.Schemas {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadataRepository(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMetadataRepository(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetadataRepository(x uint64) (n int) {
+	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageNode == nil {
+				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &varlogpb.LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+			}
+			m.LastCommittedGLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			m.ClusterID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitConsumerGroupOffsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitConsumerGroupOffsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConsumerGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConsumerGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetConsumerGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetConsumerGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetConsumerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListConsumerGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListConsumerGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListConsumerGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *SealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *SealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.Status))
-	}
-	if m.LastCommittedGLSN != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LastCommittedGLSN))
+func (m *ListConsumerGroupsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListConsumerGroupsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListConsumerGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerGroups = append(m.ConsumerGroups, varlogpb.ConsumerGroupDescriptor{})
+			if err := m.ConsumerGroups[len(m.ConsumerGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *UnsealRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClusterID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.ClusterID))
-	}
-	if m.LogStreamID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.LogStreamID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *UnsealResponse) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.Status))
+func (m *DeleteConsumerGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteConsumerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TopicRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovMetadataRepository(uint64(m.TopicID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMetadataRepository(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMetadataRepository(x uint64) (n int) {
-	return sovMetadataRepository(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1353,12 +4516,65 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			m.TransactionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, varlogpb.TopicLogStream{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1380,7 +4596,7 @@ func (m *GetMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCommitResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1403,17 +4619,17 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCommitResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCommitResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1423,28 +4639,11 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadataRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadataRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &varlogpb.MetadataDescriptor{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1466,7 +4665,7 @@ func (m *GetMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCommitResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,15 +4688,34 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCommitResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCommitResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= github_com_kakao_varlog_pkg_types.Version(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitResults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1524,10 +4742,8 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StorageNode == nil {
-				m.StorageNode = &varlogpb.StorageNodeDescriptor{}
-			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommitResults = append(m.CommitResults, snpb.LogStreamCommitResult{})
+			if err := m.CommitResults[len(m.CommitResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1552,7 +4768,7 @@ func (m *StorageNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1575,17 +4791,55 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= varlogpb.SchemaType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1595,28 +4849,45 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetadataRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadataRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &varlogpb.LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Definition = append(m.Definition[:0], dAtA[iNdEx:postIndex]...)
+			if m.Definition == nil {
+				m.Definition = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compatibility", wireType)
+			}
+			m.Compatibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compatibility |= varlogpb.SchemaCompatibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1638,7 +4909,7 @@ func (m *LogStreamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisterSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1661,17 +4932,17 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.ClusterID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1681,30 +4952,25 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1726,7 +4992,7 @@ func (m *SealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SealResponse) Unmarshal(dAtA []byte) error {
+func (m *GetSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1749,36 +5015,17 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCommittedGLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaID", wireType)
 			}
-			m.LastCommittedGLSN = 0
+			m.SchemaID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1788,7 +5035,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastCommittedGLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.SchemaID |= github_com_kakao_varlog_pkg_types.SchemaID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1814,7 +5061,7 @@ func (m *SealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
+func (m *GetSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1837,17 +5084,17 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.ClusterID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1857,30 +5104,25 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterID |= github_com_kakao_varlog_pkg_types.ClusterID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
@@ -1902,7 +5144,7 @@ func (m *UnsealRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1925,17 +5167,17 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsealResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsealResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.Status = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -1945,7 +5187,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= varlogpb.LogStreamStatus(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1971,7 +5213,7 @@ func (m *UnsealResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicRequest) Unmarshal(dAtA []byte) error {
+func (m *ListSchemasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1994,17 +5236,17 @@ func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListSchemasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadataRepository
@@ -2014,11 +5256,26 @@ func (m *TopicRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schemas = append(m.Schemas, varlogpb.SchemaDescriptor{})
+			if err := m.Schemas[len(m.Schemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadataRepository(dAtA[iNdEx:])
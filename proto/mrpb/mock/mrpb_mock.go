@@ -189,6 +189,106 @@ func (m *MockMetadataRepositoryServiceClient) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockMetadataRepositoryServiceClient) CommitConsumerGroupOffset(arg0 context.Context, arg1 *mrpb.CommitConsumerGroupOffsetRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).CommitConsumerGroupOffset), varargs...)
+}
+
+// CommitTransaction mocks base method.
+func (m *MockMetadataRepositoryServiceClient) CommitTransaction(arg0 context.Context, arg1 *mrpb.CommitTransactionRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitTransaction", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitTransaction indicates an expected call of CommitTransaction.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) CommitTransaction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTransaction", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).CommitTransaction), varargs...)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceClient) DeleteConsumerGroup(arg0 context.Context, arg1 *mrpb.DeleteConsumerGroupRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).DeleteConsumerGroup), varargs...)
+}
+
+// GetCommitResults mocks base method.
+func (m *MockMetadataRepositoryServiceClient) GetCommitResults(arg0 context.Context, arg1 *mrpb.GetCommitResultsRequest, arg2 ...grpc.CallOption) (*mrpb.GetCommitResultsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCommitResults", varargs...)
+	ret0, _ := ret[0].(*mrpb.GetCommitResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitResults indicates an expected call of GetCommitResults.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetCommitResults(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitResults", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetCommitResults), varargs...)
+}
+
+// GetConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceClient) GetConsumerGroup(arg0 context.Context, arg1 *mrpb.GetConsumerGroupRequest, arg2 ...grpc.CallOption) (*mrpb.GetConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConsumerGroup", varargs...)
+	ret0, _ := ret[0].(*mrpb.GetConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetConsumerGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetConsumerGroup), varargs...)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceClient) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest, arg2 ...grpc.CallOption) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +309,66 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetMetadata(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetMetadata), varargs...)
 }
 
+// GetSchema mocks base method.
+func (m *MockMetadataRepositoryServiceClient) GetSchema(arg0 context.Context, arg1 *mrpb.GetSchemaRequest, arg2 ...grpc.CallOption) (*mrpb.GetSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSchema", varargs...)
+	ret0, _ := ret[0].(*mrpb.GetSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) GetSchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).GetSchema), varargs...)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockMetadataRepositoryServiceClient) ListConsumerGroups(arg0 context.Context, arg1 *mrpb.ListConsumerGroupsRequest, arg2 ...grpc.CallOption) (*mrpb.ListConsumerGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConsumerGroups", varargs...)
+	ret0, _ := ret[0].(*mrpb.ListConsumerGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) ListConsumerGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).ListConsumerGroups), varargs...)
+}
+
+// ListSchemas mocks base method.
+func (m *MockMetadataRepositoryServiceClient) ListSchemas(arg0 context.Context, arg1 *mrpb.ListSchemasRequest, arg2 ...grpc.CallOption) (*mrpb.ListSchemasResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSchemas", varargs...)
+	ret0, _ := ret[0].(*mrpb.ListSchemasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) ListSchemas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).ListSchemas), varargs...)
+}
+
 // RegisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceClient) RegisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +389,26 @@ func (mr *MockMetadataRepositoryServiceClientMockRecorder) RegisterLogStream(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).RegisterLogStream), varargs...)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockMetadataRepositoryServiceClient) RegisterSchema(arg0 context.Context, arg1 *mrpb.RegisterSchemaRequest, arg2 ...grpc.CallOption) (*mrpb.RegisterSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterSchema", varargs...)
+	ret0, _ := ret[0].(*mrpb.RegisterSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockMetadataRepositoryServiceClientMockRecorder) RegisterSchema(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockMetadataRepositoryServiceClient)(nil).RegisterSchema), varargs...)
+}
+
 // RegisterStorageNode mocks base method.
 func (m *MockMetadataRepositoryServiceClient) RegisterStorageNode(arg0 context.Context, arg1 *mrpb.StorageNodeRequest, arg2 ...grpc.CallOption) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +592,81 @@ func (m *MockMetadataRepositoryServiceServer) EXPECT() *MockMetadataRepositorySe
 	return m.recorder
 }
 
+// CommitConsumerGroupOffset mocks base method.
+func (m *MockMetadataRepositoryServiceServer) CommitConsumerGroupOffset(arg0 context.Context, arg1 *mrpb.CommitConsumerGroupOffsetRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitConsumerGroupOffset", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitConsumerGroupOffset indicates an expected call of CommitConsumerGroupOffset.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) CommitConsumerGroupOffset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitConsumerGroupOffset", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).CommitConsumerGroupOffset), arg0, arg1)
+}
+
+// CommitTransaction mocks base method.
+func (m *MockMetadataRepositoryServiceServer) CommitTransaction(arg0 context.Context, arg1 *mrpb.CommitTransactionRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitTransaction indicates an expected call of CommitTransaction.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) CommitTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitTransaction", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).CommitTransaction), arg0, arg1)
+}
+
+// DeleteConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceServer) DeleteConsumerGroup(arg0 context.Context, arg1 *mrpb.DeleteConsumerGroupRequest) (*types.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*types.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConsumerGroup indicates an expected call of DeleteConsumerGroup.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) DeleteConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).DeleteConsumerGroup), arg0, arg1)
+}
+
+// GetCommitResults mocks base method.
+func (m *MockMetadataRepositoryServiceServer) GetCommitResults(arg0 context.Context, arg1 *mrpb.GetCommitResultsRequest) (*mrpb.GetCommitResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommitResults", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.GetCommitResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommitResults indicates an expected call of GetCommitResults.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetCommitResults(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommitResults", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetCommitResults), arg0, arg1)
+}
+
+// GetConsumerGroup mocks base method.
+func (m *MockMetadataRepositoryServiceServer) GetConsumerGroup(arg0 context.Context, arg1 *mrpb.GetConsumerGroupRequest) (*mrpb.GetConsumerGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroup", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.GetConsumerGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroup indicates an expected call of GetConsumerGroup.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetConsumerGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroup", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetConsumerGroup), arg0, arg1)
+}
+
 // GetMetadata mocks base method.
 func (m *MockMetadataRepositoryServiceServer) GetMetadata(arg0 context.Context, arg1 *mrpb.GetMetadataRequest) (*mrpb.GetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -427,6 +682,51 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetMetadata(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetMetadata), arg0, arg1)
 }
 
+// GetSchema mocks base method.
+func (m *MockMetadataRepositoryServiceServer) GetSchema(arg0 context.Context, arg1 *mrpb.GetSchemaRequest) (*mrpb.GetSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchema", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.GetSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchema indicates an expected call of GetSchema.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) GetSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchema", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).GetSchema), arg0, arg1)
+}
+
+// ListConsumerGroups mocks base method.
+func (m *MockMetadataRepositoryServiceServer) ListConsumerGroups(arg0 context.Context, arg1 *mrpb.ListConsumerGroupsRequest) (*mrpb.ListConsumerGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsumerGroups", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.ListConsumerGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConsumerGroups indicates an expected call of ListConsumerGroups.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) ListConsumerGroups(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsumerGroups", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).ListConsumerGroups), arg0, arg1)
+}
+
+// ListSchemas mocks base method.
+func (m *MockMetadataRepositoryServiceServer) ListSchemas(arg0 context.Context, arg1 *mrpb.ListSchemasRequest) (*mrpb.ListSchemasResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchemas", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.ListSchemasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchemas indicates an expected call of ListSchemas.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) ListSchemas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchemas", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).ListSchemas), arg0, arg1)
+}
+
 // RegisterLogStream mocks base method.
 func (m *MockMetadataRepositoryServiceServer) RegisterLogStream(arg0 context.Context, arg1 *mrpb.LogStreamRequest) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -442,6 +742,21 @@ func (mr *MockMetadataRepositoryServiceServerMockRecorder) RegisterLogStream(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterLogStream", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).RegisterLogStream), arg0, arg1)
 }
 
+// RegisterSchema mocks base method.
+func (m *MockMetadataRepositoryServiceServer) RegisterSchema(arg0 context.Context, arg1 *mrpb.RegisterSchemaRequest) (*mrpb.RegisterSchemaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSchema", arg0, arg1)
+	ret0, _ := ret[0].(*mrpb.RegisterSchemaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSchema indicates an expected call of RegisterSchema.
+func (mr *MockMetadataRepositoryServiceServerMockRecorder) RegisterSchema(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSchema", reflect.TypeOf((*MockMetadataRepositoryServiceServer)(nil).RegisterSchema), arg0, arg1)
+}
+
 // RegisterStorageNode mocks base method.
 func (m *MockMetadataRepositoryServiceServer) RegisterStorageNode(arg0 context.Context, arg1 *mrpb.StorageNodeRequest) (*types.Empty, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +122,8 @@ func (m *UnregisterStorageNode) GetStorageNodeID() github_com_kakao_varlog_pkg_t
 
 type RegisterTopic struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	Name    string                                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Config  varlogpb.TopicConfig                      `protobuf:"bytes,3,opt,name=config,proto3" json:"config"`
 }
 
 func (m *RegisterTopic) Reset()         { *m = RegisterTopic{} }
@@ -164,6 +166,20 @@ func (m *RegisterTopic) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
 	return 0
 }
 
+func (m *RegisterTopic) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisterTopic) GetConfig() varlogpb.TopicConfig {
+	if m != nil {
+		return m.Config
+	}
+	return varlogpb.TopicConfig{}
+}
+
 type UnregisterTopic struct {
 	TopicID github_com_kakao_varlog_pkg_types.TopicID `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 }
@@ -792,6 +808,216 @@ func (m *RecoverStateMachine) GetStateMachine() *MetadataRepositoryDescriptor {
 	return nil
 }
 
+type CommitConsumerGroupOffset struct {
+	Group  string                       `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Offset varlogpb.ConsumerGroupOffset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset"`
+}
+
+func (m *CommitConsumerGroupOffset) Reset()         { *m = CommitConsumerGroupOffset{} }
+func (m *CommitConsumerGroupOffset) String() string { return proto.CompactTextString(m) }
+func (*CommitConsumerGroupOffset) ProtoMessage()    {}
+func (*CommitConsumerGroupOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{16}
+}
+func (m *CommitConsumerGroupOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitConsumerGroupOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitConsumerGroupOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitConsumerGroupOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitConsumerGroupOffset.Merge(m, src)
+}
+func (m *CommitConsumerGroupOffset) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitConsumerGroupOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitConsumerGroupOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitConsumerGroupOffset proto.InternalMessageInfo
+
+func (m *CommitConsumerGroupOffset) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *CommitConsumerGroupOffset) GetOffset() varlogpb.ConsumerGroupOffset {
+	if m != nil {
+		return m.Offset
+	}
+	return varlogpb.ConsumerGroupOffset{}
+}
+
+type DeleteConsumerGroup struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (m *DeleteConsumerGroup) Reset()         { *m = DeleteConsumerGroup{} }
+func (m *DeleteConsumerGroup) String() string { return proto.CompactTextString(m) }
+func (*DeleteConsumerGroup) ProtoMessage()    {}
+func (*DeleteConsumerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{17}
+}
+func (m *DeleteConsumerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteConsumerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteConsumerGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteConsumerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteConsumerGroup.Merge(m, src)
+}
+func (m *DeleteConsumerGroup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DeleteConsumerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteConsumerGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteConsumerGroup proto.InternalMessageInfo
+
+func (m *DeleteConsumerGroup) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+type CommitTransaction struct {
+	TransactionID uint64                    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Participants  []varlogpb.TopicLogStream `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants"`
+	CreatedTime   time.Time                 `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3,stdtime" json:"created_time"`
+}
+
+func (m *CommitTransaction) Reset()         { *m = CommitTransaction{} }
+func (m *CommitTransaction) String() string { return proto.CompactTextString(m) }
+func (*CommitTransaction) ProtoMessage()    {}
+func (*CommitTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{18}
+}
+func (m *CommitTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitTransaction.Merge(m, src)
+}
+func (m *CommitTransaction) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CommitTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitTransaction proto.InternalMessageInfo
+
+func (m *CommitTransaction) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+func (m *CommitTransaction) GetParticipants() []varlogpb.TopicLogStream {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *CommitTransaction) GetCreatedTime() time.Time {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return time.Time{}
+}
+
+// RegisterSchema registers a new version of the schema of a topic. The
+// metadata repository assigns the schema ID and the version of the schema.
+type RegisterSchema struct {
+	Schema        varlogpb.SchemaDescriptor    `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+	Compatibility varlogpb.SchemaCompatibility `protobuf:"varint,2,opt,name=compatibility,proto3,enum=varlog.varlogpb.SchemaCompatibility" json:"compatibility,omitempty"`
+}
+
+func (m *RegisterSchema) Reset()         { *m = RegisterSchema{} }
+func (m *RegisterSchema) String() string { return proto.CompactTextString(m) }
+func (*RegisterSchema) ProtoMessage()    {}
+func (*RegisterSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9661c8402dd472d1, []int{19}
+}
+func (m *RegisterSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterSchema.Merge(m, src)
+}
+func (m *RegisterSchema) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *RegisterSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterSchema proto.InternalMessageInfo
+
+func (m *RegisterSchema) GetSchema() varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schema
+	}
+	return varlogpb.SchemaDescriptor{}
+}
+
+func (m *RegisterSchema) GetCompatibility() varlogpb.SchemaCompatibility {
+	if m != nil {
+		return m.Compatibility
+	}
+	return varlogpb.SchemaCompatibilityBackward
+}
+
 type RaftEntry struct {
 	NodeIndex    uint64            `protobuf:"varint,1,opt,name=node_index,json=nodeIndex,proto3" json:"node_index,omitempty"`
 	RequestIndex uint64            `protobuf:"varint,2,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
@@ -803,7 +1029,7 @@ func (m *RaftEntry) Reset()         { *m = RaftEntry{} }
 func (m *RaftEntry) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry) ProtoMessage()    {}
 func (*RaftEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16}
+	return fileDescriptor_9661c8402dd472d1, []int{20}
 }
 func (m *RaftEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,28 +1087,32 @@ func (m *RaftEntry) GetRequest() RaftEntry_Request {
 }
 
 type RaftEntry_Request struct {
-	RegisterStorageNode   *RegisterStorageNode   `protobuf:"bytes,1,opt,name=register_storage_node,json=registerStorageNode,proto3" json:"register_storage_node,omitempty"`
-	UnregisterStorageNode *UnregisterStorageNode `protobuf:"bytes,2,opt,name=unregister_storage_node,json=unregisterStorageNode,proto3" json:"unregister_storage_node,omitempty"`
-	RegisterLogStream     *RegisterLogStream     `protobuf:"bytes,3,opt,name=register_log_stream,json=registerLogStream,proto3" json:"register_log_stream,omitempty"`
-	UnregisterLogStream   *UnregisterLogStream   `protobuf:"bytes,4,opt,name=unregister_log_stream,json=unregisterLogStream,proto3" json:"unregister_log_stream,omitempty"`
-	UpdateLogStream       *UpdateLogStream       `protobuf:"bytes,5,opt,name=update_log_stream,json=updateLogStream,proto3" json:"update_log_stream,omitempty"`
-	Report                *Reports               `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
-	Commit                *Commit                `protobuf:"bytes,7,opt,name=commit,proto3" json:"commit,omitempty"`
-	Seal                  *Seal                  `protobuf:"bytes,8,opt,name=seal,proto3" json:"seal,omitempty"`
-	Unseal                *Unseal                `protobuf:"bytes,9,opt,name=unseal,proto3" json:"unseal,omitempty"`
-	AddPeer               *AddPeer               `protobuf:"bytes,10,opt,name=add_peer,json=addPeer,proto3" json:"add_peer,omitempty"`
-	RemovePeer            *RemovePeer            `protobuf:"bytes,11,opt,name=remove_peer,json=removePeer,proto3" json:"remove_peer,omitempty"`
-	Endpoint              *Endpoint              `protobuf:"bytes,12,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	RecoverStateMachine   *RecoverStateMachine   `protobuf:"bytes,13,opt,name=recover_state_machine,json=recoverStateMachine,proto3" json:"recover_state_machine,omitempty"`
-	RegisterTopic         *RegisterTopic         `protobuf:"bytes,14,opt,name=register_topic,json=registerTopic,proto3" json:"register_topic,omitempty"`
-	UnregisterTopic       *UnregisterTopic       `protobuf:"bytes,15,opt,name=unregister_topic,json=unregisterTopic,proto3" json:"unregister_topic,omitempty"`
+	RegisterStorageNode       *RegisterStorageNode       `protobuf:"bytes,1,opt,name=register_storage_node,json=registerStorageNode,proto3" json:"register_storage_node,omitempty"`
+	UnregisterStorageNode     *UnregisterStorageNode     `protobuf:"bytes,2,opt,name=unregister_storage_node,json=unregisterStorageNode,proto3" json:"unregister_storage_node,omitempty"`
+	RegisterLogStream         *RegisterLogStream         `protobuf:"bytes,3,opt,name=register_log_stream,json=registerLogStream,proto3" json:"register_log_stream,omitempty"`
+	UnregisterLogStream       *UnregisterLogStream       `protobuf:"bytes,4,opt,name=unregister_log_stream,json=unregisterLogStream,proto3" json:"unregister_log_stream,omitempty"`
+	UpdateLogStream           *UpdateLogStream           `protobuf:"bytes,5,opt,name=update_log_stream,json=updateLogStream,proto3" json:"update_log_stream,omitempty"`
+	Report                    *Reports                   `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`
+	Commit                    *Commit                    `protobuf:"bytes,7,opt,name=commit,proto3" json:"commit,omitempty"`
+	Seal                      *Seal                      `protobuf:"bytes,8,opt,name=seal,proto3" json:"seal,omitempty"`
+	Unseal                    *Unseal                    `protobuf:"bytes,9,opt,name=unseal,proto3" json:"unseal,omitempty"`
+	AddPeer                   *AddPeer                   `protobuf:"bytes,10,opt,name=add_peer,json=addPeer,proto3" json:"add_peer,omitempty"`
+	RemovePeer                *RemovePeer                `protobuf:"bytes,11,opt,name=remove_peer,json=removePeer,proto3" json:"remove_peer,omitempty"`
+	Endpoint                  *Endpoint                  `protobuf:"bytes,12,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	RecoverStateMachine       *RecoverStateMachine       `protobuf:"bytes,13,opt,name=recover_state_machine,json=recoverStateMachine,proto3" json:"recover_state_machine,omitempty"`
+	RegisterTopic             *RegisterTopic             `protobuf:"bytes,14,opt,name=register_topic,json=registerTopic,proto3" json:"register_topic,omitempty"`
+	UnregisterTopic           *UnregisterTopic           `protobuf:"bytes,15,opt,name=unregister_topic,json=unregisterTopic,proto3" json:"unregister_topic,omitempty"`
+	CommitConsumerGroupOffset *CommitConsumerGroupOffset `protobuf:"bytes,16,opt,name=commit_consumer_group_offset,json=commitConsumerGroupOffset,proto3" json:"commit_consumer_group_offset,omitempty"`
+	DeleteConsumerGroup       *DeleteConsumerGroup       `protobuf:"bytes,17,opt,name=delete_consumer_group,json=deleteConsumerGroup,proto3" json:"delete_consumer_group,omitempty"`
+	CommitTransaction         *CommitTransaction         `protobuf:"bytes,18,opt,name=commit_transaction,json=commitTransaction,proto3" json:"commit_transaction,omitempty"`
+	RegisterSchema            *RegisterSchema            `protobuf:"bytes,19,opt,name=register_schema,json=registerSchema,proto3" json:"register_schema,omitempty"`
 }
 
 func (m *RaftEntry_Request) Reset()         { *m = RaftEntry_Request{} }
 func (m *RaftEntry_Request) String() string { return proto.CompactTextString(m) }
 func (*RaftEntry_Request) ProtoMessage()    {}
 func (*RaftEntry_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9661c8402dd472d1, []int{16, 0}
+	return fileDescriptor_9661c8402dd472d1, []int{20, 0}
 }
 func (m *RaftEntry_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,6 +1246,34 @@ func (m *RaftEntry_Request) GetUnregisterTopic() *UnregisterTopic {
 	return nil
 }
 
+func (m *RaftEntry_Request) GetCommitConsumerGroupOffset() *CommitConsumerGroupOffset {
+	if m != nil {
+		return m.CommitConsumerGroupOffset
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetDeleteConsumerGroup() *DeleteConsumerGroup {
+	if m != nil {
+		return m.DeleteConsumerGroup
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetCommitTransaction() *CommitTransaction {
+	if m != nil {
+		return m.CommitTransaction
+	}
+	return nil
+}
+
+func (m *RaftEntry_Request) GetRegisterSchema() *RegisterSchema {
+	if m != nil {
+		return m.RegisterSchema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisterStorageNode)(nil), "varlog.mrpb.RegisterStorageNode")
 	proto.RegisterType((*UnregisterStorageNode)(nil), "varlog.mrpb.UnregisterStorageNode")
@@ -1033,6 +1291,10 @@ func init() {
 	proto.RegisterType((*RemovePeer)(nil), "varlog.mrpb.RemovePeer")
 	proto.RegisterType((*Endpoint)(nil), "varlog.mrpb.Endpoint")
 	proto.RegisterType((*RecoverStateMachine)(nil), "varlog.mrpb.RecoverStateMachine")
+	proto.RegisterType((*CommitConsumerGroupOffset)(nil), "varlog.mrpb.CommitConsumerGroupOffset")
+	proto.RegisterType((*DeleteConsumerGroup)(nil), "varlog.mrpb.DeleteConsumerGroup")
+	proto.RegisterType((*CommitTransaction)(nil), "varlog.mrpb.CommitTransaction")
+	proto.RegisterType((*RegisterSchema)(nil), "varlog.mrpb.RegisterSchema")
 	proto.RegisterType((*RaftEntry)(nil), "varlog.mrpb.RaftEntry")
 	proto.RegisterType((*RaftEntry_Request)(nil), "varlog.mrpb.RaftEntry.Request")
 }
@@ -1040,76 +1302,95 @@ func init() {
 func init() { proto.RegisterFile("proto/mrpb/raft_entry.proto", fileDescriptor_9661c8402dd472d1) }
 
 var fileDescriptor_9661c8402dd472d1 = []byte{
-	// 1097 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xdb, 0xfd, 0x78, 0x9b, 0xcd, 0x36, 0x0e, 0x51, 0xac, 0x00, 0xbb, 0x91, 0x0b,
-	0x28, 0x15, 0xc4, 0x16, 0x20, 0xa1, 0x0a, 0x21, 0x44, 0x43, 0xaa, 0x12, 0xa9, 0x4d, 0xd1, 0x24,
-	0xb9, 0x54, 0x80, 0xe5, 0x5d, 0x4f, 0x5c, 0x2b, 0x6b, 0x8f, 0x19, 0x8f, 0x23, 0x2a, 0xce, 0x9c,
-	0xb8, 0xf4, 0xc6, 0xb5, 0xe2, 0xaf, 0x89, 0xc4, 0xa5, 0xe2, 0xc4, 0x69, 0x41, 0x9b, 0xff, 0x82,
-	0x13, 0x9a, 0x0f, 0x7f, 0x65, 0x8d, 0x72, 0x21, 0x11, 0xb7, 0xd9, 0x99, 0xdf, 0xfb, 0xf8, 0x8d,
-	0xdf, 0xfc, 0xde, 0x5b, 0x78, 0x33, 0xa6, 0x84, 0x11, 0x3b, 0xa4, 0xf1, 0xd8, 0xa6, 0xee, 0x09,
-	0x73, 0x70, 0xc4, 0xe8, 0x0b, 0x4b, 0xec, 0xea, 0xbd, 0x33, 0x97, 0x4e, 0x89, 0x6f, 0xf1, 0xd3,
-	0xcd, 0x91, 0x4f, 0x88, 0x3f, 0xc5, 0xb6, 0x38, 0x1a, 0xa7, 0x27, 0x36, 0x0b, 0x42, 0x9c, 0x30,
-	0x37, 0x8c, 0x25, 0x7a, 0x73, 0xc7, 0x0f, 0xd8, 0xf3, 0x74, 0x6c, 0x4d, 0x48, 0x68, 0xfb, 0xc4,
-	0x27, 0x05, 0x92, 0xff, 0x92, 0x71, 0xf8, 0x4a, 0xc1, 0x37, 0xa4, 0xf3, 0x78, 0x6c, 0x87, 0x98,
-	0xb9, 0x9e, 0xcb, 0x5c, 0x75, 0x30, 0x4c, 0xa2, 0x78, 0x6c, 0x4f, 0x89, 0xef, 0x24, 0x8c, 0x62,
-	0x37, 0x74, 0x28, 0x8e, 0x09, 0x65, 0x98, 0xaa, 0xf3, 0xbb, 0x45, 0xb2, 0x99, 0xa5, 0x80, 0x24,
-	0x01, 0x23, 0x59, 0xea, 0xe6, 0x09, 0xac, 0x21, 0xec, 0x07, 0x09, 0xc3, 0xf4, 0x90, 0x11, 0xea,
-	0xfa, 0xf8, 0x80, 0x78, 0x58, 0x7f, 0x0a, 0xcb, 0x89, 0xfc, 0xe9, 0x44, 0xc4, 0xc3, 0x86, 0xb6,
-	0xa5, 0x6d, 0xf7, 0x3e, 0x7a, 0xcf, 0x52, 0x44, 0xb3, 0x94, 0xac, 0x92, 0xcd, 0x1e, 0x4e, 0x26,
-	0x34, 0x88, 0x19, 0xa1, 0xbb, 0xcd, 0xf3, 0xd9, 0x48, 0x43, 0xbd, 0xa4, 0x38, 0x34, 0x7f, 0xd2,
-	0x60, 0xfd, 0x38, 0xa2, 0x35, 0xa1, 0xa6, 0x30, 0x28, 0x87, 0x72, 0x02, 0x4f, 0x44, 0xbb, 0xbd,
-	0xbb, 0x37, 0x9f, 0x8d, 0xfa, 0x25, 0xe4, 0xfe, 0xde, 0xdf, 0xb3, 0x91, 0x5d, 0xba, 0xbc, 0x53,
-	0xf7, 0xd4, 0x25, 0xb6, 0xcc, 0xc5, 0x8e, 0x4f, 0x7d, 0x9b, 0xbd, 0x88, 0x71, 0x62, 0x55, 0x4c,
-	0x50, 0xbf, 0x94, 0xc5, 0xbe, 0x67, 0x7a, 0xd0, 0xcf, 0xf8, 0x1e, 0x91, 0x38, 0x98, 0xe8, 0x87,
-	0xd0, 0x61, 0x7c, 0x51, 0xc4, 0xbd, 0x3f, 0x9f, 0x8d, 0xda, 0xe2, 0x50, 0x44, 0xbc, 0x77, 0x75,
-	0x44, 0x05, 0x46, 0x6d, 0xe1, 0x69, 0xdf, 0x33, 0x4f, 0x60, 0x50, 0x90, 0xbd, 0xc6, 0x38, 0xdf,
-	0xc1, 0x6a, 0xc6, 0xe6, 0x31, 0xf1, 0x0f, 0x45, 0x19, 0xe8, 0xfb, 0x00, 0x45, 0x51, 0xa8, 0x2f,
-	0xf7, 0xce, 0xc2, 0x97, 0xcb, 0xf1, 0x0b, 0xdf, 0xad, 0x3b, 0xcd, 0x8e, 0xcc, 0x1f, 0x61, 0xad,
-	0xe0, 0x51, 0x44, 0xf0, 0xa0, 0x5f, 0x2a, 0xbb, 0x9c, 0xd0, 0x17, 0xf3, 0xd9, 0xa8, 0x97, 0xa3,
-	0x04, 0xa9, 0x9d, 0xab, 0x49, 0x95, 0x0c, 0x50, 0x2f, 0x0f, 0xbd, 0xef, 0x99, 0xdf, 0xc0, 0xe0,
-	0x38, 0xf6, 0x5c, 0x86, 0xaf, 0x85, 0xda, 0x6f, 0x1a, 0xb4, 0x90, 0x78, 0x30, 0x37, 0x5b, 0x81,
-	0xfa, 0x21, 0x0c, 0xd2, 0x68, 0x42, 0xc2, 0x30, 0x60, 0xea, 0xc5, 0x1a, 0x8d, 0xad, 0x46, 0x99,
-	0x08, 0x7f, 0xd7, 0x05, 0x89, 0x63, 0x05, 0x96, 0xc9, 0x0a, 0x22, 0x4b, 0x68, 0x25, 0xad, 0xec,
-	0x9a, 0xbf, 0x6b, 0xd0, 0x96, 0xcb, 0x44, 0x7f, 0x0a, 0xed, 0x32, 0x8d, 0xe6, 0xee, 0x27, 0xf3,
-	0xd9, 0xa8, 0x95, 0xe7, 0xbf, 0x7d, 0x75, 0xfe, 0x2a, 0xf1, 0x56, 0x24, 0x33, 0x7e, 0x04, 0xcb,
-	0x13, 0x8a, 0x5d, 0x86, 0x3d, 0x87, 0x6b, 0x99, 0x71, 0x4b, 0xdc, 0xfb, 0xa6, 0x25, 0x85, 0xce,
-	0xca, 0xe4, 0xcb, 0x3a, 0xca, 0x84, 0x6e, 0xb7, 0xc3, 0x93, 0x7c, 0xf9, 0x27, 0x17, 0x01, 0x65,
-	0xc9, 0xcf, 0xf4, 0x1d, 0x68, 0x4b, 0xc6, 0x89, 0xa2, 0xbc, 0x66, 0x95, 0x94, 0xd3, 0x92, 0x04,
-	0x50, 0x86, 0x31, 0x7f, 0xd5, 0xa0, 0xf5, 0xa5, 0x60, 0xf9, 0xff, 0xe5, 0x64, 0x4e, 0xa1, 0x79,
-	0x88, 0xdd, 0xe9, 0x0d, 0xbd, 0x89, 0x08, 0x5a, 0xc7, 0x51, 0x72, 0x73, 0xf1, 0x7e, 0xd6, 0xa0,
-	0xfd, 0xc0, 0xf3, 0xbe, 0xc6, 0x98, 0xfe, 0xf7, 0xdf, 0xe0, 0x0e, 0x34, 0x52, 0x3a, 0x15, 0x57,
-	0xdf, 0x45, 0x7c, 0xa9, 0xbf, 0x0d, 0x10, 0x24, 0xce, 0x14, 0xbb, 0x34, 0xc2, 0xd4, 0x68, 0x6c,
-	0x69, 0xdb, 0x1d, 0xd4, 0x0d, 0x92, 0xc7, 0x72, 0xc3, 0xfc, 0x16, 0x00, 0xe1, 0x90, 0x9c, 0xe1,
-	0x6b, 0xc9, 0xc7, 0x0c, 0xa1, 0xf3, 0x30, 0xf2, 0x62, 0x12, 0x44, 0xec, 0x06, 0xc8, 0x9a, 0x98,
-	0xb7, 0xde, 0x09, 0x39, 0xe3, 0xed, 0xd0, 0x65, 0xf8, 0x89, 0x3b, 0x79, 0x1e, 0x44, 0x58, 0x3f,
-	0x80, 0x7e, 0xc2, 0x7f, 0x3b, 0xa1, 0xdc, 0x50, 0x32, 0x77, 0xaf, 0xf2, 0x54, 0x9e, 0xa8, 0x86,
-	0x8e, 0xf2, 0x7e, 0x5e, 0x68, 0x1d, 0x5a, 0x4e, 0x4a, 0xfe, 0xcc, 0x5f, 0xba, 0xd0, 0x45, 0xee,
-	0x09, 0x7b, 0xc8, 0x07, 0x16, 0x7e, 0xc3, 0x92, 0x57, 0xe4, 0xe1, 0x1f, 0x24, 0x35, 0xd4, 0x15,
-	0x29, 0xf2, 0x0d, 0xfd, 0x2e, 0xf4, 0x29, 0xfe, 0x3e, 0xc5, 0x09, 0x53, 0x88, 0x5b, 0x02, 0xb1,
-	0xac, 0x36, 0x73, 0x90, 0x1b, 0xc7, 0xd3, 0x00, 0x7b, 0x0a, 0xd4, 0x90, 0x20, 0xb5, 0x29, 0x41,
-	0x9f, 0xf3, 0xb7, 0x2e, 0x8c, 0x8c, 0xa6, 0x20, 0x30, 0xac, 0xbe, 0xf5, 0x2c, 0x23, 0x0b, 0x49,
-	0x94, 0x12, 0xb6, 0xcc, 0x68, 0xf3, 0xaf, 0x36, 0x57, 0x34, 0xb1, 0xd6, 0x8f, 0x60, 0x3d, 0x6b,
-	0x42, 0x4e, 0xcd, 0x58, 0xb2, 0x75, 0x49, 0x45, 0x16, 0x66, 0x0c, 0xb4, 0x56, 0x37, 0x78, 0x3c,
-	0x83, 0x8d, 0x34, 0xaa, 0xf7, 0x2b, 0xd5, 0xc0, 0xac, 0xf8, 0xad, 0x9d, 0x5e, 0xd0, 0x7a, 0x5a,
-	0x3b, 0xd4, 0x1c, 0x40, 0x1e, 0xd2, 0x29, 0x75, 0xac, 0x46, 0xdd, 0x4d, 0x5c, 0x6e, 0xaf, 0x68,
-	0x75, 0xb1, 0xe3, 0x1e, 0x41, 0x29, 0x50, 0xd9, 0x63, 0xb3, 0xe6, 0x06, 0x6a, 0x5a, 0x36, 0x5a,
-	0x4b, 0x6b, 0xfa, 0xf8, 0x57, 0xb0, 0x9a, 0x8a, 0x0e, 0x5b, 0xf6, 0x78, 0x5b, 0x78, 0x7c, 0xab,
-	0xea, 0xb1, 0xda, 0x87, 0xd1, 0x20, 0xbd, 0xd4, 0x98, 0x3f, 0x80, 0x96, 0xea, 0x65, 0x2d, 0x61,
-	0xfe, 0x46, 0x8d, 0xb0, 0x27, 0x48, 0x61, 0xf4, 0xf7, 0xa1, 0x25, 0xbb, 0x97, 0xd1, 0x16, 0xe8,
-	0x6a, 0x1b, 0x90, 0x92, 0x8f, 0x14, 0x44, 0x7f, 0x17, 0x9a, 0x5c, 0xf0, 0x8c, 0x8e, 0x80, 0xae,
-	0x56, 0xa0, 0x5c, 0x79, 0x91, 0x38, 0xe6, 0x3e, 0x53, 0xa1, 0x8c, 0x46, 0xb7, 0xc6, 0xa7, 0x14,
-	0x4d, 0xa4, 0x20, 0xba, 0x0d, 0x1d, 0xd7, 0xf3, 0x9c, 0x18, 0x63, 0x6a, 0x40, 0x4d, 0xc2, 0x4a,
-	0xf2, 0x50, 0xdb, 0x55, 0xda, 0x77, 0x1f, 0x7a, 0x54, 0x28, 0x8f, 0xb4, 0xe9, 0x09, 0x9b, 0x8d,
-	0x4b, 0x24, 0x33, 0x65, 0x42, 0x40, 0x0b, 0x95, 0xfa, 0x10, 0x3a, 0x58, 0x89, 0x8a, 0xb1, 0x2c,
-	0xcc, 0xd6, 0x2b, 0x66, 0x99, 0xe2, 0xa0, 0x1c, 0x26, 0xcb, 0x5d, 0x08, 0x83, 0x53, 0x55, 0x82,
-	0x7e, 0x6d, 0xb9, 0x2f, 0x48, 0x08, 0x2f, 0xf7, 0x45, 0x5d, 0x79, 0x00, 0x2b, 0x79, 0x01, 0x89,
-	0xf9, 0xd1, 0x58, 0x51, 0x3d, 0xaf, 0xae, 0x1a, 0xc5, 0xa8, 0x89, 0xfa, 0xd5, 0x19, 0xf6, 0x11,
-	0xdc, 0x29, 0x55, 0xa1, 0x74, 0x32, 0xa8, 0x2b, 0x97, 0xea, 0xec, 0x8b, 0x06, 0x69, 0x75, 0xe3,
-	0xd3, 0xe6, 0xf9, 0xab, 0x91, 0xb6, 0xfb, 0xd9, 0xf9, 0x7c, 0xa8, 0xbd, 0x9e, 0x0f, 0xb5, 0x97,
-	0x17, 0xc3, 0xa5, 0x57, 0x17, 0x43, 0xed, 0xf5, 0xc5, 0x70, 0xe9, 0x8f, 0x8b, 0xe1, 0xd2, 0x33,
-	0xf3, 0x5f, 0xe5, 0x35, 0xff, 0x17, 0x36, 0x6e, 0x89, 0xf5, 0xc7, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe2, 0x66, 0x54, 0x77, 0x9a, 0x0d, 0x00, 0x00,
+	// 1406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0x36, 0x63, 0x45, 0x3f, 0x47, 0x96, 0x14, 0x8d, 0x62, 0x84, 0x71, 0x72, 0x25, 0x5f, 0x26,
+	0xf7, 0x22, 0x41, 0x1a, 0x09, 0x4d, 0x81, 0x22, 0x08, 0x8a, 0xb6, 0xb1, 0x1d, 0xa4, 0x2a, 0xf2,
+	0x53, 0x8c, 0xed, 0x4d, 0xd0, 0x96, 0xa0, 0xc8, 0x11, 0x43, 0x44, 0xe4, 0xb0, 0xc3, 0x61, 0xd0,
+	0xa0, 0xeb, 0xae, 0xba, 0xc9, 0x0b, 0x14, 0x08, 0xfa, 0x0c, 0x79, 0x88, 0x00, 0xdd, 0x04, 0x5d,
+	0x75, 0xe5, 0xa2, 0xf6, 0xb2, 0x6f, 0xd0, 0x55, 0xc1, 0x99, 0x21, 0x45, 0x9a, 0x0c, 0x02, 0x14,
+	0x8d, 0xd1, 0xdd, 0xcc, 0x99, 0xef, 0xfc, 0x8d, 0xce, 0x9c, 0xef, 0x50, 0x70, 0x21, 0x64, 0x94,
+	0xd3, 0x89, 0xcf, 0xc2, 0xd9, 0x84, 0x59, 0x73, 0x6e, 0x92, 0x80, 0xb3, 0x67, 0x63, 0x21, 0x45,
+	0xed, 0xa7, 0x16, 0x5b, 0x50, 0x77, 0x9c, 0x9c, 0x6e, 0x8c, 0x5c, 0x4a, 0xdd, 0x05, 0x99, 0x88,
+	0xa3, 0x59, 0x3c, 0x9f, 0x70, 0xcf, 0x27, 0x11, 0xb7, 0xfc, 0x50, 0xa2, 0x37, 0xae, 0xbb, 0x1e,
+	0x7f, 0x1c, 0xcf, 0xc6, 0x36, 0xf5, 0x27, 0x2e, 0x75, 0xe9, 0x12, 0x99, 0xec, 0xa4, 0x9f, 0x64,
+	0xa5, 0xe0, 0xe7, 0xa4, 0xf1, 0x70, 0x36, 0xf1, 0x09, 0xb7, 0x1c, 0x8b, 0x5b, 0xea, 0x60, 0x18,
+	0x05, 0xe1, 0x6c, 0xb2, 0xa0, 0xae, 0x19, 0x71, 0x46, 0x2c, 0xdf, 0x64, 0x24, 0xa4, 0x8c, 0x13,
+	0xa6, 0xce, 0x2f, 0x2d, 0x83, 0x4d, 0x35, 0x05, 0x24, 0xf2, 0x38, 0x4d, 0x43, 0x37, 0xe6, 0x30,
+	0xc0, 0xc4, 0xf5, 0x22, 0x4e, 0xd8, 0x2e, 0xa7, 0xcc, 0x72, 0xc9, 0x03, 0xea, 0x10, 0xf4, 0x10,
+	0xd6, 0x22, 0xb9, 0x35, 0x03, 0xea, 0x10, 0x5d, 0xdb, 0xd4, 0xae, 0xb4, 0x6f, 0xfc, 0x7f, 0xac,
+	0x12, 0x4d, 0x43, 0x1a, 0xe7, 0x74, 0x76, 0x48, 0x64, 0x33, 0x2f, 0xe4, 0x94, 0x6d, 0xd5, 0x5e,
+	0x1d, 0x8c, 0x34, 0xdc, 0x8e, 0x96, 0x87, 0xc6, 0xf7, 0x1a, 0xac, 0xef, 0x07, 0xac, 0xc2, 0xd5,
+	0x02, 0x7a, 0x79, 0x57, 0xa6, 0xe7, 0x08, 0x6f, 0xa7, 0xb7, 0x76, 0x0e, 0x0f, 0x46, 0x9d, 0x1c,
+	0x72, 0xba, 0xf3, 0xe7, 0xc1, 0x68, 0x92, 0xbb, 0xbc, 0x27, 0xd6, 0x13, 0x8b, 0x4e, 0x64, 0x2c,
+	0x93, 0xf0, 0x89, 0x3b, 0xe1, 0xcf, 0x42, 0x12, 0x8d, 0x0b, 0x2a, 0xb8, 0x93, 0x8b, 0x62, 0xea,
+	0x18, 0x2f, 0x35, 0xe8, 0xa4, 0x09, 0xef, 0xd1, 0xd0, 0xb3, 0xd1, 0x2e, 0x34, 0x79, 0xb2, 0x58,
+	0x3a, 0xbe, 0x79, 0x78, 0x30, 0x6a, 0x88, 0x43, 0xe1, 0xf2, 0xea, 0xdb, 0x5d, 0x2a, 0x30, 0x6e,
+	0x08, 0x4b, 0x53, 0x07, 0x21, 0xa8, 0x05, 0x96, 0x4f, 0xf4, 0x53, 0x9b, 0xda, 0x95, 0x16, 0x16,
+	0x6b, 0x74, 0x0b, 0xea, 0x36, 0x0d, 0xe6, 0x9e, 0xab, 0xaf, 0x8a, 0xdb, 0xbc, 0x58, 0xba, 0x4d,
+	0x61, 0x66, 0x5b, 0x60, 0xc4, 0x1d, 0xae, 0x60, 0xa5, 0x61, 0xcc, 0xa1, 0xb7, 0xbc, 0xbd, 0x77,
+	0x17, 0xb7, 0xf1, 0x35, 0xf4, 0xd3, 0xdb, 0xb9, 0x47, 0xdd, 0x5d, 0x51, 0x57, 0x68, 0x0a, 0xb0,
+	0xac, 0x32, 0x55, 0x0a, 0x97, 0x4b, 0xc1, 0x67, 0xf8, 0x52, 0x21, 0xb4, 0x16, 0xe9, 0x91, 0xf1,
+	0x1d, 0x0c, 0x96, 0x79, 0x2c, 0x3d, 0x38, 0xd0, 0xc9, 0xd5, 0x71, 0x96, 0xd0, 0xa7, 0x87, 0x07,
+	0xa3, 0x76, 0x86, 0x12, 0x49, 0x5d, 0x7f, 0x7b, 0x52, 0x39, 0x05, 0xdc, 0xce, 0x5c, 0x4f, 0x1d,
+	0xe3, 0x4b, 0xe8, 0xed, 0x87, 0x8e, 0xc5, 0xc9, 0x3b, 0x49, 0xed, 0x67, 0x0d, 0xea, 0x58, 0xbc,
+	0xc0, 0x93, 0x2d, 0x69, 0xb4, 0x0b, 0xbd, 0x38, 0xb0, 0xa9, 0xef, 0x7b, 0x5c, 0xb5, 0x00, 0x7d,
+	0x75, 0x73, 0x35, 0x9f, 0x48, 0x14, 0xe4, 0x93, 0xd8, 0x57, 0x60, 0x19, 0xac, 0x2a, 0xb4, 0x6e,
+	0x5c, 0x90, 0x1a, 0xbf, 0x68, 0xd0, 0x90, 0xcb, 0x08, 0x3d, 0x84, 0x46, 0x3e, 0x8d, 0xda, 0xd6,
+	0x87, 0x87, 0x07, 0xa3, 0x7a, 0x16, 0xff, 0x95, 0xb7, 0xc7, 0xaf, 0x02, 0xaf, 0x07, 0x32, 0xe2,
+	0xbb, 0xb0, 0x66, 0x33, 0x62, 0x71, 0xe2, 0x98, 0xdc, 0x53, 0xaf, 0xa4, 0x7d, 0x63, 0x63, 0x2c,
+	0x3b, 0xe7, 0x38, 0xed, 0x87, 0xe3, 0xbd, 0xb4, 0x73, 0x6e, 0x35, 0x93, 0x20, 0x9f, 0xff, 0x96,
+	0x74, 0x15, 0xa5, 0x99, 0x9c, 0xa1, 0xeb, 0xd0, 0x90, 0x19, 0x47, 0x2a, 0xe5, 0xc1, 0x38, 0xd7,
+	0x8a, 0xc7, 0x32, 0x01, 0x9c, 0x62, 0x8c, 0x9f, 0x34, 0xa8, 0x6f, 0x8b, 0x2c, 0xff, 0xbd, 0x39,
+	0x19, 0x0b, 0xa8, 0xed, 0x12, 0x6b, 0x71, 0x42, 0x6f, 0x22, 0x80, 0xfa, 0x7e, 0x10, 0x9d, 0x9c,
+	0xbf, 0x1f, 0x34, 0x68, 0xdc, 0x76, 0x9c, 0x2f, 0x08, 0x61, 0xff, 0xfc, 0x6f, 0x70, 0x06, 0x56,
+	0x63, 0xb6, 0x50, 0x4d, 0x37, 0x59, 0xa2, 0xff, 0x00, 0x78, 0x91, 0xb9, 0x20, 0x16, 0x0b, 0x08,
+	0x13, 0x7d, 0xb7, 0x89, 0x5b, 0x5e, 0x74, 0x4f, 0x0a, 0x8c, 0xaf, 0x00, 0x30, 0xf1, 0xe9, 0x53,
+	0xf2, 0x4e, 0xe2, 0x31, 0x7c, 0x68, 0xde, 0x09, 0x9c, 0x90, 0x7a, 0x01, 0x3f, 0x81, 0x64, 0x0d,
+	0x92, 0x70, 0xb9, 0x4d, 0x9f, 0x26, 0xfc, 0x6a, 0x71, 0x72, 0xdf, 0xb2, 0x1f, 0x7b, 0x01, 0x41,
+	0x0f, 0xa0, 0x13, 0x25, 0x7b, 0xd3, 0x97, 0x02, 0xd5, 0xe6, 0xae, 0x16, 0x9e, 0xca, 0x7d, 0x35,
+	0x21, 0xe0, 0x6c, 0x40, 0x58, 0xf6, 0x3a, 0xbc, 0x16, 0xe5, 0xec, 0x19, 0x31, 0x9c, 0x97, 0x8f,
+	0x68, 0x9b, 0x06, 0x51, 0xec, 0x13, 0x76, 0x97, 0xd1, 0x38, 0x7c, 0x38, 0x9f, 0x47, 0x84, 0xa3,
+	0xb3, 0x70, 0xda, 0x4d, 0xb6, 0xc2, 0x49, 0x0b, 0xcb, 0x0d, 0xda, 0x82, 0x3a, 0x15, 0xe7, 0xea,
+	0x59, 0x94, 0x5b, 0x6c, 0x85, 0xad, 0x94, 0x02, 0xa5, 0xa6, 0x71, 0x0d, 0x06, 0x3b, 0x64, 0x41,
+	0x38, 0x29, 0x40, 0xab, 0x1d, 0x1a, 0xbf, 0x6b, 0xd0, 0x97, 0x41, 0xee, 0x31, 0x2b, 0x88, 0x2c,
+	0x9b, 0x7b, 0x34, 0x40, 0x37, 0xa1, 0xcb, 0x97, 0xdb, 0xe5, 0x4f, 0xd1, 0x4f, 0xda, 0x72, 0x0e,
+	0x98, 0xf4, 0xd8, 0x1c, 0x70, 0xea, 0xa0, 0x29, 0xac, 0x85, 0x16, 0xe3, 0x9e, 0xed, 0x85, 0x56,
+	0xc0, 0x23, 0xfd, 0x94, 0xe8, 0x36, 0xa3, 0x6a, 0x06, 0xcf, 0x8a, 0x5f, 0x65, 0x50, 0x50, 0x2d,
+	0x35, 0x8a, 0xd5, 0xbf, 0xdb, 0x28, 0x7e, 0xd4, 0xa0, 0x9b, 0xcd, 0x6e, 0xf6, 0x63, 0xe2, 0x5b,
+	0xe8, 0x13, 0xa8, 0x47, 0x62, 0xa5, 0x7e, 0xe3, 0xff, 0x96, 0x07, 0x36, 0x71, 0x7c, 0x8c, 0xc7,
+	0x56, 0xb0, 0x52, 0x43, 0x9f, 0x43, 0xc7, 0xa6, 0x7e, 0x68, 0x71, 0x6f, 0xe6, 0x2d, 0x3c, 0xfe,
+	0x4c, 0xfc, 0x5e, 0xdd, 0x1b, 0x97, 0xdf, 0x60, 0x67, 0x3b, 0x8f, 0xc5, 0x45, 0x55, 0xe3, 0x8f,
+	0x36, 0xb4, 0xb0, 0x35, 0xe7, 0x77, 0x92, 0x49, 0x39, 0x79, 0x89, 0xb2, 0xfe, 0x03, 0x87, 0x7c,
+	0x2b, 0xef, 0x1d, 0xb7, 0x44, 0x29, 0x27, 0x02, 0x74, 0x09, 0x3a, 0x8c, 0x7c, 0x13, 0x93, 0x88,
+	0x2b, 0xc4, 0x29, 0x81, 0x58, 0x53, 0xc2, 0x0c, 0x64, 0x85, 0xe1, 0xc2, 0x23, 0x8e, 0x02, 0xad,
+	0x4a, 0x90, 0x12, 0x4a, 0xd0, 0xc7, 0xd0, 0x50, 0x4a, 0x7a, 0x4d, 0x5c, 0xc2, 0xb0, 0xc8, 0x09,
+	0x69, 0x44, 0x63, 0x2c, 0x51, 0xea, 0x06, 0x52, 0xa5, 0x8d, 0x97, 0x90, 0x30, 0x9f, 0x58, 0xa3,
+	0x3d, 0x58, 0x4f, 0x87, 0x15, 0xb3, 0x62, 0x1e, 0xde, 0x2c, 0x5a, 0x2e, 0x0f, 0xb7, 0x78, 0x50,
+	0x35, 0xf1, 0x3e, 0x82, 0x73, 0x71, 0x50, 0x6d, 0x57, 0x3e, 0x0f, 0xa3, 0x60, 0xb7, 0x72, 0x6c,
+	0xc6, 0xeb, 0x71, 0x95, 0x18, 0x3d, 0x80, 0xcc, 0xa5, 0x99, 0x9b, 0x6c, 0x56, 0xab, 0x6e, 0xe2,
+	0xf8, 0x18, 0x86, 0xfb, 0xe5, 0xc9, 0x6c, 0x0f, 0x72, 0x8e, 0xf2, 0x16, 0x6b, 0x15, 0x37, 0x50,
+	0x31, 0xda, 0xe1, 0x41, 0x5c, 0x16, 0xa2, 0xcf, 0xa0, 0x1f, 0x8b, 0x49, 0x2c, 0x6f, 0xf1, 0x74,
+	0x71, 0x2a, 0x96, 0x16, 0x8b, 0xf3, 0x1a, 0xee, 0xc5, 0x45, 0x01, 0x7a, 0x0f, 0xea, 0x6a, 0xe6,
+	0xa9, 0x0b, 0xf5, 0xb3, 0x15, 0x03, 0x40, 0x84, 0x15, 0x06, 0x5d, 0x4b, 0x46, 0xf0, 0xa4, 0x2b,
+	0xe8, 0x8d, 0x4d, 0xad, 0x34, 0x2e, 0xc8, 0x86, 0x81, 0x15, 0x04, 0xfd, 0x0f, 0x6a, 0x09, 0x31,
+	0xea, 0x4d, 0x01, 0xed, 0x17, 0xa0, 0x09, 0x43, 0x63, 0x71, 0x9c, 0xd8, 0x8c, 0x05, 0x83, 0xea,
+	0xad, 0x0a, 0x9b, 0x92, 0x5c, 0xb1, 0x82, 0xa0, 0x09, 0x34, 0x2d, 0xc7, 0x31, 0x43, 0x42, 0x98,
+	0x0e, 0x15, 0x01, 0x2b, 0x6a, 0xc4, 0x0d, 0x4b, 0x2e, 0xd0, 0x4d, 0x68, 0x33, 0xc1, 0x50, 0x52,
+	0xa7, 0x2d, 0x74, 0xce, 0x1d, 0x4b, 0x32, 0x65, 0x30, 0x0c, 0x2c, 0x5b, 0xa3, 0xf7, 0xa1, 0x49,
+	0x14, 0xf9, 0xe8, 0x6b, 0x42, 0x6d, 0xbd, 0xa0, 0x96, 0x32, 0x13, 0xce, 0x60, 0xb2, 0xdc, 0x05,
+	0x81, 0x98, 0x45, 0xc6, 0xe8, 0x54, 0x96, 0x7b, 0x89, 0x6a, 0x92, 0x72, 0x2f, 0x09, 0xd1, 0x6d,
+	0xe8, 0x66, 0x05, 0x24, 0xbe, 0x33, 0xf4, 0xae, 0x6a, 0x79, 0x55, 0xd5, 0x28, 0x3a, 0x28, 0xee,
+	0x14, 0xbf, 0x75, 0xee, 0xc2, 0x99, 0x38, 0x38, 0x66, 0xa4, 0x57, 0x55, 0x2e, 0xc5, 0x6f, 0x24,
+	0xdc, 0x8b, 0x8b, 0x02, 0xe4, 0xc2, 0x45, 0x35, 0x29, 0xdb, 0x8a, 0x45, 0x4c, 0x41, 0x18, 0xa6,
+	0xa2, 0xa7, 0x33, 0xc5, 0xef, 0xdc, 0x5c, 0x59, 0x54, 0x10, 0x14, 0x3e, 0x6f, 0xbf, 0xe9, 0x28,
+	0xb9, 0x4a, 0x47, 0xb0, 0xd5, 0x31, 0x47, 0x7a, 0xbf, 0xe2, 0x2a, 0x2b, 0x78, 0x0d, 0x0f, 0x9c,
+	0xb2, 0x10, 0xdd, 0x07, 0xa4, 0xc2, 0xcf, 0xd1, 0x93, 0x8e, 0x2a, 0x1e, 0x77, 0x89, 0xfc, 0x70,
+	0xdf, 0x3e, 0x2e, 0x42, 0x3b, 0xd0, 0x5b, 0xb6, 0x21, 0xc9, 0x1b, 0x03, 0x61, 0xeb, 0x42, 0x75,
+	0x63, 0x13, 0x10, 0xdc, 0x65, 0x85, 0xfd, 0xad, 0xda, 0xab, 0x17, 0x23, 0x6d, 0xeb, 0xa3, 0x57,
+	0x87, 0x43, 0xed, 0xf5, 0xe1, 0x50, 0x7b, 0x7e, 0x34, 0x5c, 0x79, 0x71, 0x34, 0xd4, 0x5e, 0x1f,
+	0x0d, 0x57, 0x7e, 0x3d, 0x1a, 0xae, 0x3c, 0x32, 0xde, 0x38, 0xda, 0x64, 0x7f, 0xa9, 0xcc, 0xea,
+	0x62, 0xfd, 0xc1, 0x5f, 0x03, 0x00, 0x12, 0x68, 0x21, 0xc9, 0x67, 0x11, 0x00, 0x00,
 }
 
 func (m *RegisterStorageNode) Marshal() (dAtA []byte, err error) {
@@ -1195,6 +1476,23 @@ func (m *RegisterTopic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TopicID != 0 {
 		i = encodeVarintRaftEntry(dAtA, i, uint64(m.TopicID))
 		i--
@@ -1405,12 +1703,12 @@ func (m *Reports) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintRaftEntry(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintRaftEntry(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if m.NodeID != 0 {
@@ -1441,12 +1739,12 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintRaftEntry(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintRaftEntry(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.NodeID != 0 {
@@ -1656,7 +1954,7 @@ func (m *RecoverStateMachine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
+func (m *CommitConsumerGroupOffset) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1666,18 +1964,18 @@ func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitConsumerGroupOffset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitConsumerGroupOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Offset.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1685,26 +1983,48 @@ func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.AppliedIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.AppliedIndex))
+	dAtA[i] = 0x12
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Group)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if m.RequestIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.RequestIndex))
-		i--
-		dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteConsumerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.NodeIndex != 0 {
-		i = encodeVarintRaftEntry(dAtA, i, uint64(m.NodeIndex))
+	return dAtA[:n], nil
+}
+
+func (m *DeleteConsumerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteConsumerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintRaftEntry(dAtA, i, uint64(len(m.Group)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
+func (m *CommitTransaction) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1714,55 +2034,247 @@ func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftEntry_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *CommitTransaction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CommitTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UnregisterTopic != nil {
-		{
-			size, err := m.UnregisterTopic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	if m.RegisterTopic != nil {
-		{
-			size, err := m.RegisterTopic.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	i -= n9
+	i = encodeVarintRaftEntry(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftEntry(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x72
 	}
-	if m.RecoverStateMachine != nil {
-		{
-			size, err := m.RecoverStateMachine.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
-		}
+	if m.TransactionID != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.TransactionID))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x8
 	}
-	if m.Endpoint != nil {
-		{
-			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterSchema) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compatibility != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.Compatibility))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftEntry) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AppliedIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.AppliedIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.RequestIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeIndex != 0 {
+		i = encodeVarintRaftEntry(dAtA, i, uint64(m.NodeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftEntry_Request) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftEntry_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftEntry_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisterSchema != nil {
+		{
+			size, err := m.RegisterSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.CommitTransaction != nil {
+		{
+			size, err := m.CommitTransaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.DeleteConsumerGroup != nil {
+		{
+			size, err := m.DeleteConsumerGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.CommitConsumerGroupOffset != nil {
+		{
+			size, err := m.CommitConsumerGroupOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnregisterTopic != nil {
+		{
+			size, err := m.UnregisterTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RegisterTopic != nil {
+		{
+			size, err := m.RegisterTopic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.RecoverStateMachine != nil {
+		{
+			size, err := m.RecoverStateMachine.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRaftEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Endpoint != nil {
+		{
+			size, err := m.Endpoint.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1952,6 +2464,12 @@ func (m *RegisterTopic) ProtoSize() (n int) {
 	if m.TopicID != 0 {
 		n += 1 + sovRaftEntry(uint64(m.TopicID))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	l = m.Config.ProtoSize()
+	n += 1 + l + sovRaftEntry(uint64(l))
 	return n
 }
 
@@ -2141,6 +2659,68 @@ func (m *RecoverStateMachine) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CommitConsumerGroupOffset) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	l = m.Offset.ProtoSize()
+	n += 1 + l + sovRaftEntry(uint64(l))
+	return n
+}
+
+func (m *DeleteConsumerGroup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovRaftEntry(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitTransaction) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionID != 0 {
+		n += 1 + sovRaftEntry(uint64(m.TransactionID))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.ProtoSize()
+			n += 1 + l + sovRaftEntry(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedTime)
+	n += 1 + l + sovRaftEntry(uint64(l))
+	return n
+}
+
+func (m *RegisterSchema) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.ProtoSize()
+	n += 1 + l + sovRaftEntry(uint64(l))
+	if m.Compatibility != 0 {
+		n += 1 + sovRaftEntry(uint64(m.Compatibility))
+	}
+	return n
+}
+
 func (m *RaftEntry) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2227,6 +2807,22 @@ func (m *RaftEntry_Request) ProtoSize() (n int) {
 		l = m.UnregisterTopic.ProtoSize()
 		n += 1 + l + sovRaftEntry(uint64(l))
 	}
+	if m.CommitConsumerGroupOffset != nil {
+		l = m.CommitConsumerGroupOffset.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.DeleteConsumerGroup != nil {
+		l = m.DeleteConsumerGroup.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.CommitTransaction != nil {
+		l = m.CommitTransaction.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
+	if m.RegisterSchema != nil {
+		l = m.RegisterSchema.ProtoSize()
+		n += 2 + l + sovRaftEntry(uint64(l))
+	}
 	return n
 }
 
@@ -2282,6 +2878,18 @@ func (this *RaftEntry_Request) GetValue() interface{} {
 	if this.UnregisterTopic != nil {
 		return this.UnregisterTopic
 	}
+	if this.CommitConsumerGroupOffset != nil {
+		return this.CommitConsumerGroupOffset
+	}
+	if this.DeleteConsumerGroup != nil {
+		return this.DeleteConsumerGroup
+	}
+	if this.CommitTransaction != nil {
+		return this.CommitTransaction
+	}
+	if this.RegisterSchema != nil {
+		return this.RegisterSchema
+	}
 	return nil
 }
 
@@ -2317,6 +2925,14 @@ func (this *RaftEntry_Request) SetValue(value interface{}) bool {
 		this.RegisterTopic = vt
 	case *UnregisterTopic:
 		this.UnregisterTopic = vt
+	case *CommitConsumerGroupOffset:
+		this.CommitConsumerGroupOffset = vt
+	case *DeleteConsumerGroup:
+		this.DeleteConsumerGroup = vt
+	case *CommitTransaction:
+		this.CommitTransaction = vt
+	case *RegisterSchema:
+		this.RegisterSchema = vt
 	default:
 		return false
 	}
@@ -2525,20 +3141,85 @@ func (m *RegisterTopic) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftEntry(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRaftEntry
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -3385,7 +4066,365 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsLearner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovePeer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovePeer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Endpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Endpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoverStateMachine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoverStateMachine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateMachine == nil {
+				m.StateMachine = &MetadataRepositoryDescriptor{}
+			}
+			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitConsumerGroupOffset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitConsumerGroupOffset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitConsumerGroupOffset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3413,13 +4452,13 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3429,12 +4468,25 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLearner = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3456,7 +4508,7 @@ func (m *AddPeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemovePeer) Unmarshal(dAtA []byte) error {
+func (m *DeleteConsumerGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3479,17 +4531,17 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemovePeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteConsumerGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemovePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteConsumerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			m.NodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3499,11 +4551,24 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -3525,7 +4590,7 @@ func (m *RemovePeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Endpoint) Unmarshal(dAtA []byte) error {
+func (m *CommitTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3548,17 +4613,17 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Endpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitTransaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Endpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
 			}
-			m.NodeID = 0
+			m.TransactionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3568,16 +4633,16 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				m.TransactionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftEntry
@@ -3587,23 +4652,58 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRaftEntry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, varlogpb.TopicLogStream{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3626,7 +4726,7 @@ func (m *Endpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
+func (m *RegisterSchema) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3649,15 +4749,15 @@ func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecoverStateMachine: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterSchema: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecoverStateMachine: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterSchema: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateMachine", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3684,13 +4784,29 @@ func (m *RecoverStateMachine) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StateMachine == nil {
-				m.StateMachine = &MetadataRepositoryDescriptor{}
-			}
-			if err := m.StateMachine.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compatibility", wireType)
+			}
+			m.Compatibility = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compatibility |= varlogpb.SchemaCompatibility(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -4421,6 +5537,150 @@ func (m *RaftEntry_Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitConsumerGroupOffset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitConsumerGroupOffset == nil {
+				m.CommitConsumerGroupOffset = &CommitConsumerGroupOffset{}
+			}
+			if err := m.CommitConsumerGroupOffset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteConsumerGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteConsumerGroup == nil {
+				m.DeleteConsumerGroup = &DeleteConsumerGroup{}
+			}
+			if err := m.DeleteConsumerGroup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTransaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitTransaction == nil {
+				m.CommitTransaction = &CommitTransaction{}
+			}
+			if err := m.CommitTransaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegisterSchema == nil {
+				m.RegisterSchema = &RegisterSchema{}
+			}
+			if err := m.RegisterSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftEntry(dAtA[iNdEx:])
@@ -8,9 +8,12 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 	snpb "github.com/kakao/varlog/proto/snpb"
@@ -21,6 +24,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -184,18 +188,93 @@ func (m *LogStreamUncommitReports) GetStatus() varlogpb.LogStreamStatus {
 	return varlogpb.LogStreamStatusRunning
 }
 
+// Transaction is a transaction waiting to be committed by the metadata
+// repository. Log entries of the transaction become visible in all
+// participants by the same commit, or in none of them.
+type Transaction struct {
+	TransactionID uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// participants are log streams in which log entries of the transaction are
+	// staged.
+	Participants []varlogpb.TopicLogStream `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants"`
+	// create_time is the time when the transaction is registered. The
+	// transaction is discarded if it is not committed in time.
+	CreateTime time.Time `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60447af781d89487, []int{3}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+func (m *Transaction) GetParticipants() []varlogpb.TopicLogStream {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *Transaction) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
 type MetadataRepositoryDescriptor struct {
 	Metadata  *varlogpb.MetadataDescriptor                        `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	LogStream *MetadataRepositoryDescriptor_LogStreamDescriptor   `protobuf:"bytes,2,opt,name=log_stream,json=logStream,proto3" json:"log_stream,omitempty"`
 	PeersMap  MetadataRepositoryDescriptor_PeerDescriptorMap      `protobuf:"bytes,3,opt,name=peers_map,json=peersMap,proto3" json:"peers_map"`
 	Endpoints map[github_com_kakao_varlog_pkg_types.NodeID]string `protobuf:"bytes,4,rep,name=endpoints,proto3,castkey=github.com/kakao/varlog/pkg/types.NodeID" json:"endpoints,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// consumer_groups are the committed offsets of consumer groups keyed by
+	// their names.
+	ConsumerGroups map[string]*varlogpb.ConsumerGroupDescriptor `protobuf:"bytes,5,rep,name=consumer_groups,json=consumerGroups,proto3" json:"consumer_groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// transactions are the transactions waiting to be committed keyed by their
+	// identifiers.
+	Transactions map[uint64]*Transaction `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// schemas are the schemas of topics keyed by their identifiers.
+	Schemas map[github_com_kakao_varlog_pkg_types.SchemaID]*varlogpb.SchemaDescriptor `protobuf:"bytes,7,rep,name=schemas,proto3,castkey=github.com/kakao/varlog/pkg/types.SchemaID" json:"schemas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *MetadataRepositoryDescriptor) Reset()         { *m = MetadataRepositoryDescriptor{} }
 func (m *MetadataRepositoryDescriptor) String() string { return proto.CompactTextString(m) }
 func (*MetadataRepositoryDescriptor) ProtoMessage()    {}
 func (*MetadataRepositoryDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3}
+	return fileDescriptor_60447af781d89487, []int{4}
 }
 func (m *MetadataRepositoryDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,6 +331,27 @@ func (m *MetadataRepositoryDescriptor) GetEndpoints() map[github_com_kakao_varlo
 	return nil
 }
 
+func (m *MetadataRepositoryDescriptor) GetConsumerGroups() map[string]*varlogpb.ConsumerGroupDescriptor {
+	if m != nil {
+		return m.ConsumerGroups
+	}
+	return nil
+}
+
+func (m *MetadataRepositoryDescriptor) GetTransactions() map[uint64]*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *MetadataRepositoryDescriptor) GetSchemas() map[github_com_kakao_varlog_pkg_types.SchemaID]*varlogpb.SchemaDescriptor {
+	if m != nil {
+		return m.Schemas
+	}
+	return nil
+}
+
 type MetadataRepositoryDescriptor_LogStreamDescriptor struct {
 	TrimVersion     github_com_kakao_varlog_pkg_types.Version                                   `protobuf:"varint,1,opt,name=trim_version,json=trimVersion,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"trim_version,omitempty"`
 	CommitHistory   []*LogStreamCommitResults                                                   `protobuf:"bytes,2,rep,name=commit_history,json=commitHistory,proto3" json:"commit_history,omitempty"`
@@ -266,7 +366,7 @@ func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_LogStreamDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 0}
+	return fileDescriptor_60447af781d89487, []int{4, 0}
 }
 func (m *MetadataRepositoryDescriptor_LogStreamDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +429,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptor) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptor) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 1}
+	return fileDescriptor_60447af781d89487, []int{4, 1}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +488,7 @@ func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) String() string {
 }
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) ProtoMessage() {}
 func (*MetadataRepositoryDescriptor_PeerDescriptorMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60447af781d89487, []int{3, 2}
+	return fileDescriptor_60447af781d89487, []int{4, 2}
 }
 func (m *MetadataRepositoryDescriptor_PeerDescriptorMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,8 +536,12 @@ func init() {
 	proto.RegisterType((*StorageNodeUncommitReport)(nil), "varlog.mrpb.StorageNodeUncommitReport")
 	proto.RegisterType((*LogStreamUncommitReports)(nil), "varlog.mrpb.LogStreamUncommitReports")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.StorageNodeID]snpb.LogStreamUncommitReport)(nil), "varlog.mrpb.LogStreamUncommitReports.ReplicasEntry")
+	proto.RegisterType((*Transaction)(nil), "varlog.mrpb.Transaction")
 	proto.RegisterType((*MetadataRepositoryDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor")
+	proto.RegisterMapType((map[string]*varlogpb.ConsumerGroupDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.ConsumerGroupsEntry")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.NodeID]string)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.EndpointsEntry")
+	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.SchemaID]*varlogpb.SchemaDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.SchemasEntry")
+	proto.RegisterMapType((map[uint64]*Transaction)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.TransactionsEntry")
 	proto.RegisterType((*MetadataRepositoryDescriptor_LogStreamDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor")
 	proto.RegisterMapType((map[github_com_kakao_varlog_pkg_types.LogStreamID]*LogStreamUncommitReports)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.LogStreamDescriptor.UncommitReportsEntry")
 	proto.RegisterType((*MetadataRepositoryDescriptor_PeerDescriptor)(nil), "varlog.mrpb.MetadataRepositoryDescriptor.PeerDescriptor")
@@ -450,61 +554,77 @@ func init() {
 }
 
 var fileDescriptor_60447af781d89487 = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc4, 0x4e, 0x1b, 0xbf, 0xd4, 0x69, 0x19, 0x2a, 0x70, 0x2d, 0xb0, 0x23, 0x07, 0xa4,
-	0x54, 0x22, 0xbb, 0x22, 0x3d, 0x10, 0xa5, 0xe5, 0x62, 0x12, 0x20, 0x28, 0x29, 0xd1, 0x46, 0xe1,
-	0xc0, 0x81, 0xd5, 0xd8, 0x9e, 0xba, 0xab, 0xec, 0xee, 0x8c, 0x66, 0x66, 0x23, 0x7c, 0x8d, 0x38,
-	0x20, 0x4e, 0x7c, 0x84, 0x7e, 0x04, 0x8e, 0xf0, 0x0d, 0x72, 0xcc, 0x0d, 0x4e, 0x0e, 0x4a, 0x2e,
-	0x7c, 0x86, 0x9e, 0xd0, 0xce, 0xcc, 0xda, 0xbb, 0x64, 0x43, 0xd2, 0xe6, 0xb6, 0xf3, 0xe7, 0xf7,
-	0x7e, 0xef, 0xf7, 0xe6, 0xfd, 0x9e, 0x16, 0x1e, 0x73, 0xc1, 0x14, 0x73, 0x23, 0xc1, 0x7b, 0xae,
-	0x20, 0x2f, 0x94, 0x1f, 0x51, 0x45, 0x06, 0x44, 0x11, 0x5f, 0x50, 0xce, 0x64, 0xa0, 0x98, 0x18,
-	0x39, 0xfa, 0x0e, 0x5e, 0x38, 0x22, 0x22, 0x64, 0x43, 0x27, 0xbd, 0xdb, 0x5c, 0x1d, 0x06, 0xea,
-	0x65, 0xd2, 0x73, 0xfa, 0x2c, 0x72, 0x87, 0x6c, 0xc8, 0x5c, 0x7d, 0xa7, 0x97, 0xbc, 0xd0, 0x2b,
-	0x13, 0x34, 0xfd, 0x32, 0xd8, 0xe6, 0xfb, 0x06, 0xcb, 0x7b, 0x6e, 0x16, 0xdf, 0x1e, 0xb4, 0x64,
-	0xcc, 0x7b, 0x6e, 0xc8, 0x86, 0xbe, 0x54, 0x82, 0x92, 0x48, 0xd3, 0x0a, 0x45, 0x85, 0x39, 0xef,
-	0xfc, 0x8e, 0xe0, 0xbd, 0x1d, 0x36, 0xdc, 0xd7, 0x87, 0x5f, 0xb0, 0x28, 0x0a, 0x94, 0x47, 0x65,
-	0x12, 0x2a, 0x89, 0xbf, 0x82, 0xbb, 0x47, 0x54, 0xc8, 0x80, 0xc5, 0x0d, 0xb4, 0x84, 0x56, 0xaa,
-	0xdd, 0xd5, 0xd7, 0xe3, 0xf6, 0xe3, 0x5c, 0x5e, 0x87, 0xe4, 0x90, 0x30, 0xd7, 0x30, 0xbb, 0xfc,
-	0x70, 0xe8, 0xaa, 0x11, 0xa7, 0xd2, 0xf9, 0xce, 0x80, 0xbc, 0x0c, 0x8d, 0xbf, 0x85, 0xc5, 0xbe,
-	0x8e, 0xec, 0x0b, 0x13, 0xba, 0x51, 0x59, 0xaa, 0xac, 0x2c, 0xac, 0x75, 0x1c, 0xab, 0x38, 0xcd,
-	0xd1, 0x29, 0xcd, 0xa2, 0x5b, 0x3d, 0x19, 0xb7, 0x67, 0xbc, 0x7a, 0x3f, 0x9f, 0xd9, 0x46, 0xf5,
-	0x9f, 0x57, 0x6d, 0xd4, 0xf9, 0x1b, 0xc1, 0xa3, 0x7d, 0xc5, 0x04, 0x19, 0xd2, 0xe7, 0x6c, 0x40,
-	0x0f, 0xe2, 0xec, 0x52, 0x2a, 0x10, 0x87, 0x70, 0x5f, 0x9a, 0x43, 0x3f, 0x66, 0x03, 0xea, 0x07,
-	0x03, 0xad, 0x62, 0xae, 0xbb, 0x79, 0x3e, 0x6e, 0xd7, 0x73, 0xb8, 0xed, 0xcd, 0xd7, 0xe3, 0xb6,
-	0x7b, 0xbd, 0xac, 0x02, 0xc4, 0xab, 0xcb, 0xdc, 0x72, 0x80, 0x0f, 0xe0, 0x41, 0x12, 0x4f, 0x44,
-	0xa6, 0x09, 0xc8, 0xc6, 0xac, 0x16, 0xf9, 0x51, 0xb9, 0xc8, 0x62, 0xb6, 0x56, 0xe6, 0xfd, 0xa4,
-	0xb0, 0x2b, 0x3b, 0x27, 0xb3, 0xd0, 0xb8, 0x02, 0x22, 0xf1, 0xcf, 0x08, 0xe6, 0x05, 0xe5, 0x61,
-	0xd0, 0x27, 0xb2, 0x81, 0x34, 0xd9, 0x13, 0x27, 0xd7, 0x43, 0x57, 0x91, 0x49, 0xc7, 0xb3, 0xa8,
-	0xad, 0x58, 0x89, 0x51, 0xf7, 0xb3, 0x94, 0xfb, 0xf8, 0xec, 0xcd, 0x6b, 0x30, 0x61, 0xc7, 0xeb,
-	0x70, 0x47, 0x2a, 0xa2, 0x92, 0x54, 0x34, 0x5a, 0x59, 0x5c, 0x5b, 0xca, 0xf2, 0xc8, 0xda, 0x72,
-	0x9a, 0xcb, 0xbe, 0xbe, 0xe7, 0xd9, 0xfb, 0x4d, 0x02, 0xf5, 0x42, 0x36, 0xf8, 0x01, 0x54, 0x0e,
-	0xe9, 0xc8, 0xbc, 0x95, 0x97, 0x7e, 0xe2, 0x0d, 0x98, 0x3b, 0x22, 0x61, 0x42, 0x75, 0xec, 0x1b,
-	0x16, 0xd4, 0x33, 0x90, 0x8d, 0xd9, 0x75, 0x64, 0xbb, 0xe5, 0x14, 0xe0, 0x83, 0x5d, 0xeb, 0x0d,
-	0x6f, 0x62, 0xbd, 0x4d, 0x2a, 0xfb, 0x22, 0xe0, 0x8a, 0x09, 0xbc, 0x05, 0xf3, 0x99, 0x77, 0x34,
-	0xfb, 0xc2, 0xda, 0xf2, 0x25, 0x15, 0x59, 0x80, 0x29, 0x4c, 0xbf, 0x1c, 0xf2, 0x26, 0x50, 0xdc,
-	0x03, 0x98, 0xba, 0xcd, 0xa6, 0xfc, 0x79, 0xe1, 0x59, 0xfe, 0x2f, 0x8b, 0xa9, 0x9e, 0x4b, 0x14,
-	0xb5, 0x30, 0x3b, 0xc2, 0x3f, 0x40, 0x8d, 0x53, 0x2a, 0xa4, 0x1f, 0x11, 0xde, 0xa8, 0x68, 0x8a,
-	0xa7, 0x37, 0xa7, 0xd8, 0xa3, 0x54, 0x4c, 0x97, 0xbb, 0x84, 0xdb, 0xee, 0x9b, 0xd7, 0x31, 0x77,
-	0x09, 0xc7, 0x3f, 0x21, 0xa8, 0xd1, 0x78, 0xc0, 0x59, 0x10, 0x2b, 0xd9, 0xa8, 0xea, 0xd6, 0x5a,
-	0xbf, 0x39, 0xc1, 0x56, 0x06, 0x35, 0xfd, 0xf5, 0xc9, 0xf1, 0x59, 0x7b, 0xe5, 0xfa, 0xde, 0xb2,
-	0x4d, 0x35, 0x25, 0x6e, 0xfe, 0x59, 0x81, 0x77, 0x4b, 0xea, 0x81, 0xf7, 0xe0, 0x9e, 0x12, 0x41,
-	0xe4, 0xdf, 0x6a, 0x3a, 0x2d, 0xa4, 0x21, 0xec, 0x02, 0xef, 0x4d, 0x26, 0xd4, 0xcb, 0x20, 0x35,
-	0xf6, 0xc8, 0x9a, 0x77, 0xb9, 0xdc, 0x4f, 0x85, 0x39, 0x69, 0x9f, 0xc7, 0x8e, 0xa8, 0xaf, 0x0d,
-	0x1e, 0xff, 0x86, 0x4a, 0x26, 0x82, 0x19, 0x7b, 0xde, 0xad, 0xba, 0xc1, 0xf9, 0x8f, 0x99, 0x4d,
-	0x8d, 0x3f, 0x3d, 0x3e, 0x6b, 0xaf, 0x5e, 0x2f, 0x7e, 0x12, 0x6f, 0x7b, 0xf3, 0xd2, 0xb0, 0x69,
-	0x06, 0xf0, 0xb0, 0x2c, 0x76, 0x89, 0x23, 0x9f, 0x16, 0x1d, 0xf9, 0xf1, 0x8d, 0xa6, 0x4e, 0xce,
-	0x92, 0xcd, 0x6f, 0x60, 0xb1, 0xd8, 0x85, 0xf8, 0x11, 0x54, 0x12, 0x11, 0x6a, 0x92, 0x5a, 0xf7,
-	0xee, 0xf9, 0xb8, 0x5d, 0x39, 0xf0, 0x76, 0xbc, 0x74, 0x0f, 0x7f, 0x08, 0x10, 0x48, 0x3f, 0xa4,
-	0x44, 0xc4, 0x54, 0x68, 0xca, 0x79, 0xaf, 0x16, 0xc8, 0x1d, 0xb3, 0xd1, 0xfc, 0x63, 0x16, 0xde,
-	0xb9, 0xd4, 0xd2, 0xf8, 0x17, 0x04, 0x73, 0xba, 0x9f, 0xed, 0x64, 0xfc, 0xf2, 0x16, 0xfe, 0xd0,
-	0x3b, 0x6f, 0xd5, 0xcc, 0x26, 0x05, 0xbc, 0x0c, 0x75, 0xc2, 0x79, 0x18, 0xd0, 0x81, 0x1f, 0xc4,
-	0x03, 0xfa, 0xa3, 0x16, 0x51, 0xf5, 0xee, 0xd9, 0xcd, 0xed, 0x74, 0xaf, 0x29, 0x00, 0xa6, 0x3c,
-	0xf9, 0xa2, 0x57, 0x4d, 0xd1, 0x9f, 0x17, 0x8b, 0xbe, 0xfe, 0xb6, 0x82, 0xf2, 0xef, 0xf0, 0x0c,
-	0x16, 0x8b, 0x66, 0x2d, 0xe1, 0x7d, 0x98, 0xe7, 0xad, 0xe5, 0xd0, 0xdd, 0x67, 0x27, 0xe7, 0x2d,
-	0x74, 0x7a, 0xde, 0x42, 0xbf, 0x5e, 0xb4, 0x66, 0x5e, 0x5d, 0xb4, 0xd0, 0xe9, 0x45, 0x6b, 0xe6,
-	0xaf, 0x8b, 0xd6, 0xcc, 0xf7, 0x9d, 0x2b, 0x2b, 0x34, 0xf9, 0x1b, 0xea, 0xdd, 0xd1, 0xdf, 0x4f,
-	0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xad, 0x94, 0x9c, 0xd0, 0x22, 0x09, 0x00, 0x00,
+	// 1119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0xaf, 0x9b, 0xf4, 0x47, 0x5e, 0xda, 0x74, 0x3b, 0xbb, 0xfa, 0x7e, 0xb3, 0x11, 0xc4, 0x25,
+	0x05, 0xa9, 0x8b, 0xa8, 0x23, 0xba, 0x12, 0x5b, 0x75, 0x17, 0x0e, 0xd9, 0x96, 0x25, 0xa8, 0x5d,
+	0x2a, 0xb7, 0x45, 0x02, 0x09, 0xac, 0x89, 0x33, 0x75, 0xad, 0xda, 0x1e, 0x6b, 0x66, 0x52, 0xd1,
+	0x1b, 0xaa, 0x38, 0x20, 0x4e, 0xfb, 0x27, 0xec, 0x9f, 0xc0, 0x11, 0xce, 0x5c, 0x7a, 0xdc, 0x1b,
+	0x70, 0x69, 0x51, 0x7b, 0xe1, 0x6f, 0xd8, 0x13, 0xf2, 0x8c, 0x9d, 0xd8, 0xc4, 0xa5, 0xe9, 0xf6,
+	0x16, 0xcf, 0xbc, 0xf7, 0x79, 0x9f, 0xf7, 0xe6, 0xbd, 0xcf, 0x53, 0xe0, 0x41, 0xc8, 0xa8, 0xa0,
+	0x4d, 0x9f, 0x85, 0x9d, 0x26, 0xc3, 0xfb, 0xc2, 0xf2, 0x89, 0xc0, 0x5d, 0x2c, 0xb0, 0xc5, 0x48,
+	0x48, 0xb9, 0x2b, 0x28, 0x3b, 0x36, 0xa4, 0x0d, 0x2a, 0x1f, 0x61, 0xe6, 0x51, 0xc7, 0x88, 0x6c,
+	0x6b, 0xba, 0x43, 0xa9, 0xe3, 0x91, 0xa6, 0xbc, 0xea, 0xf4, 0xf6, 0x9b, 0xc2, 0xf5, 0x09, 0x17,
+	0xd8, 0x0f, 0x95, 0x75, 0x6d, 0xd9, 0x71, 0xc5, 0x41, 0xaf, 0x63, 0xd8, 0xd4, 0x6f, 0x3a, 0xd4,
+	0xa1, 0x03, 0xcb, 0xe8, 0x4b, 0x45, 0x8d, 0x7e, 0xc5, 0xe6, 0xff, 0x57, 0xe0, 0x61, 0xa7, 0x99,
+	0x10, 0x88, 0x2f, 0xea, 0x3c, 0x08, 0x3b, 0x4d, 0x8f, 0x3a, 0x16, 0x17, 0x8c, 0x60, 0x5f, 0xf2,
+	0x62, 0x82, 0x30, 0x75, 0xdf, 0xf8, 0x45, 0x83, 0xff, 0x6d, 0x52, 0x67, 0x47, 0x5e, 0x3e, 0xa5,
+	0xbe, 0xef, 0x0a, 0x93, 0xf0, 0x9e, 0x27, 0x38, 0x7a, 0x06, 0x53, 0x47, 0x84, 0x71, 0x97, 0x06,
+	0x55, 0x6d, 0x41, 0x5b, 0x2a, 0xb6, 0x96, 0x5f, 0x9f, 0xe9, 0x0f, 0x52, 0xbc, 0x0e, 0xf1, 0x21,
+	0xa6, 0x4d, 0x15, 0xb9, 0x19, 0x1e, 0x3a, 0x4d, 0x71, 0x1c, 0x12, 0x6e, 0x7c, 0xa9, 0x9c, 0xcc,
+	0xc4, 0x1b, 0x7d, 0x01, 0x15, 0x5b, 0x22, 0x5b, 0x4c, 0x41, 0x57, 0x0b, 0x0b, 0x85, 0xa5, 0xf2,
+	0x4a, 0xc3, 0x88, 0x4b, 0x12, 0x71, 0x34, 0x72, 0x59, 0xb4, 0x8a, 0xa7, 0x67, 0xfa, 0x98, 0x39,
+	0x6b, 0xa7, 0x99, 0xad, 0x15, 0xff, 0x7e, 0xa9, 0x6b, 0x8d, 0xbf, 0x34, 0xb8, 0xbf, 0x23, 0x28,
+	0xc3, 0x0e, 0x79, 0x4e, 0xbb, 0x64, 0x2f, 0x48, 0x8c, 0xa2, 0x04, 0x91, 0x07, 0x73, 0x5c, 0x5d,
+	0x5a, 0x01, 0xed, 0x12, 0xcb, 0xed, 0xca, 0x2c, 0x26, 0x5a, 0xeb, 0x17, 0x67, 0xfa, 0x6c, 0xca,
+	0xaf, 0xbd, 0xfe, 0xfa, 0x4c, 0x6f, 0x5e, 0x9f, 0x56, 0xc6, 0xc5, 0x9c, 0xe5, 0xa9, 0xcf, 0x2e,
+	0xda, 0x83, 0x3b, 0xbd, 0xa0, 0x9f, 0x64, 0x44, 0x80, 0x57, 0xc7, 0x65, 0x92, 0xef, 0xe6, 0x27,
+	0x99, 0x65, 0x1b, 0xa7, 0x39, 0xd7, 0xcb, 0x9c, 0xf2, 0xc6, 0xe9, 0x38, 0x54, 0xaf, 0x70, 0xe1,
+	0xe8, 0x47, 0x0d, 0xa6, 0x19, 0x09, 0x3d, 0xd7, 0xc6, 0xbc, 0xaa, 0xc9, 0x60, 0x0f, 0x8d, 0x54,
+	0x93, 0x5d, 0x15, 0x8c, 0x1b, 0x66, 0xec, 0xb5, 0x11, 0x08, 0x76, 0xdc, 0x7a, 0x14, 0xc5, 0x3e,
+	0x39, 0xbf, 0x79, 0x0d, 0xfa, 0xd1, 0xd1, 0x2a, 0x4c, 0x72, 0x81, 0x45, 0x2f, 0x4a, 0x5a, 0x5b,
+	0xaa, 0xac, 0x2c, 0x24, 0x3c, 0x92, 0xb6, 0x1c, 0x70, 0xd9, 0x91, 0x76, 0x66, 0x6c, 0x5f, 0xc3,
+	0x30, 0x9b, 0x61, 0x83, 0xee, 0x40, 0xe1, 0x90, 0x1c, 0xab, 0xb7, 0x32, 0xa3, 0x9f, 0x68, 0x0d,
+	0x26, 0x8e, 0xb0, 0xd7, 0x23, 0x12, 0x7b, 0xc4, 0x82, 0x9a, 0xca, 0x65, 0x6d, 0x7c, 0x55, 0x8b,
+	0xbb, 0xe5, 0x4f, 0x0d, 0xca, 0xbb, 0x0c, 0x07, 0x1c, 0xdb, 0x22, 0x6a, 0xca, 0x55, 0xa8, 0x88,
+	0xc1, 0x67, 0xd2, 0x1e, 0xc5, 0xd6, 0x7c, 0xd4, 0x1e, 0x29, 0xc3, 0xe8, 0xad, 0x53, 0x86, 0xed,
+	0x2e, 0x6a, 0xc3, 0x4c, 0x88, 0x99, 0x70, 0x6d, 0x37, 0xc4, 0x41, 0xff, 0x9d, 0xf5, 0xa1, 0x94,
+	0x77, 0x69, 0xe8, 0xda, 0x7d, 0x7e, 0xf1, 0x13, 0x67, 0x5c, 0xd1, 0x06, 0x94, 0x6d, 0x46, 0xb0,
+	0x20, 0x56, 0x34, 0xff, 0xd5, 0x82, 0x4c, 0xb0, 0x66, 0x28, 0x71, 0x30, 0x92, 0x91, 0x37, 0x76,
+	0x13, 0x71, 0x68, 0x4d, 0x47, 0x20, 0x2f, 0xce, 0x75, 0xcd, 0x04, 0xe5, 0x18, 0x5d, 0x35, 0x7e,
+	0x9b, 0x83, 0xb7, 0xb6, 0xe2, 0xb9, 0x37, 0xfb, 0xba, 0xb3, 0x4e, 0xb8, 0xcd, 0xdc, 0x50, 0x50,
+	0x86, 0x36, 0x60, 0x3a, 0xd1, 0x05, 0x99, 0x66, 0x79, 0x65, 0x71, 0x88, 0x6e, 0x02, 0x30, 0x70,
+	0x93, 0x94, 0x35, 0xb3, 0xef, 0x8a, 0x3a, 0x00, 0x03, 0x25, 0x89, 0x9f, 0xe3, 0xe3, 0x4c, 0xcb,
+	0xfd, 0x17, 0x8b, 0xc1, 0x5b, 0x0d, 0x85, 0x28, 0x79, 0xc9, 0x15, 0xfa, 0x16, 0x4a, 0x21, 0x21,
+	0x8c, 0x5b, 0x3e, 0x0e, 0xe3, 0x82, 0x3c, 0x1e, 0x3d, 0xc4, 0x36, 0x21, 0x6c, 0xf0, 0xb9, 0x85,
+	0xc3, 0xb8, 0xec, 0xd3, 0x12, 0x73, 0x0b, 0x87, 0xe8, 0x07, 0x0d, 0x4a, 0x24, 0xe8, 0x86, 0xd4,
+	0x8d, 0xde, 0xae, 0x28, 0xdf, 0x6e, 0x75, 0xf4, 0x00, 0x1b, 0x89, 0xab, 0x9a, 0x9d, 0x0f, 0x4e,
+	0xce, 0xf5, 0xa5, 0xeb, 0xe7, 0x26, 0x1e, 0x98, 0x41, 0x60, 0xb4, 0x0f, 0x73, 0x36, 0x0d, 0x78,
+	0xcf, 0x27, 0xcc, 0x72, 0x18, 0xed, 0x85, 0xbc, 0x3a, 0xb1, 0x50, 0xb8, 0x59, 0x3d, 0x9f, 0xc6,
+	0x00, 0xcf, 0xa4, 0xbf, 0x24, 0x64, 0x56, 0xec, 0xcc, 0x21, 0xb2, 0x60, 0x26, 0xd5, 0xbd, 0xbc,
+	0x3a, 0xb9, 0x50, 0xb8, 0x59, 0x45, 0x53, 0xa3, 0x10, 0x87, 0xc8, 0x00, 0xa2, 0xef, 0x35, 0x98,
+	0xe2, 0xf6, 0x01, 0xf1, 0x31, 0xaf, 0x4e, 0x49, 0xf0, 0x8f, 0x46, 0x07, 0xdf, 0x51, 0x8e, 0xaa,
+	0x96, 0xc6, 0xc9, 0xb9, 0xfe, 0xfe, 0x08, 0x1a, 0x24, 0x7d, 0xda, 0xeb, 0x66, 0x12, 0xb6, 0xf6,
+	0x7b, 0x01, 0xee, 0xe6, 0xf4, 0x16, 0xda, 0x8e, 0x72, 0x77, 0x7d, 0xeb, 0x56, 0x5b, 0xac, 0x1c,
+	0x41, 0xc4, 0x1f, 0x68, 0xbb, 0xbf, 0xc9, 0x0e, 0xdc, 0x68, 0x01, 0x1c, 0xc7, 0xc3, 0xbf, 0x98,
+	0xaf, 0xbb, 0x99, 0x7d, 0x1a, 0xb7, 0x7a, 0xbc, 0xca, 0x3e, 0x53, 0xfe, 0xe8, 0x67, 0x2d, 0x67,
+	0x73, 0xa8, 0xf5, 0x68, 0xde, 0x6a, 0xb2, 0x8c, 0x7f, 0x89, 0xbe, 0xaa, 0xf1, 0x87, 0x27, 0xe7,
+	0xfa, 0xf2, 0xf5, 0xc9, 0xf7, 0xf1, 0xda, 0xeb, 0x43, 0x4b, 0xa9, 0xe6, 0xc2, 0xbd, 0x3c, 0xec,
+	0x1c, 0xe5, 0x7e, 0x9c, 0x55, 0xee, 0xf7, 0x46, 0xda, 0x4e, 0x29, 0xe9, 0xae, 0x7d, 0x0e, 0x95,
+	0xec, 0x44, 0xa3, 0xfb, 0x50, 0xe8, 0x31, 0x4f, 0x06, 0x29, 0xb5, 0xa6, 0x2e, 0xce, 0xf4, 0xc2,
+	0x9e, 0xb9, 0x69, 0x46, 0x67, 0xe8, 0x6d, 0x00, 0x97, 0x5b, 0x1e, 0xc1, 0x2c, 0x20, 0x4c, 0x86,
+	0x9c, 0x36, 0x4b, 0x2e, 0xdf, 0x54, 0x07, 0xb5, 0x5f, 0xc7, 0x61, 0x7e, 0x48, 0x1e, 0xd0, 0x4f,
+	0x1a, 0x4c, 0x48, 0x6d, 0x88, 0x37, 0xe8, 0xa7, 0xb7, 0xd0, 0x1a, 0x79, 0xf2, 0x46, 0xc2, 0xa0,
+	0x28, 0xa0, 0x45, 0x98, 0xc5, 0x61, 0xe8, 0xb9, 0xa4, 0x6b, 0xb9, 0x41, 0x97, 0x7c, 0x27, 0x93,
+	0x28, 0x9a, 0x33, 0xf1, 0x61, 0x3b, 0x3a, 0xab, 0x31, 0x80, 0x41, 0x9c, 0x74, 0xd1, 0x8b, 0xaa,
+	0xe8, 0xcf, 0xb3, 0x45, 0x5f, 0x7d, 0xd3, 0x84, 0xd2, 0xef, 0xf0, 0x04, 0x2a, 0x59, 0xe1, 0xcb,
+	0x89, 0x7b, 0x2f, 0x1d, 0xb7, 0x94, 0xf6, 0x3e, 0x84, 0xbb, 0x39, 0x52, 0x95, 0x86, 0x28, 0x29,
+	0x88, 0x4f, 0xb2, 0xd4, 0x97, 0x86, 0x76, 0x54, 0x06, 0x26, 0x9f, 0xea, 0x57, 0x30, 0x3f, 0x24,
+	0x59, 0x39, 0x6c, 0x8d, 0x6c, 0xa8, 0x6a, 0xa6, 0x4a, 0x29, 0x80, 0x34, 0xf4, 0x37, 0x30, 0x93,
+	0x16, 0xac, 0x9c, 0x86, 0x7f, 0x94, 0x45, 0x7d, 0x67, 0x28, 0x01, 0xe5, 0x9f, 0xcb, 0xbc, 0xf5,
+	0xe4, 0xf4, 0xa2, 0xae, 0xbd, 0xba, 0xa8, 0x6b, 0x2f, 0x2e, 0xeb, 0x63, 0x2f, 0x2f, 0xeb, 0xda,
+	0xab, 0xcb, 0xfa, 0xd8, 0x1f, 0x97, 0xf5, 0xb1, 0xaf, 0x1b, 0x57, 0x36, 0x52, 0xff, 0xdf, 0x47,
+	0x67, 0x52, 0xfe, 0x7e, 0xf8, 0xcf, 0x00, 0x51, 0x2c, 0x12, 0xfd, 0x92, 0x0c, 0x00, 0x00,
 }
 
 func (this *LogStreamCommitResults) Equal(that interface{}) bool {
@@ -707,6 +827,56 @@ func (m *LogStreamUncommitReports) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TransactionID != 0 {
+		i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MetadataRepositoryDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -727,6 +897,80 @@ func (m *MetadataRepositoryDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Schemas) > 0 {
+		for k := range m.Schemas {
+			v := m.Schemas[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Transactions) > 0 {
+		for k := range m.Transactions {
+			v := m.Transactions[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConsumerGroups) > 0 {
+		for k := range m.ConsumerGroups {
+			v := m.ConsumerGroups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRaftMetadataRepository(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Endpoints) > 0 {
 		for k := range m.Endpoints {
 			v := m.Endpoints[k]
@@ -1007,6 +1251,26 @@ func (m *LogStreamUncommitReports) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Transaction) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionID != 0 {
+		n += 1 + sovRaftMetadataRepository(uint64(m.TransactionID))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.ProtoSize()
+			n += 1 + l + sovRaftMetadataRepository(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovRaftMetadataRepository(uint64(l))
+	return n
+}
+
 func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1031,6 +1295,45 @@ func (m *MetadataRepositoryDescriptor) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ConsumerGroups) > 0 {
+		for k, v := range m.ConsumerGroups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovRaftMetadataRepository(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRaftMetadataRepository(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Transactions) > 0 {
+		for k, v := range m.Transactions {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovRaftMetadataRepository(uint64(l))
+			}
+			mapEntrySize := 1 + sovRaftMetadataRepository(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Schemas) > 0 {
+		for k, v := range m.Schemas {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovRaftMetadataRepository(uint64(l))
+			}
+			mapEntrySize := 1 + sovRaftMetadataRepository(uint64(k)) + l
+			n += mapEntrySize + 1 + sovRaftMetadataRepository(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1502,7 +1805,7 @@ func (m *LogStreamUncommitReports) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
+func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,17 +1828,17 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataRepositoryDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataRepositoryDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
 			}
-			var msglen int
+			m.TransactionID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftMetadataRepository
@@ -1545,31 +1848,14 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TransactionID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftMetadataRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftMetadataRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &varlogpb.MetadataDescriptor{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1596,16 +1882,14 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LogStream == nil {
-				m.LogStream = &MetadataRepositoryDescriptor_LogStreamDescriptor{}
-			}
-			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Participants = append(m.Participants, varlogpb.TopicLogStream{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeersMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1632,35 +1916,190 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PeersMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftMetadataRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRaftMetadataRepository
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRaftMetadataRepository
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftMetadataRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataRepositoryDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataRepositoryDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &varlogpb.MetadataDescriptor{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LogStream == nil {
+				m.LogStream = &MetadataRepositoryDescriptor_LogStreamDescriptor{}
+			}
+			if err := m.LogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeersMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeersMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -1749,6 +2188,365 @@ func (m *MetadataRepositoryDescriptor) Unmarshal(dAtA []byte) error {
 			}
 			m.Endpoints[github_com_kakao_varlog_pkg_types.NodeID(mapkey)] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerGroups == nil {
+				m.ConsumerGroups = make(map[string]*varlogpb.ConsumerGroupDescriptor)
+			}
+			var mapkey string
+			var mapvalue *varlogpb.ConsumerGroupDescriptor
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &varlogpb.ConsumerGroupDescriptor{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConsumerGroups[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transactions == nil {
+				m.Transactions = make(map[uint64]*Transaction)
+			}
+			var mapkey uint64
+			var mapvalue *Transaction
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Transaction{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Transactions[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftMetadataRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRaftMetadataRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schemas == nil {
+				m.Schemas = make(map[github_com_kakao_varlog_pkg_types.SchemaID]*varlogpb.SchemaDescriptor)
+			}
+			var mapkey int32
+			var mapvalue *varlogpb.SchemaDescriptor
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftMetadataRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftMetadataRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &varlogpb.SchemaDescriptor{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRaftMetadataRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Schemas[github_com_kakao_varlog_pkg_types.SchemaID(mapkey)] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftMetadataRepository(dAtA[iNdEx:])
@@ -18,3 +18,47 @@ func ValidateTopicLogStream(iface interface {
 	}
 	return nil
 }
+
+// ValidateAttributes checks whether the attributes of the AppendRequest match
+// its payload. The attributes can be empty; otherwise, there must be an
+// attribute for each payload. It also rejects compression codecs unknown to
+// the storage node so that clients do not store payloads that no one can
+// decompress.
+func (m *AppendRequest) ValidateAttributes() error {
+	if len(m.Attributes) > 0 && len(m.Attributes) != len(m.Payload) {
+		return fmt.Errorf("unmatched attributes: %d payloads, %d attributes", len(m.Payload), len(m.Attributes))
+	}
+	for i := range m.Attributes {
+		if codec := m.Attributes[i].CompressionCodec; !codec.Valid() {
+			return fmt.Errorf("unknown compression codec %d", codec)
+		}
+	}
+	return nil
+}
+
+// ValidatePosition checks whether exactly one of the GLSN and the LLSN of the
+// ReadRequest is set.
+func (m *ReadRequest) ValidatePosition() error {
+	if m.GLSN.Invalid() == m.LLSN.Invalid() {
+		return fmt.Errorf("either glsn or llsn should be set: glsn %d, llsn %d", m.GLSN, m.LLSN)
+	}
+	return nil
+}
+
+// ValidateRange checks whether exactly one of the GLSN range and the LLSN
+// range of the ReadRangeRequest is set, and the range is not empty.
+func (m *ReadRangeRequest) ValidateRange() error {
+	withGLSN := !m.GLSNBegin.Invalid() || !m.GLSNEnd.Invalid()
+	withLLSN := !m.LLSNBegin.Invalid() || !m.LLSNEnd.Invalid()
+	switch {
+	case withGLSN == withLLSN:
+		return fmt.Errorf("either glsn range or llsn range should be set: glsn [%d, %d), llsn [%d, %d)", m.GLSNBegin, m.GLSNEnd, m.LLSNBegin, m.LLSNEnd)
+	case withGLSN && m.GLSNBegin >= m.GLSNEnd:
+		return fmt.Errorf("invalid glsn range [%d, %d)", m.GLSNBegin, m.GLSNEnd)
+	case withLLSN && m.LLSNBegin >= m.LLSNEnd:
+		return fmt.Errorf("invalid llsn range [%d, %d)", m.LLSNBegin, m.LLSNEnd)
+	case m.MaxEntries < 0:
+		return fmt.Errorf("invalid max entries %d", m.MaxEntries)
+	}
+	return nil
+}
@@ -9,13 +9,16 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 	varlogpb "github.com/kakao/varlog/proto/varlogpb"
@@ -25,6 +28,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,12 +36,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StartPosition is a symbolic position from which a subscription starts.
+type StartPosition int32
+
+const (
+	// StartPositionUnspecified means that the subscription starts from the
+	// position given explicitly.
+	StartPositionUnspecified StartPosition = 0
+	// StartPositionEarliest means the first log entry that is not trimmed.
+	StartPositionEarliest StartPosition = 1
+	// StartPositionLatest means the first log entry committed after the global
+	// high watermark, that is, the subscription receives only new log entries.
+	StartPositionLatest StartPosition = 2
+)
+
+var StartPosition_name = map[int32]string{
+	0: "START_POSITION_UNSPECIFIED",
+	1: "START_POSITION_EARLIEST",
+	2: "START_POSITION_LATEST",
+}
+
+var StartPosition_value = map[string]int32{
+	"START_POSITION_UNSPECIFIED": 0,
+	"START_POSITION_EARLIEST":    1,
+	"START_POSITION_LATEST":      2,
+}
+
+func (x StartPosition) String() string {
+	return proto.EnumName(StartPosition_name, int32(x))
+}
+
+func (StartPosition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{0}
+}
+
 // AppendRequest is a message to send a payload to a storage node. It contains
 // a vector of storage nodes to replicate the payload.
 type AppendRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	Payload     [][]byte                                      `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty"`
+	// Attributes are optional attributes of each log entry in the payload. If
+	// it is not empty, its length must be the same as the payload.
+	Attributes []varlogpb.LogEntryAttributes `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes"`
+	// ProducerID identifies an idempotent producer. Zero means that the append
+	// is not idempotent.
+	ProducerID uint64 `protobuf:"varint,5,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	// Sequence is the sequence number of the first log entry in the payload.
+	// Log entries in the payload have consecutive sequence numbers. The log
+	// stream rejects the append with a duplicate sequence if the producer has
+	// already appended it. It is meaningful only if the producer_id is not
+	// zero.
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// TransactionID identifies the transaction that the payload belongs to.
+	// Zero means that the append is not transactional. The log stream stages
+	// the payload, and the metadata repository commits it together with the
+	// payloads of the transaction on other log streams. A log stream stages
+	// only one payload per transaction and one transaction at a time.
+	TransactionID uint64 `protobuf:"varint,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (m *AppendRequest) Reset()         { *m = AppendRequest{} }
@@ -94,6 +150,34 @@ func (m *AppendRequest) GetPayload() [][]byte {
 	return nil
 }
 
+func (m *AppendRequest) GetAttributes() []varlogpb.LogEntryAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *AppendRequest) GetProducerID() uint64 {
+	if m != nil {
+		return m.ProducerID
+	}
+	return 0
+}
+
+func (m *AppendRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *AppendRequest) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
 type AppendResult struct {
 	Meta  varlogpb.LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta"`
 	Error string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -191,11 +275,13 @@ func (m *AppendResponse) GetResults() []AppendResult {
 	return nil
 }
 
-// ReadRequest asks a storage node to retrieve log entry at the GLSN.
+// ReadRequest asks a storage node to retrieve a log entry at either the GLSN
+// or the LLSN. Exactly one of them should be set.
 type ReadRequest struct {
 	GLSN        github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	LLSN        github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 }
 
 func (m *ReadRequest) Reset()         { *m = ReadRequest{} }
@@ -252,12 +338,16 @@ func (m *ReadRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStre
 	return 0
 }
 
-// ReadResponse contains the contents of the log entry which is retrieved by
-// the ReadRequest.
+func (m *ReadRequest) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
+// ReadResponse contains the log entry which is retrieved by the ReadRequest.
 type ReadResponse struct {
-	GLSN    github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
-	LLSN    github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
-	Payload []byte                                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	LogEntry varlogpb.LogEntry `protobuf:"bytes,4,opt,name=log_entry,json=logEntry,proto3" json:"log_entry"`
 }
 
 func (m *ReadResponse) Reset()         { *m = ReadResponse{} }
@@ -293,23 +383,155 @@ func (m *ReadResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReadResponse proto.InternalMessageInfo
 
-func (m *ReadResponse) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+func (m *ReadResponse) GetLogEntry() varlogpb.LogEntry {
 	if m != nil {
-		return m.GLSN
+		return m.LogEntry
+	}
+	return varlogpb.LogEntry{}
+}
+
+// ReadRangeRequest asks a storage node to retrieve committed log entries in
+// the range of either GLSNs [glsn_begin, glsn_end) or LLSNs [llsn_begin,
+// llsn_end). Exactly one of the ranges should be set.
+type ReadRangeRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	GLSNBegin   github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,3,opt,name=glsn_begin,json=glsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_begin,omitempty"`
+	GLSNEnd     github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,4,opt,name=glsn_end,json=glsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_end,omitempty"`
+	LLSNBegin   github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,5,opt,name=llsn_begin,json=llsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_begin,omitempty"`
+	LLSNEnd     github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,6,opt,name=llsn_end,json=llsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_end,omitempty"`
+	// MaxEntries is the maximum number of log entries in the response. If it
+	// is zero or greater than the limit of the storage node, the storage node
+	// uses its limit.
+	MaxEntries int32 `protobuf:"varint,7,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+}
+
+func (m *ReadRangeRequest) Reset()         { *m = ReadRangeRequest{} }
+func (m *ReadRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadRangeRequest) ProtoMessage()    {}
+func (*ReadRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{5}
+}
+func (m *ReadRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadRangeRequest.Merge(m, src)
+}
+func (m *ReadRangeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ReadRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadRangeRequest proto.InternalMessageInfo
+
+func (m *ReadRangeRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
 	}
 	return 0
 }
 
-func (m *ReadResponse) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+func (m *ReadRangeRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
 	if m != nil {
-		return m.LLSN
+		return m.LogStreamID
 	}
 	return 0
 }
 
-func (m *ReadResponse) GetPayload() []byte {
+func (m *ReadRangeRequest) GetGLSNBegin() github_com_kakao_varlog_pkg_types.GLSN {
 	if m != nil {
-		return m.Payload
+		return m.GLSNBegin
+	}
+	return 0
+}
+
+func (m *ReadRangeRequest) GetGLSNEnd() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSNEnd
+	}
+	return 0
+}
+
+func (m *ReadRangeRequest) GetLLSNBegin() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSNBegin
+	}
+	return 0
+}
+
+func (m *ReadRangeRequest) GetLLSNEnd() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSNEnd
+	}
+	return 0
+}
+
+func (m *ReadRangeRequest) GetMaxEntries() int32 {
+	if m != nil {
+		return m.MaxEntries
+	}
+	return 0
+}
+
+// ReadRangeResponse contains log entries retrieved by the ReadRangeRequest.
+// The log entries are sorted by their positions. The response may have fewer
+// log entries than the range if the number of log entries exceeds the limit or
+// the rest of the range has not been committed yet.
+type ReadRangeResponse struct {
+	LogEntries []varlogpb.LogEntry `protobuf:"bytes,1,rep,name=log_entries,json=logEntries,proto3" json:"log_entries"`
+}
+
+func (m *ReadRangeResponse) Reset()         { *m = ReadRangeResponse{} }
+func (m *ReadRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadRangeResponse) ProtoMessage()    {}
+func (*ReadRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{6}
+}
+func (m *ReadRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadRangeResponse.Merge(m, src)
+}
+func (m *ReadRangeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ReadRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadRangeResponse proto.InternalMessageInfo
+
+func (m *ReadRangeResponse) GetLogEntries() []varlogpb.LogEntry {
+	if m != nil {
+		return m.LogEntries
 	}
 	return nil
 }
@@ -321,13 +543,18 @@ type SubscribeRequest struct {
 	GLSNEnd     github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,2,opt,name=glsn_end,json=glsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn_end,omitempty"`
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,3,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,4,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	// Filter selects log entries to be delivered. The storage node sends a
+	// response marked as filtered, without the payload and attributes, for each
+	// log entry that does not match the filter. It delivers all log entries if
+	// the filter is nil.
+	Filter *varlogpb.LogEntryFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()    {}
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{5}
+	return fileDescriptor_7692726f23e518ee, []int{7}
 }
 func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,18 +611,32 @@ func (m *SubscribeRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.Lo
 	return 0
 }
 
+func (m *SubscribeRequest) GetFilter() *varlogpb.LogEntryFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 // SubscribeResponse comprises the contents of the log entry and its GLSN.
 type SubscribeResponse struct {
 	GLSN    github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
 	LLSN    github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Payload []byte                                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Attributes are the attributes of the log entry. It is nil if the log entry
+	// has no attributes.
+	Attributes *varlogpb.LogEntryAttributes `protobuf:"bytes,4,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// Filtered is true if the log entry does not match the filter of the
+	// request. Such a response has neither payload nor attributes; it only
+	// advances the position of the subscriber.
+	Filtered bool `protobuf:"varint,5,opt,name=filtered,proto3" json:"filtered,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()    {}
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{6}
+	return fileDescriptor_7692726f23e518ee, []int{8}
 }
 func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,18 +686,39 @@ func (m *SubscribeResponse) GetPayload() []byte {
 	return nil
 }
 
+func (m *SubscribeResponse) GetAttributes() *varlogpb.LogEntryAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *SubscribeResponse) GetFiltered() bool {
+	if m != nil {
+		return m.Filtered
+	}
+	return false
+}
+
 type SubscribeToRequest struct {
 	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	LLSNBegin   github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,3,opt,name=llsn_begin,json=llsnBegin,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_begin,omitempty"`
 	LLSNEnd     github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn_end,json=llsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn_end,omitempty"`
+	// Filter is the same as that of SubscribeRequest.
+	Filter *varlogpb.LogEntryFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// StartPosition makes the storage node decide the first LLSN to subscribe
+	// to instead of llsn_begin. The storage node resolves it against its commit
+	// context. The field llsn_begin is ignored unless it is
+	// StartPositionUnspecified.
+	StartPosition StartPosition `protobuf:"varint,6,opt,name=start_position,json=startPosition,proto3,enum=varlog.snpb.StartPosition" json:"start_position,omitempty"`
 }
 
 func (m *SubscribeToRequest) Reset()         { *m = SubscribeToRequest{} }
 func (m *SubscribeToRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToRequest) ProtoMessage()    {}
 func (*SubscribeToRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{7}
+	return fileDescriptor_7692726f23e518ee, []int{9}
 }
 func (m *SubscribeToRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,15 +775,32 @@ func (m *SubscribeToRequest) GetLLSNEnd() github_com_kakao_varlog_pkg_types.LLSN
 	return 0
 }
 
+func (m *SubscribeToRequest) GetFilter() *varlogpb.LogEntryFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *SubscribeToRequest) GetStartPosition() StartPosition {
+	if m != nil {
+		return m.StartPosition
+	}
+	return StartPositionUnspecified
+}
+
 type SubscribeToResponse struct {
 	LogEntry varlogpb.LogEntry `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry"`
+	// Filtered is true if the log entry does not match the filter of the
+	// request. Such a log entry has only its metadata.
+	Filtered bool `protobuf:"varint,2,opt,name=filtered,proto3" json:"filtered,omitempty"`
 }
 
 func (m *SubscribeToResponse) Reset()         { *m = SubscribeToResponse{} }
 func (m *SubscribeToResponse) String() string { return proto.CompactTextString(m) }
 func (*SubscribeToResponse) ProtoMessage()    {}
 func (*SubscribeToResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{8}
+	return fileDescriptor_7692726f23e518ee, []int{10}
 }
 func (m *SubscribeToResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,6 +836,13 @@ func (m *SubscribeToResponse) GetLogEntry() varlogpb.LogEntry {
 	return varlogpb.LogEntry{}
 }
 
+func (m *SubscribeToResponse) GetFiltered() bool {
+	if m != nil {
+		return m.Filtered
+	}
+	return false
+}
+
 // TrimRequest contains inclusive GLSN until which a client wants to delete.
 // If async field is true, the trim operation returns immediately and the
 // storage node removes its log entry in the background.
@@ -569,7 +855,7 @@ func (m *TrimDeprecatedRequest) Reset()         { *m = TrimDeprecatedRequest{} }
 func (m *TrimDeprecatedRequest) String() string { return proto.CompactTextString(m) }
 func (*TrimDeprecatedRequest) ProtoMessage()    {}
 func (*TrimDeprecatedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{9}
+	return fileDescriptor_7692726f23e518ee, []int{11}
 }
 func (m *TrimDeprecatedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +907,7 @@ func (m *LogStreamMetadataRequest) Reset()         { *m = LogStreamMetadataReque
 func (m *LogStreamMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*LogStreamMetadataRequest) ProtoMessage()    {}
 func (*LogStreamMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{10}
+	return fileDescriptor_7692726f23e518ee, []int{12}
 }
 func (m *LogStreamMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -672,7 +958,7 @@ func (m *LogStreamMetadataResponse) Reset()         { *m = LogStreamMetadataResp
 func (m *LogStreamMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*LogStreamMetadataResponse) ProtoMessage()    {}
 func (*LogStreamMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{11}
+	return fileDescriptor_7692726f23e518ee, []int{13}
 }
 func (m *LogStreamMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +1003,7 @@ func (m *LogStreamReplicaMetadataRequest) Reset()         { *m = LogStreamReplic
 func (m *LogStreamReplicaMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplicaMetadataRequest) ProtoMessage()    {}
 func (*LogStreamReplicaMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{12}
+	return fileDescriptor_7692726f23e518ee, []int{14}
 }
 func (m *LogStreamReplicaMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -768,7 +1054,7 @@ func (m *LogStreamReplicaMetadataResponse) Reset()         { *m = LogStreamRepli
 func (m *LogStreamReplicaMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplicaMetadataResponse) ProtoMessage()    {}
 func (*LogStreamReplicaMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7692726f23e518ee, []int{13}
+	return fileDescriptor_7692726f23e518ee, []int{15}
 }
 func (m *LogStreamReplicaMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -804,147 +1090,439 @@ func (m *LogStreamReplicaMetadataResponse) GetLogStreamReplica() LogStreamReplic
 	return LogStreamReplicaMetadataDescriptor{}
 }
 
-func init() {
-	proto.RegisterType((*AppendRequest)(nil), "varlog.snpb.AppendRequest")
-	proto.RegisterType((*AppendResult)(nil), "varlog.snpb.AppendResult")
-	proto.RegisterType((*AppendResponse)(nil), "varlog.snpb.AppendResponse")
-	proto.RegisterType((*ReadRequest)(nil), "varlog.snpb.ReadRequest")
-	proto.RegisterType((*ReadResponse)(nil), "varlog.snpb.ReadResponse")
-	proto.RegisterType((*SubscribeRequest)(nil), "varlog.snpb.SubscribeRequest")
-	proto.RegisterType((*SubscribeResponse)(nil), "varlog.snpb.SubscribeResponse")
-	proto.RegisterType((*SubscribeToRequest)(nil), "varlog.snpb.SubscribeToRequest")
-	proto.RegisterType((*SubscribeToResponse)(nil), "varlog.snpb.SubscribeToResponse")
-	proto.RegisterType((*TrimDeprecatedRequest)(nil), "varlog.snpb.TrimDeprecatedRequest")
-	proto.RegisterType((*LogStreamMetadataRequest)(nil), "varlog.snpb.LogStreamMetadataRequest")
-	proto.RegisterType((*LogStreamMetadataResponse)(nil), "varlog.snpb.LogStreamMetadataResponse")
-	proto.RegisterType((*LogStreamReplicaMetadataRequest)(nil), "varlog.snpb.LogStreamReplicaMetadataRequest")
-	proto.RegisterType((*LogStreamReplicaMetadataResponse)(nil), "varlog.snpb.LogStreamReplicaMetadataResponse")
+type AbortTransactionRequest struct {
+	TopicID       github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID   github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	TransactionID uint64                                        `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("proto/snpb/log_io.proto", fileDescriptor_7692726f23e518ee) }
-
-var fileDescriptor_7692726f23e518ee = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0x24, 0xde, 0x66, 0xf3, 0xb2, 0xad, 0xca, 0x2c, 0xa5, 0x59, 0x57, 0x8d, 0x23, 0x0b,
-	0xa1, 0x45, 0x62, 0xed, 0x6a, 0x11, 0x2a, 0x48, 0x45, 0x82, 0x68, 0xb7, 0xd5, 0x8a, 0x74, 0x41,
-	0x4e, 0xd4, 0x03, 0x12, 0xac, 0xec, 0x78, 0x30, 0xd6, 0x4e, 0x3c, 0xc6, 0x76, 0x90, 0x22, 0x2e,
-	0xdc, 0xb8, 0xf6, 0x27, 0xf0, 0x23, 0x38, 0x70, 0xe1, 0xde, 0x63, 0x2f, 0x48, 0x1c, 0x50, 0x0e,
-	0x09, 0xff, 0x01, 0xd1, 0x13, 0x9a, 0xf1, 0xd8, 0xb1, 0xb7, 0x89, 0xba, 0x11, 0xe4, 0xd0, 0xbd,
-	0x79, 0x66, 0xde, 0xfb, 0xe6, 0xbd, 0xef, 0x7d, 0x7a, 0xf3, 0x0c, 0xb7, 0xc3, 0x88, 0x25, 0xcc,
-	0x8c, 0x83, 0xd0, 0x31, 0x29, 0xf3, 0xce, 0x7c, 0x66, 0x88, 0x1d, 0xdc, 0xfc, 0xde, 0x8e, 0x28,
-	0xf3, 0x0c, 0x7e, 0xa2, 0x1e, 0x78, 0x7e, 0xf2, 0xed, 0xd8, 0x31, 0x86, 0x6c, 0x64, 0x7a, 0xcc,
-	0x63, 0xa6, 0xb0, 0x71, 0xc6, 0xdf, 0x88, 0x55, 0x0a, 0xc1, 0xbf, 0x52, 0x5f, 0xf5, 0x8e, 0xc7,
-	0x98, 0x47, 0xc9, 0xc2, 0x8a, 0x8c, 0xc2, 0x64, 0x22, 0x0f, 0x6f, 0xa7, 0xc0, 0xa1, 0x63, 0x8e,
-	0x48, 0x62, 0xbb, 0x76, 0x62, 0xcb, 0x83, 0x5d, 0x11, 0x44, 0x79, 0x53, 0xff, 0x0b, 0xc1, 0xf5,
-	0x4f, 0xc3, 0x90, 0x04, 0xae, 0x45, 0xbe, 0x1b, 0x93, 0x38, 0xc1, 0x7d, 0xd8, 0x4e, 0x58, 0xe8,
-	0x0f, 0xcf, 0x7c, 0xb7, 0x85, 0x3a, 0x68, 0x7f, 0xab, 0xfb, 0xe1, 0x6c, 0xaa, 0xd5, 0x07, 0x7c,
-	0xef, 0xe4, 0xe8, 0xc5, 0x54, 0x7b, 0xb7, 0x10, 0xec, 0xb9, 0x7d, 0x6e, 0x33, 0x33, 0xbd, 0xd1,
-	0x0c, 0xcf, 0x3d, 0x33, 0x99, 0x84, 0x24, 0x36, 0xa4, 0xb1, 0x55, 0x17, 0x48, 0x27, 0x2e, 0x76,
-	0xe1, 0x3a, 0xcf, 0x3e, 0x4e, 0x22, 0x62, 0x8f, 0x38, 0x72, 0x55, 0x20, 0x7f, 0x32, 0x9b, 0x6a,
-	0xcd, 0x1e, 0xf3, 0xfa, 0x62, 0x5f, 0xa0, 0x1f, 0xbc, 0x1a, 0xbd, 0xe0, 0x60, 0x35, 0x69, 0xbe,
-	0x70, 0x71, 0x0b, 0xea, 0xa1, 0x3d, 0xa1, 0xcc, 0x76, 0x5b, 0xb5, 0x4e, 0x6d, 0x7f, 0xc7, 0xca,
-	0x96, 0xfa, 0x57, 0xb0, 0x93, 0x65, 0x19, 0x8f, 0x69, 0x82, 0xef, 0x83, 0xc2, 0x89, 0x10, 0x09,
-	0x36, 0x0f, 0xef, 0x1a, 0xb2, 0x18, 0x19, 0x75, 0xfc, 0x8a, 0xe3, 0x20, 0x89, 0x26, 0x8f, 0x49,
-	0x62, 0x77, 0x95, 0x67, 0x53, 0xad, 0x62, 0x09, 0x07, 0xfc, 0x26, 0x6c, 0x91, 0x28, 0x62, 0x91,
-	0x48, 0xa0, 0x61, 0xa5, 0x0b, 0xfd, 0x33, 0xb8, 0x91, 0xc3, 0x87, 0x2c, 0x88, 0x09, 0xfe, 0x08,
-	0xea, 0x91, 0xb8, 0x2a, 0x6e, 0xa1, 0x4e, 0x6d, 0xbf, 0x79, 0xb8, 0x67, 0x14, 0x0a, 0x6e, 0x14,
-	0x83, 0x91, 0xf8, 0x99, 0xbd, 0xfe, 0xb4, 0x0a, 0x4d, 0x8b, 0xd8, 0x79, 0x41, 0x1e, 0x82, 0xe2,
-	0xd1, 0x38, 0x10, 0xb1, 0x2a, 0xdd, 0xc3, 0xd9, 0x54, 0x53, 0x1e, 0xf5, 0xfa, 0xa7, 0x2f, 0xa6,
-	0xda, 0x3b, 0xaf, 0xe6, 0x8a, 0x5b, 0x5a, 0xc2, 0xbf, 0x54, 0xd8, 0xea, 0xc6, 0x0a, 0x5b, 0xdb,
-	0x40, 0x61, 0xf5, 0x5f, 0x11, 0xec, 0xa4, 0x94, 0x48, 0x7a, 0xff, 0x2f, 0x4e, 0x1e, 0x82, 0x42,
-	0x39, 0x4e, 0x75, 0x81, 0xd3, 0xbb, 0x34, 0x4e, 0x4f, 0xe0, 0x70, 0xff, 0xb2, 0xf2, 0x50, 0x51,
-	0x79, 0x7f, 0x57, 0xe1, 0x66, 0x7f, 0xec, 0xc4, 0xc3, 0xc8, 0x77, 0x48, 0x56, 0xd2, 0x27, 0x00,
-	0xfc, 0xfa, 0x33, 0x87, 0x78, 0x7e, 0x96, 0xc4, 0xfd, 0xd9, 0x54, 0x6b, 0xf0, 0xd0, 0xba, 0x7c,
-	0x73, 0x8d, 0x4c, 0x1a, 0x1c, 0x4a, 0x38, 0xe1, 0x2f, 0x60, 0x5b, 0xe0, 0x92, 0xc0, 0x95, 0x29,
-	0x7d, 0xc0, 0x4b, 0xcc, 0xcd, 0x8e, 0x03, 0x77, 0x0d, 0xcc, 0x3a, 0x87, 0x39, 0x0e, 0xdc, 0x92,
-	0x68, 0x6a, 0x1b, 0x13, 0x8d, 0xb2, 0x09, 0xd1, 0xfc, 0x86, 0xe0, 0x8d, 0x02, 0xf3, 0xaf, 0x9d,
-	0x72, 0xfe, 0xa9, 0x02, 0xce, 0xe3, 0x1f, 0xb0, 0x2b, 0xd0, 0x9f, 0x9f, 0x00, 0xd0, 0x85, 0xec,
-	0x6b, 0x0b, 0xd9, 0xf7, 0xd6, 0x93, 0xbd, 0xa0, 0xaf, 0x41, 0x8b, 0xb2, 0xa7, 0x99, 0xec, 0x95,
-	0x85, 0xec, 0x7b, 0xeb, 0xc8, 0x5e, 0x60, 0xd6, 0x69, 0x2a, 0x7b, 0xbd, 0x0f, 0xbb, 0x25, 0xea,
-	0xa5, 0x78, 0x1e, 0x40, 0x83, 0xd3, 0x44, 0xf8, 0xd3, 0x20, 0xdf, 0x8e, 0xbd, 0x95, 0x6f, 0x87,
-	0xec, 0xeb, 0xdb, 0x54, 0xae, 0xf5, 0x5f, 0x10, 0xdc, 0x1a, 0x44, 0xfe, 0xe8, 0x88, 0x84, 0x11,
-	0x19, 0xda, 0x09, 0xd9, 0xec, 0x9b, 0x9b, 0x29, 0xbd, 0xfa, 0xdf, 0x94, 0xae, 0xff, 0x8e, 0xa0,
-	0x95, 0x97, 0xf4, 0xb1, 0x1c, 0x1f, 0x5e, 0x7f, 0x35, 0xea, 0x3f, 0xc0, 0xde, 0x92, 0xb4, 0x64,
-	0xa5, 0xbf, 0x86, 0x5b, 0x85, 0x10, 0x5c, 0xc2, 0xa5, 0x10, 0x26, 0x2c, 0x92, 0x55, 0x7f, 0x7b,
-	0x59, 0xd5, 0x53, 0xa8, 0xa3, 0xdc, 0x56, 0x0a, 0x60, 0x97, 0xbe, 0x7c, 0xa4, 0xff, 0x89, 0x40,
-	0xcb, 0x5d, 0x2c, 0x12, 0x52, 0x7f, 0x68, 0x5f, 0x21, 0x6e, 0x7f, 0x42, 0xd0, 0x59, 0x9d, 0x9e,
-	0xe4, 0x78, 0x08, 0xb8, 0x10, 0x4a, 0x94, 0x5a, 0x49, 0x82, 0xcd, 0xd2, 0xb8, 0xb4, 0x0a, 0xea,
-	0x25, 0xae, 0x6f, 0xd2, 0x0b, 0x96, 0x87, 0x3f, 0x2a, 0xb0, 0xd5, 0x63, 0xde, 0xc9, 0xe7, 0xf8,
-	0x11, 0x5c, 0x4b, 0xc7, 0x2e, 0xac, 0x2e, 0x9d, 0xc5, 0x04, 0xe9, 0xea, 0x9d, 0xe5, 0x73, 0x9a,
-	0x88, 0x58, 0xaf, 0xec, 0xa3, 0x7b, 0x08, 0x7f, 0x0c, 0x0a, 0x1f, 0x46, 0x70, 0xab, 0x64, 0x5a,
-	0x18, 0xd9, 0xd4, 0xbd, 0x25, 0x27, 0x19, 0x04, 0x3e, 0x85, 0x46, 0xde, 0x5b, 0xf0, 0xdd, 0x92,
-	0xe5, 0xc5, 0x41, 0x41, 0x6d, 0xaf, 0x3a, 0xce, 0xd0, 0xee, 0x21, 0x3c, 0x80, 0x66, 0xa1, 0x57,
-	0x61, 0x6d, 0xb9, 0x4b, 0xfe, 0x80, 0xa8, 0x9d, 0xd5, 0x06, 0x05, 0xd4, 0x53, 0xb8, 0x51, 0xee,
-	0x55, 0x58, 0x2f, 0xf9, 0x2d, 0x6d, 0x64, 0xea, 0x5b, 0x46, 0xfa, 0x6b, 0x62, 0x64, 0xbf, 0x26,
-	0xc6, 0x31, 0xff, 0x35, 0xd1, 0x2b, 0x78, 0x52, 0x68, 0x22, 0x17, 0xaa, 0x88, 0xdf, 0xbb, 0x54,
-	0xb1, 0xb3, 0x3b, 0x0e, 0x2e, 0x69, 0x9d, 0x25, 0xd3, 0x7d, 0xf0, 0x6c, 0xd6, 0x46, 0xcf, 0x67,
-	0x6d, 0xf4, 0x74, 0xde, 0xae, 0xfc, 0x3c, 0x6f, 0xa3, 0xe7, 0xf3, 0x76, 0xe5, 0x8f, 0x79, 0xbb,
-	0xf2, 0xa5, 0xbe, 0x52, 0xe2, 0xf9, 0x5f, 0x9b, 0x73, 0x4d, 0x7c, 0xbf, 0xff, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x09, 0x66, 0x9b, 0x7b, 0xca, 0x0d, 0x00, 0x00,
+func (m *AbortTransactionRequest) Reset()         { *m = AbortTransactionRequest{} }
+func (m *AbortTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortTransactionRequest) ProtoMessage()    {}
+func (*AbortTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{16}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// LogIOClient is the client API for LogIO service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LogIOClient interface {
-	// Append stores a list of log entries to the end of the log stream
-	// specified by AppendRequest. The log entries are appended partially; that
-	// is, some of the log entries could not be stored due to failures.
-	//
-	// It returns the following gRPC errors:
-	// - InvalidArgument: AppendRequest has invalid fields; for instance, TopicID
-	// is invalid.
-	// - NotFound: The log stream replica specified by the AppendRequest does not
-	// exist in the storage node. Note that it does not mean that the log stream
-	// does not exist in the cluster.
-	// - FailedPrecondition: The log stream may be sealed; thus, clients cannot
-	// write the log entry. Clients should unseal the log stream to append a log
-	// entry to the log stream.
-	// - Unavailable: The storage node is shutting down, or the log stream replica
-	// is not primary.
-	// - Canceled: The client canceled the request.
-	// - DeadlineExceeded: The client's timeout has expired.
-	//
-	// FIXME: Partial failures are not specified by the gRPC error codes.
-	Append(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendClient, error)
-	// Read reads a log entry from the log stream specified by ReadRequest.
-	// Deprecated: Use Subscribe or SubscribeTo.
-	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
-	// Subscribe reads a range of log entries specified by SubscribeRequest.
-	//
-	// It returns the following gRPC errors:
-	// - NotFound: The log stream replica specified by the SubscribeRequest does
-	// not exist in the storage node. Note that it does not mean that the log
-	// stream does not exist in the cluster.
-	// - Unavailable: The storage node is shutting down.
-	// - InvalidArgument: The range is invalid; for example, the beginning of the
-	// range is greater than or equal to the end.
-	// - OutOfRange: The parts or whole range are already trimmed.
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LogIO_SubscribeClient, error)
-	// SubscribeTo is similar to Subscribe except that it specifies the range with
-	// LLSN.
-	SubscribeTo(ctx context.Context, in *SubscribeToRequest, opts ...grpc.CallOption) (LogIO_SubscribeToClient, error)
-	TrimDeprecated(ctx context.Context, in *TrimDeprecatedRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	// LogStreamReplicaMetadata returns metadata of the log stream replica
-	// specified by the LogStreamReplicaMetadataRequest.
-	LogStreamReplicaMetadata(ctx context.Context, in *LogStreamReplicaMetadataRequest, opts ...grpc.CallOption) (*LogStreamReplicaMetadataResponse, error)
+func (m *AbortTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type logIOClient struct {
-	cc *grpc.ClientConn
+func (m *AbortTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewLogIOClient(cc *grpc.ClientConn) LogIOClient {
-	return &logIOClient{cc}
+func (m *AbortTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortTransactionRequest.Merge(m, src)
+}
+func (m *AbortTransactionRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AbortTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortTransactionRequest proto.InternalMessageInfo
+
+func (m *AbortTransactionRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *AbortTransactionRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *AbortTransactionRequest) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+type AbortTransactionResponse struct {
+}
+
+func (m *AbortTransactionResponse) Reset()         { *m = AbortTransactionResponse{} }
+func (m *AbortTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*AbortTransactionResponse) ProtoMessage()    {}
+func (*AbortTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{17}
+}
+func (m *AbortTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AbortTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AbortTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AbortTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortTransactionResponse.Merge(m, src)
+}
+func (m *AbortTransactionResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AbortTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortTransactionResponse proto.InternalMessageInfo
+
+// LookupGLSNByTimeRequest asks a storage node to find the first log entry
+// committed at or after the time in the log stream.
+type LookupGLSNByTimeRequest struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topic_id,omitempty"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
+	Time        time.Time                                     `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LookupGLSNByTimeRequest) Reset()         { *m = LookupGLSNByTimeRequest{} }
+func (m *LookupGLSNByTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupGLSNByTimeRequest) ProtoMessage()    {}
+func (*LookupGLSNByTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{18}
+}
+func (m *LookupGLSNByTimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupGLSNByTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupGLSNByTimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupGLSNByTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupGLSNByTimeRequest.Merge(m, src)
+}
+func (m *LookupGLSNByTimeRequest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupGLSNByTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupGLSNByTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupGLSNByTimeRequest proto.InternalMessageInfo
+
+func (m *LookupGLSNByTimeRequest) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *LookupGLSNByTimeRequest) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *LookupGLSNByTimeRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// LookupGLSNByTimeResponse contains the GLSN and LLSN of the log entry found
+// by the LookupGLSNByTimeRequest.
+type LookupGLSNByTimeResponse struct {
+	GLSN github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,1,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
+	LLSN github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,2,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
+}
+
+func (m *LookupGLSNByTimeResponse) Reset()         { *m = LookupGLSNByTimeResponse{} }
+func (m *LookupGLSNByTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupGLSNByTimeResponse) ProtoMessage()    {}
+func (*LookupGLSNByTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7692726f23e518ee, []int{19}
+}
+func (m *LookupGLSNByTimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LookupGLSNByTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LookupGLSNByTimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LookupGLSNByTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupGLSNByTimeResponse.Merge(m, src)
+}
+func (m *LookupGLSNByTimeResponse) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LookupGLSNByTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupGLSNByTimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupGLSNByTimeResponse proto.InternalMessageInfo
+
+func (m *LookupGLSNByTimeResponse) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSN
+	}
+	return 0
+}
+
+func (m *LookupGLSNByTimeResponse) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("varlog.snpb.StartPosition", StartPosition_name, StartPosition_value)
+	proto.RegisterType((*AppendRequest)(nil), "varlog.snpb.AppendRequest")
+	proto.RegisterType((*AppendResult)(nil), "varlog.snpb.AppendResult")
+	proto.RegisterType((*AppendResponse)(nil), "varlog.snpb.AppendResponse")
+	proto.RegisterType((*ReadRequest)(nil), "varlog.snpb.ReadRequest")
+	proto.RegisterType((*ReadResponse)(nil), "varlog.snpb.ReadResponse")
+	proto.RegisterType((*ReadRangeRequest)(nil), "varlog.snpb.ReadRangeRequest")
+	proto.RegisterType((*ReadRangeResponse)(nil), "varlog.snpb.ReadRangeResponse")
+	proto.RegisterType((*SubscribeRequest)(nil), "varlog.snpb.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "varlog.snpb.SubscribeResponse")
+	proto.RegisterType((*SubscribeToRequest)(nil), "varlog.snpb.SubscribeToRequest")
+	proto.RegisterType((*SubscribeToResponse)(nil), "varlog.snpb.SubscribeToResponse")
+	proto.RegisterType((*TrimDeprecatedRequest)(nil), "varlog.snpb.TrimDeprecatedRequest")
+	proto.RegisterType((*LogStreamMetadataRequest)(nil), "varlog.snpb.LogStreamMetadataRequest")
+	proto.RegisterType((*LogStreamMetadataResponse)(nil), "varlog.snpb.LogStreamMetadataResponse")
+	proto.RegisterType((*LogStreamReplicaMetadataRequest)(nil), "varlog.snpb.LogStreamReplicaMetadataRequest")
+	proto.RegisterType((*LogStreamReplicaMetadataResponse)(nil), "varlog.snpb.LogStreamReplicaMetadataResponse")
+	proto.RegisterType((*AbortTransactionRequest)(nil), "varlog.snpb.AbortTransactionRequest")
+	proto.RegisterType((*AbortTransactionResponse)(nil), "varlog.snpb.AbortTransactionResponse")
+	proto.RegisterType((*LookupGLSNByTimeRequest)(nil), "varlog.snpb.LookupGLSNByTimeRequest")
+	proto.RegisterType((*LookupGLSNByTimeResponse)(nil), "varlog.snpb.LookupGLSNByTimeResponse")
+}
+
+func init() { proto.RegisterFile("proto/snpb/log_io.proto", fileDescriptor_7692726f23e518ee) }
+
+var fileDescriptor_7692726f23e518ee = []byte{
+	// 1436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0x9b, 0xd8, 0x7e, 0x6e, 0x22, 0x77, 0xf2, 0xcd, 0x37, 0xce, 0xf6, 0x5b, 0xaf,
+	0xb5, 0xdf, 0x16, 0x05, 0x44, 0xed, 0xca, 0x08, 0x5a, 0xa4, 0x22, 0xd5, 0x6e, 0x9c, 0xca, 0xe0,
+	0xa6, 0xd1, 0xda, 0xe5, 0x80, 0x04, 0xd1, 0xda, 0x3b, 0x35, 0xab, 0xac, 0x77, 0x96, 0xdd, 0x31,
+	0x6a, 0xc4, 0x1f, 0x00, 0xea, 0x01, 0xf5, 0xc0, 0xb5, 0x08, 0x89, 0x1b, 0x27, 0x0e, 0x88, 0x2b,
+	0x12, 0xa7, 0x1e, 0x7b, 0x41, 0xea, 0x01, 0x19, 0xc9, 0xf9, 0x1b, 0xb8, 0xf4, 0x84, 0x66, 0xf6,
+	0x47, 0x76, 0xfd, 0x43, 0x49, 0x68, 0x22, 0x41, 0x6e, 0x9e, 0x99, 0xcf, 0xfb, 0xcc, 0xdb, 0xf7,
+	0x3e, 0xf3, 0xfc, 0x66, 0x60, 0xcd, 0x76, 0x08, 0x25, 0x15, 0xd7, 0xb2, 0xbb, 0x15, 0x93, 0xf4,
+	0x77, 0x0d, 0x52, 0xe6, 0x33, 0x28, 0xf7, 0xb9, 0xe6, 0x98, 0xa4, 0x5f, 0x66, 0x2b, 0xd2, 0xb5,
+	0xbe, 0x41, 0x3f, 0x1d, 0x76, 0xcb, 0x3d, 0x32, 0xa8, 0xf4, 0x49, 0x9f, 0x54, 0x38, 0xa6, 0x3b,
+	0x7c, 0xc8, 0x47, 0x1e, 0x05, 0xfb, 0xe5, 0xd9, 0x4a, 0x97, 0xfa, 0x84, 0xf4, 0x4d, 0x7c, 0x88,
+	0xc2, 0x03, 0x9b, 0xee, 0xfb, 0x8b, 0xf2, 0xe4, 0x22, 0x35, 0x06, 0xd8, 0xa5, 0xda, 0xc0, 0xf6,
+	0x01, 0x6b, 0xde, 0xce, 0x76, 0xb7, 0x32, 0xc0, 0x54, 0xd3, 0x35, 0xaa, 0xf9, 0x0b, 0x2b, 0xae,
+	0x35, 0x35, 0xa9, 0xfc, 0x9c, 0x82, 0xa5, 0x9a, 0x6d, 0x63, 0x4b, 0x57, 0xf1, 0x67, 0x43, 0xec,
+	0x52, 0xd4, 0x86, 0x0c, 0x25, 0xb6, 0xd1, 0xdb, 0x35, 0xf4, 0x82, 0x50, 0x12, 0x36, 0x16, 0xea,
+	0x37, 0xc7, 0x23, 0x39, 0xdd, 0x61, 0x73, 0xcd, 0xcd, 0x97, 0x23, 0xf9, 0xf5, 0xc8, 0xd7, 0xec,
+	0x69, 0x7b, 0x1a, 0xa9, 0x78, 0x3b, 0x56, 0xec, 0xbd, 0x7e, 0x85, 0xee, 0xdb, 0xd8, 0x2d, 0xfb,
+	0x60, 0x35, 0xcd, 0x99, 0x9a, 0x3a, 0xd2, 0x61, 0x89, 0x85, 0xc7, 0xa5, 0x0e, 0xd6, 0x06, 0x8c,
+	0x39, 0xc9, 0x99, 0x6f, 0x8f, 0x47, 0x72, 0xae, 0x45, 0xfa, 0x6d, 0x3e, 0xcf, 0xd9, 0xaf, 0x1d,
+	0xcd, 0x1e, 0x31, 0x50, 0x73, 0x66, 0x38, 0xd0, 0x51, 0x01, 0xd2, 0xb6, 0xb6, 0x6f, 0x12, 0x4d,
+	0x2f, 0xa4, 0x4a, 0xa9, 0x8d, 0x0b, 0x6a, 0x30, 0x44, 0x4d, 0x00, 0x8d, 0x52, 0xc7, 0xe8, 0x0e,
+	0x29, 0x76, 0x0b, 0x62, 0x29, 0xb5, 0x91, 0xab, 0xfe, 0xbf, 0xec, 0xe7, 0x28, 0x08, 0x18, 0x23,
+	0x6e, 0x58, 0xd4, 0xd9, 0xaf, 0x85, 0xd0, 0xba, 0xf8, 0x6c, 0x24, 0x27, 0xd4, 0x88, 0x31, 0xaa,
+	0x40, 0xce, 0x76, 0x88, 0x3e, 0xec, 0x61, 0x87, 0x7d, 0xc8, 0x42, 0x49, 0xd8, 0x10, 0xeb, 0xcb,
+	0xe3, 0x91, 0x0c, 0x3b, 0xfe, 0x74, 0x73, 0x53, 0x85, 0x00, 0xd2, 0xd4, 0x91, 0x04, 0x19, 0x97,
+	0xc5, 0xd6, 0xea, 0xe1, 0xc2, 0x22, 0x43, 0xab, 0xe1, 0x18, 0xdd, 0x84, 0x65, 0xea, 0x68, 0x96,
+	0xab, 0xf5, 0xa8, 0x41, 0x2c, 0xc6, 0x97, 0xe6, 0x7c, 0x17, 0xc7, 0x23, 0x79, 0xa9, 0x73, 0xb8,
+	0xd2, 0xdc, 0x54, 0x97, 0x22, 0xc0, 0xa6, 0xae, 0x7c, 0x0c, 0x17, 0x82, 0xbc, 0xb9, 0x43, 0x93,
+	0xa2, 0x1b, 0x20, 0xb2, 0xd4, 0xf2, 0x94, 0xe5, 0xaa, 0x97, 0xe7, 0x7e, 0xdb, 0x3d, 0x4c, 0x35,
+	0xff, 0xab, 0xb8, 0x01, 0xfa, 0x0f, 0x2c, 0x60, 0xc7, 0x21, 0x0e, 0x4f, 0x49, 0x56, 0xf5, 0x06,
+	0xca, 0x07, 0xb0, 0x1c, 0xd2, 0xdb, 0xc4, 0x72, 0x31, 0x7a, 0x17, 0xd2, 0x0e, 0xdf, 0xca, 0x2d,
+	0x08, 0x3c, 0x7e, 0xeb, 0xe5, 0x88, 0xc6, 0xcb, 0x51, 0x67, 0x7c, 0xfe, 0x00, 0xaf, 0xbc, 0x48,
+	0x42, 0x4e, 0xc5, 0x5a, 0x28, 0xb1, 0x2d, 0x10, 0xfb, 0xa6, 0x6b, 0x71, 0x5f, 0xc5, 0x7a, 0x75,
+	0x3c, 0x92, 0xc5, 0xbb, 0xad, 0xf6, 0xf6, 0xcb, 0x91, 0xfc, 0xda, 0xd1, 0xd9, 0x67, 0x48, 0x95,
+	0xdb, 0xc7, 0xa4, 0x9a, 0x3c, 0x33, 0xa9, 0xa6, 0xce, 0x42, 0xaa, 0x5b, 0x20, 0x9a, 0x2c, 0x04,
+	0xe2, 0x61, 0x08, 0x5a, 0xc7, 0x0e, 0x41, 0x8b, 0x87, 0x80, 0xd9, 0x2b, 0x2a, 0x5c, 0xf0, 0x22,
+	0xeb, 0x67, 0xe9, 0x16, 0x64, 0x99, 0xf7, 0x98, 0xa5, 0x9a, 0x93, 0x47, 0xf2, 0x34, 0xa5, 0x05,
+	0x3f, 0x4f, 0x19, 0xd3, 0x1f, 0xbf, 0x2f, 0x66, 0x84, 0xbc, 0xa8, 0xfc, 0x2a, 0x42, 0x9e, 0x93,
+	0x6a, 0x56, 0x1f, 0x9f, 0x83, 0xb2, 0xf0, 0x21, 0x00, 0x93, 0xcb, 0x6e, 0x17, 0xf7, 0x0d, 0x8b,
+	0xa7, 0x53, 0xac, 0xdf, 0x18, 0x8f, 0xe4, 0x2c, 0x93, 0x52, 0x9d, 0x4d, 0x9e, 0x40, 0x79, 0x59,
+	0x46, 0xc5, 0x8d, 0xd0, 0x0e, 0x64, 0x38, 0x2f, 0xb6, 0x74, 0x3f, 0x8f, 0x6f, 0xb3, 0x90, 0x30,
+	0x58, 0xc3, 0xd2, 0x4f, 0xc0, 0x99, 0x66, 0x34, 0x0d, 0x8b, 0x7b, 0x6a, 0x1e, 0x7a, 0xba, 0x70,
+	0xe8, 0x69, 0xeb, 0x64, 0x9e, 0x72, 0x81, 0x64, 0xcd, 0xa8, 0xa7, 0x66, 0xe0, 0xe9, 0xe2, 0xa1,
+	0xa7, 0xad, 0x93, 0x78, 0xca, 0x39, 0xd3, 0xa6, 0xef, 0xa9, 0x0c, 0xb9, 0x81, 0xf6, 0x88, 0xeb,
+	0xcc, 0xc0, 0x2e, 0xaf, 0x5a, 0x0b, 0x2a, 0x0c, 0xb4, 0x47, 0x0d, 0x6f, 0x46, 0x79, 0x00, 0x17,
+	0x23, 0x1a, 0xf2, 0xd5, 0x79, 0x1b, 0x72, 0x81, 0x3a, 0x0d, 0x3c, 0x55, 0x47, 0xe6, 0xe9, 0x13,
+	0x7c, 0x7d, 0x32, 0xda, 0x1f, 0x53, 0x90, 0x6f, 0x0f, 0xbb, 0x6e, 0xcf, 0x31, 0xba, 0xa1, 0x36,
+	0xe3, 0x09, 0x16, 0xce, 0x24, 0xc1, 0xc9, 0x53, 0x49, 0x70, 0xf4, 0x14, 0xa5, 0xce, 0xec, 0x14,
+	0x89, 0x67, 0x71, 0x8a, 0x6e, 0xc0, 0xe2, 0x43, 0xc3, 0xa4, 0xd8, 0xe1, 0xba, 0xcc, 0x55, 0xe5,
+	0xb9, 0x69, 0xdb, 0xe2, 0x30, 0xd5, 0x87, 0x2b, 0xdf, 0x26, 0xe1, 0x62, 0x24, 0x65, 0xbe, 0x14,
+	0x4e, 0xeb, 0x3f, 0x20, 0x28, 0xa4, 0xc9, 0x57, 0x2b, 0xa4, 0xf1, 0xde, 0x41, 0x88, 0xf6, 0x0e,
+	0x77, 0x26, 0x7a, 0x07, 0xe1, 0x98, 0xbd, 0x43, 0xac, 0x6b, 0x90, 0x20, 0xe3, 0x85, 0x03, 0x7b,
+	0x2d, 0x43, 0x46, 0x0d, 0xc7, 0xca, 0x9f, 0x29, 0x40, 0x61, 0x80, 0x3a, 0xe4, 0x7c, 0x54, 0x5c,
+	0x73, 0x66, 0xc5, 0x3d, 0xc5, 0x3a, 0x26, 0x9e, 0x4a, 0x1d, 0xfb, 0xbb, 0xaa, 0x46, 0x35, 0x58,
+	0x76, 0xa9, 0xe6, 0xd0, 0x5d, 0x9b, 0xb8, 0x06, 0xeb, 0xc9, 0x78, 0x61, 0x5d, 0xae, 0x4a, 0xb1,
+	0xae, 0xa8, 0xcd, 0x20, 0x3b, 0x3e, 0x42, 0x5d, 0x72, 0xa3, 0x43, 0x85, 0xc0, 0x4a, 0x2c, 0xed,
+	0xb3, 0xfe, 0xc2, 0x85, 0x13, 0xfe, 0x85, 0xc7, 0x84, 0x96, 0x9c, 0x10, 0xda, 0x4f, 0x02, 0xac,
+	0x76, 0x1c, 0x63, 0xb0, 0x89, 0x6d, 0x07, 0xf7, 0x34, 0x8a, 0xcf, 0xb6, 0xe9, 0x0f, 0x8e, 0x78,
+	0xf2, 0xd5, 0x8e, 0xb8, 0xf2, 0x9b, 0x00, 0x85, 0x50, 0x6a, 0xf7, 0xfc, 0xfb, 0xcb, 0xbf, 0xff,
+	0x94, 0x28, 0x5f, 0xc0, 0xfa, 0x8c, 0xcf, 0xf2, 0x55, 0xf0, 0x09, 0xac, 0x46, 0x5c, 0xd0, 0x31,
+	0x93, 0x89, 0x4d, 0x89, 0xe3, 0x2b, 0xe2, 0xca, 0x2c, 0x45, 0x78, 0x54, 0x9b, 0x21, 0xd6, 0x17,
+	0xc7, 0x8a, 0x39, 0xbd, 0xa4, 0xfc, 0x2e, 0x80, 0x1c, 0x9a, 0xa8, 0xd8, 0x36, 0x8d, 0x9e, 0x76,
+	0x8e, 0x62, 0xfb, 0xa5, 0x00, 0xa5, 0xf9, 0x9f, 0xe7, 0xc7, 0xb8, 0x07, 0x28, 0xe2, 0x8a, 0xe3,
+	0xa1, 0xfc, 0x00, 0x57, 0x62, 0xe7, 0x78, 0x1e, 0xd5, 0x54, 0xac, 0xf3, 0xe6, 0x04, 0x52, 0xf9,
+	0x3a, 0x09, 0x6b, 0xb5, 0x2e, 0x71, 0x68, 0xe4, 0x3a, 0x77, 0x0e, 0x4a, 0xfc, 0xf4, 0xcd, 0x35,
+	0x75, 0xcc, 0x9b, 0xab, 0x04, 0x85, 0xe9, 0x78, 0x78, 0x19, 0xe1, 0xc1, 0x6a, 0x11, 0xb2, 0x37,
+	0xb4, 0x79, 0xeb, 0xb6, 0xdf, 0x31, 0x06, 0xf8, 0x5c, 0x04, 0x4b, 0x64, 0xcf, 0x34, 0x3c, 0x44,
+	0xb9, 0xaa, 0x54, 0xf6, 0xde, 0x70, 0xca, 0xc1, 0x1b, 0x4e, 0xb9, 0x13, 0xbc, 0xe1, 0xd4, 0x33,
+	0x4c, 0x45, 0x4f, 0xfe, 0x90, 0x05, 0x95, 0x5b, 0x28, 0x3f, 0xf0, 0xda, 0x37, 0x19, 0x90, 0x7f,
+	0x66, 0x0f, 0xf5, 0xc6, 0x2f, 0x02, 0x2c, 0xc5, 0xfe, 0xf1, 0xd0, 0x2d, 0x90, 0xda, 0x9d, 0x9a,
+	0xda, 0xd9, 0xdd, 0xb9, 0xdf, 0x6e, 0x76, 0x9a, 0xf7, 0xb7, 0x77, 0x1f, 0x6c, 0xb7, 0x77, 0x1a,
+	0x77, 0x9a, 0x5b, 0xcd, 0xc6, 0x66, 0x3e, 0x21, 0xfd, 0xef, 0xf1, 0xd3, 0x52, 0x21, 0x66, 0xf2,
+	0xc0, 0x72, 0x6d, 0xdc, 0x33, 0x1e, 0x1a, 0x58, 0x47, 0xef, 0xc0, 0xda, 0x84, 0x75, 0xa3, 0xa6,
+	0xb6, 0x9a, 0x8d, 0x76, 0x27, 0x2f, 0x48, 0xeb, 0x8f, 0x9f, 0x96, 0x56, 0x63, 0xa6, 0x0d, 0xcd,
+	0x31, 0x0d, 0xa6, 0x94, 0x2a, 0xac, 0x4e, 0xd8, 0xb5, 0x6a, 0x1d, 0x66, 0x95, 0x94, 0xd6, 0x1e,
+	0x3f, 0x2d, 0xad, 0xc4, 0xac, 0x5a, 0x1a, 0xc5, 0x2e, 0x95, 0xc4, 0xaf, 0xbe, 0x2f, 0x26, 0xaa,
+	0xdf, 0x2c, 0xc2, 0x42, 0x8b, 0xf4, 0x9b, 0xf7, 0xd1, 0x5d, 0x58, 0xf4, 0x9e, 0x34, 0x90, 0x34,
+	0xf3, 0x9d, 0x83, 0x6b, 0x52, 0xba, 0x34, 0x73, 0xcd, 0x17, 0x73, 0x62, 0x43, 0xb8, 0x2e, 0xa0,
+	0xf7, 0x40, 0x64, 0x17, 0x21, 0x54, 0x88, 0x41, 0x23, 0xcf, 0x21, 0xd2, 0xfa, 0x8c, 0x95, 0x80,
+	0x02, 0xb5, 0x20, 0x1b, 0xde, 0xa3, 0xd0, 0xe5, 0x69, 0x64, 0xe4, 0x8e, 0x2e, 0x15, 0xe7, 0x2d,
+	0x87, 0x6c, 0xdb, 0x90, 0x0d, 0x5b, 0x8e, 0x09, 0xb6, 0xc9, 0x5b, 0x95, 0x54, 0x9c, 0xb7, 0x1c,
+	0xb0, 0x5d, 0x17, 0x50, 0x07, 0x72, 0x91, 0x16, 0x06, 0xc9, 0xb3, 0x4d, 0xc2, 0x9e, 0x56, 0x2a,
+	0xcd, 0x07, 0x44, 0x58, 0xb7, 0x61, 0x39, 0xde, 0xa6, 0x20, 0x25, 0x66, 0x37, 0xb3, 0x87, 0x91,
+	0xfe, 0x3b, 0x75, 0xac, 0x1a, 0xec, 0xdd, 0x54, 0x49, 0xa0, 0xfd, 0x48, 0xff, 0x30, 0x51, 0xc0,
+	0xd1, 0x9b, 0xc7, 0xaa, 0xf3, 0xc1, 0x1e, 0xd7, 0x8e, 0x89, 0x0e, 0x03, 0xae, 0x41, 0x7e, 0xb2,
+	0xd8, 0xa1, 0x2b, 0x71, 0xd1, 0xcc, 0xfe, 0x6f, 0x90, 0xae, 0x1e, 0x81, 0x8a, 0x6e, 0x31, 0x59,
+	0x21, 0x26, 0xb6, 0x98, 0x53, 0x51, 0xa5, 0xab, 0x47, 0xa0, 0x82, 0x2d, 0xea, 0xb7, 0x9e, 0x8d,
+	0x8b, 0xc2, 0xf3, 0x71, 0x51, 0x78, 0x72, 0x50, 0x4c, 0x7c, 0x77, 0x50, 0x14, 0x9e, 0x1f, 0x14,
+	0x13, 0x2f, 0x0e, 0x8a, 0x89, 0x8f, 0x94, 0xb9, 0x05, 0x22, 0x7c, 0x18, 0xef, 0x2e, 0xf2, 0xdf,
+	0x6f, 0xfd, 0x35, 0x00, 0x51, 0xac, 0x19, 0x6a, 0x2d, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LogIOClient is the client API for LogIO service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LogIOClient interface {
+	// Append stores a list of log entries to the end of the log stream
+	// specified by AppendRequest. The log entries are appended partially; that
+	// is, some of the log entries could not be stored due to failures.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: AppendRequest has invalid fields; for instance, TopicID
+	// is invalid.
+	// - NotFound: The log stream replica specified by the AppendRequest does not
+	// exist in the storage node. Note that it does not mean that the log stream
+	// does not exist in the cluster.
+	// - FailedPrecondition: The log stream may be sealed; thus, clients cannot
+	// write the log entry. Clients should unseal the log stream to append a log
+	// entry to the log stream.
+	// - Unavailable: The storage node is shutting down, or the log stream replica
+	// is not primary.
+	// - Canceled: The client canceled the request.
+	// - DeadlineExceeded: The client's timeout has expired.
+	//
+	// FIXME: Partial failures are not specified by the gRPC error codes.
+	Append(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendClient, error)
+	// Read reads a committed log entry at the GLSN or LLSN from the log stream
+	// specified by ReadRequest.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadRequest has invalid fields; for instance, both GLSN
+	// and LLSN are set.
+	// - NotFound: The log stream replica specified by the ReadRequest does not
+	// exist in the storage node, or the log entry does not exist in the log
+	// stream replica. Note that the log entry might not have been committed yet.
+	// - OutOfRange: The log entry is already trimmed.
+	// - Unavailable: The storage node is shutting down.
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// ReadRange reads committed log entries in the range specified by
+	// ReadRangeRequest. Unlike Subscribe and SubscribeTo, it does not wait for
+	// log entries to be committed.
+	//
+	// It returns the same gRPC errors as Read except that it does not return
+	// NotFound if there are no log entries in the range.
+	ReadRange(ctx context.Context, in *ReadRangeRequest, opts ...grpc.CallOption) (*ReadRangeResponse, error)
+	// Subscribe reads a range of log entries specified by SubscribeRequest.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The log stream replica specified by the SubscribeRequest does
+	// not exist in the storage node. Note that it does not mean that the log
+	// stream does not exist in the cluster.
+	// - Unavailable: The storage node is shutting down.
+	// - InvalidArgument: The range is invalid; for example, the beginning of the
+	// range is greater than or equal to the end.
+	// - OutOfRange: The parts or whole range are already trimmed.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LogIO_SubscribeClient, error)
+	// SubscribeTo is similar to Subscribe except that it specifies the range with
+	// LLSN.
+	SubscribeTo(ctx context.Context, in *SubscribeToRequest, opts ...grpc.CallOption) (LogIO_SubscribeToClient, error)
+	TrimDeprecated(ctx context.Context, in *TrimDeprecatedRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// LogStreamReplicaMetadata returns metadata of the log stream replica
+	// specified by the LogStreamReplicaMetadataRequest.
+	LogStreamReplicaMetadata(ctx context.Context, in *LogStreamReplicaMetadataRequest, opts ...grpc.CallOption) (*LogStreamReplicaMetadataResponse, error)
+	// AbortTransaction discards the log entries of the transaction staged in
+	// the log stream specified by the AbortTransactionRequest. Since the staged
+	// log entries can be followed by other uncommitted log entries, the log
+	// stream replica stops accepting appends and waits to be sealed, which
+	// discards all uncommitted log entries. It does nothing if the transaction
+	// is not staged in the log stream.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The log stream replica specified by the
+	// AbortTransactionRequest does not exist in the storage node.
+	// - Unavailable: The storage node is shutting down, or the log stream replica
+	// is not primary.
+	AbortTransaction(ctx context.Context, in *AbortTransactionRequest, opts ...grpc.CallOption) (*AbortTransactionResponse, error)
+	// LookupGLSNByTime finds the first log entry committed at or after the time
+	// in the log stream specified by the LookupGLSNByTimeRequest. Since commit
+	// times are recorded for each commit, the log entry is the first one of the
+	// commit.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The log stream replica specified by the
+	// LookupGLSNByTimeRequest does not exist in the storage node, or no log
+	// entry has been committed at or after the time.
+	// - Unavailable: The storage node is shutting down.
+	LookupGLSNByTime(ctx context.Context, in *LookupGLSNByTimeRequest, opts ...grpc.CallOption) (*LookupGLSNByTimeResponse, error)
+}
+
+type logIOClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLogIOClient(cc *grpc.ClientConn) LogIOClient {
+	return &logIOClient{cc}
 }
 
 func (c *logIOClient) Append(ctx context.Context, opts ...grpc.CallOption) (LogIO_AppendClient, error) {
@@ -987,6 +1565,15 @@ func (c *logIOClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *logIOClient) ReadRange(ctx context.Context, in *ReadRangeRequest, opts ...grpc.CallOption) (*ReadRangeResponse, error) {
+	out := new(ReadRangeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/ReadRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *logIOClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (LogIO_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_LogIO_serviceDesc.Streams[1], "/varlog.snpb.LogIO/Subscribe", opts...)
 	if err != nil {
@@ -1069,6 +1656,24 @@ func (c *logIOClient) LogStreamReplicaMetadata(ctx context.Context, in *LogStrea
 	return out, nil
 }
 
+func (c *logIOClient) AbortTransaction(ctx context.Context, in *AbortTransactionRequest, opts ...grpc.CallOption) (*AbortTransactionResponse, error) {
+	out := new(AbortTransactionResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/AbortTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logIOClient) LookupGLSNByTime(ctx context.Context, in *LookupGLSNByTimeRequest, opts ...grpc.CallOption) (*LookupGLSNByTimeResponse, error) {
+	out := new(LookupGLSNByTimeResponse)
+	err := c.cc.Invoke(ctx, "/varlog.snpb.LogIO/LookupGLSNByTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogIOServer is the server API for LogIO service.
 type LogIOServer interface {
 	// Append stores a list of log entries to the end of the log stream
@@ -1091,9 +1696,25 @@ type LogIOServer interface {
 	//
 	// FIXME: Partial failures are not specified by the gRPC error codes.
 	Append(LogIO_AppendServer) error
-	// Read reads a log entry from the log stream specified by ReadRequest.
-	// Deprecated: Use Subscribe or SubscribeTo.
+	// Read reads a committed log entry at the GLSN or LLSN from the log stream
+	// specified by ReadRequest.
+	//
+	// It returns the following gRPC errors:
+	// - InvalidArgument: ReadRequest has invalid fields; for instance, both GLSN
+	// and LLSN are set.
+	// - NotFound: The log stream replica specified by the ReadRequest does not
+	// exist in the storage node, or the log entry does not exist in the log
+	// stream replica. Note that the log entry might not have been committed yet.
+	// - OutOfRange: The log entry is already trimmed.
+	// - Unavailable: The storage node is shutting down.
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// ReadRange reads committed log entries in the range specified by
+	// ReadRangeRequest. Unlike Subscribe and SubscribeTo, it does not wait for
+	// log entries to be committed.
+	//
+	// It returns the same gRPC errors as Read except that it does not return
+	// NotFound if there are no log entries in the range.
+	ReadRange(context.Context, *ReadRangeRequest) (*ReadRangeResponse, error)
 	// Subscribe reads a range of log entries specified by SubscribeRequest.
 	//
 	// It returns the following gRPC errors:
@@ -1112,6 +1733,30 @@ type LogIOServer interface {
 	// LogStreamReplicaMetadata returns metadata of the log stream replica
 	// specified by the LogStreamReplicaMetadataRequest.
 	LogStreamReplicaMetadata(context.Context, *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error)
+	// AbortTransaction discards the log entries of the transaction staged in
+	// the log stream specified by the AbortTransactionRequest. Since the staged
+	// log entries can be followed by other uncommitted log entries, the log
+	// stream replica stops accepting appends and waits to be sealed, which
+	// discards all uncommitted log entries. It does nothing if the transaction
+	// is not staged in the log stream.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The log stream replica specified by the
+	// AbortTransactionRequest does not exist in the storage node.
+	// - Unavailable: The storage node is shutting down, or the log stream replica
+	// is not primary.
+	AbortTransaction(context.Context, *AbortTransactionRequest) (*AbortTransactionResponse, error)
+	// LookupGLSNByTime finds the first log entry committed at or after the time
+	// in the log stream specified by the LookupGLSNByTimeRequest. Since commit
+	// times are recorded for each commit, the log entry is the first one of the
+	// commit.
+	//
+	// It returns the following gRPC errors:
+	// - NotFound: The log stream replica specified by the
+	// LookupGLSNByTimeRequest does not exist in the storage node, or no log
+	// entry has been committed at or after the time.
+	// - Unavailable: The storage node is shutting down.
+	LookupGLSNByTime(context.Context, *LookupGLSNByTimeRequest) (*LookupGLSNByTimeResponse, error)
 }
 
 // UnimplementedLogIOServer can be embedded to have forward compatible implementations.
@@ -1124,6 +1769,9 @@ func (*UnimplementedLogIOServer) Append(srv LogIO_AppendServer) error {
 func (*UnimplementedLogIOServer) Read(ctx context.Context, req *ReadRequest) (*ReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (*UnimplementedLogIOServer) ReadRange(ctx context.Context, req *ReadRangeRequest) (*ReadRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRange not implemented")
+}
 func (*UnimplementedLogIOServer) Subscribe(req *SubscribeRequest, srv LogIO_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -1136,6 +1784,12 @@ func (*UnimplementedLogIOServer) TrimDeprecated(ctx context.Context, req *TrimDe
 func (*UnimplementedLogIOServer) LogStreamReplicaMetadata(ctx context.Context, req *LogStreamReplicaMetadataRequest) (*LogStreamReplicaMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogStreamReplicaMetadata not implemented")
 }
+func (*UnimplementedLogIOServer) AbortTransaction(ctx context.Context, req *AbortTransactionRequest) (*AbortTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortTransaction not implemented")
+}
+func (*UnimplementedLogIOServer) LookupGLSNByTime(ctx context.Context, req *LookupGLSNByTimeRequest) (*LookupGLSNByTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupGLSNByTime not implemented")
+}
 
 func RegisterLogIOServer(s *grpc.Server, srv LogIOServer) {
 	s.RegisterService(&_LogIO_serviceDesc, srv)
@@ -1185,6 +1839,24 @@ func _LogIO_Read_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogIO_ReadRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogIOServer).ReadRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.LogIO/ReadRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogIOServer).ReadRange(ctx, req.(*ReadRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LogIO_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1263,14 +1935,54 @@ func _LogIO_LogStreamReplicaMetadata_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-var _LogIO_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "varlog.snpb.LogIO",
-	HandlerType: (*LogIOServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Read",
+func _LogIO_AbortTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogIOServer).AbortTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.LogIO/AbortTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogIOServer).AbortTransaction(ctx, req.(*AbortTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogIO_LookupGLSNByTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupGLSNByTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogIOServer).LookupGLSNByTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/varlog.snpb.LogIO/LookupGLSNByTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogIOServer).LookupGLSNByTime(ctx, req.(*LookupGLSNByTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LogIO_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "varlog.snpb.LogIO",
+	HandlerType: (*LogIOServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Read",
 			Handler:    _LogIO_Read_Handler,
 		},
+		{
+			MethodName: "ReadRange",
+			Handler:    _LogIO_ReadRange_Handler,
+		},
 		{
 			MethodName: "TrimDeprecated",
 			Handler:    _LogIO_TrimDeprecated_Handler,
@@ -1279,6 +1991,14 @@ var _LogIO_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogStreamReplicaMetadata",
 			Handler:    _LogIO_LogStreamReplicaMetadata_Handler,
 		},
+		{
+			MethodName: "AbortTransaction",
+			Handler:    _LogIO_AbortTransaction_Handler,
+		},
+		{
+			MethodName: "LookupGLSNByTime",
+			Handler:    _LogIO_LookupGLSNByTime_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1321,6 +2041,35 @@ func (m *AppendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransactionID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProducerID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.ProducerID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Payload) > 0 {
 		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Payload[iNdEx])
@@ -1440,6 +2189,11 @@ func (m *ReadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LLSN != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LogStreamID != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
 		i--
@@ -1478,26 +2232,114 @@ func (m *ReadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintLogIo(dAtA, i, uint64(len(m.Payload)))
+	{
+		size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogIo(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *ReadRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEntries != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.MaxEntries))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x38
 	}
-	if m.LLSN != 0 {
-		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSN))
+	if m.LLSNEnd != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSNEnd))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LLSNBegin != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSNBegin))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GLSNEnd != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GLSNBegin != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSNBegin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LogStreamID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.GLSN != 0 {
-		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSN))
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogEntries) > 0 {
+		for iNdEx := len(m.LogEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogIo(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1518,6 +2360,18 @@ func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogIo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.LogStreamID != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
 		i--
@@ -1561,6 +2415,28 @@ func (m *SubscribeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Attributes != nil {
+		{
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogIo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Payload) > 0 {
 		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
@@ -1601,6 +2477,23 @@ func (m *SubscribeToRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StartPosition != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.StartPosition))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogIo(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.LLSNEnd != 0 {
 		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSNEnd))
 		i--
@@ -1644,6 +2537,16 @@ func (m *SubscribeToResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Filtered {
+		i--
+		if m.Filtered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1822,51 +2725,201 @@ func (m *LogStreamReplicaMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLogIo(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLogIo(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AbortTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AppendRequest) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AbortTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TopicID != 0 {
-		n += 1 + sovLogIo(uint64(m.TopicID))
+	if m.TransactionID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.LogStreamID != 0 {
-		n += 1 + sovLogIo(uint64(m.LogStreamID))
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Payload) > 0 {
-		for _, b := range m.Payload {
-			l = len(b)
-			n += 1 + l + sovLogIo(uint64(l))
-		}
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AppendResult) ProtoSize() (n int) {
-	if m == nil {
-		return 0
+func (m *AbortTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AbortTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbortTransactionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Meta.ProtoSize()
-	n += 1 + l + sovLogIo(uint64(l))
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovLogIo(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupGLSNByTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *LookupGLSNByTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupGLSNByTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintLogIo(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.LogStreamID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LookupGLSNByTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LookupGLSNByTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LookupGLSNByTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LLSN != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GLSN != 0 {
+		i = encodeVarintLogIo(dAtA, i, uint64(m.GLSN))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogIo(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogIo(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AppendRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	if len(m.Payload) > 0 {
+		for _, b := range m.Payload {
+			l = len(b)
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
+	}
+	if m.ProducerID != 0 {
+		n += 1 + sovLogIo(uint64(m.ProducerID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLogIo(uint64(m.Sequence))
+	}
+	if m.TransactionID != 0 {
+		n += 1 + sovLogIo(uint64(m.TransactionID))
+	}
+	return n
+}
+
+func (m *AppendResult) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Meta.ProtoSize()
+	n += 1 + l + sovLogIo(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	return n
 }
 
 func (m *AppendResponse) ProtoSize() (n int) {
@@ -1899,6 +2952,9 @@ func (m *ReadRequest) ProtoSize() (n int) {
 	if m.LogStreamID != 0 {
 		n += 1 + sovLogIo(uint64(m.LogStreamID))
 	}
+	if m.LLSN != 0 {
+		n += 1 + sovLogIo(uint64(m.LLSN))
+	}
 	return n
 }
 
@@ -1908,15 +2964,52 @@ func (m *ReadResponse) ProtoSize() (n int) {
 	}
 	var l int
 	_ = l
-	if m.GLSN != 0 {
-		n += 1 + sovLogIo(uint64(m.GLSN))
+	l = m.LogEntry.ProtoSize()
+	n += 1 + l + sovLogIo(uint64(l))
+	return n
+}
+
+func (m *ReadRangeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.LLSN != 0 {
-		n += 1 + sovLogIo(uint64(m.LLSN))
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovLogIo(uint64(l))
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	if m.GLSNBegin != 0 {
+		n += 1 + sovLogIo(uint64(m.GLSNBegin))
+	}
+	if m.GLSNEnd != 0 {
+		n += 1 + sovLogIo(uint64(m.GLSNEnd))
+	}
+	if m.LLSNBegin != 0 {
+		n += 1 + sovLogIo(uint64(m.LLSNBegin))
+	}
+	if m.LLSNEnd != 0 {
+		n += 1 + sovLogIo(uint64(m.LLSNEnd))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovLogIo(uint64(m.MaxEntries))
+	}
+	return n
+}
+
+func (m *ReadRangeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LogEntries) > 0 {
+		for _, e := range m.LogEntries {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogIo(uint64(l))
+		}
 	}
 	return n
 }
@@ -1939,6 +3032,10 @@ func (m *SubscribeRequest) ProtoSize() (n int) {
 	if m.LogStreamID != 0 {
 		n += 1 + sovLogIo(uint64(m.LogStreamID))
 	}
+	if m.Filter != nil {
+		l = m.Filter.ProtoSize()
+		n += 1 + l + sovLogIo(uint64(l))
+	}
 	return n
 }
 
@@ -1958,6 +3055,13 @@ func (m *SubscribeResponse) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogIo(uint64(l))
 	}
+	if m.Attributes != nil {
+		l = m.Attributes.ProtoSize()
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	if m.Filtered {
+		n += 2
+	}
 	return n
 }
 
@@ -1979,6 +3083,13 @@ func (m *SubscribeToRequest) ProtoSize() (n int) {
 	if m.LLSNEnd != 0 {
 		n += 1 + sovLogIo(uint64(m.LLSNEnd))
 	}
+	if m.Filter != nil {
+		l = m.Filter.ProtoSize()
+		n += 1 + l + sovLogIo(uint64(l))
+	}
+	if m.StartPosition != 0 {
+		n += 1 + sovLogIo(uint64(m.StartPosition))
+	}
 	return n
 }
 
@@ -1990,6 +3101,9 @@ func (m *SubscribeToResponse) ProtoSize() (n int) {
 	_ = l
 	l = m.LogEntry.ProtoSize()
 	n += 1 + l + sovLogIo(uint64(l))
+	if m.Filtered {
+		n += 2
+	}
 	return n
 }
 
@@ -2060,13 +3174,608 @@ func (m *LogStreamReplicaMetadataResponse) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AbortTransactionRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	if m.TransactionID != 0 {
+		n += 1 + sovLogIo(uint64(m.TransactionID))
+	}
+	return n
+}
+
+func (m *AbortTransactionResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LookupGLSNByTimeRequest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovLogIo(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovLogIo(uint64(m.LogStreamID))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLogIo(uint64(l))
+	return n
+}
+
+func (m *LookupGLSNByTimeResponse) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GLSN != 0 {
+		n += 1 + sovLogIo(uint64(m.GLSN))
+	}
+	if m.LLSN != 0 {
+		n += 1 + sovLogIo(uint64(m.LLSN))
+	}
+	return n
+}
+
 func sovLogIo(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozLogIo(x uint64) (n int) {
 	return sovLogIo(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+func (m *AppendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, make([]byte, postIndex-iNdEx))
+			copy(m.Payload[len(m.Payload)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, varlogpb.LogEntryAttributes{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			m.ProducerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			m.TransactionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, AppendResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			}
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			}
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,55 +3798,17 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
-			}
-			m.TopicID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
-			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntry", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2147,23 +3818,24 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLogIo
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLogIo
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, make([]byte, postIndex-iNdEx))
-			copy(m.Payload[len(m.Payload)-1], dAtA[iNdEx:postIndex])
+			if err := m.LogEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2186,7 +3858,7 @@ func (m *AppendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendResult) Unmarshal(dAtA []byte) error {
+func (m *ReadRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2209,17 +3881,17 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2229,30 +3901,54 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogIo
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogIo
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
 			}
-			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
 			}
-			var stringLen uint64
+			m.GLSNEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2262,24 +3958,68 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLogIo
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNBegin", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogIo
+			m.LLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNEnd", wireType)
+			}
+			m.LLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSNEnd |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2301,7 +4041,7 @@ func (m *AppendResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppendResponse) Unmarshal(dAtA []byte) error {
+func (m *ReadRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2324,15 +4064,15 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2359,8 +4099,8 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, AppendResult{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LogEntries = append(m.LogEntries, varlogpb.LogEntry{})
+			if err := m.LogEntries[len(m.LogEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2385,7 +4125,7 @@ func (m *AppendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2408,17 +4148,17 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
 			}
-			m.GLSN = 0
+			m.GLSNBegin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2428,12 +4168,31 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
+			}
+			m.GLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
@@ -2452,7 +4211,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
@@ -2471,6 +4230,42 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &varlogpb.LogEntryFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2492,7 +4287,7 @@ func (m *ReadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,10 +4310,10 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2593,6 +4388,62 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = &varlogpb.LogEntryAttributes{}
+			}
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Filtered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2614,7 +4465,7 @@ func (m *ReadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2637,17 +4488,17 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeToRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeToRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSNBegin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.GLSNBegin = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2657,16 +4508,16 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSNEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.GLSNEnd = 0
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2676,16 +4527,16 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNBegin", wireType)
 			}
-			m.TopicID = 0
+			m.LLSNBegin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2695,16 +4546,16 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.LLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSNEnd", wireType)
 			}
-			m.LogStreamID = 0
+			m.LLSNEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2714,7 +4565,62 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.LLSNEnd |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &varlogpb.LogEntryFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPosition", wireType)
+			}
+			m.StartPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartPosition |= StartPosition(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2740,7 +4646,7 @@ func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+func (m *SubscribeToResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2763,17 +4669,17 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeToResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeToResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntry", wireType)
 			}
-			m.GLSN = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2783,35 +4689,30 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
 			}
-			m.LLSN = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			if err := m.LogEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filtered", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2821,26 +4722,12 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
+			m.Filtered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -2862,7 +4749,7 @@ func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
+func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2885,10 +4772,10 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeToRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrimDeprecatedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeToRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrimDeprecatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2912,9 +4799,9 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			m.LogStreamID = 0
+			m.GLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2924,16 +4811,66 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSNBegin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.LLSNBegin = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2943,16 +4880,16 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSNEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.LLSNEnd = 0
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -2962,7 +4899,7 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LLSNEnd |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2988,7 +4925,7 @@ func (m *SubscribeToRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeToResponse) Unmarshal(dAtA []byte) error {
+func (m *LogStreamMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3011,15 +4948,15 @@ func (m *SubscribeToResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeToResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogStreamMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeToResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogStreamMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamDescriptor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3046,7 +4983,7 @@ func (m *SubscribeToResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LogEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LogStreamDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3071,7 +5008,7 @@ func (m *SubscribeToResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
+func (m *LogStreamReplicaMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3094,10 +5031,10 @@ func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TrimDeprecatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogStreamReplicaMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrimDeprecatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogStreamReplicaMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3121,9 +5058,9 @@ func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			m.GLSN = 0
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -3133,7 +5070,7 @@ func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3159,7 +5096,7 @@ func (m *TrimDeprecatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3182,17 +5119,17 @@ func (m *LogStreamMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogStreamReplicaMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogStreamReplicaMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamReplica", wireType)
 			}
-			m.TopicID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -3202,30 +5139,25 @@ func (m *LogStreamMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
 			}
-			m.LogStreamID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogIo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogStreamReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -3247,7 +5179,7 @@ func (m *LogStreamMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *AbortTransactionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3270,17 +5202,17 @@ func (m *LogStreamMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbortTransactionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbortTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamDescriptor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			var msglen int
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -3290,25 +5222,99 @@ func (m *LogStreamMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogIo
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			m.TransactionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogIo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLogIo
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LogStreamDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbortTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogIo
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbortTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbortTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -3330,7 +5336,7 @@ func (m *LogStreamMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamReplicaMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *LookupGLSNByTimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3353,10 +5359,10 @@ func (m *LogStreamReplicaMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamReplicaMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupGLSNByTimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamReplicaMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupGLSNByTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3397,6 +5403,39 @@ func (m *LogStreamReplicaMetadataRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogIo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -3418,7 +5457,7 @@ func (m *LogStreamReplicaMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *LookupGLSNByTimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3441,17 +5480,17 @@ func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamReplicaMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LookupGLSNByTimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamReplicaMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LookupGLSNByTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamReplica", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			var msglen int
+			m.GLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogIo
@@ -3461,25 +5500,30 @@ func (m *LogStreamReplicaMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLogIo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
 			}
-			if err := m.LogStreamReplica.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogIo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogIo(dAtA[iNdEx:])
@@ -9,12 +9,15 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 
 	github_com_kakao_varlog_pkg_types "github.com/kakao/varlog/pkg/types"
 )
@@ -23,6 +26,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,18 @@ type LogStreamUncommitReport struct {
 	UncommittedLLSNLength uint64                                        `protobuf:"varint,3,opt,name=uncommitted_llsn_length,json=uncommittedLlsnLength,proto3" json:"uncommitted_llsn_length,omitempty"`
 	Version               github_com_kakao_varlog_pkg_types.Version     `protobuf:"varint,4,opt,name=version,proto3,casttype=github.com/kakao/varlog/pkg/types.Version" json:"version,omitempty"`
 	HighWatermark         github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,5,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
+	// TransactionID is the transaction staged in the log stream replica. Zero
+	// means that there is no staged transaction. The metadata repository does
+	// not commit log entries from the TransactionLLSNOffset until it commits
+	// the transaction.
+	TransactionID uint64 `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// TransactionLLSNOffset is the LLSN of the first log entry of the staged
+	// transaction.
+	TransactionLLSNOffset github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,7,opt,name=transaction_llsn_offset,json=transactionLlsnOffset,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"transaction_llsn_offset,omitempty"`
+	// TransactionLLSNEnd is the LLSN next to the last log entry of the staged
+	// transaction. It is reported only by the primary replica once all log
+	// entries of the transaction are sequenced; otherwise, it is invalid.
+	TransactionLLSNEnd github_com_kakao_varlog_pkg_types.LLSN `protobuf:"varint,8,opt,name=transaction_llsn_end,json=transactionLlsnEnd,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"transaction_llsn_end,omitempty"`
 }
 
 func (m *LogStreamUncommitReport) Reset()         { *m = LogStreamUncommitReport{} }
@@ -108,6 +124,27 @@ func (m *LogStreamUncommitReport) GetHighWatermark() github_com_kakao_varlog_pkg
 	return 0
 }
 
+func (m *LogStreamUncommitReport) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
+func (m *LogStreamUncommitReport) GetTransactionLLSNOffset() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.TransactionLLSNOffset
+	}
+	return 0
+}
+
+func (m *LogStreamUncommitReport) GetTransactionLLSNEnd() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.TransactionLLSNEnd
+	}
+	return 0
+}
+
 type GetReportRequest struct {
 }
 
@@ -309,6 +346,10 @@ type LogStreamCommitResult struct {
 	// HighWatermark is the maximum GLSN across all log streams of the topic in a
 	// specific commit version.
 	HighWatermark github_com_kakao_varlog_pkg_types.GLSN `protobuf:"varint,7,opt,name=high_watermark,json=highWatermark,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"high_watermark,omitempty"`
+	// CommitTime is the time when the metadata repository issued the commit.
+	// It is set only if the commit has log entries to be committed. Log stream
+	// replicas keep it in their time index to look up log entries by time.
+	CommitTime *time.Time `protobuf:"bytes,8,opt,name=commit_time,json=commitTime,proto3,stdtime" json:"commit_time,omitempty"`
 }
 
 func (m *LogStreamCommitResult) Reset()         { *m = LogStreamCommitResult{} }
@@ -393,6 +434,13 @@ func (m *LogStreamCommitResult) GetHighWatermark() github_com_kakao_varlog_pkg_t
 	return 0
 }
 
+func (m *LogStreamCommitResult) GetCommitTime() *time.Time {
+	if m != nil {
+		return m.CommitTime
+	}
+	return nil
+}
+
 type CommitRequest struct {
 	StorageNodeID github_com_kakao_varlog_pkg_types.StorageNodeID `protobuf:"varint,1,opt,name=storage_node_id,json=storageNodeId,proto3,casttype=github.com/kakao/varlog/pkg/types.StorageNodeID" json:"storage_node_id,omitempty"`
 	CommitResult  LogStreamCommitResult                           `protobuf:"bytes,2,opt,name=commit_result,json=commitResult,proto3" json:"commit_result"`
@@ -585,54 +633,62 @@ func init() {
 }
 
 var fileDescriptor_b6a839cf0bdc32d5 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xd3, 0xb4, 0x81, 0x0b, 0xe9, 0x9f, 0xab, 0xa2, 0x86, 0x20, 0xe2, 0xc8, 0xaa, 0x50,
-	0x40, 0x6a, 0x8c, 0xca, 0x82, 0x80, 0x01, 0xd2, 0xa2, 0x50, 0x11, 0x5a, 0xea, 0xb4, 0x20, 0xb1,
-	0x44, 0x4e, 0x7c, 0x75, 0xa2, 0x38, 0xbe, 0xe0, 0x3b, 0x17, 0x21, 0x36, 0x3e, 0x01, 0x1f, 0xa1,
-	0x1f, 0x85, 0xb1, 0x03, 0x43, 0x25, 0x96, 0x4e, 0x1e, 0x9c, 0x85, 0x95, 0xb5, 0x13, 0xf2, 0xd9,
-	0x71, 0xce, 0xc4, 0x51, 0x83, 0x2a, 0x95, 0xcd, 0xfe, 0xdd, 0xfb, 0xbd, 0x77, 0x3f, 0xdf, 0x7b,
-	0x97, 0x80, 0xf5, 0x81, 0x85, 0x29, 0x96, 0x89, 0x39, 0x68, 0xc9, 0x06, 0xd6, 0x9b, 0x84, 0x5a,
-	0x48, 0xed, 0x37, 0x2d, 0x34, 0xc0, 0x16, 0x45, 0x56, 0x85, 0x2d, 0xc3, 0xcc, 0xb1, 0x6a, 0x19,
-	0x58, 0xaf, 0x78, 0xb0, 0xc2, 0x86, 0xde, 0xa5, 0x1d, 0xbb, 0x55, 0x69, 0xe3, 0xbe, 0xac, 0x63,
-	0x1d, 0xcb, 0x0c, 0xd3, 0xb2, 0x8f, 0xd8, 0x9b, 0xcf, 0xe7, 0x3d, 0xf9, 0xbd, 0xd2, 0xef, 0x39,
-	0xb0, 0x56, 0xc7, 0x7a, 0x83, 0x11, 0x1f, 0x9a, 0x6d, 0xdc, 0xef, 0x77, 0xa9, 0xc2, 0xf8, 0xa1,
-	0x06, 0xb2, 0x9c, 0x68, 0x57, 0xcb, 0x0b, 0x25, 0xa1, 0x3c, 0x5f, 0x7d, 0xee, 0x3a, 0x62, 0x26,
-	0xec, 0xd9, 0xd9, 0xbe, 0x70, 0x44, 0x5e, 0xb4, 0xa7, 0xf6, 0x54, 0x2c, 0xfb, 0x5b, 0x92, 0x07,
-	0x3d, 0x5d, 0xa6, 0x9f, 0x07, 0x88, 0x54, 0xb8, 0x06, 0x25, 0x63, 0x84, 0x2f, 0x1a, 0xfc, 0x02,
-	0xd6, 0xec, 0x40, 0x97, 0x22, 0xad, 0x69, 0x18, 0xc4, 0x6c, 0xe2, 0xa3, 0x23, 0x82, 0x68, 0x3e,
-	0x59, 0x12, 0xca, 0xa9, 0xea, 0x96, 0xeb, 0x88, 0xb9, 0xc3, 0x31, 0xa4, 0x5e, 0x6f, 0xec, 0xee,
-	0x31, 0xc0, 0x85, 0x23, 0xde, 0x9b, 0x41, 0xb9, 0xde, 0xd8, 0x55, 0x72, 0x9c, 0x46, 0xdd, 0x20,
-	0xa6, 0x4f, 0x00, 0xf7, 0x63, 0xc4, 0x0d, 0x64, 0xea, 0xb4, 0x93, 0x9f, 0x63, 0xe2, 0xb7, 0x63,
-	0xc4, 0xeb, 0x0c, 0x30, 0x41, 0xe9, 0x97, 0x61, 0x0d, 0xa4, 0x8f, 0x91, 0x45, 0xba, 0xd8, 0xcc,
-	0xa7, 0x18, 0xc5, 0xc6, 0x85, 0x23, 0xde, 0xbf, 0x7c, 0x9b, 0xef, 0xfc, 0x26, 0x65, 0xd4, 0x0d,
-	0xf7, 0xc1, 0x62, 0xa7, 0xab, 0x77, 0x9a, 0x9f, 0x54, 0x8a, 0xac, 0xbe, 0x6a, 0xf5, 0xf2, 0xf3,
-	0x8c, 0xef, 0xc1, 0x6c, 0x63, 0xd7, 0xbc, 0xb1, 0xb3, 0x1e, 0xc3, 0xfb, 0x11, 0xc1, 0x93, 0xd4,
-	0xaf, 0x13, 0x51, 0x90, 0x20, 0x58, 0xae, 0xa1, 0xe0, 0x90, 0x15, 0xf4, 0xd1, 0x46, 0x84, 0x4a,
-	0xe7, 0x02, 0x58, 0xe1, 0x8a, 0x64, 0x80, 0x4d, 0x82, 0xa0, 0x01, 0x96, 0x08, 0xc5, 0x96, 0xaa,
-	0xa3, 0xa6, 0x89, 0x35, 0x34, 0xf6, 0xc0, 0xb6, 0xeb, 0x88, 0xd9, 0x86, 0xbf, 0xb4, 0x8b, 0x35,
-	0xc4, 0x5c, 0x20, 0x5f, 0xbe, 0xa9, 0x48, 0x8b, 0x92, 0x25, 0xdc, 0xab, 0x06, 0x0f, 0xc1, 0xf2,
-	0xe8, 0x93, 0x06, 0x16, 0x27, 0xf9, 0x64, 0x69, 0xae, 0x9c, 0xd9, 0x5c, 0xaf, 0x70, 0x16, 0xaf,
-	0x4c, 0xf1, 0x6b, 0x35, 0x75, 0xea, 0x88, 0x09, 0x65, 0xc9, 0x8e, 0x54, 0x89, 0xf4, 0x7d, 0x1e,
-	0xe4, 0xc2, 0x96, 0xad, 0x60, 0x89, 0xd8, 0xc6, 0x75, 0x19, 0xbc, 0x01, 0x6e, 0x50, 0x3c, 0xe8,
-	0xb6, 0x3d, 0x81, 0x24, 0x13, 0x78, 0xec, 0x3a, 0x62, 0xfa, 0xc0, 0xab, 0x31, 0xf2, 0x19, 0xcc,
-	0x11, 0x80, 0x95, 0x34, 0x63, 0xda, 0xd1, 0xa0, 0x0d, 0x72, 0xf1, 0x99, 0xf1, 0x6d, 0xfb, 0xc2,
-	0x75, 0xc4, 0xd5, 0xad, 0x2b, 0x25, 0x66, 0x35, 0x2e, 0x2f, 0x11, 0x59, 0x9d, 0x93, 0x4d, 0xc5,
-	0xc8, 0xd6, 0xfe, 0x51, 0xb6, 0x16, 0x95, 0xad, 0x8d, 0x65, 0x5f, 0x4f, 0xc8, 0x06, 0x21, 0xf5,
-	0x13, 0xb1, 0x36, 0x21, 0x1b, 0x44, 0x34, 0x4a, 0x36, 0x19, 0xd0, 0x85, 0x2b, 0x05, 0xb4, 0x35,
-	0x11, 0xd0, 0x34, 0xe3, 0x7b, 0xea, 0x85, 0xe3, 0x15, 0x1f, 0xbc, 0xab, 0x26, 0xf6, 0x87, 0x00,
-	0xb2, 0x23, 0xe7, 0xb2, 0xbc, 0x5e, 0x73, 0x32, 0xdf, 0x80, 0x6c, 0x98, 0x4b, 0x2f, 0x39, 0xcc,
-	0xc7, 0x99, 0x4d, 0x29, 0x3e, 0x96, 0x7c, 0xc6, 0x82, 0x50, 0xde, 0x6a, 0x73, 0x35, 0x69, 0x19,
-	0x2c, 0x86, 0x18, 0x76, 0xd1, 0x48, 0x3f, 0x05, 0x00, 0xfd, 0x52, 0x55, 0xa5, 0xed, 0xce, 0xff,
-	0x99, 0x72, 0x0f, 0x2c, 0x46, 0xa6, 0x1c, 0xdd, 0x3e, 0xb3, 0x8f, 0x99, 0xe5, 0xc7, 0x24, 0x52,
-	0x0e, 0xac, 0x46, 0x86, 0xf2, 0x87, 0xdd, 0xfc, 0x9a, 0x04, 0x2b, 0x21, 0x8b, 0x12, 0xfc, 0x96,
-	0xc3, 0xb7, 0xe0, 0x66, 0x78, 0x01, 0xc3, 0xbb, 0x11, 0xc9, 0xbf, 0x6f, 0xeb, 0x42, 0x71, 0xda,
-	0x72, 0xf0, 0x39, 0x13, 0x65, 0xe1, 0xa1, 0x00, 0x5f, 0x82, 0x05, 0x5f, 0x1e, 0x16, 0x22, 0xf8,
-	0x88, 0x93, 0x0a, 0x77, 0x62, 0xd7, 0xc6, 0x44, 0xf0, 0x00, 0x64, 0xb8, 0x29, 0xa0, 0x18, 0x83,
-	0xe7, 0x0f, 0xad, 0x50, 0x9a, 0x0e, 0x18, 0xb3, 0x56, 0x9f, 0x9d, 0xba, 0x45, 0xe1, 0xcc, 0x2d,
-	0x0a, 0xdf, 0x86, 0xc5, 0xc4, 0xc9, 0xb0, 0x28, 0x9c, 0x0d, 0x8b, 0x89, 0xf3, 0x61, 0x31, 0xf1,
-	0x41, 0x9a, 0x7a, 0x8c, 0xe1, 0x9f, 0xa1, 0xd6, 0x02, 0x7b, 0x7e, 0xf4, 0x27, 0x00, 0x00, 0xff,
-	0xff, 0xb2, 0xcb, 0x7a, 0x75, 0x21, 0x09, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0xb7, 0x69, 0xb6, 0x8c, 0xf1, 0x76, 0x77, 0x96, 0x68, 0x43, 0x10, 0xf1, 0xca, 0xaa,
+	0xd0, 0x82, 0x54, 0x1b, 0x85, 0x4b, 0x05, 0x1c, 0x68, 0x36, 0xab, 0x10, 0x11, 0xb6, 0xd4, 0xc9,
+	0x82, 0xc4, 0x25, 0x72, 0xe2, 0x59, 0xc7, 0x8a, 0xe3, 0x31, 0x9e, 0x49, 0x11, 0xe2, 0x06, 0x7f,
+	0xa0, 0x3f, 0x80, 0x43, 0x7f, 0x4e, 0x0f, 0x1c, 0x2a, 0x71, 0xe9, 0xc9, 0x48, 0xc9, 0x85, 0xdf,
+	0xb0, 0x27, 0xe4, 0x19, 0xc7, 0x1e, 0x6f, 0x1c, 0x35, 0x6d, 0xa5, 0xed, 0xcd, 0x7e, 0xf3, 0xde,
+	0xf7, 0xcd, 0xf3, 0x9b, 0xef, 0x1b, 0x83, 0x7b, 0x41, 0x88, 0x29, 0x36, 0x88, 0x1f, 0x8c, 0x0c,
+	0x0f, 0x3b, 0x43, 0x42, 0x43, 0x64, 0xcd, 0x86, 0x21, 0x0a, 0x70, 0x48, 0x51, 0xa8, 0xb3, 0x65,
+	0x28, 0x3f, 0xb1, 0x42, 0x0f, 0x3b, 0x7a, 0x9c, 0x56, 0xbf, 0xef, 0xb8, 0x74, 0x32, 0x1f, 0xe9,
+	0x63, 0x3c, 0x33, 0x1c, 0xec, 0x60, 0x83, 0xe5, 0x8c, 0xe6, 0x97, 0xec, 0x8d, 0xe3, 0xc5, 0x4f,
+	0xbc, 0xb6, 0xae, 0x3a, 0x18, 0x3b, 0x1e, 0xca, 0xb2, 0xa8, 0x3b, 0x43, 0x84, 0x5a, 0xb3, 0x80,
+	0x27, 0x68, 0x7f, 0x55, 0xc0, 0x51, 0x0f, 0x3b, 0x7d, 0xc6, 0x7c, 0xe1, 0x8f, 0xf1, 0x6c, 0xe6,
+	0x52, 0x93, 0x6d, 0x00, 0xda, 0x40, 0x11, 0x76, 0xe5, 0xda, 0x35, 0xe9, 0x58, 0x3a, 0xb9, 0xdd,
+	0xfa, 0x66, 0x11, 0xa9, 0x72, 0x5a, 0xd3, 0x6d, 0x5f, 0x45, 0xaa, 0xb8, 0xab, 0xa9, 0x35, 0xb5,
+	0xb0, 0xc1, 0xf7, 0x6c, 0x04, 0x53, 0xc7, 0xa0, 0xbf, 0x05, 0x88, 0xe8, 0x42, 0x81, 0x29, 0x7b,
+	0xe9, 0x8b, 0x0d, 0x7f, 0x07, 0x47, 0xf3, 0x84, 0x97, 0x22, 0x7b, 0xe8, 0x79, 0xc4, 0x1f, 0xe2,
+	0xcb, 0x4b, 0x82, 0x68, 0x6d, 0xe7, 0x58, 0x3a, 0x29, 0xb7, 0x4e, 0x17, 0x91, 0x5a, 0xbd, 0xc8,
+	0x52, 0x7a, 0xbd, 0xfe, 0xf9, 0x23, 0x96, 0x70, 0x15, 0xa9, 0x9f, 0x6c, 0xc1, 0xdc, 0xeb, 0x9f,
+	0x9b, 0x55, 0x81, 0xa3, 0xe7, 0x11, 0x9f, 0x03, 0xc0, 0xc7, 0x05, 0xe4, 0x1e, 0xf2, 0x1d, 0x3a,
+	0xa9, 0xdd, 0x62, 0xe4, 0x1f, 0x16, 0x90, 0xf7, 0x58, 0xc2, 0x1a, 0x24, 0x0f, 0xc3, 0x0e, 0xd8,
+	0x7d, 0x82, 0x42, 0xe2, 0x62, 0xbf, 0x56, 0x66, 0x10, 0xf7, 0xaf, 0x22, 0xf5, 0xd3, 0x57, 0x6f,
+	0xf3, 0x47, 0x5e, 0x64, 0xae, 0xaa, 0xe1, 0x63, 0xb0, 0x37, 0x71, 0x9d, 0xc9, 0xf0, 0x57, 0x8b,
+	0xa2, 0x70, 0x66, 0x85, 0xd3, 0xda, 0x6d, 0x86, 0xf7, 0xd9, 0x76, 0x6d, 0x77, 0xe2, 0xb6, 0x95,
+	0x18, 0xe1, 0xa7, 0x15, 0x00, 0x7c, 0x00, 0xf6, 0x68, 0x68, 0xf9, 0xc4, 0x1a, 0x53, 0x17, 0xfb,
+	0xf1, 0x48, 0x2b, 0x0c, 0xf2, 0x60, 0x11, 0xa9, 0xca, 0x20, 0x5b, 0xe9, 0xb6, 0x4d, 0x45, 0x48,
+	0xe4, 0x53, 0x12, 0x2b, 0xc5, 0x29, 0xed, 0x66, 0x53, 0x12, 0x20, 0xde, 0x6c, 0x4a, 0x02, 0x87,
+	0x30, 0xa5, 0x10, 0x7c, 0xb0, 0x46, 0x8e, 0x7c, 0xbb, 0x76, 0x87, 0x31, 0xc7, 0xe7, 0x11, 0x5e,
+	0x63, 0x3e, 0xf3, 0xed, 0xd7, 0xa0, 0x85, 0xd7, 0x68, 0xcf, 0x7c, 0xfb, 0xcb, 0xf2, 0x7f, 0xcf,
+	0x54, 0x49, 0x83, 0x60, 0xbf, 0x83, 0x12, 0x3d, 0x98, 0xe8, 0x97, 0x39, 0x22, 0x54, 0x7b, 0x29,
+	0x81, 0x03, 0x21, 0x48, 0x02, 0xec, 0x13, 0x04, 0x3d, 0x70, 0x97, 0x50, 0x1c, 0x5a, 0x0e, 0x1a,
+	0xfa, 0xd8, 0x46, 0x99, 0x5c, 0xda, 0xf1, 0xb7, 0xed, 0xf3, 0xa5, 0x73, 0x6c, 0x23, 0x26, 0x18,
+	0xe3, 0xd5, 0x3b, 0xcb, 0x95, 0x98, 0x0a, 0x11, 0x5e, 0x6d, 0x78, 0x01, 0xf6, 0x57, 0xa7, 0x2f,
+	0xb1, 0x0b, 0x52, 0xdb, 0x39, 0xbe, 0x75, 0x22, 0x37, 0xef, 0xe9, 0x82, 0x5d, 0xe8, 0x1b, 0xa4,
+	0xdd, 0x2a, 0x3f, 0x8f, 0xd4, 0x92, 0x79, 0x77, 0x9e, 0x8b, 0x12, 0xed, 0xcf, 0x0a, 0xa8, 0xa6,
+	0x25, 0xa7, 0xc9, 0x12, 0x99, 0x7b, 0x37, 0xe5, 0x05, 0x7d, 0x70, 0x87, 0xe2, 0xc0, 0x1d, 0xc7,
+	0x04, 0x3b, 0x8c, 0xe0, 0xc1, 0x22, 0x52, 0x77, 0x07, 0x71, 0xac, 0xdb, 0xde, 0x4e, 0x47, 0x49,
+	0xb2, 0xb9, 0xcb, 0x90, 0xba, 0x36, 0x9c, 0x83, 0x6a, 0xb1, 0xbd, 0x70, 0x85, 0x3f, 0x5c, 0x44,
+	0xea, 0xe1, 0xe9, 0x5b, 0x99, 0xcb, 0x61, 0x91, 0xb5, 0xe4, 0x68, 0x1d, 0x81, 0xb6, 0x5c, 0x40,
+	0xdb, 0x79, 0x4d, 0xda, 0x4e, 0x9e, 0xb6, 0x93, 0xd1, 0x7e, 0xb7, 0x46, 0x9b, 0xf8, 0x19, 0x37,
+	0x8f, 0xa3, 0x35, 0xda, 0xc4, 0xcd, 0xf2, 0x60, 0xeb, 0x5e, 0x56, 0x79, 0x2b, 0x2f, 0x1b, 0xad,
+	0x79, 0x19, 0x77, 0x8d, 0xaf, 0x62, 0x71, 0x7c, 0x2b, 0x7a, 0xd4, 0x9b, 0x9b, 0xdb, 0x43, 0x20,
+	0x27, 0x8a, 0x88, 0x2f, 0x39, 0x66, 0x0e, 0x72, 0xb3, 0xae, 0xf3, 0x1b, 0x50, 0x5f, 0xdd, 0x80,
+	0xfa, 0x60, 0x75, 0x03, 0xb6, 0xca, 0x4f, 0xff, 0x55, 0x25, 0x13, 0xf0, 0xa2, 0x38, 0x9c, 0x88,
+	0xfe, 0x6f, 0x09, 0x28, 0xab, 0xc3, 0xcf, 0x24, 0x7f, 0xc3, 0xe2, 0xfe, 0x1e, 0x28, 0xa9, 0xb4,
+	0x63, 0xf1, 0x31, 0x29, 0xc8, 0x4d, 0xad, 0x58, 0xd9, 0xa2, 0x4c, 0x13, 0x5d, 0xbf, 0x3f, 0x16,
+	0x62, 0xda, 0x3e, 0xd8, 0x4b, 0x73, 0x98, 0x57, 0x69, 0xff, 0x48, 0x00, 0xf2, 0x50, 0xcb, 0xa2,
+	0xe3, 0xc9, 0xbb, 0xe9, 0xf2, 0x11, 0xd8, 0xcb, 0x75, 0xb9, 0x32, 0xb0, 0xed, 0xdb, 0x54, 0xc4,
+	0x36, 0x89, 0x56, 0x05, 0x87, 0xb9, 0xa6, 0x78, 0xb3, 0xcd, 0x3f, 0x76, 0xc0, 0x41, 0x8a, 0x62,
+	0x26, 0xbf, 0x56, 0xf0, 0x07, 0xf0, 0x5e, 0xea, 0xe1, 0xf0, 0xe3, 0x1c, 0xe5, 0x75, 0xc3, 0xaf,
+	0x37, 0x36, 0x2d, 0x27, 0x9f, 0xb3, 0x74, 0x22, 0x7d, 0x2e, 0xc1, 0x33, 0x50, 0xe1, 0xf4, 0xb0,
+	0x9e, 0xcb, 0xcf, 0x9d, 0xa4, 0xfa, 0x47, 0x85, 0x6b, 0x19, 0x10, 0x1c, 0x00, 0x59, 0xe8, 0x02,
+	0xaa, 0x05, 0xf9, 0xe2, 0xd0, 0xea, 0xc7, 0x9b, 0x13, 0x32, 0xd4, 0xd6, 0xd7, 0xcf, 0x17, 0x0d,
+	0xe9, 0xc5, 0xa2, 0x21, 0x3d, 0x5d, 0x36, 0x4a, 0xcf, 0x96, 0x0d, 0xe9, 0xc5, 0xb2, 0x51, 0x7a,
+	0xb9, 0x6c, 0x94, 0x7e, 0xd6, 0x36, 0x8e, 0x31, 0xfd, 0x37, 0x1d, 0x55, 0xd8, 0xf3, 0x17, 0xff,
+	0x0f, 0x00, 0x68, 0x4f, 0x1f, 0x59, 0xb0, 0x0a, 0x00, 0x00,
 }
 
 func (this *LogStreamUncommitReport) Equal(that interface{}) bool {
@@ -669,6 +725,15 @@ func (this *LogStreamUncommitReport) Equal(that interface{}) bool {
 	if this.HighWatermark != that1.HighWatermark {
 		return false
 	}
+	if this.TransactionID != that1.TransactionID {
+		return false
+	}
+	if this.TransactionLLSNOffset != that1.TransactionLLSNOffset {
+		return false
+	}
+	if this.TransactionLLSNEnd != that1.TransactionLLSNEnd {
+		return false
+	}
 	return true
 }
 func (this *LogStreamCommitResult) Equal(that interface{}) bool {
@@ -711,6 +776,13 @@ func (this *LogStreamCommitResult) Equal(that interface{}) bool {
 	if this.HighWatermark != that1.HighWatermark {
 		return false
 	}
+	if that1.CommitTime == nil {
+		if this.CommitTime != nil {
+			return false
+		}
+	} else if !this.CommitTime.Equal(*that1.CommitTime) {
+		return false
+	}
 	return true
 }
 
@@ -986,6 +1058,21 @@ func (m *LogStreamUncommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.TransactionLLSNEnd != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.TransactionLLSNEnd))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TransactionLLSNOffset != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.TransactionLLSNOffset))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TransactionID != 0 {
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.HighWatermark != 0 {
 		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.HighWatermark))
 		i--
@@ -1099,6 +1186,16 @@ func (m *LogStreamCommitResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CommitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CommitTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLogStreamReporter(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.HighWatermark != 0 {
 		i = encodeVarintLogStreamReporter(dAtA, i, uint64(m.HighWatermark))
 		i--
@@ -1295,6 +1392,15 @@ func (m *LogStreamUncommitReport) ProtoSize() (n int) {
 	if m.HighWatermark != 0 {
 		n += 1 + sovLogStreamReporter(uint64(m.HighWatermark))
 	}
+	if m.TransactionID != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.TransactionID))
+	}
+	if m.TransactionLLSNOffset != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.TransactionLLSNOffset))
+	}
+	if m.TransactionLLSNEnd != 0 {
+		n += 1 + sovLogStreamReporter(uint64(m.TransactionLLSNEnd))
+	}
 	return n
 }
 
@@ -1352,6 +1458,10 @@ func (m *LogStreamCommitResult) ProtoSize() (n int) {
 	if m.HighWatermark != 0 {
 		n += 1 + sovLogStreamReporter(uint64(m.HighWatermark))
 	}
+	if m.CommitTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CommitTime)
+		n += 1 + l + sovLogStreamReporter(uint64(l))
+	}
 	return n
 }
 
@@ -1535,6 +1645,63 @@ func (m *LogStreamUncommitReport) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			m.TransactionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionLLSNOffset", wireType)
+			}
+			m.TransactionLLSNOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionLLSNOffset |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionLLSNEnd", wireType)
+			}
+			m.TransactionLLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionLLSNEnd |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
@@ -1871,6 +2038,42 @@ func (m *LogStreamCommitResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogStreamReporter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogStreamReporter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitTime == nil {
+				m.CommitTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CommitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogStreamReporter(dAtA[iNdEx:])
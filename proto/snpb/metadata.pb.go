@@ -149,6 +149,14 @@ type LogStreamReplicaMetadataDescriptor struct {
 	//
 	// Deprecated:
 	UpdatedTime time.Time `protobuf:"bytes,10,opt,name=updated_time,json=updatedTime,proto3,stdtime" json:"updatedTime"`
+	// InflightAppends is the number of append requests being processed by the
+	// log stream replica. Clients use it as a hint of the load on the log
+	// stream.
+	InflightAppends int64 `protobuf:"varint,11,opt,name=inflight_appends,json=inflightAppends,proto3" json:"inflightAppends,omitempty"`
+	// UncommittedLLSNLength is the number of log entries written to the log
+	// stream replica but not committed yet. A long backlog means that the log
+	// stream replica falls behind appends.
+	UncommittedLLSNLength uint64 `protobuf:"varint,12,opt,name=uncommitted_llsn_length,json=uncommittedLlsnLength,proto3" json:"uncommittedLLSNLength,omitempty"`
 }
 
 func (m *LogStreamReplicaMetadataDescriptor) Reset()         { *m = LogStreamReplicaMetadataDescriptor{} }
@@ -247,6 +255,20 @@ func (m *LogStreamReplicaMetadataDescriptor) GetUpdatedTime() time.Time {
 	return time.Time{}
 }
 
+func (m *LogStreamReplicaMetadataDescriptor) GetInflightAppends() int64 {
+	if m != nil {
+		return m.InflightAppends
+	}
+	return 0
+}
+
+func (m *LogStreamReplicaMetadataDescriptor) GetUncommittedLLSNLength() uint64 {
+	if m != nil {
+		return m.UncommittedLLSNLength
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StorageNodeMetadataDescriptor)(nil), "varlog.snpb.StorageNodeMetadataDescriptor")
 	proto.RegisterType((*LogStreamReplicaMetadataDescriptor)(nil), "varlog.snpb.LogStreamReplicaMetadataDescriptor")
@@ -255,53 +277,59 @@ func init() {
 func init() { proto.RegisterFile("proto/snpb/metadata.proto", fileDescriptor_b0d7c3885ca513ae) }
 
 var fileDescriptor_b0d7c3885ca513ae = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x21, 0x04, 0x32, 0xe1, 0x3d, 0xc1, 0xf0, 0x10, 0x26, 0xef, 0xbd, 0x38, 0xcd, 0xa2,
-	0x4a, 0xd5, 0x62, 0x4b, 0x74, 0x53, 0xa1, 0x6e, 0xea, 0x22, 0x51, 0x24, 0x40, 0x95, 0x53, 0x51,
-	0xa9, 0x52, 0x65, 0x4d, 0x92, 0xa9, 0x63, 0xc5, 0xce, 0xb8, 0x33, 0x63, 0x10, 0x7c, 0x05, 0x9f,
-	0xc0, 0xae, 0xbf, 0xc2, 0x92, 0x55, 0xd5, 0x95, 0x2b, 0x91, 0x4d, 0x95, 0x4f, 0x60, 0x55, 0x79,
-	0x3c, 0x8e, 0x53, 0x87, 0x28, 0xec, 0x66, 0xee, 0xbd, 0xe7, 0xdc, 0x7b, 0xcf, 0x9c, 0xc4, 0x60,
-	0x3b, 0xa0, 0x84, 0x13, 0x83, 0x0d, 0x82, 0xb6, 0xe1, 0x63, 0x8e, 0xba, 0x88, 0x23, 0x5d, 0xc4,
-	0x60, 0xe5, 0x0c, 0x51, 0x8f, 0x38, 0x7a, 0x9c, 0xab, 0xee, 0x38, 0x2e, 0xef, 0x85, 0x6d, 0xbd,
-	0x43, 0x7c, 0xc3, 0x21, 0x0e, 0x31, 0x44, 0x4d, 0x3b, 0xfc, 0x22, 0x6e, 0x09, 0x49, 0x7c, 0x4a,
-	0xb0, 0xd5, 0x7f, 0x1d, 0x42, 0x1c, 0x0f, 0x67, 0x55, 0xd8, 0x0f, 0xf8, 0x85, 0x4c, 0x6a, 0xf9,
-	0x24, 0x77, 0x7d, 0xcc, 0x38, 0xf2, 0x03, 0x59, 0xb0, 0x95, 0x74, 0x9e, 0x1a, 0xa9, 0xf1, 0xad,
-	0x08, 0xfe, 0x6f, 0x71, 0x42, 0x91, 0x83, 0x4f, 0x48, 0x17, 0x1f, 0xcb, 0xec, 0x3e, 0x66, 0x1d,
-	0xea, 0x06, 0x9c, 0x50, 0xd8, 0x03, 0xa0, 0xe3, 0x85, 0x8c, 0x63, 0x6a, 0xbb, 0x5d, 0x55, 0xa9,
-	0x2b, 0xcd, 0xbf, 0xcc, 0xc3, 0xbb, 0x48, 0x2b, 0xbf, 0x4d, 0xa2, 0x87, 0xfb, 0xa3, 0x48, 0x2b,
-	0xcb, 0x92, 0xc3, 0xee, 0x7d, 0xa4, 0x3d, 0x9f, 0xd8, 0xac, 0x8f, 0xfa, 0x88, 0x18, 0x49, 0x77,
-	0x23, 0xe8, 0x3b, 0x06, 0xbf, 0x08, 0x30, 0xd3, 0xc7, 0x58, 0x2b, 0x43, 0xc2, 0x63, 0xb0, 0xca,
-	0x92, 0x51, 0xec, 0x01, 0xe9, 0x62, 0x75, 0xa1, 0xae, 0x34, 0x2b, 0xbb, 0xff, 0xe9, 0x52, 0xb5,
-	0x74, 0x05, 0x7d, 0x62, 0x5e, 0x73, 0xf5, 0x26, 0xd2, 0x0a, 0xb7, 0x91, 0xa6, 0x8c, 0x22, 0xad,
-	0x60, 0x55, 0x58, 0x96, 0x82, 0xfb, 0x60, 0x45, 0x5e, 0x99, 0xba, 0x58, 0x5f, 0x6c, 0x56, 0x76,
-	0x1b, 0xb3, 0xa8, 0xb2, 0x75, 0xcd, 0x62, 0x4c, 0x68, 0x8d, 0x91, 0x90, 0x81, 0x0d, 0x8f, 0x38,
-	0x36, 0xe3, 0x14, 0x23, 0xdf, 0xa6, 0x38, 0xf0, 0xdc, 0x0e, 0x62, 0x6a, 0x51, 0x10, 0x1a, 0xfa,
-	0xc4, 0x8b, 0xea, 0x47, 0xc4, 0x69, 0x89, 0x32, 0x2b, 0xa9, 0x9a, 0x16, 0xd3, 0x84, 0x31, 0xfb,
-	0x28, 0xd2, 0x80, 0x97, 0xd6, 0x32, 0x6b, 0xdd, 0xcb, 0xe1, 0x18, 0xdc, 0x03, 0x25, 0xc6, 0x11,
-	0x0f, 0x99, 0xba, 0x54, 0x57, 0x9a, 0x7f, 0xcf, 0x1e, 0x3c, 0x5e, 0xb4, 0x25, 0x2a, 0x2d, 0x89,
-	0x80, 0xef, 0x01, 0x60, 0x1c, 0x51, 0x6e, 0xc7, 0x1e, 0x50, 0x4b, 0x42, 0xc3, 0xaa, 0x9e, 0x18,
-	0x44, 0x4f, 0x0d, 0xa2, 0x7f, 0x48, 0x0d, 0x62, 0x6e, 0xca, 0x91, 0xca, 0x02, 0x15, 0xc7, 0xaf,
-	0x7e, 0x6a, 0x8a, 0x95, 0x5d, 0xf7, 0x8a, 0xbf, 0xae, 0x35, 0xa5, 0xf1, 0xbd, 0x04, 0x1a, 0xf3,
-	0x37, 0x84, 0x9f, 0x01, 0x9c, 0xd6, 0x4b, 0xd8, 0xa6, 0xb2, 0xfb, 0x64, 0x6a, 0x8d, 0x3c, 0x61,
-	0xee, 0x3d, 0xd7, 0xf2, 0xd2, 0xc0, 0x57, 0x63, 0x65, 0x16, 0x84, 0x32, 0xf5, 0xd9, 0x94, 0x39,
-	0x5d, 0x0e, 0xc0, 0xf2, 0x19, 0xa6, 0xcc, 0x25, 0x03, 0x75, 0xb1, 0xae, 0x34, 0x8b, 0xe6, 0xce,
-	0x7d, 0xa4, 0x3d, 0x9b, 0x6f, 0xd5, 0xd3, 0x04, 0x64, 0xa5, 0x68, 0x18, 0x82, 0x4d, 0xc7, 0x23,
-	0x6d, 0xe4, 0xd9, 0x3d, 0xd7, 0xe9, 0xd9, 0xe7, 0x88, 0x63, 0xea, 0x23, 0xda, 0x57, 0x8b, 0x82,
-	0xf6, 0xcd, 0x28, 0xd2, 0x36, 0x92, 0x82, 0x77, 0xae, 0xd3, 0xfb, 0x98, 0xa6, 0xef, 0x23, 0xed,
-	0xe9, 0xfc, 0x6e, 0x07, 0x47, 0xad, 0x13, 0xeb, 0x21, 0x38, 0xf4, 0x63, 0x23, 0x76, 0x90, 0x67,
-	0x7b, 0xe4, 0x7c, 0xa2, 0xe9, 0x92, 0x50, 0xb6, 0xf1, 0xa0, 0x0c, 0xf8, 0x6b, 0x88, 0x07, 0x1d,
-	0x7c, 0x12, 0xfa, 0x6d, 0x4c, 0xcd, 0x6d, 0xf9, 0xd0, 0xeb, 0x82, 0xe6, 0x88, 0x9c, 0x8f, 0xb9,
-	0xad, 0xe9, 0x10, 0x0c, 0xc0, 0x3f, 0x49, 0xbb, 0xdc, 0x92, 0xa5, 0x47, 0xf7, 0xab, 0xca, 0x7e,
-	0x50, 0xf0, 0xfc, 0xb1, 0x8c, 0xf5, 0x40, 0x0c, 0x42, 0x50, 0x0c, 0x10, 0xef, 0xa9, 0xcb, 0x75,
-	0xa5, 0x59, 0xb6, 0xc4, 0x19, 0xbe, 0x00, 0x30, 0xfd, 0x4b, 0x60, 0xee, 0x25, 0xb6, 0xdb, 0x17,
-	0x1c, 0x33, 0x75, 0x25, 0x16, 0xda, 0x5a, 0x93, 0x99, 0x96, 0x7b, 0x89, 0xcd, 0x38, 0x0e, 0x4f,
-	0xc1, 0x6a, 0x87, 0x62, 0xc4, 0x71, 0x37, 0x31, 0x7f, 0x79, 0xae, 0xf9, 0xb7, 0xe4, 0x8c, 0x15,
-	0x89, 0x1b, 0xdb, 0x7f, 0x32, 0x10, 0xf3, 0x86, 0x41, 0x37, 0xe3, 0x05, 0x8f, 0xe7, 0x95, 0xb8,
-	0x8c, 0x77, 0x22, 0x90, 0xfc, 0xb0, 0xcc, 0xd7, 0x37, 0x77, 0x35, 0xe5, 0xf6, 0xae, 0xa6, 0x5c,
-	0x0d, 0x6b, 0x85, 0xeb, 0x61, 0x4d, 0xb9, 0x1d, 0xd6, 0x0a, 0x3f, 0x86, 0xb5, 0xc2, 0xa7, 0xc6,
-	0x4c, 0xbf, 0x8c, 0x3f, 0x31, 0xed, 0x92, 0x38, 0xbf, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa6,
-	0xcd, 0x63, 0x29, 0x77, 0x06, 0x00, 0x00,
+	// 817 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
+	0x1c, 0xb5, 0x1a, 0x37, 0x8d, 0xe9, 0x6c, 0x4b, 0xd9, 0x05, 0x51, 0xb2, 0xd5, 0xd4, 0x7c, 0x18,
+	0x3c, 0xac, 0x95, 0x80, 0xec, 0x32, 0x14, 0xbd, 0x54, 0x0b, 0xd0, 0x06, 0x70, 0x83, 0x41, 0xde,
+	0x3a, 0x60, 0xc0, 0x20, 0xd0, 0x12, 0x2b, 0x09, 0xa1, 0x44, 0x4d, 0xa4, 0x1a, 0xa4, 0x9f, 0xa2,
+	0xa7, 0x9d, 0x7b, 0xdb, 0x57, 0xe9, 0x31, 0xc7, 0x9d, 0x34, 0xc0, 0xbe, 0x0c, 0xfe, 0x08, 0x39,
+	0x0d, 0xa2, 0x24, 0x4b, 0x95, 0x6d, 0xb8, 0x37, 0xf1, 0xf7, 0x7b, 0xef, 0xfd, 0xfe, 0xf0, 0x81,
+	0x02, 0xc7, 0x71, 0xc2, 0x04, 0x33, 0x78, 0x14, 0x4f, 0x8d, 0x90, 0x08, 0xec, 0x62, 0x81, 0x75,
+	0x19, 0x83, 0xfd, 0x37, 0x38, 0xa1, 0xcc, 0xd3, 0xf3, 0xdc, 0xc9, 0x63, 0x2f, 0x10, 0x7e, 0x3a,
+	0xd5, 0x1d, 0x16, 0x1a, 0x1e, 0xf3, 0x98, 0x21, 0x31, 0xd3, 0xf4, 0xb5, 0x3c, 0x15, 0x22, 0xf9,
+	0x57, 0xc1, 0x3d, 0xf9, 0xca, 0x63, 0xcc, 0xa3, 0xa4, 0x46, 0x91, 0x30, 0x16, 0xd7, 0x65, 0x12,
+	0xb5, 0x93, 0x22, 0x08, 0x09, 0x17, 0x38, 0x8c, 0x4b, 0xc0, 0x51, 0x51, 0x79, 0xa5, 0xa5, 0xe1,
+	0xdf, 0x5d, 0xf0, 0x70, 0x22, 0x58, 0x82, 0x3d, 0x72, 0xc1, 0x5c, 0xf2, 0xb2, 0xcc, 0x9e, 0x11,
+	0xee, 0x24, 0x41, 0x2c, 0x58, 0x02, 0x7d, 0x00, 0x1c, 0x9a, 0x72, 0x41, 0x12, 0x3b, 0x70, 0x55,
+	0x45, 0x53, 0x46, 0x9f, 0x99, 0xe7, 0xb3, 0x0c, 0xf5, 0x7e, 0x2a, 0xa2, 0xe7, 0x67, 0x8b, 0x0c,
+	0xf5, 0x4a, 0xc8, 0xb9, 0x7b, 0x9b, 0xa1, 0xef, 0x1b, 0x93, 0x5d, 0xe2, 0x4b, 0xcc, 0x8c, 0xa2,
+	0xba, 0x11, 0x5f, 0x7a, 0x86, 0xb8, 0x8e, 0x09, 0xd7, 0x97, 0x5c, 0xab, 0x66, 0xc2, 0x97, 0x60,
+	0x9f, 0x17, 0xad, 0xd8, 0x11, 0x73, 0x89, 0x7a, 0x47, 0x53, 0x46, 0xfd, 0xd3, 0xaf, 0xf5, 0x72,
+	0x6b, 0xd5, 0x08, 0x7a, 0xa3, 0x5f, 0x73, 0xff, 0x43, 0x86, 0x3a, 0x37, 0x19, 0x52, 0x16, 0x19,
+	0xea, 0x58, 0x7d, 0x5e, 0xa7, 0xe0, 0x19, 0xd8, 0x2b, 0x8f, 0x5c, 0xdd, 0xd1, 0x76, 0x46, 0xfd,
+	0xd3, 0xe1, 0x26, 0xa9, 0x7a, 0x5c, 0xb3, 0x9b, 0x0b, 0x5a, 0x4b, 0x26, 0xe4, 0xe0, 0x01, 0x65,
+	0x9e, 0xcd, 0x45, 0x42, 0x70, 0x68, 0x27, 0x24, 0xa6, 0x81, 0x83, 0xb9, 0xda, 0x95, 0x82, 0x86,
+	0xde, 0xb8, 0x51, 0x7d, 0xcc, 0xbc, 0x89, 0x84, 0x59, 0x05, 0x6a, 0x75, 0x99, 0x26, 0xcc, 0xd5,
+	0x17, 0x19, 0x02, 0xb4, 0xc2, 0x72, 0xeb, 0x3e, 0x6d, 0xf1, 0x38, 0x7c, 0x02, 0x76, 0xb9, 0xc0,
+	0x22, 0xe5, 0xea, 0x5d, 0x4d, 0x19, 0x7d, 0xbe, 0xb9, 0xf1, 0x7c, 0xd0, 0x89, 0x44, 0x5a, 0x25,
+	0x03, 0xfe, 0x0c, 0x00, 0x17, 0x38, 0x11, 0x76, 0xee, 0x01, 0x75, 0x57, 0xee, 0xf0, 0x44, 0x2f,
+	0x0c, 0xa2, 0x57, 0x06, 0xd1, 0x7f, 0xa9, 0x0c, 0x62, 0x1e, 0x96, 0x2d, 0xf5, 0x24, 0x2b, 0x8f,
+	0xbf, 0xfb, 0x17, 0x29, 0x56, 0x7d, 0x7c, 0xd2, 0xfd, 0xef, 0x3d, 0x52, 0x86, 0x7f, 0xed, 0x81,
+	0xe1, 0xf6, 0x09, 0xe1, 0x1f, 0x00, 0xae, 0xee, 0x4b, 0xda, 0xa6, 0x7f, 0xfa, 0xcd, 0xca, 0x18,
+	0x6d, 0xc1, 0xd6, 0x7d, 0x1e, 0xb4, 0x57, 0x03, 0x7f, 0x5c, 0x6e, 0xe6, 0x8e, 0xdc, 0x8c, 0xb6,
+	0x59, 0xb2, 0xb5, 0x97, 0xe7, 0xe0, 0xde, 0x1b, 0x92, 0xf0, 0x80, 0x45, 0xea, 0x8e, 0xa6, 0x8c,
+	0xba, 0xe6, 0xe3, 0xdb, 0x0c, 0x7d, 0xb7, 0xdd, 0xaa, 0xaf, 0x0a, 0x92, 0x55, 0xb1, 0x61, 0x0a,
+	0x0e, 0x3d, 0xca, 0xa6, 0x98, 0xda, 0x7e, 0xe0, 0xf9, 0xf6, 0x15, 0x16, 0x24, 0x09, 0x71, 0x72,
+	0xa9, 0x76, 0xa5, 0xec, 0xb3, 0x45, 0x86, 0x1e, 0x14, 0x80, 0x17, 0x81, 0xe7, 0xff, 0x56, 0xa5,
+	0x6f, 0x33, 0xf4, 0xed, 0xf6, 0x6a, 0xcf, 0xc7, 0x93, 0x0b, 0x6b, 0x1d, 0x1d, 0x86, 0xb9, 0x11,
+	0x1d, 0x4c, 0x6d, 0xca, 0xae, 0x1a, 0x45, 0xef, 0xca, 0xcd, 0x0e, 0xd7, 0xae, 0x81, 0xfc, 0x99,
+	0x92, 0xc8, 0x21, 0x17, 0x69, 0x38, 0x25, 0x89, 0x79, 0x5c, 0x5e, 0xf4, 0x7d, 0x29, 0x33, 0x66,
+	0x57, 0x4b, 0x6d, 0x6b, 0x35, 0x04, 0x63, 0xf0, 0x65, 0x51, 0xae, 0x35, 0xe4, 0xee, 0x27, 0xd7,
+	0x3b, 0x29, 0xeb, 0x41, 0xa9, 0xf3, 0xd1, 0x30, 0xd6, 0x9a, 0x18, 0x84, 0xa0, 0x1b, 0x63, 0xe1,
+	0xab, 0xf7, 0x34, 0x65, 0xd4, 0xb3, 0xe4, 0x37, 0x7c, 0x04, 0x60, 0xf5, 0x24, 0xf0, 0xe0, 0x2d,
+	0xb1, 0xa7, 0xd7, 0x82, 0x70, 0x75, 0x2f, 0x5f, 0xb4, 0x75, 0x50, 0x66, 0x26, 0xc1, 0x5b, 0x62,
+	0xe6, 0x71, 0xf8, 0x0a, 0xec, 0x3b, 0x09, 0xc1, 0x82, 0xb8, 0x85, 0xf9, 0x7b, 0x5b, 0xcd, 0x7f,
+	0x54, 0xf6, 0xd8, 0x2f, 0x79, 0x4b, 0xfb, 0x37, 0x03, 0xb9, 0x6e, 0x1a, 0xbb, 0xb5, 0x2e, 0xf8,
+	0x74, 0xdd, 0x92, 0x57, 0xeb, 0x36, 0x02, 0xf0, 0x05, 0x38, 0x08, 0xa2, 0xd7, 0x34, 0xf0, 0x7c,
+	0x61, 0xe3, 0x38, 0x26, 0x91, 0xcb, 0xd5, 0xbe, 0xa6, 0x8c, 0x76, 0xcc, 0x87, 0x8b, 0x0c, 0x1d,
+	0x57, 0xb9, 0x67, 0x45, 0xea, 0x11, 0x0b, 0x03, 0x21, 0x5f, 0x7d, 0xeb, 0x8b, 0x56, 0x0a, 0x0a,
+	0x70, 0x94, 0x46, 0x0e, 0x0b, 0xc3, 0x40, 0xe4, 0x5d, 0x52, 0xca, 0x23, 0x9b, 0x92, 0xc8, 0x13,
+	0xbe, 0xba, 0x2f, 0x5d, 0xf9, 0x74, 0x96, 0xa1, 0xc3, 0x5f, 0x6b, 0xc8, 0x78, 0x3c, 0xb9, 0x18,
+	0x4b, 0xc0, 0x22, 0x43, 0x28, 0x5d, 0x97, 0x68, 0xd4, 0x3b, 0x6c, 0x02, 0x28, 0x8f, 0x0a, 0x40,
+	0xf1, 0x30, 0x98, 0x4f, 0x3f, 0xcc, 0x06, 0xca, 0xcd, 0x6c, 0xa0, 0xbc, 0x9b, 0x0f, 0x3a, 0xef,
+	0xe7, 0x03, 0xe5, 0x66, 0x3e, 0xe8, 0xfc, 0x33, 0x1f, 0x74, 0x7e, 0x1f, 0x6e, 0xf4, 0xfb, 0xf2,
+	0x17, 0x39, 0xdd, 0x95, 0xdf, 0x3f, 0xfc, 0x3f, 0x00, 0x45, 0x6b, 0x01, 0x77, 0x37, 0x07, 0x00,
+	0x00,
 }
 
 func (this *StorageNodeMetadataDescriptor) Equal(that interface{}) bool {
@@ -402,6 +430,12 @@ func (this *LogStreamReplicaMetadataDescriptor) Equal(that interface{}) bool {
 	if !this.UpdatedTime.Equal(that1.UpdatedTime) {
 		return false
 	}
+	if this.InflightAppends != that1.InflightAppends {
+		return false
+	}
+	if this.UncommittedLLSNLength != that1.UncommittedLLSNLength {
+		return false
+	}
 	return true
 }
 func (m *StorageNodeMetadataDescriptor) Marshal() (dAtA []byte, err error) {
@@ -503,6 +537,16 @@ func (m *LogStreamReplicaMetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.UncommittedLLSNLength != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.UncommittedLLSNLength))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.InflightAppends != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.InflightAppends))
+		i--
+		dAtA[i] = 0x58
+	}
 	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedTime):])
 	if err3 != nil {
 		return 0, err3
@@ -653,6 +697,12 @@ func (m *LogStreamReplicaMetadataDescriptor) ProtoSize() (n int) {
 	n += 1 + l + sovMetadata(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedTime)
 	n += 1 + l + sovMetadata(uint64(l))
+	if m.InflightAppends != 0 {
+		n += 1 + sovMetadata(uint64(m.InflightAppends))
+	}
+	if m.UncommittedLLSNLength != 0 {
+		n += 1 + sovMetadata(uint64(m.UncommittedLLSNLength))
+	}
 	return n
 }
 
@@ -1186,6 +1236,44 @@ func (m *LogStreamReplicaMetadataDescriptor) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflightAppends", wireType)
+			}
+			m.InflightAppends = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflightAppends |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncommittedLLSNLength", wireType)
+			}
+			m.UncommittedLLSNLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncommittedLLSNLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -10,10 +10,9 @@ import (
 
 	types "github.com/gogo/protobuf/types"
 	gomock "github.com/golang/mock/gomock"
+	snpb "github.com/kakao/varlog/proto/snpb"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
-
-	snpb "github.com/kakao/varlog/proto/snpb"
 )
 
 // MockReplicatorClient is a mock of ReplicatorClient interface.
@@ -360,6 +359,26 @@ func (m *MockLogIOClient) EXPECT() *MockLogIOClientMockRecorder {
 	return m.recorder
 }
 
+// AbortTransaction mocks base method.
+func (m *MockLogIOClient) AbortTransaction(arg0 context.Context, arg1 *snpb.AbortTransactionRequest, arg2 ...grpc.CallOption) (*snpb.AbortTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AbortTransaction", varargs...)
+	ret0, _ := ret[0].(*snpb.AbortTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortTransaction indicates an expected call of AbortTransaction.
+func (mr *MockLogIOClientMockRecorder) AbortTransaction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortTransaction", reflect.TypeOf((*MockLogIOClient)(nil).AbortTransaction), varargs...)
+}
+
 // Append mocks base method.
 func (m *MockLogIOClient) Append(arg0 context.Context, arg1 ...grpc.CallOption) (snpb.LogIO_AppendClient, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +419,26 @@ func (mr *MockLogIOClientMockRecorder) LogStreamReplicaMetadata(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamReplicaMetadata", reflect.TypeOf((*MockLogIOClient)(nil).LogStreamReplicaMetadata), varargs...)
 }
 
+// LookupGLSNByTime mocks base method.
+func (m *MockLogIOClient) LookupGLSNByTime(arg0 context.Context, arg1 *snpb.LookupGLSNByTimeRequest, arg2 ...grpc.CallOption) (*snpb.LookupGLSNByTimeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupGLSNByTime", varargs...)
+	ret0, _ := ret[0].(*snpb.LookupGLSNByTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSNByTime indicates an expected call of LookupGLSNByTime.
+func (mr *MockLogIOClientMockRecorder) LookupGLSNByTime(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSNByTime", reflect.TypeOf((*MockLogIOClient)(nil).LookupGLSNByTime), varargs...)
+}
+
 // Read mocks base method.
 func (m *MockLogIOClient) Read(arg0 context.Context, arg1 *snpb.ReadRequest, arg2 ...grpc.CallOption) (*snpb.ReadResponse, error) {
 	m.ctrl.T.Helper()
@@ -420,6 +459,26 @@ func (mr *MockLogIOClientMockRecorder) Read(arg0, arg1 interface{}, arg2 ...inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLogIOClient)(nil).Read), varargs...)
 }
 
+// ReadRange mocks base method.
+func (m *MockLogIOClient) ReadRange(arg0 context.Context, arg1 *snpb.ReadRangeRequest, arg2 ...grpc.CallOption) (*snpb.ReadRangeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadRange", varargs...)
+	ret0, _ := ret[0].(*snpb.ReadRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRange indicates an expected call of ReadRange.
+func (mr *MockLogIOClientMockRecorder) ReadRange(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRange", reflect.TypeOf((*MockLogIOClient)(nil).ReadRange), varargs...)
+}
+
 // Subscribe mocks base method.
 func (m *MockLogIOClient) Subscribe(arg0 context.Context, arg1 *snpb.SubscribeRequest, arg2 ...grpc.CallOption) (snpb.LogIO_SubscribeClient, error) {
 	m.ctrl.T.Helper()
@@ -503,6 +562,21 @@ func (m *MockLogIOServer) EXPECT() *MockLogIOServerMockRecorder {
 	return m.recorder
 }
 
+// AbortTransaction mocks base method.
+func (m *MockLogIOServer) AbortTransaction(arg0 context.Context, arg1 *snpb.AbortTransactionRequest) (*snpb.AbortTransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortTransaction", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.AbortTransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortTransaction indicates an expected call of AbortTransaction.
+func (mr *MockLogIOServerMockRecorder) AbortTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortTransaction", reflect.TypeOf((*MockLogIOServer)(nil).AbortTransaction), arg0, arg1)
+}
+
 // Append mocks base method.
 func (m *MockLogIOServer) Append(arg0 snpb.LogIO_AppendServer) error {
 	m.ctrl.T.Helper()
@@ -532,6 +606,21 @@ func (mr *MockLogIOServerMockRecorder) LogStreamReplicaMetadata(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogStreamReplicaMetadata", reflect.TypeOf((*MockLogIOServer)(nil).LogStreamReplicaMetadata), arg0, arg1)
 }
 
+// LookupGLSNByTime mocks base method.
+func (m *MockLogIOServer) LookupGLSNByTime(arg0 context.Context, arg1 *snpb.LookupGLSNByTimeRequest) (*snpb.LookupGLSNByTimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupGLSNByTime", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.LookupGLSNByTimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupGLSNByTime indicates an expected call of LookupGLSNByTime.
+func (mr *MockLogIOServerMockRecorder) LookupGLSNByTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupGLSNByTime", reflect.TypeOf((*MockLogIOServer)(nil).LookupGLSNByTime), arg0, arg1)
+}
+
 // Read mocks base method.
 func (m *MockLogIOServer) Read(arg0 context.Context, arg1 *snpb.ReadRequest) (*snpb.ReadResponse, error) {
 	m.ctrl.T.Helper()
@@ -547,6 +636,21 @@ func (mr *MockLogIOServerMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockLogIOServer)(nil).Read), arg0, arg1)
 }
 
+// ReadRange mocks base method.
+func (m *MockLogIOServer) ReadRange(arg0 context.Context, arg1 *snpb.ReadRangeRequest) (*snpb.ReadRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRange", arg0, arg1)
+	ret0, _ := ret[0].(*snpb.ReadRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRange indicates an expected call of ReadRange.
+func (mr *MockLogIOServerMockRecorder) ReadRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRange", reflect.TypeOf((*MockLogIOServer)(nil).ReadRange), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockLogIOServer) Subscribe(arg0 *snpb.SubscribeRequest, arg1 snpb.LogIO_SubscribeServer) error {
 	m.ctrl.T.Helper()
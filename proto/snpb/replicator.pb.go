@@ -10,9 +10,11 @@ import (
 	math "math"
 	math_bits "math/bits"
 	strconv "strconv"
+	time "time"
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,6 +28,7 @@ import (
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -71,6 +74,15 @@ type ReplicateRequest struct {
 	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"log_stream_id,omitempty"`
 	LLSN        []github_com_kakao_varlog_pkg_types.LLSN      `protobuf:"varint,3,rep,packed,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
 	Data        [][]byte                                      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	// Attributes are optional attributes of each log entry in the data. If it
+	// is not empty, its length must be the same as the data.
+	Attributes []varlogpb.LogEntryAttributes `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes"`
+	// ProducerID and Sequence are those of the AppendRequest that the data
+	// belong to. The sequence is of the first log entry in the data.
+	ProducerID uint64 `protobuf:"varint,6,opt,name=producer_id,json=producerId,proto3" json:"producer_id,omitempty"`
+	Sequence   uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// TransactionID is that of the AppendRequest that the data belong to.
+	TransactionID uint64 `protobuf:"varint,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
 func (m *ReplicateRequest) Reset()         { *m = ReplicateRequest{} }
@@ -134,6 +146,34 @@ func (m *ReplicateRequest) GetData() [][]byte {
 	return nil
 }
 
+func (m *ReplicateRequest) GetAttributes() []varlogpb.LogEntryAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *ReplicateRequest) GetProducerID() uint64 {
+	if m != nil {
+		return m.ProducerID
+	}
+	return 0
+}
+
+func (m *ReplicateRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ReplicateRequest) GetTransactionID() uint64 {
+	if m != nil {
+		return m.TransactionID
+	}
+	return 0
+}
+
 type ReplicateResponse struct {
 }
 
@@ -482,9 +522,14 @@ func (m *SyncStatus) GetCurrent() SyncPosition {
 	return SyncPosition{}
 }
 
+// SyncPayload has either a log entry or the commit context. The commit time
+// can accompany the log entry if it is the first log entry of a commit or the
+// sync range, and it is the time when the commit including the log entry was
+// issued.
 type SyncPayload struct {
 	CommitContext *varlogpb.CommitContext `protobuf:"bytes,1,opt,name=commit_context,json=commitContext,proto3" json:"commit_context,omitempty"`
 	LogEntry      *varlogpb.LogEntry      `protobuf:"bytes,2,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	CommitTime    *time.Time              `protobuf:"bytes,3,opt,name=commit_time,json=commitTime,proto3,stdtime" json:"commit_time,omitempty"`
 }
 
 func (m *SyncPayload) Reset()         { *m = SyncPayload{} }
@@ -534,6 +579,13 @@ func (m *SyncPayload) GetLogEntry() *varlogpb.LogEntry {
 	return nil
 }
 
+func (m *SyncPayload) GetCommitTime() *time.Time {
+	if m != nil {
+		return m.CommitTime
+	}
+	return nil
+}
+
 type SyncReplicateRequest struct {
 	ClusterID   github_com_kakao_varlog_pkg_types.ClusterID `protobuf:"varint,1,opt,name=cluster_id,json=clusterId,proto3,casttype=github.com/kakao/varlog/pkg/types.ClusterID" json:"cluster_id,omitempty"`
 	Source      varlogpb.LogStreamReplica                   `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
@@ -663,70 +715,78 @@ func init() {
 func init() { proto.RegisterFile("proto/snpb/replicator.proto", fileDescriptor_85705cb817486b63) }
 
 var fileDescriptor_85705cb817486b63 = []byte{
-	// 1002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xa7, 0x4d, 0x5e, 0xda, 0x92, 0x4e, 0x59, 0x1a, 0x02, 0xb5, 0xb3, 0x46, 0x42,
-	0xe1, 0xc7, 0xc6, 0x52, 0x57, 0x2c, 0xcb, 0x6a, 0xa5, 0x85, 0x96, 0xb4, 0x44, 0x0a, 0x6d, 0x35,
-	0xae, 0x10, 0x82, 0x43, 0x71, 0x9d, 0x59, 0x63, 0xd5, 0xf1, 0x18, 0x7b, 0x82, 0xe8, 0x7f, 0x80,
-	0x7a, 0x42, 0xdc, 0x2b, 0x56, 0xa2, 0x42, 0x70, 0xe3, 0x08, 0xff, 0x41, 0x8f, 0x7b, 0xe4, 0x14,
-	0x89, 0xf4, 0xc2, 0xdf, 0xb0, 0x27, 0x34, 0xe3, 0xb1, 0x9b, 0x26, 0x5b, 0xda, 0x0a, 0x6e, 0xdc,
-	0xec, 0x79, 0xdf, 0xfb, 0xe6, 0xcd, 0xfb, 0xbe, 0x37, 0x36, 0xbc, 0x12, 0x46, 0x94, 0x51, 0x33,
-	0x0e, 0xc2, 0x7d, 0x33, 0x22, 0xa1, 0xef, 0x39, 0x36, 0xa3, 0x51, 0x4b, 0xac, 0xa2, 0xca, 0xd7,
-	0x76, 0xe4, 0x53, 0xb7, 0xc5, 0xa3, 0x75, 0xdd, 0xa5, 0xd4, 0xf5, 0x89, 0x29, 0x42, 0xfb, 0x83,
-	0xc7, 0x26, 0xf3, 0xfa, 0x24, 0x66, 0x76, 0x3f, 0x4c, 0xd0, 0xf5, 0x3b, 0xae, 0xc7, 0xbe, 0x1c,
-	0xec, 0xb7, 0x1c, 0xda, 0x37, 0x5d, 0xea, 0xd2, 0x73, 0x24, 0x7f, 0x4b, 0xf6, 0xe1, 0x4f, 0x12,
-	0xbe, 0x9c, 0x90, 0x87, 0xfb, 0x66, 0x9f, 0x30, 0xbb, 0x67, 0x33, 0x3b, 0x09, 0x18, 0xbf, 0xe4,
-	0xa1, 0x8a, 0x65, 0x29, 0x04, 0x93, 0xaf, 0x06, 0x24, 0x66, 0xc8, 0x82, 0x12, 0xa3, 0xa1, 0xe7,
-	0xec, 0x79, 0xbd, 0x9a, 0xd2, 0x50, 0x9a, 0xc5, 0xb5, 0xfb, 0xa3, 0xa1, 0x3e, 0xbb, 0xcb, 0xd7,
-	0x3a, 0x1f, 0x3e, 0x1b, 0xea, 0x6f, 0x8c, 0xed, 0x7e, 0x60, 0x1f, 0xd8, 0xd4, 0x4c, 0xf8, 0xcd,
-	0xf0, 0xc0, 0x35, 0xd9, 0x61, 0x48, 0xe2, 0x96, 0x04, 0xe3, 0x59, 0xc1, 0xd4, 0xe9, 0xa1, 0x1e,
-	0xcc, 0xfb, 0xd4, 0xdd, 0x8b, 0x59, 0x44, 0xec, 0x3e, 0x67, 0xce, 0x0b, 0xe6, 0xf7, 0x47, 0x43,
-	0xbd, 0xd2, 0xa5, 0xae, 0x25, 0xd6, 0x05, 0xfb, 0x9d, 0xab, 0xd9, 0xc7, 0x12, 0x70, 0xc5, 0xcf,
-	0x5e, 0x7a, 0x68, 0x03, 0x54, 0xdf, 0x8f, 0x83, 0x5a, 0xa1, 0x51, 0x68, 0xaa, 0x6b, 0xab, 0xa3,
-	0xa1, 0xae, 0x76, 0xbb, 0xd6, 0xd6, 0xb3, 0xa1, 0xfe, 0xfa, 0x35, 0x58, 0xbb, 0xd6, 0x16, 0x16,
-	0xf9, 0x08, 0x81, 0xca, 0xbb, 0x54, 0x53, 0x1b, 0x85, 0xe6, 0x1c, 0x16, 0xcf, 0xc6, 0x12, 0x2c,
-	0x8e, 0xb5, 0x2a, 0x0e, 0x69, 0x10, 0x13, 0xe3, 0x44, 0x81, 0x39, 0xeb, 0x30, 0x70, 0x76, 0x68,
-	0xec, 0x31, 0x8f, 0x06, 0x59, 0x05, 0xbc, 0x71, 0xff, 0xa6, 0x82, 0x0d, 0x50, 0x5d, 0xce, 0x93,
-	0x3f, 0xe7, 0xd9, 0xbc, 0x36, 0xcf, 0xa6, 0xe0, 0xe1, 0xf9, 0x0f, 0xd4, 0xbf, 0x9e, 0xe8, 0x8a,
-	0xf1, 0x9b, 0x02, 0x65, 0x5e, 0x26, 0xb6, 0x03, 0x97, 0xa0, 0x4f, 0x00, 0x1e, 0x7b, 0x51, 0xcc,
-	0xf6, 0xc6, 0x2a, 0x7d, 0x77, 0x34, 0xd4, 0xcb, 0x1b, 0x7c, 0xf5, 0x86, 0xe5, 0x96, 0x05, 0x55,
-	0x97, 0xd7, 0x6c, 0x41, 0xd9, 0xb7, 0x53, 0xda, 0xa4, 0xf0, 0x7b, 0xa3, 0xa1, 0x5e, 0xea, 0xda,
-	0x37, 0x66, 0x2d, 0x71, 0x22, 0x4e, 0x6a, 0xfc, 0x50, 0x80, 0x17, 0x78, 0xe9, 0x9d, 0xc0, 0x63,
-	0xa9, 0x43, 0x3f, 0x07, 0x70, 0xfc, 0x41, 0xcc, 0x48, 0x94, 0x7a, 0x74, 0x7e, 0xed, 0x21, 0x3f,
-	0xc0, 0x7a, 0xb2, 0x2a, 0x7c, 0xf4, 0xd6, 0xd5, 0x5b, 0x65, 0x70, 0x5c, 0x96, 0x7c, 0x9d, 0x1e,
-	0x7a, 0x04, 0x33, 0x31, 0x1d, 0x44, 0x0e, 0x11, 0x47, 0xa8, 0xac, 0xde, 0x6e, 0xc9, 0xd1, 0x4c,
-	0x87, 0xe8, 0xdc, 0x7e, 0xd2, 0x0f, 0x6b, 0xea, 0xe9, 0x50, 0xcf, 0x61, 0x99, 0x86, 0x3a, 0x50,
-	0xe9, 0x91, 0x98, 0x79, 0x81, 0xcd, 0x1d, 0x51, 0x2b, 0xdc, 0x8c, 0x65, 0x3c, 0x17, 0xad, 0x42,
-	0x31, 0xe2, 0x92, 0xd5, 0x54, 0x41, 0xf2, 0x52, 0x6b, 0xec, 0x96, 0x68, 0x65, 0x82, 0xca, 0xcc,
-	0x04, 0x8a, 0x28, 0x2c, 0x09, 0x15, 0x1c, 0xda, 0xef, 0x7b, 0x8c, 0x91, 0x5e, 0xa2, 0x47, 0x51,
-	0xe8, 0xf1, 0x68, 0x34, 0xd4, 0x17, 0xb9, 0x1e, 0xeb, 0x69, 0xf4, 0x86, 0xc2, 0x2c, 0xfa, 0x17,
-	0x92, 0xb9, 0x42, 0x1b, 0x50, 0x3d, 0x17, 0x28, 0x99, 0x8b, 0xf3, 0xc2, 0x95, 0x6b, 0x17, 0x6e,
-	0xfc, 0xa9, 0x00, 0xf0, 0x90, 0xc5, 0x6c, 0x36, 0x88, 0xd1, 0xdb, 0x50, 0x8c, 0x99, 0xcd, 0x12,
-	0x8a, 0x85, 0xe7, 0x50, 0x70, 0x1c, 0xc1, 0x09, 0x08, 0xbd, 0x03, 0x45, 0x61, 0x44, 0x29, 0xda,
-	0xcb, 0x53, 0xe8, 0x74, 0x42, 0xd3, 0x3d, 0x05, 0x1a, 0xdd, 0x05, 0x95, 0x1f, 0x48, 0x8a, 0x74,
-	0x65, 0x96, 0x00, 0xa3, 0xf7, 0x60, 0xd6, 0x19, 0x44, 0x11, 0x09, 0x98, 0xd4, 0xe5, 0xca, 0xbc,
-	0x14, 0x6f, 0x7c, 0xaf, 0x40, 0x45, 0xc4, 0xed, 0x43, 0x9f, 0xda, 0x3d, 0xd4, 0x86, 0x85, 0x44,
-	0xa7, 0x3d, 0x87, 0x06, 0x8c, 0x7c, 0xc3, 0x64, 0xc3, 0xb4, 0x29, 0xbb, 0x24, 0x3d, 0x5f, 0x4f,
-	0x50, 0x78, 0xde, 0x19, 0x7f, 0x45, 0xf7, 0xa0, 0xcc, 0x6f, 0x57, 0x12, 0xb0, 0xe8, 0x70, 0xb2,
-	0x03, 0xe3, 0x86, 0x6b, 0x73, 0x00, 0x2e, 0xf9, 0xf2, 0xe9, 0x81, 0x7a, 0xca, 0x6f, 0x87, 0xdf,
-	0xf3, 0xf0, 0xa2, 0xd0, 0x64, 0xf2, 0x4b, 0xf0, 0xbf, 0x99, 0xb3, 0xfb, 0x30, 0x1b, 0x26, 0x8a,
-	0x48, 0x45, 0x6b, 0xd3, 0x8a, 0x26, 0xf1, 0x54, 0x50, 0x09, 0x37, 0x3e, 0x82, 0x5b, 0x13, 0xad,
-	0x93, 0x13, 0x60, 0xc2, 0x4c, 0x2c, 0x8c, 0x2c, 0x15, 0x5d, 0x7e, 0xae, 0x7f, 0x07, 0x31, 0x96,
-	0xb0, 0x37, 0x7f, 0x92, 0x77, 0xb4, 0xb0, 0x35, 0x5a, 0x81, 0x62, 0x1b, 0xe3, 0x6d, 0x5c, 0xcd,
-	0xd5, 0xd1, 0xd1, 0x71, 0x63, 0x21, 0x8b, 0xb4, 0xa3, 0x88, 0x46, 0xa8, 0x09, 0x95, 0xce, 0xd6,
-	0xde, 0x0e, 0xde, 0xde, 0xc4, 0x6d, 0xcb, 0xaa, 0x2a, 0xf5, 0xe5, 0xa3, 0xe3, 0xc6, 0x52, 0x06,
-	0xea, 0x04, 0x3b, 0x11, 0x75, 0x23, 0x12, 0xc7, 0xe8, 0x35, 0x28, 0xad, 0x6f, 0x7f, 0xbc, 0xd3,
-	0x6d, 0xef, 0xb6, 0xab, 0xf9, 0xfa, 0xad, 0xa3, 0xe3, 0xc6, 0x62, 0x06, 0x5b, 0xa7, 0xfd, 0xd0,
-	0x27, 0xc9, 0x6e, 0xd6, 0xee, 0x07, 0x78, 0xb7, 0x5a, 0x98, 0xd8, 0xcd, 0x62, 0x76, 0xc4, 0xea,
-	0x73, 0xdf, 0xfe, 0xa8, 0xe5, 0x7e, 0x3e, 0xd1, 0x72, 0xbf, 0x9e, 0x68, 0xca, 0xea, 0x59, 0x1e,
-	0x00, 0x67, 0xff, 0x2f, 0x68, 0x0b, 0xca, 0xd9, 0xe9, 0xd1, 0xca, 0x85, 0x53, 0x4e, 0x1a, 0xaa,
-	0xae, 0x5d, 0x16, 0x96, 0x9f, 0xd3, 0x5c, 0x53, 0x41, 0x1d, 0x28, 0xa5, 0xd7, 0x09, 0x7a, 0x75,
-	0xaa, 0x69, 0x63, 0x9f, 0x81, 0xfa, 0xca, 0x25, 0xd1, 0x94, 0x0c, 0x7d, 0x0a, 0xf3, 0x17, 0xc4,
-	0x41, 0xb7, 0xa7, 0xef, 0xa1, 0xc9, 0x12, 0x8d, 0x7f, 0x82, 0x64, 0xcc, 0x5f, 0xc0, 0xd2, 0x85,
-	0x50, 0xe2, 0xb0, 0xff, 0x8c, 0xbf, 0xa9, 0xac, 0x3d, 0x3c, 0x1d, 0x69, 0xca, 0xd3, 0x91, 0xa6,
-	0x7c, 0x77, 0xa6, 0xe5, 0x9e, 0x9c, 0x69, 0xca, 0xd3, 0x33, 0x2d, 0xf7, 0xc7, 0x99, 0x96, 0xfb,
-	0xcc, 0xb8, 0x74, 0xe0, 0xb2, 0xff, 0xcb, 0xfd, 0x19, 0xf1, 0x7c, 0xf7, 0xef, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf2, 0xb0, 0x60, 0xe6, 0x74, 0x0a, 0x00, 0x00,
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x1b, 0xa7, 0x4d, 0x5e, 0x36, 0x25, 0x9d, 0xb2, 0x6c, 0x08, 0x6c, 0x9c, 0xcd, 0x4a,
+	0x28, 0xfc, 0xd9, 0x44, 0xea, 0x8a, 0x65, 0x41, 0x2b, 0x2d, 0x4d, 0x9b, 0x16, 0x4b, 0xa1, 0xad,
+	0xc6, 0x11, 0x42, 0x70, 0x28, 0x8e, 0x3d, 0x6b, 0xac, 0x3a, 0x1e, 0x63, 0x4f, 0x10, 0xfd, 0x06,
+	0xa8, 0xa7, 0xfd, 0x02, 0x15, 0x2b, 0x51, 0x21, 0x8e, 0x1c, 0xe1, 0x1b, 0xec, 0x8d, 0x3d, 0x72,
+	0x0a, 0x22, 0xbd, 0xf0, 0x19, 0x96, 0x0b, 0x9a, 0x19, 0xdb, 0x4d, 0x9b, 0x96, 0x6d, 0x05, 0x37,
+	0x6e, 0x9e, 0x79, 0xbf, 0xf7, 0x9b, 0x37, 0xef, 0xf7, 0xde, 0x3c, 0xc3, 0x6b, 0x41, 0x48, 0x19,
+	0x6d, 0x47, 0x7e, 0x30, 0x68, 0x87, 0x24, 0xf0, 0x5c, 0xcb, 0x64, 0x34, 0x6c, 0x89, 0x5d, 0x54,
+	0xfc, 0xda, 0x0c, 0x3d, 0xea, 0xb4, 0xb8, 0xb5, 0xaa, 0x39, 0x94, 0x3a, 0x1e, 0x69, 0x0b, 0xd3,
+	0x60, 0xf4, 0xa8, 0xcd, 0xdc, 0x21, 0x89, 0x98, 0x39, 0x0c, 0x24, 0xba, 0x7a, 0xc7, 0x71, 0xd9,
+	0x97, 0xa3, 0x41, 0xcb, 0xa2, 0xc3, 0xb6, 0x43, 0x1d, 0x7a, 0x82, 0xe4, 0x2b, 0x79, 0x0e, 0xff,
+	0x8a, 0xe1, 0x37, 0x24, 0x79, 0x30, 0x68, 0x0f, 0x09, 0x33, 0x6d, 0x93, 0x99, 0xd2, 0xd0, 0xf8,
+	0x2b, 0x0b, 0x65, 0x1c, 0x87, 0x42, 0x30, 0xf9, 0x6a, 0x44, 0x22, 0x86, 0x0c, 0xc8, 0x33, 0x1a,
+	0xb8, 0xd6, 0xae, 0x6b, 0x57, 0x94, 0xba, 0xd2, 0xcc, 0x75, 0xee, 0x4f, 0xc6, 0xda, 0x42, 0x9f,
+	0xef, 0xe9, 0xeb, 0xcf, 0xc7, 0xda, 0x9b, 0x53, 0xa7, 0xef, 0x99, 0x7b, 0x26, 0x6d, 0x4b, 0xfe,
+	0x76, 0xb0, 0xe7, 0xb4, 0xd9, 0x7e, 0x40, 0xa2, 0x56, 0x0c, 0xc6, 0x0b, 0x82, 0x49, 0xb7, 0x91,
+	0x0d, 0x25, 0x8f, 0x3a, 0xbb, 0x11, 0x0b, 0x89, 0x39, 0xe4, 0xcc, 0x73, 0x82, 0xf9, 0xc3, 0xc9,
+	0x58, 0x2b, 0xf6, 0xa8, 0x63, 0x88, 0x7d, 0xc1, 0x7e, 0xe7, 0xc5, 0xec, 0x53, 0x0e, 0xb8, 0xe8,
+	0xa5, 0x0b, 0x1b, 0x6d, 0x80, 0xea, 0x79, 0x91, 0x5f, 0xc9, 0xd6, 0xb3, 0x4d, 0xb5, 0xb3, 0x32,
+	0x19, 0x6b, 0x6a, 0xaf, 0x67, 0x6c, 0x3d, 0x1f, 0x6b, 0x6f, 0x5c, 0x82, 0xb5, 0x67, 0x6c, 0x61,
+	0xe1, 0x8f, 0x10, 0xa8, 0x3c, 0x4b, 0x15, 0xb5, 0x9e, 0x6d, 0x5e, 0xc3, 0xe2, 0x1b, 0xe9, 0x00,
+	0x26, 0x63, 0xa1, 0x3b, 0x18, 0x31, 0x12, 0x55, 0x72, 0xf5, 0x6c, 0xb3, 0xb8, 0x72, 0xbb, 0x15,
+	0xcb, 0x96, 0x24, 0x98, 0x87, 0xd6, 0xf5, 0x59, 0xb8, 0xbf, 0x9a, 0x42, 0x3b, 0xea, 0xd3, 0xb1,
+	0x96, 0xc1, 0x53, 0xce, 0xa8, 0x0d, 0xc5, 0x20, 0xa4, 0xf6, 0xc8, 0x22, 0x21, 0x4f, 0xc5, 0x7c,
+	0x5d, 0x69, 0xaa, 0x9d, 0xc5, 0xc9, 0x58, 0x83, 0x9d, 0x78, 0x5b, 0x5f, 0xc7, 0x90, 0x40, 0x74,
+	0x1b, 0x55, 0x21, 0x1f, 0x71, 0x75, 0x7c, 0x8b, 0x54, 0x16, 0x38, 0x1a, 0xa7, 0x6b, 0x74, 0x1f,
+	0x16, 0x59, 0x68, 0xfa, 0x91, 0x69, 0x31, 0x97, 0xfa, 0x9c, 0x2f, 0x2f, 0xf8, 0x96, 0x26, 0x63,
+	0xad, 0xd4, 0x3f, 0xb1, 0xe8, 0xeb, 0xb8, 0x34, 0x05, 0xd4, 0xed, 0xc6, 0x32, 0x2c, 0x4d, 0x89,
+	0x1f, 0x05, 0xd4, 0x8f, 0x48, 0xe3, 0x48, 0x81, 0x6b, 0xc6, 0xbe, 0x6f, 0xed, 0xd0, 0xc8, 0xe5,
+	0xb8, 0x34, 0xa7, 0x4a, 0x5d, 0xf9, 0x57, 0x39, 0xdd, 0x00, 0xd5, 0xe1, 0x3c, 0x73, 0x27, 0x3c,
+	0x9b, 0x97, 0xe6, 0xd9, 0x14, 0x3c, 0xdc, 0xff, 0x03, 0xf5, 0xcf, 0x27, 0x9a, 0xd2, 0xf8, 0x59,
+	0x81, 0x02, 0x0f, 0x13, 0x9b, 0xbe, 0x43, 0xd0, 0x27, 0x00, 0x8f, 0xdc, 0x30, 0x62, 0xbb, 0x53,
+	0x91, 0xbe, 0x37, 0x19, 0x6b, 0x85, 0x0d, 0xbe, 0x7b, 0xc5, 0x70, 0x0b, 0x82, 0xaa, 0xc7, 0x63,
+	0x36, 0xa0, 0xe0, 0x99, 0x09, 0xad, 0x0c, 0xfc, 0xde, 0x64, 0xac, 0xe5, 0x7b, 0xe6, 0x95, 0x59,
+	0xf3, 0x9e, 0x29, 0x49, 0x1b, 0xdf, 0x65, 0xe1, 0x25, 0x1e, 0xba, 0xee, 0xbb, 0x2c, 0xe9, 0xb9,
+	0xcf, 0x01, 0x2c, 0x6f, 0x14, 0x31, 0x59, 0x10, 0xfc, 0x02, 0xa5, 0xce, 0x03, 0x7e, 0x81, 0x35,
+	0xb9, 0x2b, 0x3a, 0xe3, 0xed, 0x17, 0x1f, 0x95, 0xc2, 0x71, 0x21, 0xe6, 0xd3, 0x6d, 0xf4, 0x10,
+	0xe6, 0x23, 0x3a, 0x0a, 0x2d, 0x22, 0xae, 0x50, 0x5c, 0xb9, 0x75, 0x5e, 0xd5, 0xca, 0x1e, 0x8a,
+	0xeb, 0x21, 0xae, 0xd9, 0xd8, 0x0d, 0xe9, 0x50, 0xb4, 0x49, 0xc4, 0x5c, 0xdf, 0xe4, 0x15, 0x51,
+	0xc9, 0x5e, 0x8d, 0x65, 0xda, 0x17, 0xad, 0x40, 0x2e, 0xe4, 0x92, 0x55, 0x54, 0x41, 0xf2, 0x4a,
+	0x6b, 0xea, 0xdd, 0x6b, 0xa5, 0x82, 0xc6, 0x9e, 0x12, 0x8a, 0x28, 0x2c, 0x0b, 0x15, 0x2c, 0x3a,
+	0x1c, 0xba, 0x8c, 0x11, 0x5b, 0xea, 0x91, 0x13, 0x7a, 0x3c, 0x9c, 0x8c, 0xb5, 0x25, 0xae, 0xc7,
+	0x5a, 0x62, 0xbd, 0xa2, 0x30, 0x4b, 0xde, 0x29, 0x67, 0xae, 0xd0, 0x06, 0x94, 0x4f, 0x04, 0x92,
+	0x7d, 0x71, 0x12, 0xb8, 0x72, 0xe9, 0xc0, 0x1b, 0x7f, 0x28, 0x00, 0xdc, 0x64, 0x30, 0x93, 0x8d,
+	0x22, 0xf4, 0x0e, 0xe4, 0x22, 0x66, 0x32, 0x49, 0xb1, 0x78, 0x0e, 0x05, 0xc7, 0x11, 0x2c, 0x41,
+	0xe8, 0x5d, 0xc8, 0x89, 0x42, 0x8c, 0x45, 0x7b, 0x75, 0x06, 0x9d, 0x74, 0x68, 0x72, 0xa6, 0x40,
+	0xa3, 0xbb, 0xa0, 0xf2, 0x0b, 0x55, 0xb2, 0x97, 0xf3, 0x12, 0x60, 0xf4, 0x3e, 0x2c, 0x58, 0xa3,
+	0x30, 0x24, 0x3e, 0xab, 0xa8, 0x97, 0xf3, 0x4b, 0xf0, 0x8d, 0x5f, 0x15, 0x28, 0x0a, 0xbb, 0xb9,
+	0xef, 0x51, 0xd3, 0x46, 0x5d, 0x58, 0x94, 0x3a, 0xed, 0x5a, 0xd4, 0x67, 0xe4, 0x1b, 0x16, 0x27,
+	0xac, 0x36, 0x53, 0x2e, 0x32, 0xe7, 0x6b, 0x12, 0x85, 0x4b, 0xd6, 0xf4, 0x12, 0xdd, 0x83, 0x02,
+	0x9f, 0x17, 0x84, 0xbf, 0xa5, 0x67, 0x33, 0x30, 0xf3, 0xd8, 0xe2, 0xbc, 0x17, 0x7f, 0xa1, 0x55,
+	0x28, 0xc6, 0xc7, 0xf3, 0x99, 0x19, 0x67, 0xa1, 0xda, 0x92, 0x03, 0xb5, 0x95, 0x8c, 0xc9, 0x56,
+	0x3f, 0x19, 0xa8, 0x1d, 0xf5, 0xf1, 0xef, 0x9a, 0x82, 0x41, 0x3a, 0xf1, 0xed, 0xc6, 0x2f, 0x73,
+	0xf0, 0xb2, 0x10, 0xf4, 0xec, 0x60, 0xfc, 0xdf, 0x34, 0xe9, 0x7d, 0x58, 0x08, 0xa4, 0x9c, 0x71,
+	0x39, 0x54, 0x66, 0xcb, 0x41, 0xda, 0x93, 0x6a, 0x88, 0xe1, 0x8d, 0x8f, 0xe0, 0xfa, 0x99, 0xd4,
+	0xc5, 0xed, 0xd3, 0x86, 0xf9, 0x48, 0x74, 0x41, 0x5c, 0x0e, 0x37, 0xce, 0x2d, 0xfe, 0x51, 0x84,
+	0x63, 0xd8, 0x5b, 0x3f, 0xc4, 0x0f, 0xbc, 0x21, 0x9a, 0xe1, 0x26, 0xe4, 0xba, 0x18, 0x6f, 0xe3,
+	0x72, 0xa6, 0x8a, 0x0e, 0x0e, 0xeb, 0x8b, 0xa9, 0xa5, 0x1b, 0x86, 0x34, 0x44, 0x4d, 0x28, 0xea,
+	0x5b, 0xbb, 0x3b, 0x78, 0x7b, 0x13, 0x77, 0x0d, 0xa3, 0xac, 0x54, 0x6f, 0x1c, 0x1c, 0xd6, 0x97,
+	0x53, 0x90, 0xee, 0xef, 0x84, 0xd4, 0x09, 0x49, 0x14, 0xa1, 0xdb, 0x90, 0x5f, 0xdb, 0xfe, 0x78,
+	0xa7, 0xd7, 0xed, 0x77, 0xcb, 0x73, 0xd5, 0xeb, 0x07, 0x87, 0xf5, 0xa5, 0x14, 0xb6, 0x46, 0x87,
+	0x81, 0x47, 0xe4, 0x69, 0x46, 0x7f, 0x15, 0xf7, 0xcb, 0xd9, 0x33, 0xa7, 0x19, 0xcc, 0x0c, 0x59,
+	0xf5, 0xda, 0xb7, 0xdf, 0xd7, 0x32, 0x3f, 0x1e, 0xd5, 0x32, 0x3f, 0x1d, 0xd5, 0x94, 0x95, 0xe3,
+	0x39, 0x00, 0x9c, 0xfe, 0xce, 0xa1, 0x2d, 0x28, 0x24, 0x2b, 0x82, 0x6e, 0x9e, 0xba, 0xe5, 0xd9,
+	0x82, 0xaa, 0xd6, 0x2e, 0x32, 0xc7, 0xb3, 0x38, 0xd3, 0x54, 0x90, 0x0e, 0xf9, 0xe4, 0x2d, 0x42,
+	0xaf, 0xcf, 0x24, 0x6d, 0x6a, 0x86, 0x54, 0x6f, 0x5e, 0x60, 0x4d, 0xc8, 0xd0, 0xa7, 0x50, 0x3a,
+	0x25, 0x0e, 0xba, 0x35, 0xe3, 0x31, 0x13, 0x62, 0xe3, 0x9f, 0x20, 0x29, 0xf3, 0x17, 0xb0, 0x7c,
+	0xca, 0x24, 0x2b, 0xec, 0x3f, 0xe3, 0x6f, 0x2a, 0x9d, 0x07, 0x4f, 0x27, 0x35, 0xe5, 0xd9, 0xa4,
+	0xa6, 0x3c, 0x3e, 0xae, 0x65, 0x9e, 0x1c, 0xd7, 0x94, 0x67, 0xc7, 0xb5, 0xcc, 0x6f, 0xc7, 0xb5,
+	0xcc, 0x67, 0x8d, 0x0b, 0x1b, 0x2e, 0xfd, 0xdd, 0x1e, 0xcc, 0x8b, 0xef, 0xbb, 0x7f, 0x0f, 0x00,
+	0x8d, 0x47, 0xe5, 0xe6, 0x83, 0x0b, 0x00, 0x00,
 }
 
 func (x SyncState) String() string {
@@ -1133,6 +1193,35 @@ func (m *ReplicateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TransactionID != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.TransactionID))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProducerID != 0 {
+		i = encodeVarintReplicator(dAtA, i, uint64(m.ProducerID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReplicator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Data) > 0 {
 		for iNdEx := len(m.Data) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Data[iNdEx])
@@ -1436,6 +1525,16 @@ func (m *SyncPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommitTime != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CommitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CommitTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintReplicator(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.LogEntry != nil {
 		{
 			size, err := m.LogEntry.MarshalToSizedBuffer(dAtA[:i])
@@ -1592,6 +1691,21 @@ func (m *ReplicateRequest) ProtoSize() (n int) {
 			n += 1 + l + sovReplicator(uint64(l))
 		}
 	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.ProtoSize()
+			n += 1 + l + sovReplicator(uint64(l))
+		}
+	}
+	if m.ProducerID != 0 {
+		n += 1 + sovReplicator(uint64(m.ProducerID))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovReplicator(uint64(m.Sequence))
+	}
+	if m.TransactionID != 0 {
+		n += 1 + sovReplicator(uint64(m.TransactionID))
+	}
 	return n
 }
 
@@ -1698,6 +1812,10 @@ func (m *SyncPayload) ProtoSize() (n int) {
 		l = m.LogEntry.ProtoSize()
 		n += 1 + l + sovReplicator(uint64(l))
 	}
+	if m.CommitTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CommitTime)
+		n += 1 + l + sovReplicator(uint64(l))
+	}
 	return n
 }
 
@@ -1738,27 +1856,6 @@ func sovReplicator(x uint64) (n int) {
 func sozReplicator(x uint64) (n int) {
 	return sovReplicator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (this *SyncPayload) GetValue() interface{} {
-	if this.CommitContext != nil {
-		return this.CommitContext
-	}
-	if this.LogEntry != nil {
-		return this.LogEntry
-	}
-	return nil
-}
-
-func (this *SyncPayload) SetValue(value interface{}) bool {
-	switch vt := value.(type) {
-	case *varlogpb.CommitContext:
-		this.CommitContext = vt
-	case *varlogpb.LogEntry:
-		this.LogEntry = vt
-	default:
-		return false
-	}
-	return true
-}
 func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1934,6 +2031,97 @@ func (m *ReplicateRequest) Unmarshal(dAtA []byte) error {
 			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
 			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, varlogpb.LogEntryAttributes{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerID", wireType)
+			}
+			m.ProducerID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionID", wireType)
+			}
+			m.TransactionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransactionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -2720,6 +2908,42 @@ func (m *SyncPayload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplicator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplicator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommitTime == nil {
+				m.CommitTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CommitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplicator(dAtA[iNdEx:])
@@ -0,0 +1,75 @@
+package varlogpb
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/kakao/varlog/pkg/types"
+)
+
+// TopicLevel returns true if the offset is a topic-level offset, which tracks
+// a GLSN in the topic rather than an LLSN in a log stream.
+func (o ConsumerGroupOffset) TopicLevel() bool {
+	return o.LogStreamID == 0
+}
+
+// Validate checks whether the offset is well-formed. A topic-level offset
+// should not have LLSN, and a log-stream-level offset should not have GLSN.
+// Zero offsets are allowed: they mean that the consumer group has not
+// processed any log entries yet.
+func (o ConsumerGroupOffset) Validate() error {
+	if o.TopicID.Invalid() {
+		return fmt.Errorf("invalid topic id %d", o.TopicID)
+	}
+	if o.TopicLevel() {
+		if !o.LLSN.Invalid() {
+			return errors.New("topic-level offset should not have llsn")
+		}
+		return nil
+	}
+	if o.LogStreamID.Invalid() {
+		return fmt.Errorf("invalid log stream id %d", o.LogStreamID)
+	}
+	if !o.GLSN.Invalid() {
+		return errors.New("log-stream-level offset should not have glsn")
+	}
+	return nil
+}
+
+func (cgd *ConsumerGroupDescriptor) searchOffset(tpid types.TopicID, lsid types.LogStreamID) (int, bool) {
+	i := sort.Search(len(cgd.Offsets), func(i int) bool {
+		o := cgd.Offsets[i]
+		return o.TopicID > tpid || (o.TopicID == tpid && o.LogStreamID >= lsid)
+	})
+	if i < len(cgd.Offsets) && cgd.Offsets[i].TopicID == tpid && cgd.Offsets[i].LogStreamID == lsid {
+		return i, true
+	}
+	return i, false
+}
+
+// GetOffset returns the offset of the topic tpid and the log stream lsid. The
+// argument lsid should be zero to get the topic-level offset.
+func (cgd *ConsumerGroupDescriptor) GetOffset(tpid types.TopicID, lsid types.LogStreamID) (ConsumerGroupOffset, bool) {
+	if cgd == nil {
+		return ConsumerGroupOffset{}, false
+	}
+	idx, match := cgd.searchOffset(tpid, lsid)
+	if !match {
+		return ConsumerGroupOffset{}, false
+	}
+	return cgd.Offsets[idx], true
+}
+
+// UpsertOffset inserts the offset or overwrites the existing offset of the
+// same topic and log stream. It keeps the offsets sorted.
+func (cgd *ConsumerGroupDescriptor) UpsertOffset(offset ConsumerGroupOffset) {
+	idx, match := cgd.searchOffset(offset.TopicID, offset.LogStreamID)
+	if match {
+		cgd.Offsets[idx] = offset
+		return
+	}
+	cgd.Offsets = append(cgd.Offsets, ConsumerGroupOffset{})
+	copy(cgd.Offsets[idx+1:], cgd.Offsets[idx:])
+	cgd.Offsets[idx] = offset
+}
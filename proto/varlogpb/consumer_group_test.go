@@ -0,0 +1,87 @@
+package varlogpb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumerGroupOffset_Validate(t *testing.T) {
+	tcs := []struct {
+		name    string
+		offset  ConsumerGroupOffset
+		wantErr bool
+	}{
+		{
+			name:    "InvalidTopicID",
+			offset:  ConsumerGroupOffset{GLSN: 1},
+			wantErr: true,
+		},
+		{
+			name:    "TopicLevelWithLLSN",
+			offset:  ConsumerGroupOffset{TopicID: 1, GLSN: 1, LLSN: 1},
+			wantErr: true,
+		},
+		{
+			name:    "LogStreamLevelWithGLSN",
+			offset:  ConsumerGroupOffset{TopicID: 1, LogStreamID: 1, GLSN: 1, LLSN: 1},
+			wantErr: true,
+		},
+		{
+			name:    "InvalidLogStreamID",
+			offset:  ConsumerGroupOffset{TopicID: 1, LogStreamID: -1, LLSN: 1},
+			wantErr: true,
+		},
+		{
+			name:   "TopicLevel",
+			offset: ConsumerGroupOffset{TopicID: 1, GLSN: 1},
+		},
+		{
+			name:   "LogStreamLevel",
+			offset: ConsumerGroupOffset{TopicID: 1, LogStreamID: 1, LLSN: 1},
+		},
+		{
+			name:   "Zero",
+			offset: ConsumerGroupOffset{TopicID: 1, LogStreamID: 1},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.offset.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConsumerGroupDescriptor_UpsertOffset(t *testing.T) {
+	var cgd ConsumerGroupDescriptor
+
+	_, ok := cgd.GetOffset(1, 0)
+	require.False(t, ok)
+
+	cgd.UpsertOffset(ConsumerGroupOffset{TopicID: 2, GLSN: 10})
+	cgd.UpsertOffset(ConsumerGroupOffset{TopicID: 1, LogStreamID: 2, LLSN: 3})
+	cgd.UpsertOffset(ConsumerGroupOffset{TopicID: 1, GLSN: 5})
+	cgd.UpsertOffset(ConsumerGroupOffset{TopicID: 1, LogStreamID: 1, LLSN: 4})
+	require.Equal(t, []ConsumerGroupOffset{
+		{TopicID: 1, GLSN: 5},
+		{TopicID: 1, LogStreamID: 1, LLSN: 4},
+		{TopicID: 1, LogStreamID: 2, LLSN: 3},
+		{TopicID: 2, GLSN: 10},
+	}, cgd.Offsets)
+
+	// overwrite, even if the new offset is behind the old one
+	cgd.UpsertOffset(ConsumerGroupOffset{TopicID: 1, GLSN: 2})
+	offset, ok := cgd.GetOffset(1, 0)
+	require.True(t, ok)
+	require.Equal(t, ConsumerGroupOffset{TopicID: 1, GLSN: 2}, offset)
+	require.Len(t, cgd.Offsets, 4)
+
+	_, ok = cgd.GetOffset(1, 3)
+	require.False(t, ok)
+}
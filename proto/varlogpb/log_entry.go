@@ -1,5 +1,7 @@
 package varlogpb
 
+import "bytes"
+
 func InvalidLogEntryMeta() LogEntryMeta {
 	return LogEntryMeta{}
 }
@@ -11,3 +13,96 @@ func InvalidLogEntry() LogEntry {
 func (le LogEntry) Invalid() bool {
 	return le.GLSN.Invalid() && le.LLSN.Invalid() && len(le.Data) == 0
 }
+
+// Empty returns true if the attributes have neither key, timestamp, headers,
+// compression codec, nor encryption.
+func (attrs LogEntryAttributes) Empty() bool {
+	return len(attrs.Key) == 0 && attrs.Timestamp == nil && len(attrs.Headers) == 0 && attrs.CompressionCodec == CompressionCodecNone && attrs.Encryption == nil
+}
+
+// Clone returns a deep copy of the attributes.
+func (attrs LogEntryAttributes) Clone() LogEntryAttributes {
+	ret := LogEntryAttributes{CompressionCodec: attrs.CompressionCodec}
+	if attrs.Key != nil {
+		ret.Key = append([]byte(nil), attrs.Key...)
+	}
+	if attrs.Timestamp != nil {
+		ts := *attrs.Timestamp
+		ret.Timestamp = &ts
+	}
+	if attrs.Headers != nil {
+		ret.Headers = make([]LogEntryHeader, len(attrs.Headers))
+		for i, header := range attrs.Headers {
+			ret.Headers[i].Key = header.Key
+			if header.Value != nil {
+				ret.Headers[i].Value = append([]byte(nil), header.Value...)
+			}
+		}
+	}
+	if attrs.Encryption != nil {
+		ret.Encryption = &LogEntryEncryption{
+			KeyID:            attrs.Encryption.KeyID,
+			CompressionCodec: attrs.Encryption.CompressionCodec,
+		}
+		if attrs.Encryption.WrappedDataKey != nil {
+			ret.Encryption.WrappedDataKey = append([]byte(nil), attrs.Encryption.WrappedDataKey...)
+		}
+	}
+	return ret
+}
+
+// Valid returns true if the codec is one of the known compression codecs.
+func (c CompressionCodec) Valid() bool {
+	_, ok := CompressionCodec_name[int32(c)]
+	return ok
+}
+
+// Empty returns true if the filter has no conditions, that is, it matches all
+// log entries.
+func (f *LogEntryFilter) Empty() bool {
+	return f == nil || (len(f.KeyPrefix) == 0 && len(f.Headers) == 0 && f.TimestampBegin == nil && f.TimestampEnd == nil)
+}
+
+// Match returns true if the log entry having the argument attrs satisfies all
+// conditions of the filter. A nil filter matches all log entries.
+//
+// Log entries without attributes match only the empty filter. Thus, once a
+// log entry is stripped of its payload and attributes because it does not
+// match the filter, the stripped one does not match it either.
+func (f *LogEntryFilter) Match(attrs LogEntryAttributes) bool {
+	if f == nil {
+		return true
+	}
+	if !bytes.HasPrefix(attrs.Key, f.KeyPrefix) {
+		return false
+	}
+	for _, want := range f.Headers {
+		if !hasHeader(attrs.Headers, want) {
+			return false
+		}
+	}
+	if f.TimestampBegin != nil || f.TimestampEnd != nil {
+		if attrs.Timestamp == nil {
+			return false
+		}
+		if f.TimestampBegin != nil && attrs.Timestamp.Before(*f.TimestampBegin) {
+			return false
+		}
+		if f.TimestampEnd != nil && !attrs.Timestamp.Before(*f.TimestampEnd) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasHeader(headers []LogEntryHeader, want LogEntryHeader) bool {
+	for _, header := range headers {
+		if header.Key != want.Key {
+			continue
+		}
+		if len(want.Value) == 0 || bytes.Equal(header.Value, want.Value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package varlogpb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogEntryFilter_Match(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Second)
+	after := now.Add(time.Second)
+	attrs := LogEntryAttributes{
+		Key:       []byte("user-1"),
+		Timestamp: &now,
+		Headers: []LogEntryHeader{
+			{Key: "region", Value: []byte("kr")},
+			{Key: "trace"},
+		},
+	}
+
+	tcs := []struct {
+		name   string
+		filter *LogEntryFilter
+		want   bool
+	}{
+		{
+			name:   "Nil",
+			filter: nil,
+			want:   true,
+		},
+		{
+			name:   "Empty",
+			filter: &LogEntryFilter{},
+			want:   true,
+		},
+		{
+			name:   "KeyPrefix",
+			filter: &LogEntryFilter{KeyPrefix: []byte("user-")},
+			want:   true,
+		},
+		{
+			name:   "UnmatchedKeyPrefix",
+			filter: &LogEntryFilter{KeyPrefix: []byte("order-")},
+			want:   false,
+		},
+		{
+			name: "Headers",
+			filter: &LogEntryFilter{Headers: []LogEntryHeader{
+				{Key: "region", Value: []byte("kr")},
+				{Key: "trace"},
+			}},
+			want: true,
+		},
+		{
+			name:   "HeaderWithoutValue",
+			filter: &LogEntryFilter{Headers: []LogEntryHeader{{Key: "region"}}},
+			want:   true,
+		},
+		{
+			name:   "UnmatchedHeaderValue",
+			filter: &LogEntryFilter{Headers: []LogEntryHeader{{Key: "region", Value: []byte("us")}}},
+			want:   false,
+		},
+		{
+			name:   "NoSuchHeader",
+			filter: &LogEntryFilter{Headers: []LogEntryHeader{{Key: "tenant"}}},
+			want:   false,
+		},
+		{
+			name:   "TimestampRange",
+			filter: &LogEntryFilter{TimestampBegin: &now, TimestampEnd: &after},
+			want:   true,
+		},
+		{
+			name:   "TimestampBeforeRange",
+			filter: &LogEntryFilter{TimestampBegin: &after},
+			want:   false,
+		},
+		{
+			name:   "TimestampAfterRange",
+			filter: &LogEntryFilter{TimestampBegin: &before, TimestampEnd: &now},
+			want:   false,
+		},
+		{
+			name:   "AllConditions",
+			filter: &LogEntryFilter{KeyPrefix: []byte("user"), Headers: []LogEntryHeader{{Key: "trace"}}, TimestampEnd: &after},
+			want:   true,
+		},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.filter.Match(attrs))
+			// Log entries without attributes match only the empty filter.
+			require.Equal(t, tc.filter.Empty(), tc.filter.Match(LogEntryAttributes{}))
+		})
+	}
+}
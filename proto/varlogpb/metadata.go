@@ -535,6 +535,19 @@ func (t *TopicDescriptor) InsertLogStream(lsID types.LogStreamID) {
 	t.insertLogStreamAt(idx, lsID)
 }
 
+func (t *TopicDescriptor) DeleteLogStream(lsID types.LogStreamID) {
+	if t == nil {
+		return
+	}
+
+	idx, match := t.searchLogStream(lsID)
+	if !match {
+		return
+	}
+
+	t.LogStreams = append(t.LogStreams[:idx], t.LogStreams[idx+1:]...)
+}
+
 func (t *TopicDescriptor) HasLogStream(lsID types.LogStreamID) bool {
 	if t == nil {
 		return false
@@ -115,6 +115,113 @@ func (TopicStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_eb4411772ca3492a, []int{2}
 }
 
+// CompressionCodec is a codec compressing the payload of a log entry.
+type CompressionCodec int32
+
+const (
+	CompressionCodecNone   CompressionCodec = 0
+	CompressionCodecZstd   CompressionCodec = 1
+	CompressionCodecSnappy CompressionCodec = 2
+	CompressionCodecLZ4    CompressionCodec = 3
+)
+
+var CompressionCodec_name = map[int32]string{
+	0: "COMPRESSION_CODEC_NONE",
+	1: "COMPRESSION_CODEC_ZSTD",
+	2: "COMPRESSION_CODEC_SNAPPY",
+	3: "COMPRESSION_CODEC_LZ4",
+}
+
+var CompressionCodec_value = map[string]int32{
+	"COMPRESSION_CODEC_NONE":   0,
+	"COMPRESSION_CODEC_ZSTD":   1,
+	"COMPRESSION_CODEC_SNAPPY": 2,
+	"COMPRESSION_CODEC_LZ4":    3,
+}
+
+func (x CompressionCodec) String() string {
+	return proto.EnumName(CompressionCodec_name, int32(x))
+}
+
+func (CompressionCodec) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{3}
+}
+
+// SchemaType is the format of a schema.
+type SchemaType int32
+
+const (
+	SchemaTypeUnspecified SchemaType = 0
+	// SCHEMA_TYPE_PROTOBUF is a serialized google.protobuf.FileDescriptorSet
+	// that contains all dependencies of its files.
+	SchemaTypeProtobuf SchemaType = 1
+	// SCHEMA_TYPE_JSON is a JSON Schema.
+	SchemaTypeJSON SchemaType = 2
+	// SCHEMA_TYPE_AVRO is an Avro schema in its JSON form.
+	SchemaTypeAvro SchemaType = 3
+)
+
+var SchemaType_name = map[int32]string{
+	0: "SCHEMA_TYPE_UNSPECIFIED",
+	1: "SCHEMA_TYPE_PROTOBUF",
+	2: "SCHEMA_TYPE_JSON",
+	3: "SCHEMA_TYPE_AVRO",
+}
+
+var SchemaType_value = map[string]int32{
+	"SCHEMA_TYPE_UNSPECIFIED": 0,
+	"SCHEMA_TYPE_PROTOBUF":    1,
+	"SCHEMA_TYPE_JSON":        2,
+	"SCHEMA_TYPE_AVRO":        3,
+}
+
+func (x SchemaType) String() string {
+	return proto.EnumName(SchemaType_name, int32(x))
+}
+
+func (SchemaType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{4}
+}
+
+// SchemaCompatibility decides which versions of a schema a new version should
+// be compatible with when it is registered to a topic.
+type SchemaCompatibility int32
+
+const (
+	// SCHEMA_COMPATIBILITY_BACKWARD means that consumers using the new version
+	// can read data written with the latest version.
+	SchemaCompatibilityBackward SchemaCompatibility = 0
+	// SCHEMA_COMPATIBILITY_FORWARD means that consumers using the latest
+	// version can read data written with the new version.
+	SchemaCompatibilityForward SchemaCompatibility = 1
+	// SCHEMA_COMPATIBILITY_FULL means both BACKWARD and FORWARD.
+	SchemaCompatibilityFull SchemaCompatibility = 2
+	// SCHEMA_COMPATIBILITY_NONE disables the compatibility check.
+	SchemaCompatibilityNone SchemaCompatibility = 3
+)
+
+var SchemaCompatibility_name = map[int32]string{
+	0: "SCHEMA_COMPATIBILITY_BACKWARD",
+	1: "SCHEMA_COMPATIBILITY_FORWARD",
+	2: "SCHEMA_COMPATIBILITY_FULL",
+	3: "SCHEMA_COMPATIBILITY_NONE",
+}
+
+var SchemaCompatibility_value = map[string]int32{
+	"SCHEMA_COMPATIBILITY_BACKWARD": 0,
+	"SCHEMA_COMPATIBILITY_FORWARD":  1,
+	"SCHEMA_COMPATIBILITY_FULL":     2,
+	"SCHEMA_COMPATIBILITY_NONE":     3,
+}
+
+func (x SchemaCompatibility) String() string {
+	return proto.EnumName(SchemaCompatibility_name, int32(x))
+}
+
+func (SchemaCompatibility) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{5}
+}
+
 // MetadataDescriptor is metadata to persist the overall state of the cluster in
 // the metadata repository.
 type MetadataDescriptor struct {
@@ -454,6 +561,10 @@ type TopicDescriptor struct {
 	TopicID    github_com_kakao_varlog_pkg_types.TopicID       `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
 	Status     TopicStatus                                     `protobuf:"varint,2,opt,name=status,proto3,enum=varlog.varlogpb.TopicStatus" json:"status"`
 	LogStreams []github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,3,rep,packed,name=log_streams,json=logStreams,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreams,omitempty"`
+	// Name is a human-readable name of the topic. It is unique among the topics
+	// in the cluster. A topic added without a name has an empty name.
+	Name   string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Config TopicConfig `protobuf:"bytes,5,opt,name=config,proto3" json:"config"`
 }
 
 func (m *TopicDescriptor) Reset()         { *m = TopicDescriptor{} }
@@ -510,6 +621,97 @@ func (m *TopicDescriptor) GetLogStreams() []github_com_kakao_varlog_pkg_types.Lo
 	return nil
 }
 
+func (m *TopicDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TopicDescriptor) GetConfig() TopicConfig {
+	if m != nil {
+		return m.Config
+	}
+	return TopicConfig{}
+}
+
+// TopicConfig is the configuration of a topic. Zero values of its fields mean
+// that the defaults of the cluster are used.
+type TopicConfig struct {
+	// ReplicationFactor is the number of replicas of each log stream in the
+	// topic. It cannot be less than the replication factor of the cluster.
+	ReplicationFactor int32 `protobuf:"varint,1,opt,name=replication_factor,json=replicationFactor,proto3" json:"replicationFactor,omitempty"`
+	// Retention is how long log entries in the topic should be kept. The
+	// cluster does not trim the topic by itself; tools that trim topics can
+	// refer to it.
+	Retention time.Duration `protobuf:"varint,2,opt,name=retention,proto3,casttype=time.Duration" json:"retention,omitempty"`
+	// MaxLogStreams is the maximum number of log streams in the topic.
+	MaxLogStreams int32 `protobuf:"varint,3,opt,name=max_log_streams,json=maxLogStreams,proto3" json:"maxLogStreams,omitempty"`
+	// Description is a free-form description of the topic.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *TopicConfig) Reset()         { *m = TopicConfig{} }
+func (m *TopicConfig) String() string { return proto.CompactTextString(m) }
+func (*TopicConfig) ProtoMessage()    {}
+func (*TopicConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{6}
+}
+func (m *TopicConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopicConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopicConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopicConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopicConfig.Merge(m, src)
+}
+func (m *TopicConfig) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TopicConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopicConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopicConfig proto.InternalMessageInfo
+
+func (m *TopicConfig) GetReplicationFactor() int32 {
+	if m != nil {
+		return m.ReplicationFactor
+	}
+	return 0
+}
+
+func (m *TopicConfig) GetRetention() time.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+func (m *TopicConfig) GetMaxLogStreams() int32 {
+	if m != nil {
+		return m.MaxLogStreams
+	}
+	return 0
+}
+
+func (m *TopicConfig) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 // StorageNode is a structure to represent identifier and address of storage
 // node.
 type StorageNode struct {
@@ -521,7 +723,7 @@ func (m *StorageNode) Reset()         { *m = StorageNode{} }
 func (m *StorageNode) String() string { return proto.CompactTextString(m) }
 func (*StorageNode) ProtoMessage()    {}
 func (*StorageNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{6}
+	return fileDescriptor_eb4411772ca3492a, []int{7}
 }
 func (m *StorageNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -574,7 +776,7 @@ func (m *TopicLogStream) Reset()         { *m = TopicLogStream{} }
 func (m *TopicLogStream) String() string { return proto.CompactTextString(m) }
 func (*TopicLogStream) ProtoMessage()    {}
 func (*TopicLogStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{7}
+	return fileDescriptor_eb4411772ca3492a, []int{8}
 }
 func (m *TopicLogStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +829,7 @@ func (m *LogStreamReplica) Reset()         { *m = LogStreamReplica{} }
 func (m *LogStreamReplica) String() string { return proto.CompactTextString(m) }
 func (*LogStreamReplica) ProtoMessage()    {}
 func (*LogStreamReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{8}
+	return fileDescriptor_eb4411772ca3492a, []int{9}
 }
 func (m *LogStreamReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +870,7 @@ func (m *LogSequenceNumber) Reset()         { *m = LogSequenceNumber{} }
 func (m *LogSequenceNumber) String() string { return proto.CompactTextString(m) }
 func (*LogSequenceNumber) ProtoMessage()    {}
 func (*LogSequenceNumber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{9}
+	return fileDescriptor_eb4411772ca3492a, []int{10}
 }
 func (m *LogSequenceNumber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +924,7 @@ func (m *LogEntryMeta) Reset()         { *m = LogEntryMeta{} }
 func (m *LogEntryMeta) String() string { return proto.CompactTextString(m) }
 func (*LogEntryMeta) ProtoMessage()    {}
 func (*LogEntryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{10}
+	return fileDescriptor_eb4411772ca3492a, []int{11}
 }
 func (m *LogEntryMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,16 +981,309 @@ func (m *LogEntryMeta) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
 	return 0
 }
 
+// LogEntryHeader is a key-value pair attached to a log entry. Varlog does not
+// interpret headers; they are delivered to subscribers as they are.
+type LogEntryHeader struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *LogEntryHeader) Reset()         { *m = LogEntryHeader{} }
+func (m *LogEntryHeader) String() string { return proto.CompactTextString(m) }
+func (*LogEntryHeader) ProtoMessage()    {}
+func (*LogEntryHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{12}
+}
+func (m *LogEntryHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntryHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntryHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogEntryHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntryHeader.Merge(m, src)
+}
+func (m *LogEntryHeader) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogEntryHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntryHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntryHeader proto.InternalMessageInfo
+
+func (m *LogEntryHeader) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LogEntryHeader) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// LogEntryAttributes are optional fields of a log entry stored alongside its
+// payload. A log entry appended without any attributes is stored in the same
+// format as before the attributes were introduced.
+type LogEntryAttributes struct {
+	// Key is a record key chosen by the producer.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Timestamp is a time chosen by the producer, for instance, the time when
+	// the record was created. It is not related to the commit time of the log
+	// entry.
+	Timestamp *time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp,omitempty"`
+	// Headers are arbitrary key-value pairs, for instance, tracing contexts.
+	Headers []LogEntryHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers"`
+	// CompressionCodec is the codec compressing the payload. Storage nodes
+	// store and replicate the compressed payload as it is, and clients
+	// decompress it before handing the log entry to the user.
+	CompressionCodec CompressionCodec `protobuf:"varint,4,opt,name=compression_codec,json=compressionCodec,proto3,enum=varlog.varlogpb.CompressionCodec" json:"compression_codec,omitempty"`
+	// Encryption is set if the payload is encrypted by the client. Storage
+	// nodes store and replicate the encrypted payload as it is, and clients
+	// having the key decrypt it before handing the log entry to the user.
+	Encryption *LogEntryEncryption `protobuf:"bytes,5,opt,name=encryption,proto3" json:"encryption,omitempty"`
+}
+
+func (m *LogEntryAttributes) Reset()         { *m = LogEntryAttributes{} }
+func (m *LogEntryAttributes) String() string { return proto.CompactTextString(m) }
+func (*LogEntryAttributes) ProtoMessage()    {}
+func (*LogEntryAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{13}
+}
+func (m *LogEntryAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntryAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntryAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogEntryAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntryAttributes.Merge(m, src)
+}
+func (m *LogEntryAttributes) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogEntryAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntryAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntryAttributes proto.InternalMessageInfo
+
+func (m *LogEntryAttributes) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *LogEntryAttributes) GetTimestamp() *time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *LogEntryAttributes) GetHeaders() []LogEntryHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *LogEntryAttributes) GetCompressionCodec() CompressionCodec {
+	if m != nil {
+		return m.CompressionCodec
+	}
+	return CompressionCodecNone
+}
+
+func (m *LogEntryAttributes) GetEncryption() *LogEntryEncryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+// LogEntryEncryption describes how the payload of a log entry is encrypted.
+// The payload is encrypted with a data key, which is wrapped by a key
+// encryption key managed outside of Varlog.
+type LogEntryEncryption struct {
+	// KeyID identifies the key encryption key wrapping the data key. It lets
+	// clients unwrap data keys even after key encryption keys are rotated.
+	KeyID string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// WrappedDataKey is the data key encrypted by the key encryption key.
+	WrappedDataKey []byte `protobuf:"bytes,2,opt,name=wrapped_data_key,json=wrappedDataKey,proto3" json:"wrapped_data_key,omitempty"`
+	// CompressionCodec is the codec compressing the payload before it is
+	// encrypted.
+	CompressionCodec CompressionCodec `protobuf:"varint,3,opt,name=compression_codec,json=compressionCodec,proto3,enum=varlog.varlogpb.CompressionCodec" json:"compression_codec,omitempty"`
+}
+
+func (m *LogEntryEncryption) Reset()         { *m = LogEntryEncryption{} }
+func (m *LogEntryEncryption) String() string { return proto.CompactTextString(m) }
+func (*LogEntryEncryption) ProtoMessage()    {}
+func (*LogEntryEncryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{14}
+}
+func (m *LogEntryEncryption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntryEncryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntryEncryption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogEntryEncryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntryEncryption.Merge(m, src)
+}
+func (m *LogEntryEncryption) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogEntryEncryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntryEncryption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntryEncryption proto.InternalMessageInfo
+
+func (m *LogEntryEncryption) GetKeyID() string {
+	if m != nil {
+		return m.KeyID
+	}
+	return ""
+}
+
+func (m *LogEntryEncryption) GetWrappedDataKey() []byte {
+	if m != nil {
+		return m.WrappedDataKey
+	}
+	return nil
+}
+
+func (m *LogEntryEncryption) GetCompressionCodec() CompressionCodec {
+	if m != nil {
+		return m.CompressionCodec
+	}
+	return CompressionCodecNone
+}
+
+// LogEntryFilter selects log entries by their attributes. A log entry matches
+// the filter if it satisfies all conditions set in the filter; thus, an empty
+// filter matches all log entries. Storage nodes evaluate the filter before
+// sending log entries to subscribers.
+type LogEntryFilter struct {
+	// KeyPrefix matches log entries whose keys begin with it.
+	KeyPrefix []byte `protobuf:"bytes,1,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// Headers match log entries that have all of them. A header matches a
+	// header of the log entry with the same key and value. A header with an
+	// empty value matches any header with the same key.
+	Headers []LogEntryHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers"`
+	// TimestampBegin and TimestampEnd match log entries whose timestamps are in
+	// the range [TimestampBegin, TimestampEnd). Either of them can be unset to
+	// leave the range open. Log entries without timestamps do not match if
+	// either of them is set.
+	TimestampBegin *time.Time `protobuf:"bytes,3,opt,name=timestamp_begin,json=timestampBegin,proto3,stdtime" json:"timestamp_begin,omitempty"`
+	TimestampEnd   *time.Time `protobuf:"bytes,4,opt,name=timestamp_end,json=timestampEnd,proto3,stdtime" json:"timestamp_end,omitempty"`
+}
+
+func (m *LogEntryFilter) Reset()         { *m = LogEntryFilter{} }
+func (m *LogEntryFilter) String() string { return proto.CompactTextString(m) }
+func (*LogEntryFilter) ProtoMessage()    {}
+func (*LogEntryFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{15}
+}
+func (m *LogEntryFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntryFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogEntryFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogEntryFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntryFilter.Merge(m, src)
+}
+func (m *LogEntryFilter) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *LogEntryFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntryFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntryFilter proto.InternalMessageInfo
+
+func (m *LogEntryFilter) GetKeyPrefix() []byte {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return nil
+}
+
+func (m *LogEntryFilter) GetHeaders() []LogEntryHeader {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *LogEntryFilter) GetTimestampBegin() *time.Time {
+	if m != nil {
+		return m.TimestampBegin
+	}
+	return nil
+}
+
+func (m *LogEntryFilter) GetTimestampEnd() *time.Time {
+	if m != nil {
+		return m.TimestampEnd
+	}
+	return nil
+}
+
 type LogEntry struct {
-	LogEntryMeta `protobuf:"bytes,1,opt,name=meta,proto3,embedded=meta" json:"meta"`
-	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	LogEntryMeta       `protobuf:"bytes,1,opt,name=meta,proto3,embedded=meta" json:"meta"`
+	Data               []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	LogEntryAttributes `protobuf:"bytes,3,opt,name=attributes,proto3,embedded=attributes" json:"attributes"`
 }
 
 func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{11}
+	return fileDescriptor_eb4411772ca3492a, []int{16}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1331,7 @@ func (m *CommitContext) Reset()         { *m = CommitContext{} }
 func (m *CommitContext) String() string { return proto.CompactTextString(m) }
 func (*CommitContext) ProtoMessage()    {}
 func (*CommitContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{12}
+	return fileDescriptor_eb4411772ca3492a, []int{17}
 }
 func (m *CommitContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1407,7 @@ func (m *MetadataRepositoryNode) Reset()         { *m = MetadataRepositoryNode{}
 func (m *MetadataRepositoryNode) String() string { return proto.CompactTextString(m) }
 func (*MetadataRepositoryNode) ProtoMessage()    {}
 func (*MetadataRepositoryNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb4411772ca3492a, []int{13}
+	return fileDescriptor_eb4411772ca3492a, []int{18}
 }
 func (m *MetadataRepositoryNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,122 +1471,421 @@ func (m *MetadataRepositoryNode) GetLearner() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterEnum("varlog.varlogpb.StorageNodeStatus", StorageNodeStatus_name, StorageNodeStatus_value)
-	proto.RegisterEnum("varlog.varlogpb.LogStreamStatus", LogStreamStatus_name, LogStreamStatus_value)
-	proto.RegisterEnum("varlog.varlogpb.TopicStatus", TopicStatus_name, TopicStatus_value)
-	proto.RegisterType((*MetadataDescriptor)(nil), "varlog.varlogpb.MetadataDescriptor")
-	proto.RegisterType((*StorageNodeDescriptor)(nil), "varlog.varlogpb.StorageNodeDescriptor")
-	proto.RegisterType((*StorageDescriptor)(nil), "varlog.varlogpb.StorageDescriptor")
-	proto.RegisterType((*LogStreamDescriptor)(nil), "varlog.varlogpb.LogStreamDescriptor")
-	proto.RegisterType((*ReplicaDescriptor)(nil), "varlog.varlogpb.ReplicaDescriptor")
-	proto.RegisterType((*TopicDescriptor)(nil), "varlog.varlogpb.TopicDescriptor")
-	proto.RegisterType((*StorageNode)(nil), "varlog.varlogpb.StorageNode")
-	proto.RegisterType((*TopicLogStream)(nil), "varlog.varlogpb.TopicLogStream")
+// ConsumerGroupOffset is the position committed by a consumer group. An offset
+// whose log_stream_id is zero is a topic-level offset, which is the GLSN of the
+// last log entry processed by the consumer group in the topic. Otherwise, it is
+// a log-stream-level offset, which is the LLSN of the last log entry processed
+// in the log stream.
+type ConsumerGroupOffset struct {
+	TopicID     github_com_kakao_varlog_pkg_types.TopicID     `protobuf:"varint,1,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	LogStreamID github_com_kakao_varlog_pkg_types.LogStreamID `protobuf:"varint,2,opt,name=log_stream_id,json=logStreamId,proto3,casttype=github.com/kakao/varlog/pkg/types.LogStreamID" json:"logStreamId,omitempty"`
+	GLSN        github_com_kakao_varlog_pkg_types.GLSN        `protobuf:"varint,3,opt,name=glsn,proto3,casttype=github.com/kakao/varlog/pkg/types.GLSN" json:"glsn,omitempty"`
+	LLSN        github_com_kakao_varlog_pkg_types.LLSN        `protobuf:"varint,4,opt,name=llsn,proto3,casttype=github.com/kakao/varlog/pkg/types.LLSN" json:"llsn,omitempty"`
+	UpdateTime  time.Time                                     `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3,stdtime" json:"updateTime"`
+}
+
+func (m *ConsumerGroupOffset) Reset()         { *m = ConsumerGroupOffset{} }
+func (m *ConsumerGroupOffset) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupOffset) ProtoMessage()    {}
+func (*ConsumerGroupOffset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{19}
+}
+func (m *ConsumerGroupOffset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupOffset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupOffset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupOffset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupOffset.Merge(m, src)
+}
+func (m *ConsumerGroupOffset) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupOffset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupOffset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroupOffset proto.InternalMessageInfo
+
+func (m *ConsumerGroupOffset) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetLogStreamID() github_com_kakao_varlog_pkg_types.LogStreamID {
+	if m != nil {
+		return m.LogStreamID
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetGLSN() github_com_kakao_varlog_pkg_types.GLSN {
+	if m != nil {
+		return m.GLSN
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetLLSN() github_com_kakao_varlog_pkg_types.LLSN {
+	if m != nil {
+		return m.LLSN
+	}
+	return 0
+}
+
+func (m *ConsumerGroupOffset) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return time.Time{}
+}
+
+// ConsumerGroupDescriptor is metadata to persist the committed offsets of a
+// consumer group in the metadata repository.
+type ConsumerGroupDescriptor struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	// Offsets are sorted by TopicID and LogStreamID.
+	Offsets []ConsumerGroupOffset `protobuf:"bytes,2,rep,name=offsets,proto3" json:"offsets"`
+}
+
+func (m *ConsumerGroupDescriptor) Reset()         { *m = ConsumerGroupDescriptor{} }
+func (m *ConsumerGroupDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGroupDescriptor) ProtoMessage()    {}
+func (*ConsumerGroupDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{20}
+}
+func (m *ConsumerGroupDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGroupDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGroupDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGroupDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGroupDescriptor.Merge(m, src)
+}
+func (m *ConsumerGroupDescriptor) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ConsumerGroupDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGroupDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGroupDescriptor proto.InternalMessageInfo
+
+func (m *ConsumerGroupDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerGroupDescriptor) GetOffsets() []ConsumerGroupOffset {
+	if m != nil {
+		return m.Offsets
+	}
+	return nil
+}
+
+// SchemaDescriptor is metadata to persist a version of the schema of a topic
+// in the metadata repository. It is immutable once registered.
+type SchemaDescriptor struct {
+	// SchemaID is unique across topics.
+	SchemaID github_com_kakao_varlog_pkg_types.SchemaID `protobuf:"varint,1,opt,name=schema_id,json=schemaId,proto3,casttype=github.com/kakao/varlog/pkg/types.SchemaID" json:"schemaId"`
+	TopicID  github_com_kakao_varlog_pkg_types.TopicID  `protobuf:"varint,2,opt,name=topic_id,json=topicId,proto3,casttype=github.com/kakao/varlog/pkg/types.TopicID" json:"topicId"`
+	// Version starts from one and increases by one for each schema registered
+	// to the topic.
+	Version    int32      `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+	Type       SchemaType `protobuf:"varint,4,opt,name=type,proto3,enum=varlog.varlogpb.SchemaType" json:"type"`
+	Definition []byte     `protobuf:"bytes,5,opt,name=definition,proto3" json:"definition"`
+	CreateTime time.Time  `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3,stdtime" json:"createTime"`
+}
+
+func (m *SchemaDescriptor) Reset()         { *m = SchemaDescriptor{} }
+func (m *SchemaDescriptor) String() string { return proto.CompactTextString(m) }
+func (*SchemaDescriptor) ProtoMessage()    {}
+func (*SchemaDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb4411772ca3492a, []int{21}
+}
+func (m *SchemaDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchemaDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaDescriptor.Merge(m, src)
+}
+func (m *SchemaDescriptor) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *SchemaDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaDescriptor proto.InternalMessageInfo
+
+func (m *SchemaDescriptor) GetSchemaID() github_com_kakao_varlog_pkg_types.SchemaID {
+	if m != nil {
+		return m.SchemaID
+	}
+	return 0
+}
+
+func (m *SchemaDescriptor) GetTopicID() github_com_kakao_varlog_pkg_types.TopicID {
+	if m != nil {
+		return m.TopicID
+	}
+	return 0
+}
+
+func (m *SchemaDescriptor) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SchemaDescriptor) GetType() SchemaType {
+	if m != nil {
+		return m.Type
+	}
+	return SchemaTypeUnspecified
+}
+
+func (m *SchemaDescriptor) GetDefinition() []byte {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func (m *SchemaDescriptor) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("varlog.varlogpb.StorageNodeStatus", StorageNodeStatus_name, StorageNodeStatus_value)
+	proto.RegisterEnum("varlog.varlogpb.LogStreamStatus", LogStreamStatus_name, LogStreamStatus_value)
+	proto.RegisterEnum("varlog.varlogpb.TopicStatus", TopicStatus_name, TopicStatus_value)
+	proto.RegisterEnum("varlog.varlogpb.CompressionCodec", CompressionCodec_name, CompressionCodec_value)
+	proto.RegisterEnum("varlog.varlogpb.SchemaType", SchemaType_name, SchemaType_value)
+	proto.RegisterEnum("varlog.varlogpb.SchemaCompatibility", SchemaCompatibility_name, SchemaCompatibility_value)
+	proto.RegisterType((*MetadataDescriptor)(nil), "varlog.varlogpb.MetadataDescriptor")
+	proto.RegisterType((*StorageNodeDescriptor)(nil), "varlog.varlogpb.StorageNodeDescriptor")
+	proto.RegisterType((*StorageDescriptor)(nil), "varlog.varlogpb.StorageDescriptor")
+	proto.RegisterType((*LogStreamDescriptor)(nil), "varlog.varlogpb.LogStreamDescriptor")
+	proto.RegisterType((*ReplicaDescriptor)(nil), "varlog.varlogpb.ReplicaDescriptor")
+	proto.RegisterType((*TopicDescriptor)(nil), "varlog.varlogpb.TopicDescriptor")
+	proto.RegisterType((*TopicConfig)(nil), "varlog.varlogpb.TopicConfig")
+	proto.RegisterType((*StorageNode)(nil), "varlog.varlogpb.StorageNode")
+	proto.RegisterType((*TopicLogStream)(nil), "varlog.varlogpb.TopicLogStream")
 	proto.RegisterType((*LogStreamReplica)(nil), "varlog.varlogpb.LogStreamReplica")
 	proto.RegisterType((*LogSequenceNumber)(nil), "varlog.varlogpb.LogSequenceNumber")
 	proto.RegisterType((*LogEntryMeta)(nil), "varlog.varlogpb.LogEntryMeta")
+	proto.RegisterType((*LogEntryHeader)(nil), "varlog.varlogpb.LogEntryHeader")
+	proto.RegisterType((*LogEntryAttributes)(nil), "varlog.varlogpb.LogEntryAttributes")
+	proto.RegisterType((*LogEntryEncryption)(nil), "varlog.varlogpb.LogEntryEncryption")
+	proto.RegisterType((*LogEntryFilter)(nil), "varlog.varlogpb.LogEntryFilter")
 	proto.RegisterType((*LogEntry)(nil), "varlog.varlogpb.LogEntry")
 	proto.RegisterType((*CommitContext)(nil), "varlog.varlogpb.CommitContext")
 	proto.RegisterType((*MetadataRepositoryNode)(nil), "varlog.varlogpb.MetadataRepositoryNode")
+	proto.RegisterType((*ConsumerGroupOffset)(nil), "varlog.varlogpb.ConsumerGroupOffset")
+	proto.RegisterType((*ConsumerGroupDescriptor)(nil), "varlog.varlogpb.ConsumerGroupDescriptor")
+	proto.RegisterType((*SchemaDescriptor)(nil), "varlog.varlogpb.SchemaDescriptor")
 }
 
 func init() { proto.RegisterFile("proto/varlogpb/metadata.proto", fileDescriptor_eb4411772ca3492a) }
 
 var fileDescriptor_eb4411772ca3492a = []byte{
-	// 1465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
-	0x1c, 0xce, 0xda, 0x9b, 0xc4, 0x19, 0xc7, 0x89, 0x33, 0x4d, 0x23, 0x63, 0x5a, 0xaf, 0x15, 0x41,
-	0x95, 0x56, 0xd4, 0xa6, 0x41, 0x95, 0xaa, 0x56, 0x40, 0xe3, 0xd8, 0xa4, 0x91, 0x5c, 0xa7, 0x1a,
-	0x3b, 0x54, 0xe5, 0x80, 0x35, 0xf6, 0x4e, 0xd7, 0xab, 0xac, 0x77, 0x97, 0xdd, 0x71, 0xdb, 0x1c,
-	0xb8, 0x71, 0x40, 0x39, 0x55, 0x70, 0x80, 0x4b, 0xa4, 0x4a, 0x70, 0x41, 0xe2, 0x8f, 0xe0, 0xd8,
-	0x63, 0x8f, 0x70, 0x71, 0x25, 0xe7, 0x82, 0xc2, 0x85, 0x73, 0x4f, 0x68, 0x66, 0x67, 0xed, 0xdd,
-	0xb5, 0xd3, 0x36, 0x14, 0x84, 0xc4, 0xc9, 0xf3, 0xfa, 0x7e, 0x8f, 0xef, 0xf7, 0xcd, 0x63, 0x0d,
-	0xce, 0xdb, 0x8e, 0x45, 0xad, 0xe2, 0x03, 0xec, 0x18, 0x96, 0x66, 0xb7, 0x8a, 0x5d, 0x42, 0xb1,
-	0x8a, 0x29, 0x2e, 0xf0, 0x71, 0xb8, 0xe8, 0x4d, 0x14, 0xfc, 0xf9, 0xac, 0xa2, 0x59, 0x96, 0x66,
-	0x90, 0x22, 0x9f, 0x6e, 0xf5, 0xee, 0x17, 0xa9, 0xde, 0x25, 0x2e, 0xc5, 0x5d, 0xdb, 0x43, 0x64,
-	0x2f, 0x6b, 0x3a, 0xed, 0xf4, 0x5a, 0x85, 0xb6, 0xd5, 0x2d, 0x6a, 0x96, 0x66, 0x8d, 0x56, 0xb2,
-	0x9e, 0xe7, 0x8d, 0xb5, 0xbc, 0xe5, 0xab, 0xbf, 0xc5, 0x00, 0xbc, 0x2d, 0x7c, 0x96, 0x89, 0xdb,
-	0x76, 0x74, 0x9b, 0x5a, 0x0e, 0xbc, 0x0a, 0x52, 0xd8, 0xb6, 0x0d, 0x9d, 0xa8, 0x4d, 0xdd, 0x54,
-	0xc9, 0xa3, 0x8c, 0x94, 0x97, 0xd6, 0xe4, 0x52, 0xfa, 0xb8, 0xaf, 0xcc, 0x8b, 0x89, 0x6d, 0x36,
-	0x8e, 0x42, 0x3d, 0x88, 0x41, 0xca, 0xa5, 0x96, 0x83, 0x35, 0xd2, 0x34, 0x2d, 0x95, 0xb8, 0x99,
-	0x58, 0x3e, 0xbe, 0x96, 0x5c, 0xbf, 0x50, 0x88, 0xa4, 0x51, 0xa8, 0x7b, 0xab, 0x6a, 0x96, 0x4a,
-	0x46, 0x5e, 0x4b, 0xcb, 0x4f, 0xfb, 0x8a, 0xc4, 0x5c, 0xb8, 0xa3, 0x69, 0x17, 0x85, 0x7a, 0xf0,
-	0x1e, 0x48, 0x1a, 0x96, 0xd6, 0x74, 0xa9, 0x43, 0x70, 0xd7, 0xcd, 0xc4, 0xb9, 0x83, 0x77, 0xc6,
-	0x1c, 0x54, 0x2d, 0xad, 0xce, 0x97, 0x04, 0xcc, 0x43, 0x61, 0x1e, 0x18, 0xfe, 0xa4, 0x8b, 0x02,
-	0x6d, 0x78, 0x0b, 0xcc, 0x50, 0xcb, 0xd6, 0xdb, 0x6e, 0x46, 0xe6, 0x56, 0xf3, 0x63, 0x56, 0x1b,
-	0x6c, 0x3a, 0x60, 0x71, 0x41, 0x58, 0x14, 0x38, 0x24, 0x7e, 0xaf, 0xcb, 0xbf, 0x3f, 0x51, 0xa4,
-	0xd5, 0x6f, 0x63, 0xe0, 0xec, 0xc4, 0x44, 0xe1, 0x6d, 0x30, 0x1f, 0xe4, 0x89, 0xb3, 0x9b, 0x5c,
-	0x3f, 0xf7, 0x32, 0x9a, 0x4a, 0xf3, 0x4f, 0xfb, 0xca, 0xd4, 0x33, 0xcf, 0xdf, 0x14, 0x4a, 0x06,
-	0x48, 0x81, 0xd7, 0xc1, 0x8c, 0x4b, 0x31, 0xed, 0x31, 0xbe, 0xa5, 0xb5, 0x85, 0xf5, 0xd5, 0x97,
-	0x19, 0xaa, 0xf3, 0x95, 0x48, 0x20, 0xe0, 0x32, 0x98, 0xb6, 0x31, 0xed, 0x78, 0x4c, 0xce, 0x21,
-	0xaf, 0x03, 0xeb, 0x20, 0xd9, 0x76, 0x08, 0xa6, 0xa4, 0xc9, 0xf4, 0x95, 0x91, 0x79, 0x7c, 0xd9,
-	0x82, 0x27, 0xbe, 0x82, 0x2f, 0xa9, 0x42, 0xc3, 0x17, 0x5f, 0x69, 0x85, 0x45, 0xc7, 0xb8, 0xf5,
-	0x60, 0x6c, 0xe2, 0xf1, 0x73, 0x45, 0x42, 0x81, 0xbe, 0x60, 0xe5, 0x2e, 0x58, 0x12, 0xd1, 0x04,
-	0x08, 0x81, 0x40, 0x66, 0x8e, 0x39, 0x11, 0x73, 0x88, 0xb7, 0xd9, 0x58, 0xcf, 0x25, 0x2a, 0xcf,
-	0x49, 0x46, 0xbc, 0xcd, 0xa2, 0xa5, 0x16, 0xc5, 0x46, 0x26, 0xce, 0x07, 0xbd, 0x8e, 0x30, 0xfc,
-	0x67, 0x0c, 0x9c, 0x99, 0x50, 0x76, 0xf8, 0x39, 0x48, 0xf0, 0xb2, 0x34, 0x75, 0x95, 0xdb, 0x9f,
-	0x2e, 0x6d, 0x0e, 0xfa, 0xca, 0x2c, 0xaf, 0xe5, 0x76, 0xf9, 0xb8, 0xaf, 0xcc, 0xf2, 0xe9, 0x6d,
-	0xf5, 0x45, 0x5f, 0xb9, 0x18, 0xd8, 0x3d, 0x7b, 0x78, 0x0f, 0xfb, 0x3b, 0xb3, 0x68, 0xef, 0x69,
-	0x45, 0xba, 0x6f, 0x13, 0xb7, 0x20, 0x70, 0xc8, 0x47, 0x41, 0x17, 0xa4, 0x46, 0x8a, 0x64, 0x4e,
-	0x62, 0xdc, 0xc9, 0xce, 0xa0, 0xaf, 0x24, 0x87, 0xf1, 0x70, 0x47, 0xc9, 0xa1, 0xd8, 0xb8, 0xb3,
-	0xcb, 0xaf, 0x76, 0x16, 0xc0, 0xa3, 0x20, 0x1a, 0x5e, 0x1b, 0x96, 0x3c, 0xce, 0x4b, 0x9e, 0x3f,
-	0x79, 0x07, 0x44, 0x0a, 0x5e, 0x06, 0x09, 0x87, 0xd8, 0x86, 0xde, 0xc6, 0xbe, 0xce, 0xc7, 0xe5,
-	0x82, 0xbc, 0x05, 0x01, 0xa5, 0xcb, 0x4c, 0xe9, 0x68, 0x88, 0x14, 0x94, 0x7f, 0x15, 0x03, 0x4b,
-	0x63, 0x6b, 0xe1, 0x97, 0x60, 0x31, 0xa8, 0xee, 0x11, 0xef, 0xbb, 0x83, 0xbe, 0x92, 0x0a, 0x48,
-	0x91, 0x93, 0x92, 0x0a, 0x28, 0x99, 0xd3, 0x52, 0x7c, 0x35, 0x2d, 0x21, 0x1b, 0x28, 0x6c, 0x01,
-	0x7e, 0x0c, 0x96, 0x42, 0xee, 0xb9, 0xb0, 0x58, 0x4d, 0xe6, 0x4a, 0x67, 0x8e, 0xfb, 0xca, 0x62,
-	0x60, 0xf5, 0x1d, 0x4c, 0x3b, 0x28, 0x3a, 0x00, 0x2f, 0x82, 0x39, 0x76, 0x1c, 0x7a, 0xc0, 0x38,
-	0x07, 0xce, 0x1f, 0xf7, 0x95, 0x04, 0x1b, 0xe4, 0x88, 0x61, 0x4b, 0xd0, 0xf0, 0x53, 0x0c, 0x2c,
-	0x46, 0x8e, 0x86, 0x7f, 0x5d, 0x75, 0x37, 0x23, 0x7b, 0xfe, 0xdc, 0xe4, 0xc3, 0xca, 0x2b, 0x7e,
-	0x09, 0xb0, 0x43, 0xca, 0x0d, 0x0b, 0xc1, 0x1c, 0x3f, 0x49, 0xa7, 0x4b, 0xb7, 0xc5, 0x89, 0xb6,
-	0x3c, 0x3a, 0x17, 0xdf, 0xb3, 0xba, 0x3a, 0x25, 0x5d, 0x9b, 0xee, 0x9f, 0x5e, 0xb3, 0x81, 0xe3,
-	0x55, 0x70, 0xf5, 0xb3, 0x04, 0x92, 0x81, 0xf2, 0xfd, 0xd7, 0x62, 0xc9, 0x80, 0x59, 0xac, 0xaa,
-	0x0e, 0x71, 0x3d, 0x1e, 0xe7, 0x90, 0xdf, 0x15, 0xe1, 0xfe, 0x21, 0x81, 0x05, 0x4e, 0xe4, 0x30,
-	0xab, 0xff, 0xe5, 0x79, 0x22, 0xb2, 0xfd, 0x45, 0x02, 0xe9, 0xe1, 0x12, 0xb1, 0xb1, 0xff, 0xe9,
-	0xcb, 0xea, 0x2e, 0x48, 0x7b, 0xf4, 0x8d, 0x92, 0xe4, 0x19, 0x26, 0xd7, 0x95, 0xc9, 0x12, 0x1e,
-	0x06, 0x14, 0xb1, 0xba, 0x40, 0x43, 0xb3, 0xfe, 0x5e, 0x94, 0xc0, 0x12, 0x1b, 0x23, 0x5f, 0xf4,
-	0x88, 0xd9, 0x26, 0xb5, 0x5e, 0xb7, 0x45, 0x1c, 0xf8, 0x09, 0x90, 0x0d, 0xc3, 0x35, 0xc5, 0x33,
-	0x66, 0x7d, 0xd0, 0x57, 0xe4, 0x6a, 0xb5, 0x5e, 0x7b, 0xd1, 0x57, 0x2e, 0xbc, 0x06, 0x69, 0xd5,
-	0x7a, 0x0d, 0x71, 0x3c, 0xb3, 0xa3, 0x31, 0x3b, 0xb1, 0x91, 0x9d, 0xad, 0xd7, 0xb6, 0xb3, 0xc5,
-	0xed, 0x30, 0xbc, 0x88, 0xf5, 0x79, 0x0c, 0xcc, 0x57, 0x2d, 0xad, 0x62, 0x52, 0x67, 0x9f, 0x3d,
-	0xc2, 0x60, 0x7d, 0x4c, 0x5a, 0xd7, 0x02, 0xd2, 0xfa, 0x9b, 0x7a, 0x52, 0x27, 0xeb, 0xe9, 0x66,
-	0x44, 0x4f, 0x6f, 0x78, 0x21, 0xf9, 0xcc, 0xc4, 0xdf, 0x8c, 0x99, 0x61, 0xa5, 0xe4, 0x37, 0xab,
-	0x94, 0x60, 0x18, 0x83, 0x84, 0x4f, 0x30, 0xbc, 0x01, 0x64, 0xf6, 0xba, 0x16, 0xfa, 0x3d, 0x3f,
-	0xe9, 0xc2, 0x1c, 0x56, 0xa2, 0x94, 0xf0, 0xa5, 0x86, 0x38, 0x88, 0x3d, 0x46, 0xd8, 0xa1, 0xcf,
-	0xb9, 0x9b, 0x47, 0xbc, 0x2d, 0x5c, 0x7c, 0x23, 0x83, 0xd4, 0xa6, 0xd5, 0xed, 0xea, 0x74, 0xd3,
-	0x32, 0x29, 0x79, 0x44, 0xe1, 0x16, 0x98, 0x7d, 0x40, 0x1c, 0x57, 0xb7, 0x7c, 0xbd, 0x5d, 0x7e,
-	0xbd, 0xca, 0x7d, 0xea, 0x81, 0x90, 0x8f, 0x86, 0x2d, 0xb0, 0xd0, 0xd1, 0xb5, 0x4e, 0xf3, 0x21,
-	0xa6, 0xc4, 0xe9, 0x62, 0x67, 0x4f, 0xe8, 0xee, 0x06, 0x3b, 0x1a, 0x6f, 0xe9, 0x5a, 0xe7, 0xae,
-	0x3f, 0x71, 0x0a, 0x9a, 0x53, 0x9d, 0x20, 0x10, 0x3a, 0x60, 0xb9, 0xcd, 0xa3, 0xa7, 0x44, 0x6d,
-	0xb2, 0x0a, 0x34, 0x5b, 0x44, 0xd3, 0xfd, 0x3a, 0x32, 0x91, 0xc0, 0x4d, 0x7f, 0x9e, 0xe1, 0x4b,
-	0x6c, 0xf6, 0x14, 0xee, 0xe0, 0xd0, 0xfa, 0x96, 0xe1, 0x9a, 0x1c, 0x0d, 0x0d, 0x00, 0x23, 0x3e,
-	0x89, 0xa9, 0x8a, 0x8a, 0x7f, 0x34, 0xe8, 0x2b, 0xe9, 0x90, 0xc7, 0x8a, 0xa9, 0x9e, 0xc2, 0x5f,
-	0x3a, 0xe4, 0xaf, 0x62, 0xaa, 0xe1, 0x0c, 0x8d, 0x51, 0x86, 0xd3, 0x13, 0x32, 0xac, 0x9e, 0x2e,
-	0xc3, 0x6a, 0x38, 0xc3, 0xaa, 0x9f, 0xe1, 0xea, 0x8f, 0x31, 0xb0, 0xe2, 0x7f, 0x56, 0x21, 0x62,
-	0x5b, 0xae, 0x4e, 0x2d, 0x67, 0x9f, 0x9f, 0x7f, 0xf7, 0xc0, 0x6c, 0xf0, 0xa2, 0xf3, 0x22, 0x98,
-	0x19, 0xde, 0x70, 0x33, 0xa6, 0x7f, 0xb5, 0xad, 0xbd, 0xda, 0xbf, 0xb8, 0xd3, 0x04, 0x06, 0x5e,
-	0x01, 0x09, 0x07, 0xdf, 0xa7, 0xcd, 0x9e, 0x63, 0x88, 0x07, 0xcf, 0x0a, 0x3b, 0x3e, 0x10, 0xbe,
-	0x4f, 0x77, 0x51, 0x95, 0xdd, 0x4c, 0x8e, 0xd7, 0x44, 0x5e, 0xc3, 0x31, 0x38, 0xc4, 0x6e, 0x37,
-	0xd9, 0xa5, 0x27, 0x9e, 0x3a, 0x1e, 0xe4, 0xce, 0xe6, 0x86, 0xaa, 0x3a, 0x1c, 0x62, 0xb7, 0x59,
-	0x13, 0xf9, 0x0d, 0xb8, 0x0a, 0x66, 0x0c, 0x82, 0x55, 0xe2, 0xf0, 0x8a, 0x25, 0xbc, 0xb7, 0x85,
-	0x37, 0x82, 0xc4, 0x2f, 0x7c, 0x17, 0xcc, 0x1a, 0x04, 0x3b, 0x26, 0x71, 0x38, 0xcd, 0x89, 0x52,
-	0x92, 0x99, 0x12, 0x43, 0xc8, 0x6f, 0x5c, 0xfa, 0x4e, 0x1a, 0x7e, 0x0c, 0x8c, 0x3e, 0x4d, 0xe0,
-	0x87, 0xe0, 0xed, 0x7a, 0x63, 0x07, 0x6d, 0x6c, 0x55, 0x9a, 0xb5, 0x9d, 0x72, 0xa5, 0x59, 0x6f,
-	0x6c, 0x34, 0x76, 0xeb, 0x4d, 0xb4, 0x5b, 0xab, 0x6d, 0xd7, 0xb6, 0xd2, 0x53, 0xd9, 0x73, 0x07,
-	0x87, 0xf9, 0xcc, 0xf8, 0x27, 0x4d, 0xcf, 0x34, 0x75, 0x53, 0x3b, 0x09, 0x5e, 0xae, 0x54, 0x2b,
-	0x8d, 0x4a, 0x39, 0x2d, 0x9d, 0x00, 0x2f, 0x13, 0x83, 0x50, 0xa2, 0x66, 0xe5, 0xaf, 0x7f, 0xc8,
-	0x4d, 0x5d, 0xfa, 0x3e, 0x06, 0x16, 0x23, 0x2f, 0x68, 0x78, 0x05, 0x2c, 0x55, 0xeb, 0xe3, 0xd1,
-	0x64, 0x0f, 0x0e, 0xf3, 0x2b, 0xd1, 0xd7, 0xb6, 0x88, 0x25, 0x04, 0xa9, 0x57, 0x36, 0xaa, 0x0c,
-	0x22, 0x4d, 0x84, 0xd4, 0x09, 0x36, 0x18, 0xa4, 0x08, 0xd2, 0x61, 0x48, 0xa5, 0x9c, 0x8e, 0x65,
-	0xdf, 0x3a, 0x38, 0xcc, 0x9f, 0x9d, 0x80, 0x20, 0x6a, 0xd8, 0x87, 0x9f, 0x65, 0x7c, 0xa2, 0x0f,
-	0x91, 0x23, 0xbc, 0x0a, 0xce, 0x8c, 0x20, 0xbb, 0x35, 0x3f, 0x30, 0xd9, 0xa3, 0x26, 0x02, 0xda,
-	0x35, 0x5d, 0x2f, 0x34, 0x41, 0xcd, 0x43, 0x90, 0x0c, 0x3c, 0x2d, 0xe1, 0xfb, 0x60, 0xb9, 0xb1,
-	0x73, 0x67, 0x7b, 0x73, 0x9c, 0x98, 0x95, 0x83, 0xc3, 0x3c, 0x0c, 0x2c, 0xf5, 0x49, 0x89, 0x22,
-	0x46, 0x95, 0x89, 0x22, 0x42, 0x35, 0x29, 0xdd, 0x7c, 0x3a, 0xc8, 0x49, 0xcf, 0x06, 0x39, 0xe9,
-	0xf1, 0x51, 0x6e, 0xea, 0xc9, 0x51, 0x4e, 0x7a, 0x76, 0x94, 0x9b, 0xfa, 0xf5, 0x28, 0x37, 0xf5,
-	0xd9, 0xc9, 0x5b, 0x35, 0xf4, 0xef, 0x4a, 0x6b, 0x86, 0xf7, 0x3f, 0xf8, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0x6c, 0x3e, 0x87, 0x81, 0x76, 0x11, 0x00, 0x00,
+	// 2474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0x17, 0x3f, 0xf4, 0xc1, 0x47, 0x89, 0xa2, 0x46, 0xb2, 0xcc, 0x50, 0xb6, 0x96, 0x7f, 0xfd,
+	0x93, 0x40, 0x31, 0x6a, 0xa9, 0x51, 0x9d, 0x22, 0xb5, 0x51, 0xc7, 0x5c, 0x92, 0x92, 0x59, 0x53,
+	0xa4, 0x30, 0xa4, 0xe2, 0xc8, 0x87, 0x12, 0x2b, 0xee, 0x88, 0x5a, 0x68, 0xb9, 0xbb, 0xdd, 0x5d,
+	0xda, 0xe6, 0x21, 0xa7, 0xf6, 0x10, 0xe8, 0x50, 0x04, 0xed, 0x21, 0xed, 0x41, 0x40, 0x80, 0xf6,
+	0x52, 0xa0, 0x05, 0x7a, 0xea, 0xb9, 0x28, 0x7a, 0xf0, 0xd1, 0xc7, 0xf6, 0xc2, 0x00, 0xf2, 0xa5,
+	0x50, 0x2f, 0x45, 0x8f, 0x39, 0x15, 0x33, 0x3b, 0xcb, 0xfd, 0x20, 0x15, 0x4b, 0x76, 0x8c, 0x02,
+	0xbd, 0x88, 0xb3, 0x33, 0xef, 0xf7, 0xe6, 0xcd, 0x7b, 0xbf, 0x79, 0xf3, 0x66, 0x04, 0xd7, 0x0d,
+	0x53, 0xb7, 0xf5, 0xf5, 0xc7, 0x92, 0xa9, 0xea, 0x6d, 0x63, 0x7f, 0xbd, 0x43, 0x6c, 0x49, 0x96,
+	0x6c, 0x69, 0x8d, 0xf5, 0xa3, 0x59, 0x67, 0x60, 0xcd, 0x1d, 0xcf, 0x0a, 0x6d, 0x5d, 0x6f, 0xab,
+	0x64, 0x9d, 0x0d, 0xef, 0x77, 0x0f, 0xd6, 0x6d, 0xa5, 0x43, 0x2c, 0x5b, 0xea, 0x18, 0x0e, 0x22,
+	0x7b, 0xb3, 0xad, 0xd8, 0x87, 0xdd, 0xfd, 0xb5, 0x96, 0xde, 0x59, 0x6f, 0xeb, 0x6d, 0xdd, 0x93,
+	0xa4, 0x5f, 0xce, 0x6c, 0xb4, 0xe5, 0x88, 0xaf, 0xfc, 0x3d, 0x0a, 0x68, 0x9b, 0xcf, 0x59, 0x24,
+	0x56, 0xcb, 0x54, 0x0c, 0x5b, 0x37, 0xd1, 0x07, 0x30, 0x23, 0x19, 0x86, 0xaa, 0x10, 0xb9, 0xa9,
+	0x68, 0x32, 0x79, 0x9a, 0x89, 0xe4, 0x22, 0xab, 0x71, 0x31, 0x7d, 0xd6, 0x17, 0xa6, 0xf9, 0x40,
+	0x99, 0xf6, 0xe3, 0xc0, 0x17, 0x92, 0x60, 0xc6, 0xb2, 0x75, 0x53, 0x6a, 0x93, 0xa6, 0xa6, 0xcb,
+	0xc4, 0xca, 0x44, 0x73, 0xb1, 0xd5, 0xe4, 0xc6, 0xbb, 0x6b, 0xa1, 0x65, 0xac, 0xd5, 0x1d, 0xa9,
+	0xaa, 0x2e, 0x13, 0x6f, 0x56, 0x71, 0xe1, 0x59, 0x5f, 0x88, 0xd0, 0x29, 0x2c, 0x6f, 0xd8, 0xc2,
+	0x81, 0x2f, 0xb4, 0x07, 0x49, 0x55, 0x6f, 0x37, 0x2d, 0xdb, 0x24, 0x52, 0xc7, 0xca, 0xc4, 0xd8,
+	0x04, 0x6f, 0x0f, 0x4d, 0x50, 0xd1, 0xdb, 0x75, 0x26, 0xe2, 0x53, 0x8f, 0xb8, 0x7a, 0x50, 0xdd,
+	0x41, 0x0b, 0xfb, 0xda, 0xe8, 0x3e, 0x4c, 0xd8, 0xba, 0xa1, 0xb4, 0xac, 0x4c, 0x9c, 0x69, 0xcd,
+	0x0d, 0x69, 0x6d, 0xd0, 0x61, 0x9f, 0xc6, 0x14, 0xd7, 0xc8, 0x71, 0x98, 0xff, 0xde, 0x8e, 0xff,
+	0xe3, 0x4b, 0x21, 0xb2, 0xf2, 0xcb, 0x28, 0x5c, 0x19, 0xb9, 0x50, 0xb4, 0x0d, 0xd3, 0x7e, 0x3f,
+	0x31, 0xef, 0x26, 0x37, 0xae, 0x7d, 0x93, 0x9b, 0xc4, 0xe9, 0x67, 0x7d, 0x61, 0xec, 0xb9, 0x33,
+	0xdf, 0x18, 0x4e, 0xfa, 0x9c, 0x82, 0x6e, 0xc3, 0x84, 0x65, 0x4b, 0x76, 0x97, 0xfa, 0x3b, 0xb2,
+	0x9a, 0xda, 0x58, 0xf9, 0x26, 0x45, 0x75, 0x26, 0x89, 0x39, 0x02, 0x2d, 0xc0, 0xb8, 0x21, 0xd9,
+	0x87, 0x8e, 0x27, 0x13, 0xd8, 0xf9, 0x40, 0x75, 0x48, 0xb6, 0x4c, 0x22, 0xd9, 0xa4, 0x49, 0xf9,
+	0x95, 0x89, 0x33, 0xfb, 0xb2, 0x6b, 0x0e, 0xf9, 0xd6, 0x5c, 0x4a, 0xad, 0x35, 0x5c, 0xf2, 0x89,
+	0x8b, 0xd4, 0x3a, 0xea, 0x5b, 0x07, 0x46, 0x07, 0x3e, 0xff, 0x4a, 0x88, 0x60, 0xdf, 0x37, 0xf7,
+	0xca, 0x43, 0x98, 0xe3, 0xd6, 0xf8, 0x1c, 0x82, 0x20, 0x4e, 0x27, 0x66, 0x8e, 0x48, 0x60, 0xd6,
+	0xa6, 0x7d, 0x5d, 0x8b, 0xc8, 0x6c, 0x4d, 0x71, 0xcc, 0xda, 0xd4, 0x5a, 0x5b, 0xb7, 0x25, 0x35,
+	0x13, 0x63, 0x9d, 0xce, 0x07, 0x57, 0xfc, 0xaf, 0x28, 0xcc, 0x8f, 0x08, 0x3b, 0xfa, 0x31, 0x4c,
+	0xb1, 0xb0, 0x34, 0x15, 0x99, 0xe9, 0x1f, 0x17, 0x0b, 0xa7, 0x7d, 0x61, 0x92, 0xc5, 0xb2, 0x5c,
+	0x3c, 0xeb, 0x0b, 0x93, 0x6c, 0xb8, 0x2c, 0x7f, 0xdd, 0x17, 0xde, 0xf3, 0xed, 0x9e, 0x23, 0xe9,
+	0x48, 0x72, 0x77, 0xe6, 0xba, 0x71, 0xd4, 0x5e, 0xb7, 0x7b, 0x06, 0xb1, 0xd6, 0x38, 0x0e, 0xbb,
+	0x28, 0x64, 0xc1, 0x8c, 0xc7, 0xc8, 0xa6, 0xe2, 0x18, 0x3c, 0x2e, 0xd6, 0x4e, 0xfb, 0x42, 0x72,
+	0x60, 0x0f, 0x9b, 0x28, 0x39, 0x20, 0x1b, 0x9b, 0xec, 0xe6, 0xcb, 0x27, 0xf3, 0xe1, 0xb1, 0x1f,
+	0x8d, 0x3e, 0x1c, 0x84, 0x3c, 0xc6, 0x42, 0x9e, 0x3b, 0x7f, 0x07, 0x84, 0x02, 0x5e, 0x84, 0x29,
+	0x93, 0x18, 0xaa, 0xd2, 0x92, 0x5c, 0x9e, 0x0f, 0xd3, 0x05, 0x3b, 0x02, 0x3e, 0xa6, 0xc7, 0x29,
+	0xd3, 0xf1, 0x00, 0xc9, 0x5d, 0xfe, 0xb3, 0x28, 0xcc, 0x0d, 0xc9, 0xa2, 0x4f, 0x61, 0xd6, 0xcf,
+	0x6e, 0xcf, 0xef, 0xbb, 0xa7, 0x7d, 0x61, 0xc6, 0x47, 0x45, 0xe6, 0x94, 0x19, 0x1f, 0x93, 0x99,
+	0x5b, 0xd6, 0x5f, 0xee, 0x96, 0x80, 0x0e, 0x1c, 0xd4, 0x80, 0x3e, 0x82, 0xb9, 0xc0, 0xf4, 0x8c,
+	0x58, 0x34, 0x26, 0x09, 0x71, 0xfe, 0xac, 0x2f, 0xcc, 0xfa, 0xa4, 0x77, 0x24, 0xfb, 0x10, 0x87,
+	0x3b, 0xd0, 0x7b, 0x90, 0xa0, 0xe9, 0xd0, 0x01, 0xc6, 0x18, 0x70, 0xfa, 0xac, 0x2f, 0x4c, 0xd1,
+	0x4e, 0x86, 0x18, 0xb4, 0xb8, 0x1b, 0xbe, 0x88, 0xc1, 0x6c, 0x28, 0x35, 0xbc, 0x71, 0xd6, 0xdd,
+	0x0b, 0xed, 0xf9, 0x6b, 0xa3, 0x93, 0x95, 0x13, 0x7c, 0x11, 0x68, 0x92, 0xb2, 0x82, 0x44, 0xd0,
+	0x86, 0x33, 0xe9, 0xb8, 0xb8, 0xcd, 0x33, 0xda, 0x82, 0x97, 0x17, 0xbf, 0xa3, 0x77, 0x14, 0x9b,
+	0x74, 0x0c, 0xbb, 0x77, 0x79, 0xce, 0xfa, 0xd3, 0xeb, 0xbb, 0x10, 0xd7, 0x24, 0x9e, 0x4c, 0x12,
+	0x22, 0x3a, 0xeb, 0x0b, 0x29, 0xfa, 0xed, 0xa9, 0xc7, 0x6c, 0x1c, 0x15, 0x61, 0xa2, 0xa5, 0x6b,
+	0x07, 0x4a, 0x3b, 0x33, 0x7e, 0x4e, 0x5a, 0x64, 0x2b, 0x2b, 0x30, 0x19, 0x31, 0xc5, 0x13, 0x0f,
+	0xc7, 0x60, 0xfe, 0xcb, 0x23, 0xf3, 0x87, 0x28, 0x24, 0x7d, 0xd2, 0xa8, 0x0a, 0x88, 0x53, 0xd8,
+	0x56, 0x74, 0xad, 0x79, 0x20, 0xb5, 0x6c, 0xdd, 0xe4, 0xf1, 0x11, 0xce, 0xfa, 0xc2, 0x92, 0x6f,
+	0x74, 0x93, 0x0d, 0xfa, 0xcc, 0x9b, 0x1b, 0x1a, 0x44, 0x9b, 0x90, 0x30, 0x89, 0x4d, 0x34, 0xda,
+	0xc5, 0x02, 0x11, 0x13, 0x57, 0xcf, 0xfa, 0xc2, 0xfc, 0xa0, 0x33, 0xe0, 0xbc, 0x19, 0x9a, 0x4c,
+	0xd7, 0x8a, 0x5d, 0x93, 0xa9, 0xc0, 0x1e, 0x14, 0x15, 0x60, 0xb6, 0x23, 0x3d, 0x6d, 0x06, 0xe3,
+	0x41, 0x8d, 0x5a, 0x3a, 0xeb, 0x0b, 0x57, 0x3b, 0xd2, 0xd3, 0xca, 0x88, 0x70, 0xe0, 0x99, 0xc0,
+	0x00, 0xba, 0x03, 0x49, 0x99, 0x13, 0x90, 0x9a, 0xe3, 0xf8, 0xf9, 0xad, 0xb3, 0xbe, 0x70, 0xc5,
+	0xd7, 0xed, 0x83, 0xfb, 0xa5, 0xb9, 0xbf, 0x7e, 0x1f, 0x81, 0xa4, 0x6f, 0x73, 0xfd, 0xb7, 0xb7,
+	0x72, 0x06, 0x26, 0x25, 0x59, 0x36, 0x89, 0xe5, 0xb0, 0x3c, 0x81, 0xdd, 0x4f, 0x6e, 0xee, 0x3f,
+	0x23, 0x90, 0x62, 0xe1, 0x1d, 0x78, 0xe1, 0x7f, 0x32, 0xdb, 0xf3, 0xd5, 0xfe, 0x39, 0x02, 0xe9,
+	0x81, 0x08, 0x4f, 0xbb, 0xdf, 0x76, 0x29, 0xf1, 0x10, 0xd2, 0x8e, 0xfb, 0xbc, 0x45, 0xb2, 0x15,
+	0x26, 0x37, 0x84, 0xd1, 0xdb, 0x70, 0x60, 0x50, 0x48, 0x6b, 0xca, 0x0e, 0x8c, 0xf2, 0x25, 0xfc,
+	0x2e, 0x02, 0x73, 0xb4, 0x8f, 0xfc, 0xa4, 0x4b, 0xb4, 0x16, 0xa9, 0x76, 0x3b, 0xfb, 0x84, 0xee,
+	0xa2, 0xb8, 0xaa, 0x5a, 0x1a, 0x2f, 0x32, 0x37, 0x4e, 0xfb, 0x42, 0xbc, 0x52, 0xa9, 0x57, 0xbf,
+	0xee, 0x0b, 0xef, 0x5e, 0xc0, 0x69, 0x95, 0x7a, 0x15, 0x33, 0x3c, 0xd5, 0xd3, 0xa6, 0x7a, 0xa2,
+	0x9e, 0x9e, 0xad, 0x0b, 0xeb, 0xd9, 0x62, 0x7a, 0x28, 0x9e, 0xdb, 0xfa, 0x55, 0x14, 0xa6, 0x2b,
+	0x7a, 0xbb, 0xa4, 0xd9, 0x66, 0x8f, 0x96, 0xc8, 0xa8, 0x3e, 0x44, 0xad, 0x0f, 0x7d, 0xd4, 0x7a,
+	0x45, 0x3e, 0xc9, 0xa3, 0xf9, 0x74, 0x2f, 0xc4, 0xa7, 0xd7, 0x2c, 0x17, 0x5c, 0xcf, 0xc4, 0x5e,
+	0xcf, 0x33, 0x83, 0x48, 0xc5, 0x5f, 0x2f, 0x52, 0xdc, 0xc3, 0x77, 0x21, 0xe5, 0x3a, 0xf8, 0x3e,
+	0x91, 0x64, 0x62, 0xa2, 0x34, 0xc4, 0x8e, 0x48, 0x8f, 0x97, 0x81, 0xb4, 0x49, 0x2b, 0xbe, 0xc7,
+	0x92, 0xda, 0x25, 0xcc, 0x2f, 0xd3, 0xd8, 0xf9, 0xe0, 0xf8, 0xbf, 0x44, 0x01, 0xb9, 0x0a, 0xf2,
+	0xb6, 0x6d, 0x2a, 0xfb, 0x5d, 0x9b, 0x58, 0x7e, 0x25, 0xd3, 0x8e, 0x92, 0xbb, 0x90, 0x18, 0xdc,
+	0x93, 0x32, 0xd1, 0x97, 0x16, 0xb3, 0x71, 0x56, 0xba, 0x7a, 0x10, 0xf4, 0x11, 0x4c, 0x1e, 0x32,
+	0x03, 0xdd, 0x0b, 0x87, 0x30, 0xaa, 0xdc, 0xf2, 0x2d, 0x84, 0xd5, 0x4b, 0x63, 0xd8, 0x45, 0xa1,
+	0x2a, 0xcc, 0xb5, 0xf4, 0x8e, 0x41, 0x53, 0x17, 0x3d, 0x77, 0x5a, 0xba, 0x4c, 0x5a, 0xcc, 0x89,
+	0xa9, 0x8d, 0xff, 0x1b, 0x52, 0x55, 0xf0, 0x24, 0x0b, 0x54, 0x10, 0xa7, 0x5b, 0xa1, 0x1e, 0x54,
+	0x00, 0x20, 0x5a, 0xcb, 0xec, 0x39, 0x99, 0xde, 0x39, 0x27, 0xff, 0xff, 0x5c, 0x9b, 0x4a, 0x03,
+	0x51, 0xec, 0x83, 0x71, 0x27, 0xfe, 0x29, 0x02, 0x68, 0x58, 0x10, 0xe5, 0x60, 0xe2, 0x88, 0xf4,
+	0x5c, 0xaa, 0x27, 0xc4, 0xc4, 0x69, 0x5f, 0x18, 0x7f, 0x40, 0x7a, 0xe5, 0x22, 0x1e, 0x3f, 0x22,
+	0xbd, 0xb2, 0x8c, 0x56, 0x21, 0xfd, 0xc4, 0x94, 0x0c, 0x83, 0xc8, 0x4d, 0x56, 0x2e, 0x51, 0x9f,
+	0x3b, 0x41, 0x4a, 0xf1, 0xfe, 0xa2, 0x64, 0x4b, 0x0f, 0x48, 0x6f, 0xf4, 0xea, 0x63, 0xaf, 0xbc,
+	0x7a, 0x6e, 0xf8, 0x67, 0x51, 0x8f, 0x3e, 0x9b, 0x8a, 0x6a, 0x13, 0x13, 0x5d, 0x07, 0xa0, 0x46,
+	0x1b, 0x26, 0x39, 0x50, 0x9e, 0x72, 0x02, 0x24, 0x8e, 0x48, 0x6f, 0x87, 0x75, 0xf8, 0xc3, 0x18,
+	0x7d, 0xa5, 0x30, 0x96, 0x61, 0x76, 0x40, 0x8a, 0xe6, 0x3e, 0x69, 0x2b, 0xce, 0x8e, 0xba, 0x08,
+	0x9b, 0x52, 0x03, 0xa0, 0x48, 0x71, 0xa8, 0x04, 0x33, 0x9e, 0x2a, 0xa2, 0xc9, 0x99, 0xf8, 0x05,
+	0x15, 0x4d, 0x0f, 0x60, 0x25, 0xcd, 0x3d, 0x19, 0xfe, 0x18, 0x81, 0x29, 0xd7, 0x72, 0x74, 0x07,
+	0xe2, 0x1d, 0x62, 0x4b, 0xfc, 0x24, 0xb8, 0x7e, 0xee, 0x12, 0x69, 0x4e, 0x13, 0xa7, 0xdc, 0xa4,
+	0x8d, 0x19, 0x88, 0x5e, 0xba, 0x68, 0x30, 0x79, 0x20, 0x59, 0x1b, 0x6d, 0x03, 0x48, 0x83, 0xdd,
+	0x95, 0x89, 0xbd, 0x84, 0x6c, 0xde, 0x46, 0xf4, 0x29, 0xf7, 0x29, 0xe0, 0x26, 0xff, 0x22, 0x0e,
+	0x33, 0x05, 0xbd, 0xd3, 0x51, 0xec, 0x82, 0xae, 0xd9, 0xe4, 0xa9, 0x8d, 0xb6, 0x60, 0xf2, 0x31,
+	0x31, 0x69, 0x94, 0xf9, 0x41, 0x70, 0xf3, 0x62, 0x29, 0xf5, 0x63, 0x07, 0x84, 0x5d, 0x34, 0xda,
+	0x87, 0xd4, 0xa1, 0xd2, 0x3e, 0x6c, 0x3e, 0x91, 0x6c, 0x62, 0x76, 0x24, 0xf3, 0x88, 0x1f, 0x08,
+	0x77, 0x68, 0xcd, 0x72, 0x5f, 0x69, 0x1f, 0x3e, 0x74, 0x07, 0x2e, 0x91, 0xff, 0x66, 0x0e, 0xfd,
+	0x40, 0x64, 0xc2, 0x42, 0x8b, 0x59, 0x6f, 0x13, 0xb9, 0x49, 0x53, 0xa3, 0x8f, 0x0e, 0x71, 0x96,
+	0xbd, 0x51, 0xc1, 0x1d, 0xa7, 0x78, 0x16, 0xf4, 0x4b, 0x4c, 0x87, 0x06, 0xda, 0xb7, 0x54, 0x4b,
+	0x73, 0x28, 0xa3, 0x02, 0x0a, 0xcd, 0xe9, 0xf2, 0x26, 0x2e, 0xde, 0x3d, 0xed, 0x0b, 0xe9, 0xc0,
+	0x8c, 0x25, 0x4d, 0xbe, 0xc4, 0x7c, 0xe9, 0xc0, 0x7c, 0x25, 0x4d, 0x0e, 0xae, 0x50, 0xf5, 0x56,
+	0x38, 0x3e, 0x62, 0x85, 0x95, 0xcb, 0xad, 0xb0, 0x12, 0x5c, 0x61, 0xc5, 0x5d, 0xe1, 0xca, 0x6f,
+	0xa3, 0xb0, 0xe8, 0xbe, 0x46, 0x61, 0x62, 0xe8, 0x96, 0x62, 0xeb, 0x66, 0x8f, 0x15, 0x26, 0x7b,
+	0x30, 0xe9, 0xaf, 0x40, 0x1d, 0x0b, 0x26, 0x06, 0xa5, 0xe7, 0x84, 0xe6, 0xd6, 0x9c, 0xab, 0x2f,
+	0x9f, 0xdf, 0x41, 0x61, 0x8e, 0x41, 0xef, 0xc3, 0x94, 0x29, 0x1d, 0xd8, 0xcd, 0xae, 0xa9, 0xf2,
+	0x7b, 0xe2, 0x22, 0x3d, 0xd7, 0xb1, 0x74, 0x60, 0xef, 0xe2, 0x0a, 0x2d, 0x19, 0x4d, 0xa7, 0x89,
+	0x9d, 0x86, 0xa9, 0x32, 0x88, 0xd1, 0x6a, 0xd2, 0x6a, 0x34, 0x13, 0xf3, 0x41, 0x76, 0x0a, 0x79,
+	0x59, 0x36, 0x19, 0xc4, 0x68, 0xd1, 0x26, 0x76, 0x1b, 0x68, 0x05, 0x26, 0x54, 0x96, 0x46, 0x58,
+	0xc4, 0xa6, 0x9c, 0x2b, 0x99, 0xd3, 0x83, 0xf9, 0x2f, 0x7a, 0x07, 0x26, 0x55, 0x22, 0x99, 0x1a,
+	0x31, 0x99, 0x9b, 0xa7, 0xc4, 0x24, 0x55, 0xc5, 0xbb, 0xb0, 0xdb, 0x58, 0xf9, 0x69, 0x1c, 0xe6,
+	0x0b, 0xba, 0x66, 0x75, 0x3b, 0xc4, 0xdc, 0x32, 0xf5, 0xae, 0x51, 0x3b, 0x38, 0xb0, 0x88, 0xfd,
+	0xc6, 0x6b, 0xdf, 0x4f, 0x47, 0xd7, 0x2a, 0x7b, 0xc3, 0xb5, 0xef, 0x15, 0x5f, 0xf1, 0xf1, 0x3a,
+	0xf7, 0xc7, 0x40, 0x11, 0xf3, 0x49, 0xa0, 0x88, 0x29, 0xba, 0x45, 0x0c, 0xbd, 0x48, 0xd2, 0xfe,
+	0xc0, 0x3c, 0x97, 0x2b, 0x6b, 0x3e, 0x09, 0x94, 0x35, 0x45, 0xb7, 0xac, 0xa1, 0x9a, 0xd5, 0x57,
+	0xd0, 0xec, 0x2b, 0x49, 0xeb, 0x90, 0xec, 0x1a, 0xf2, 0xe0, 0x21, 0x6d, 0xfc, 0xe2, 0x0f, 0x69,
+	0x0e, 0xcc, 0x7b, 0x48, 0xf3, 0xbe, 0x79, 0x06, 0xfd, 0x79, 0x04, 0xae, 0x06, 0x58, 0xe0, 0x7b,
+	0x7d, 0xb8, 0xc6, 0xef, 0xda, 0xce, 0xd9, 0x3d, 0x75, 0xd6, 0x17, 0xd8, 0x37, 0xbf, 0x61, 0xd7,
+	0x60, 0x52, 0x67, 0x8c, 0x71, 0xcf, 0xc1, 0xb7, 0x47, 0x9c, 0xc2, 0x43, 0xf4, 0x12, 0x67, 0xb9,
+	0x69, 0x2e, 0x18, 0xbb, 0x0d, 0x6e, 0xd0, 0x5f, 0x63, 0x90, 0xae, 0xb7, 0x0e, 0x49, 0xc7, 0xff,
+	0x18, 0xd4, 0x82, 0x84, 0xc5, 0xfa, 0x3c, 0x52, 0x6e, 0x9e, 0xf6, 0x85, 0x29, 0x47, 0x90, 0x91,
+	0x65, 0xca, 0x11, 0x60, 0xb4, 0xbc, 0x71, 0x81, 0x1b, 0x23, 0x47, 0xe2, 0x01, 0x2e, 0x40, 0xfc,
+	0xe8, 0x1b, 0x20, 0xfe, 0x3b, 0xde, 0xd1, 0xe4, 0x5c, 0xcb, 0xd9, 0xbe, 0x7c, 0x1c, 0x3e, 0x78,
+	0x7e, 0x00, 0x71, 0xaa, 0x80, 0x17, 0x76, 0x4b, 0xc3, 0x77, 0x30, 0x66, 0x6f, 0xa3, 0x67, 0x10,
+	0x27, 0x24, 0x54, 0x18, 0xb3, 0xbf, 0x68, 0x0d, 0x40, 0x26, 0x07, 0x8a, 0xa6, 0x0c, 0x0a, 0xba,
+	0x69, 0x31, 0x45, 0x69, 0xe0, 0xf5, 0x62, 0x5f, 0x3b, 0xfc, 0x40, 0x3b, 0xf1, 0xed, 0x3d, 0xd0,
+	0xde, 0xf8, 0x22, 0x32, 0x78, 0xa1, 0xf5, 0xde, 0x8b, 0xd1, 0x0f, 0x61, 0xa9, 0xde, 0xa8, 0xe1,
+	0xfc, 0x56, 0xa9, 0x59, 0xad, 0x15, 0x4b, 0xcd, 0x7a, 0x23, 0xdf, 0xd8, 0xad, 0x37, 0xf1, 0x6e,
+	0xb5, 0x5a, 0xae, 0x6e, 0xa5, 0xc7, 0xb2, 0xd7, 0x8e, 0x4f, 0x72, 0x99, 0x21, 0x1c, 0xee, 0x6a,
+	0x9a, 0xa2, 0xb5, 0xcf, 0x83, 0x17, 0x4b, 0x95, 0x52, 0xa3, 0x54, 0x4c, 0x47, 0xce, 0x81, 0x17,
+	0x89, 0x4a, 0x6c, 0x22, 0x67, 0xe3, 0x9f, 0xfd, 0x66, 0x79, 0xec, 0xc6, 0xaf, 0xa2, 0x30, 0x1b,
+	0x7a, 0xd6, 0x44, 0xef, 0xc3, 0x5c, 0xa5, 0x3e, 0x6c, 0x4d, 0xf6, 0xf8, 0x24, 0xb7, 0x18, 0x92,
+	0x75, 0x6d, 0x09, 0x40, 0xea, 0xa5, 0x7c, 0x85, 0x42, 0x22, 0x23, 0x21, 0x75, 0x22, 0xa9, 0x14,
+	0xb2, 0x0e, 0xe9, 0x20, 0xa4, 0x54, 0x4c, 0x47, 0xb3, 0x6f, 0x1d, 0x9f, 0xe4, 0xae, 0x8c, 0x40,
+	0x10, 0x39, 0x38, 0x87, 0xbb, 0xca, 0xd8, 0xc8, 0x39, 0xf8, 0x1a, 0xd1, 0x07, 0x30, 0xef, 0x41,
+	0x76, 0xab, 0xae, 0x61, 0x71, 0xc7, 0x35, 0x21, 0xd0, 0xae, 0x66, 0x39, 0xa6, 0x71, 0xd7, 0x3c,
+	0xe1, 0xef, 0x5c, 0xdc, 0x2b, 0xdf, 0x85, 0x85, 0x46, 0x6d, 0xa7, 0x5c, 0x18, 0x76, 0xcc, 0xe2,
+	0xf1, 0x49, 0x0e, 0xf9, 0x44, 0x5d, 0xa7, 0x84, 0x11, 0x5e, 0x64, 0xc2, 0x88, 0x60, 0x4c, 0xfe,
+	0x1d, 0x81, 0x74, 0xb8, 0x64, 0x47, 0xb7, 0x60, 0xb1, 0x50, 0xdb, 0xde, 0xc1, 0xa5, 0x7a, 0xbd,
+	0x5c, 0xab, 0x36, 0x0b, 0xb5, 0x62, 0xa9, 0xd0, 0xac, 0xd6, 0xaa, 0xa5, 0xf4, 0x58, 0x36, 0x73,
+	0x7c, 0x92, 0x5b, 0x08, 0x23, 0xaa, 0xba, 0x46, 0x46, 0xa3, 0x1e, 0xd5, 0x1b, 0xd4, 0x88, 0x91,
+	0xa8, 0x47, 0x96, 0x4d, 0x5f, 0xc2, 0x33, 0xc3, 0xa8, 0x7a, 0x35, 0xbf, 0xb3, 0xb3, 0x97, 0x8e,
+	0x3a, 0x0e, 0x0f, 0xe3, 0xea, 0x9a, 0x64, 0x18, 0x3d, 0xb4, 0x01, 0x57, 0x86, 0x91, 0x95, 0x47,
+	0xb7, 0xd2, 0xb1, 0xec, 0xd5, 0xe3, 0x93, 0xdc, 0x7c, 0x18, 0x56, 0x79, 0x74, 0x8b, 0x2f, 0xfa,
+	0x79, 0x04, 0xc0, 0xdb, 0xcc, 0xe8, 0xfb, 0x70, 0xb5, 0x5e, 0xb8, 0x5f, 0xda, 0xce, 0x37, 0x1b,
+	0x7b, 0x3b, 0x25, 0x1a, 0xbb, 0x9d, 0x52, 0xa1, 0xbc, 0x59, 0x2e, 0x15, 0xd3, 0x63, 0x0e, 0x49,
+	0x3c, 0xe1, 0x5d, 0xcd, 0x32, 0x48, 0x4b, 0x39, 0x50, 0x88, 0x4c, 0x7d, 0xee, 0xc7, 0xed, 0xe0,
+	0x5a, 0xa3, 0x26, 0xee, 0x6e, 0xba, 0x3e, 0xf7, 0x40, 0x3b, 0x7c, 0x3f, 0xd3, 0x3b, 0x97, 0x1f,
+	0xf1, 0xa3, 0x7a, 0xad, 0x9a, 0x8e, 0x66, 0xd1, 0xf1, 0x49, 0x2e, 0xe5, 0x49, 0xd3, 0xde, 0xb0,
+	0x64, 0xfe, 0x63, 0x5c, 0x4b, 0xc7, 0xc2, 0x92, 0xf9, 0xc7, 0xa6, 0xce, 0x97, 0xf4, 0xeb, 0x28,
+	0xcc, 0x3b, 0x03, 0x74, 0xd9, 0x92, 0xad, 0xec, 0x2b, 0xaa, 0x62, 0xf7, 0x90, 0x08, 0xd7, 0xb9,
+	0x1e, 0xea, 0xab, 0x7c, 0xa3, 0x2c, 0x96, 0x2b, 0xe5, 0xc6, 0x5e, 0x53, 0xcc, 0x17, 0x1e, 0x3c,
+	0xcc, 0x63, 0xba, 0x42, 0xe1, 0xf8, 0x24, 0xb7, 0x34, 0x02, 0x2b, 0x4a, 0xad, 0xa3, 0x27, 0x92,
+	0x49, 0xdf, 0xaa, 0xaf, 0x8d, 0xd4, 0xb1, 0x59, 0xc3, 0x4c, 0x45, 0x24, 0xbb, 0x7c, 0x7c, 0x92,
+	0xcb, 0x8e, 0x50, 0xb1, 0xa9, 0x9b, 0x4c, 0xc3, 0x6d, 0x78, 0x6b, 0xb4, 0x86, 0xdd, 0x4a, 0x25,
+	0x1d, 0xcd, 0x2e, 0x1d, 0x9f, 0xe4, 0xae, 0x8e, 0x82, 0x77, 0x55, 0xf5, 0x5c, 0x2c, 0xe3, 0x63,
+	0xec, 0x5c, 0x2c, 0xa5, 0xa4, 0xe3, 0x1b, 0xf1, 0xde, 0xb3, 0xd3, 0xe5, 0xc8, 0xf3, 0xd3, 0xe5,
+	0xc8, 0xe7, 0x2f, 0x96, 0xc7, 0xbe, 0x7c, 0xb1, 0x1c, 0x79, 0xfe, 0x62, 0x79, 0xec, 0x6f, 0x2f,
+	0x96, 0xc7, 0x1e, 0x9d, 0x5f, 0x0c, 0x04, 0xfe, 0xad, 0xbb, 0x3f, 0xc1, 0xbe, 0xbf, 0xf7, 0x9f,
+	0x01, 0x00, 0x8f, 0xd4, 0x42, 0x6f, 0xef, 0x1d, 0x00, 0x00,
 }
 
 func (this *MetadataDescriptor) Equal(that interface{}) bool {
@@ -1311,6 +2105,45 @@ func (this *TopicDescriptor) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.Config.Equal(&that1.Config) {
+		return false
+	}
+	return true
+}
+func (this *TopicConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TopicConfig)
+	if !ok {
+		that2, ok := that.(TopicConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReplicationFactor != that1.ReplicationFactor {
+		return false
+	}
+	if this.Retention != that1.Retention {
+		return false
+	}
+	if this.MaxLogStreams != that1.MaxLogStreams {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
 	return true
 }
 func (this *StorageNode) Equal(that interface{}) bool {
@@ -1454,14 +2287,14 @@ func (this *LogEntryMeta) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *LogEntry) Equal(that interface{}) bool {
+func (this *LogEntryHeader) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*LogEntry)
+	that1, ok := that.(*LogEntryHeader)
 	if !ok {
-		that2, ok := that.(LogEntry)
+		that2, ok := that.(LogEntryHeader)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1473,78 +2306,336 @@ func (this *LogEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.LogEntryMeta.Equal(&that1.LogEntryMeta) {
+	if this.Key != that1.Key {
 		return false
 	}
-	if !bytes.Equal(this.Data, that1.Data) {
+	if !bytes.Equal(this.Value, that1.Value) {
 		return false
 	}
 	return true
 }
-func (m *MetadataDescriptor) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *LogEntryAttributes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MetadataDescriptor) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Topics) > 0 {
-		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMetadata(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	that1, ok := that.(*LogEntryAttributes)
+	if !ok {
+		that2, ok := that.(LogEntryAttributes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.LogStreams) > 0 {
-		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMetadata(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.StorageNodes) > 0 {
-		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMetadata(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if that1.Timestamp == nil {
+		if this.Timestamp != nil {
+			return false
 		}
+	} else if !this.Timestamp.Equal(*that1.Timestamp) {
+		return false
 	}
-	if m.AppliedIndex != 0 {
-		i = encodeVarintMetadata(dAtA, i, uint64(m.AppliedIndex))
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(&that1.Headers[i]) {
+			return false
+		}
+	}
+	if this.CompressionCodec != that1.CompressionCodec {
+		return false
+	}
+	if !this.Encryption.Equal(that1.Encryption) {
+		return false
+	}
+	return true
+}
+func (this *LogEntryEncryption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntryEncryption)
+	if !ok {
+		that2, ok := that.(LogEntryEncryption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyID != that1.KeyID {
+		return false
+	}
+	if !bytes.Equal(this.WrappedDataKey, that1.WrappedDataKey) {
+		return false
+	}
+	if this.CompressionCodec != that1.CompressionCodec {
+		return false
+	}
+	return true
+}
+func (this *LogEntryFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntryFilter)
+	if !ok {
+		that2, ok := that.(LogEntryFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.KeyPrefix, that1.KeyPrefix) {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if !this.Headers[i].Equal(&that1.Headers[i]) {
+			return false
+		}
+	}
+	if that1.TimestampBegin == nil {
+		if this.TimestampBegin != nil {
+			return false
+		}
+	} else if !this.TimestampBegin.Equal(*that1.TimestampBegin) {
+		return false
+	}
+	if that1.TimestampEnd == nil {
+		if this.TimestampEnd != nil {
+			return false
+		}
+	} else if !this.TimestampEnd.Equal(*that1.TimestampEnd) {
+		return false
+	}
+	return true
+}
+func (this *LogEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntry)
+	if !ok {
+		that2, ok := that.(LogEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LogEntryMeta.Equal(&that1.LogEntryMeta) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !this.LogEntryAttributes.Equal(&that1.LogEntryAttributes) {
+		return false
+	}
+	return true
+}
+func (this *ConsumerGroupOffset) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsumerGroupOffset)
+	if !ok {
+		that2, ok := that.(ConsumerGroupOffset)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.LogStreamID != that1.LogStreamID {
+		return false
+	}
+	if this.GLSN != that1.GLSN {
+		return false
+	}
+	if this.LLSN != that1.LLSN {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *ConsumerGroupDescriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConsumerGroupDescriptor)
+	if !ok {
+		that2, ok := that.(ConsumerGroupDescriptor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Offsets) != len(that1.Offsets) {
+		return false
+	}
+	for i := range this.Offsets {
+		if !this.Offsets[i].Equal(&that1.Offsets[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SchemaDescriptor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SchemaDescriptor)
+	if !ok {
+		that2, ok := that.(SchemaDescriptor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SchemaID != that1.SchemaID {
+		return false
+	}
+	if this.TopicID != that1.TopicID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Definition, that1.Definition) {
+		return false
+	}
+	if !this.CreateTime.Equal(that1.CreateTime) {
+		return false
+	}
+	return true
+}
+func (m *MetadataDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LogStreams) > 0 {
+		for iNdEx := len(m.LogStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AppliedIndex != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.AppliedIndex))
 		i--
 		dAtA[i] = 0x8
 	}
@@ -1760,22 +2851,39 @@ func (m *TopicDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.LogStreams) > 0 {
-		dAtA4 := make([]byte, len(m.LogStreams)*10)
-		var j3 int
-		for _, num1 := range m.LogStreams {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LogStreams) > 0 {
+		dAtA5 := make([]byte, len(m.LogStreams)*10)
+		var j4 int
+		for _, num1 := range m.LogStreams {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintMetadata(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintMetadata(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1792,6 +2900,51 @@ func (m *TopicDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TopicConfig) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopicConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopicConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxLogStreams != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.MaxLogStreams))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Retention != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Retention))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReplicationFactor != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.ReplicationFactor))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StorageNode) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1979,6 +3132,220 @@ func (m *LogEntryMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LogEntryHeader) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntryHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntryHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntryAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntryAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntryAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CompressionCodec != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.CompressionCodec))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Timestamp != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintMetadata(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntryEncryption) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntryEncryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntryEncryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompressionCodec != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.CompressionCodec))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.WrappedDataKey) > 0 {
+		i -= len(m.WrappedDataKey)
+		copy(dAtA[i:], m.WrappedDataKey)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.WrappedDataKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyID) > 0 {
+		i -= len(m.KeyID)
+		copy(dAtA[i:], m.KeyID)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.KeyID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntryFilter) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntryFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntryFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampEnd != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimestampEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimestampEnd):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintMetadata(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimestampBegin != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimestampBegin, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimestampBegin):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintMetadata(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.KeyPrefix) > 0 {
+		i -= len(m.KeyPrefix)
+		copy(dAtA[i:], m.KeyPrefix)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.KeyPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LogEntry) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1999,6 +3366,16 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.LogEntryAttributes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Data) > 0 {
 		i -= len(m.Data)
 		copy(dAtA[i:], m.Data)
@@ -2129,47 +3506,200 @@ func (m *MetadataRepositoryNode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMetadata(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConsumerGroupOffset) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MetadataDescriptor) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConsumerGroupOffset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppliedIndex != 0 {
-		n += 1 + sovMetadata(uint64(m.AppliedIndex))
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	if len(m.StorageNodes) > 0 {
-		for _, e := range m.StorageNodes {
-			l = e.ProtoSize()
-			n += 1 + l + sovMetadata(uint64(l))
-		}
+	i -= n14
+	i = encodeVarintMetadata(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if m.LLSN != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LLSN))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.LogStreams) > 0 {
-		for _, e := range m.LogStreams {
-			l = e.ProtoSize()
-			n += 1 + l + sovMetadata(uint64(l))
-		}
+	if m.GLSN != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.GLSN))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.ProtoSize()
-			n += 1 + l + sovMetadata(uint64(l))
-		}
+	if m.LogStreamID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.LogStreamID))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
+	if m.TopicID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerGroupDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGroupDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGroupDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offsets) > 0 {
+		for iNdEx := len(m.Offsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMetadata(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Definition) > 0 {
+		i -= len(m.Definition)
+		copy(dAtA[i:], m.Definition)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Definition)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TopicID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.TopicID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SchemaID != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.SchemaID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMetadata(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MetadataDescriptor) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppliedIndex != 0 {
+		n += 1 + sovMetadata(uint64(m.AppliedIndex))
+	}
+	if len(m.StorageNodes) > 0 {
+		for _, e := range m.StorageNodes {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if len(m.LogStreams) > 0 {
+		for _, e := range m.LogStreams {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *StorageNodeDescriptor) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2274,6 +3804,34 @@ func (m *TopicDescriptor) ProtoSize() (n int) {
 		}
 		n += 1 + sovMetadata(uint64(l)) + l
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = m.Config.ProtoSize()
+	n += 1 + l + sovMetadata(uint64(l))
+	return n
+}
+
+func (m *TopicConfig) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovMetadata(uint64(m.ReplicationFactor))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovMetadata(uint64(m.Retention))
+	}
+	if m.MaxLogStreams != 0 {
+		n += 1 + sovMetadata(uint64(m.MaxLogStreams))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -2357,6 +3915,100 @@ func (m *LogEntryMeta) ProtoSize() (n int) {
 	return n
 }
 
+func (m *LogEntryHeader) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *LogEntryAttributes) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if m.CompressionCodec != 0 {
+		n += 1 + sovMetadata(uint64(m.CompressionCodec))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.ProtoSize()
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
+func (m *LogEntryEncryption) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyID)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.WrappedDataKey)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.CompressionCodec != 0 {
+		n += 1 + sovMetadata(uint64(m.CompressionCodec))
+	}
+	return n
+}
+
+func (m *LogEntryFilter) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyPrefix)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	if m.TimestampBegin != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimestampBegin)
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.TimestampEnd != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimestampEnd)
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
 func (m *LogEntry) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2369,6 +4021,8 @@ func (m *LogEntry) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = m.LogEntryAttributes.ProtoSize()
+	n += 1 + l + sovMetadata(uint64(l))
 	return n
 }
 
@@ -2422,21 +4076,90 @@ func (m *MetadataRepositoryNode) ProtoSize() (n int) {
 	return n
 }
 
-func sovMetadata(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ConsumerGroupOffset) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TopicID != 0 {
+		n += 1 + sovMetadata(uint64(m.TopicID))
+	}
+	if m.LogStreamID != 0 {
+		n += 1 + sovMetadata(uint64(m.LogStreamID))
+	}
+	if m.GLSN != 0 {
+		n += 1 + sovMetadata(uint64(m.GLSN))
+	}
+	if m.LLSN != 0 {
+		n += 1 + sovMetadata(uint64(m.LLSN))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovMetadata(uint64(l))
+	return n
 }
-func sozMetadata(x uint64) (n int) {
-	return sovMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ConsumerGroupDescriptor) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if len(m.Offsets) > 0 {
+		for _, e := range m.Offsets {
+			l = e.ProtoSize()
+			n += 1 + l + sovMetadata(uint64(l))
+		}
+	}
+	return n
 }
-func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
+
+func (m *SchemaDescriptor) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SchemaID != 0 {
+		n += 1 + sovMetadata(uint64(m.SchemaID))
+	}
+	if m.TopicID != 0 {
+		n += 1 + sovMetadata(uint64(m.TopicID))
+	}
+	if m.Version != 0 {
+		n += 1 + sovMetadata(uint64(m.Version))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMetadata(uint64(m.Type))
+	}
+	l = len(m.Definition)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovMetadata(uint64(l))
+	return n
+}
+
+func sovMetadata(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMetadata(x uint64) (n int) {
+	return sovMetadata(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -2512,7 +4235,1243 @@ func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogStreams = append(m.LogStreams, &LogStreamDescriptor{})
+			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, &TopicDescriptor{})
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNodeDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNodeDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StorageNodeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LogStreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, &ReplicaDescriptor{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicaDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicaDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TopicStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v github_com_kakao_varlog_pkg_types.LogStreamID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LogStreams = append(m.LogStreams, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMetadata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMetadata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMetadata
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LogStreams) == 0 {
+					m.LogStreams = make([]github_com_kakao_varlog_pkg_types.LogStreamID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_kakao_varlog_pkg_types.LogStreamID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMetadata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LogStreams = append(m.LogStreams, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= time.Duration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogStreams", wireType)
+			}
+			m.MaxLogStreams = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogStreams |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			}
+			m.StorageNodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopicLogStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopicLogStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			}
+			m.TopicID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			}
+			m.LogStreamID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStreamReplica) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogStreamReplica: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogStreamReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2539,14 +5498,13 @@ func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogStreams = append(m.LogStreams, &LogStreamDescriptor{})
-			if err := m.LogStreams[len(m.LogStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicLogStream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2573,8 +5531,7 @@ func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topics = append(m.Topics, &TopicDescriptor{})
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TopicLogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2599,7 +5556,7 @@ func (m *MetadataDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
+func (m *LogSequenceNumber) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2622,17 +5579,17 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNodeDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogSequenceNumber: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNodeDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogSequenceNumber: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
 			}
-			var msglen int
+			m.LLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2642,30 +5599,85 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadata
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntryMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntryMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntryMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.Status = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2675,16 +5687,16 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= StorageNodeStatus(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
 			}
-			var stringLen uint64
+			m.LogStreamID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2694,29 +5706,35 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GLSN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
 			}
-			var msglen int
+			m.LLSN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2726,25 +5744,11 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -2766,7 +5770,7 @@ func (m *StorageNodeDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
+func (m *LogEntryHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2789,15 +5793,15 @@ func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntryHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntryHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2825,13 +5829,13 @@ func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.Used = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2841,30 +5845,26 @@ func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Used |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
 			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -2886,7 +5886,7 @@ func (m *StorageDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
+func (m *LogEntryAttributes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2909,17 +5909,17 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntryAttributes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntryAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.TopicID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2929,16 +5929,67 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
 			}
-			m.LogStreamID = 0
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2948,16 +5999,31 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, LogEntryHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
 			}
-			m.Status = 0
+			m.CompressionCodec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -2967,14 +6033,14 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= LogStreamStatus(b&0x7F) << shift
+				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3001,8 +6067,10 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, &ReplicaDescriptor{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Encryption == nil {
+				m.Encryption = &LogEntryEncryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3027,7 +6095,7 @@ func (m *LogStreamDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
+func (m *LogEntryEncryption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,17 +6118,17 @@ func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicaDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntryEncryption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicaDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntryEncryption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
-			m.StorageNodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3070,16 +6138,29 @@ func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedDataKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3089,29 +6170,31 @@ func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageNodePath = string(dAtA[iNdEx:postIndex])
+			m.WrappedDataKey = append(m.WrappedDataKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.WrappedDataKey == nil {
+				m.WrappedDataKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataPath", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressionCodec", wireType)
 			}
-			var stringLen uint64
+			m.CompressionCodec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3121,24 +6204,11 @@ func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompressionCodec |= CompressionCodec(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DataPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3160,7 +6230,7 @@ func (m *ReplicaDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
+func (m *LogEntryFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3183,17 +6253,17 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicDescriptor: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntryFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntryFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPrefix", wireType)
 			}
-			m.TopicID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3203,16 +6273,31 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPrefix = append(m.KeyPrefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyPrefix == nil {
+				m.KeyPrefix = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3222,87 +6307,98 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= TopicStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, LogEntryHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v github_com_kakao_varlog_pkg_types.LogStreamID
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetadata
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampBegin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
 				}
-				m.LogStreams = append(m.LogStreams, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMetadata
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMetadata
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMetadata
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimestampBegin == nil {
+				m.TimestampBegin = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimestampBegin, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LogStreams) == 0 {
-					m.LogStreams = make([]github_com_kakao_varlog_pkg_types.LogStreamID, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v github_com_kakao_varlog_pkg_types.LogStreamID
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMetadata
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LogStreams = append(m.LogStreams, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreams", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimestampEnd == nil {
+				m.TimestampEnd = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TimestampEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3324,7 +6420,7 @@ func (m *TopicDescriptor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StorageNode) Unmarshal(dAtA []byte) error {
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3347,17 +6443,17 @@ func (m *StorageNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StorageNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StorageNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntryMeta", wireType)
 			}
-			m.StorageNodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3367,16 +6463,30 @@ func (m *StorageNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StorageNodeID |= github_com_kakao_varlog_pkg_types.StorageNodeID(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogEntryMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3386,23 +6496,58 @@ func (m *StorageNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogEntryAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LogEntryAttributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3425,7 +6570,7 @@ func (m *StorageNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
+func (m *CommitContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3448,17 +6593,17 @@ func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TopicLogStream: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TopicLogStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.TopicID = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3468,16 +6613,16 @@ func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
+				m.Version |= github_com_kakao_varlog_pkg_types.Version(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogStreamID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			m.LogStreamID = 0
+			m.HighWatermark = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3487,7 +6632,64 @@ func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogStreamID |= github_com_kakao_varlog_pkg_types.LogStreamID(b&0x7F) << shift
+				m.HighWatermark |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedGLSNBegin", wireType)
+			}
+			m.CommittedGLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommittedGLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedGLSNEnd", wireType)
+			}
+			m.CommittedGLSNEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommittedGLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedLLSNBegin", wireType)
+			}
+			m.CommittedLLSNBegin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommittedLLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3513,7 +6715,7 @@ func (m *TopicLogStream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogStreamReplica) Unmarshal(dAtA []byte) error {
+func (m *MetadataRepositoryNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3536,17 +6738,36 @@ func (m *LogStreamReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogStreamReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetadataRepositoryNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogStreamReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetadataRepositoryNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			m.NodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageNode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3556,30 +6777,29 @@ func (m *LogStreamReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StorageNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RaftURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TopicLogStream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3589,80 +6809,29 @@ func (m *LogStreamReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TopicLogStream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RPCAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogSequenceNumber) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LogSequenceNumber: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogSequenceNumber: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
-			m.LLSN = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3672,16 +6841,17 @@ func (m *LogSequenceNumber) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LLSN |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Leader = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GLSN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
 			}
-			m.GLSN = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3691,11 +6861,12 @@ func (m *LogSequenceNumber) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GLSN |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Learner = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3717,7 +6888,7 @@ func (m *LogSequenceNumber) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEntryMeta) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupOffset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3740,10 +6911,10 @@ func (m *LogEntryMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntryMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupOffset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntryMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupOffset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3822,6 +6993,39 @@ func (m *LogEntryMeta) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
@@ -3843,7 +7047,7 @@ func (m *LogEntryMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGroupDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3866,17 +7070,17 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGroupDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGroupDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogEntryMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3886,30 +7090,29 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LogEntryMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offsets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -3919,24 +7122,24 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Offsets = append(m.Offsets, ConsumerGroupOffset{})
+			if err := m.Offsets[len(m.Offsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3960,7 +7163,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitContext) Unmarshal(dAtA []byte) error {
+func (m *SchemaDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3983,17 +7186,17 @@ func (m *CommitContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaDescriptor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaID", wireType)
 			}
-			m.Version = 0
+			m.SchemaID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4003,16 +7206,16 @@ func (m *CommitContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= github_com_kakao_varlog_pkg_types.Version(b&0x7F) << shift
+				m.SchemaID |= github_com_kakao_varlog_pkg_types.SchemaID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicID", wireType)
 			}
-			m.HighWatermark = 0
+			m.TopicID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4022,16 +7225,16 @@ func (m *CommitContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HighWatermark |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.TopicID |= github_com_kakao_varlog_pkg_types.TopicID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommittedGLSNBegin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.CommittedGLSNBegin = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4041,16 +7244,16 @@ func (m *CommitContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommittedGLSNBegin |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.Version |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommittedGLSNEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.CommittedGLSNEnd = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4060,104 +7263,16 @@ func (m *CommitContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommittedGLSNEnd |= github_com_kakao_varlog_pkg_types.GLSN(b&0x7F) << shift
+				m.Type |= SchemaType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommittedLLSNBegin", wireType)
-			}
-			m.CommittedLLSNBegin = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CommittedLLSNBegin |= github_com_kakao_varlog_pkg_types.LLSN(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMetadata(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMetadata
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MetadataRepositoryNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMetadata
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MetadataRepositoryNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetadataRepositoryNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
-			}
-			m.NodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeID |= github_com_kakao_varlog_pkg_types.NodeID(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaftURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Definition", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4167,29 +7282,31 @@ func (m *MetadataRepositoryNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RaftURL = string(dAtA[iNdEx:postIndex])
+			m.Definition = append(m.Definition[:0], dAtA[iNdEx:postIndex]...)
+			if m.Definition == nil {
+				m.Definition = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RPCAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMetadata
@@ -4199,64 +7316,25 @@ func (m *MetadataRepositoryNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMetadata
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMetadata
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RPCAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Leader = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMetadata
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Learner = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
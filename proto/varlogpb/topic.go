@@ -0,0 +1,64 @@
+package varlogpb
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// MaxTopicNameLength is the maximum length of the name of a topic.
+const MaxTopicNameLength = 249
+
+var (
+	topicNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+	digitsRegexp    = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ValidateTopicName checks whether the name can be the name of a topic. It
+// consists of ASCII alphanumerics, '.', '_' and '-'. A name of only digits is
+// not allowed since tools accept either the ID or the name of a topic in the
+// same place.
+func ValidateTopicName(name string) error {
+	if len(name) == 0 {
+		return errors.New("empty topic name")
+	}
+	if len(name) > MaxTopicNameLength {
+		return fmt.Errorf("topic name too long: %d > %d", len(name), MaxTopicNameLength)
+	}
+	if !topicNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid topic name %q: only alphanumerics, '.', '_' and '-' are allowed", name)
+	}
+	if digitsRegexp.MatchString(name) {
+		return fmt.Errorf("invalid topic name %q: only digits", name)
+	}
+	return nil
+}
+
+// Validate checks whether the configuration is well-formed. It does not know
+// the defaults of the cluster; hence, the caller should check them.
+func (c TopicConfig) Validate() error {
+	if c.ReplicationFactor < 0 {
+		return fmt.Errorf("negative replication factor %d", c.ReplicationFactor)
+	}
+	if c.Retention < 0 {
+		return fmt.Errorf("negative retention %v", c.Retention)
+	}
+	if c.MaxLogStreams < 0 {
+		return fmt.Errorf("negative max log streams %d", c.MaxLogStreams)
+	}
+	return nil
+}
+
+// GetTopicByName returns the topic whose name is the argument name. It
+// returns nil if the name is empty or no running topic has the name.
+func (m *MetadataDescriptor) GetTopicByName(name string) *TopicDescriptor {
+	if m == nil || len(name) == 0 {
+		return nil
+	}
+	for _, topic := range m.Topics {
+		if topic.Name == name && !topic.Status.Deleted() {
+			return topic
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package varlogpb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTopicName(t *testing.T) {
+	tcs := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "orders-events"},
+		{name: "orders.events_v2"},
+		{name: "7a"},
+		{name: strings.Repeat("a", MaxTopicNameLength)},
+		{name: "", wantErr: true},
+		{name: "7", wantErr: true},
+		{name: "orders events", wantErr: true},
+		{name: "orders/events", wantErr: true},
+		{name: strings.Repeat("a", MaxTopicNameLength+1), wantErr: true},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTopicName(tc.name)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTopicConfig_Validate(t *testing.T) {
+	require.NoError(t, TopicConfig{}.Validate())
+	require.NoError(t, TopicConfig{ReplicationFactor: 3, Retention: time.Hour, MaxLogStreams: 4, Description: "desc"}.Validate())
+	require.Error(t, TopicConfig{ReplicationFactor: -1}.Validate())
+	require.Error(t, TopicConfig{Retention: -time.Second}.Validate())
+	require.Error(t, TopicConfig{MaxLogStreams: -1}.Validate())
+}
+
+func TestMetadataDescriptor_GetTopicByName(t *testing.T) {
+	var md *MetadataDescriptor
+	require.Nil(t, md.GetTopicByName("foo"))
+
+	md = &MetadataDescriptor{
+		Topics: []*TopicDescriptor{
+			{TopicID: 1},
+			{TopicID: 2, Name: "foo", Status: TopicStatusDeleted},
+			{TopicID: 3, Name: "foo"},
+			{TopicID: 4, Name: "bar"},
+		},
+	}
+	require.Nil(t, md.GetTopicByName(""))
+	require.Nil(t, md.GetTopicByName("baz"))
+	require.EqualValues(t, 3, md.GetTopicByName("foo").TopicID)
+	require.EqualValues(t, 4, md.GetTopicByName("bar").TopicID)
+}
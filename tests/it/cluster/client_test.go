@@ -2,8 +2,12 @@ package cluster
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -12,11 +16,16 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/kakao/varlog/internal/encrypt"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
 	"github.com/kakao/varlog/pkg/varlog"
 	"github.com/kakao/varlog/pkg/varlog/x/mlsa"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/proto/varlogpb"
 	"github.com/kakao/varlog/tests/it"
 )
@@ -112,6 +121,85 @@ func TestClientAppendToSubscribeTo(t *testing.T) {
 	require.NoError(t, subscriber.Close())
 }
 
+func TestClientLogEntryAttributes(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	topicID := clus.TopicIDs()[0]
+	logStreamID := clus.LogStreamIDs(topicID)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	ts := time.Unix(1700000000, 0).UTC()
+	attrs := []varlogpb.LogEntryAttributes{
+		{Key: []byte("k1"), Timestamp: &ts},
+		{},
+		{Headers: []varlogpb.LogEntryHeader{{Key: "h1", Value: []byte("v1")}}},
+	}
+	dataBatch := [][]byte{[]byte("1"), []byte("2"), []byte("3")}
+
+	// The number of attributes differs from the number of data.
+	res := client.Append(context.Background(), topicID, dataBatch[:1], varlog.WithLogEntryAttributes(attrs))
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	lsa, err := client.NewLogStreamAppender(topicID, logStreamID)
+	require.NoError(t, err)
+	defer lsa.Close()
+	err = lsa.AppendBatch(dataBatch[:1], nil, varlog.WithLogEntryAttributes(attrs))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// GLSN: 1, 2, 3
+	res = client.Append(context.Background(), topicID, dataBatch, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, res.Err)
+	// GLSN: 4, 5, 6
+	res = client.AppendTo(context.Background(), topicID, logStreamID, dataBatch, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, res.Err)
+	// GLSN: 7, 8, 9
+	var wg sync.WaitGroup
+	wg.Add(1)
+	err = lsa.AppendBatch(dataBatch, func(_ []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.NoError(t, err)
+	}, varlog.WithLogEntryAttributes(attrs))
+	require.NoError(t, err)
+	wg.Wait()
+
+	const numLogs = 9
+
+	leC := make(chan varlogpb.LogEntry, numLogs)
+	closer, err := client.Subscribe(context.Background(), topicID, types.MinGLSN, types.GLSN(numLogs+1), func(le varlogpb.LogEntry, err error) {
+		if err == nil {
+			leC <- le
+		}
+	})
+	require.NoError(t, err)
+	for i := 0; i < numLogs; i++ {
+		le := <-leC
+		require.Equal(t, dataBatch[i%3], le.Data)
+		require.True(t, attrs[i%3].Equal(le.LogEntryAttributes))
+	}
+	closer()
+
+	subscriber := client.SubscribeTo(context.Background(), topicID, logStreamID, types.MinLLSN, types.LLSN(numLogs+1))
+	for i := 0; i < numLogs; i++ {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, dataBatch[i%3], le.Data)
+		require.True(t, attrs[i%3].Equal(le.LogEntryAttributes))
+	}
+	require.NoError(t, subscriber.Close())
+}
+
 func TestClientAppendTo(t *testing.T) {
 	// defer goleak.VerifyNone(t)
 	clus := it.NewVarlogCluster(t,
@@ -327,6 +415,84 @@ func TestClientSubscribe(t *testing.T) {
 	}
 }
 
+func TestClientSubscribeIterator(t *testing.T) {
+	const (
+		batchSize = 10
+		appendCnt = 10
+		nrLogs    = batchSize * appendCnt
+		window    = 2
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(3),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	newMsg := func(glsn types.GLSN) string {
+		return fmt.Sprintf("msg-%03d", glsn)
+	}
+
+	topicID := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < appendCnt; i++ {
+		batch := make([][]byte, batchSize)
+		for j := 0; j < batchSize; j++ {
+			batch[j] = []byte(newMsg(types.GLSN(i*batchSize + j + 1)))
+		}
+		res := client.Append(context.Background(), topicID, batch)
+		require.NoError(t, res.Err)
+	}
+
+	_, err := client.SubscribeIterator(context.Background(), topicID, types.GLSN(2), types.GLSN(1))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	_, err = client.SubscribeIterator(context.Background(), topicID, types.MinGLSN, types.GLSN(nrLogs+1), varlog.WithPrefetchWindow(0))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	iter, err := client.SubscribeIterator(context.Background(), topicID, types.MinGLSN, types.GLSN(nrLogs+1), varlog.WithPrefetchWindow(window))
+	require.NoError(t, err)
+	for glsn := types.MinGLSN; glsn <= nrLogs; glsn++ {
+		// A slow consumer does not lose or reorder log entries.
+		if glsn%10 == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+		le, err := iter.Next(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, glsn, le.GLSN)
+		require.Equal(t, newMsg(glsn), string(le.Data))
+	}
+	_, err = iter.Next(context.Background())
+	require.ErrorIs(t, err, io.EOF)
+	_, err = iter.Next(context.Background())
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, iter.Close())
+
+	// Next waits for log entries not committed yet.
+	iter, err = client.SubscribeIterator(context.Background(), topicID, types.GLSN(nrLogs+1), types.MaxGLSN, varlog.WithPrefetchWindow(window))
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	_, err = iter.Next(ctx)
+	cancel()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	res := client.Append(context.Background(), topicID, [][]byte{[]byte(newMsg(nrLogs + 1))})
+	require.NoError(t, res.Err)
+	le, err := iter.Next(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(nrLogs+1), le.GLSN)
+
+	require.NoError(t, iter.Close())
+	_, err = iter.Next(context.Background())
+	require.ErrorIs(t, err, verrors.ErrClosed)
+	require.NoError(t, iter.Close())
+}
+
 func TestClientTrim(t *testing.T) {
 	// defer goleak.VerifyNone(t)
 	const (
@@ -659,6 +825,139 @@ func TestClientPeekLogStream(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestClientReadAt(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(2),
+		it.WithReplicationFactor(2),
+		it.WithNumberOfTopics(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+	)
+	defer clus.Close(t)
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	_, err := client.ReadAt(context.Background(), tpid, lsid, types.MinGLSN)
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")},
+		varlog.WithLogEntryAttributes([]varlogpb.LogEntryAttributes{{Key: []byte("k")}}),
+	)
+	require.NoError(t, res.Err)
+	glsn := res.Metadata[0].GLSN
+
+	le, err := client.ReadAt(context.Background(), tpid, lsid, glsn)
+	require.NoError(t, err)
+	require.Equal(t, tpid, le.TopicID)
+	require.Equal(t, lsid, le.LogStreamID)
+	require.Equal(t, glsn, le.GLSN)
+	require.Equal(t, types.MinLLSN, le.LLSN)
+	require.Equal(t, []byte("foo"), le.Data)
+	require.Equal(t, []byte("k"), le.Key)
+
+	_, err = client.ReadAt(context.Background(), tpid, lsid, types.InvalidGLSN)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	idx := int(time.Now().UnixNano() % 2)
+	clus.CloseSN(t, clus.StorageNodeIDAtIndex(t, idx))
+
+	le, err = client.ReadAt(context.Background(), tpid, lsid, glsn)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), le.Data)
+
+	idx = (idx + 1) % 2
+	clus.CloseSN(t, clus.StorageNodeIDAtIndex(t, idx))
+
+	_, err = client.ReadAt(context.Background(), tpid, lsid, glsn)
+	require.Error(t, err)
+}
+
+func TestClientConsumerGroup(t *testing.T) {
+	const (
+		numLogs = 10
+		group   = "cg"
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfTopics(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+	client := clus.ClientAtIndex(t, 0)
+	adm := clus.GetVMSClient(t)
+
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	_, err := client.FetchOffset(context.Background(), group, tpid, 0)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	err = client.CommitOffset(context.Background(), group, varlogpb.ConsumerGroupOffset{TopicID: tpid, LogStreamID: lsid, GLSN: 1})
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	err = client.CommitOffset(context.Background(), group, varlogpb.ConsumerGroupOffset{TopicID: tpid, GLSN: 5})
+	require.NoError(t, err)
+
+	// The committed offset is stored in the metadata repository; hence, a
+	// restarted client can fetch it.
+	require.NoError(t, client.Close())
+	client, err = varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	offset, err := client.FetchOffset(context.Background(), group, tpid, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(5), offset.GLSN)
+
+	// resume from the next position of the committed offset
+	glsnC := make(chan types.GLSN, numLogs)
+	closer, err := client.Subscribe(context.Background(), tpid, offset.GLSN+1, numLogs+1, func(le varlogpb.LogEntry, err error) {
+		if err == nil {
+			glsnC <- le.GLSN
+			return
+		}
+		close(glsnC)
+	})
+	require.NoError(t, err)
+	var glsns []types.GLSN
+	for glsn := range glsnC {
+		glsns = append(glsns, glsn)
+	}
+	closer()
+	require.Equal(t, []types.GLSN{6, 7, 8, 9, 10}, glsns)
+
+	require.Eventually(t, func() bool {
+		cgm, err := adm.GetConsumerGroup(context.Background(), group)
+		return err == nil && cgm.Offsets[0].HighWatermark == numLogs && cgm.Offsets[0].Lag == numLogs-5
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = adm.ResetConsumerGroupOffset(context.Background(), group, varlogpb.ConsumerGroupOffset{TopicID: tpid})
+	require.NoError(t, err)
+	offset, err = client.FetchOffset(context.Background(), group, tpid, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.InvalidGLSN, offset.GLSN)
+
+	require.NoError(t, adm.DeleteConsumerGroup(context.Background(), group))
+	_, err = client.FetchOffset(context.Background(), group, tpid, 0)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+}
+
 func TestClientAppendWithAllowedLogStream(t *testing.T) {
 	const numLogs = 100
 	const numLogStreams = 10
@@ -709,104 +1008,406 @@ func TestClientAppendWithAllowedLogStream(t *testing.T) {
 	require.NoError(t, subscriber.Close())
 }
 
-func TestLogStreamAppender(t *testing.T) {
+func TestClientAppendWithSelectionKey(t *testing.T) {
 	const (
-		pipelineSize = 2
-		calls        = pipelineSize * 5
-		batchSize    = 2
+		numLogs       = 10
+		numLogStreams = 3
 	)
 
 	tcs := []struct {
 		name  string
-		testf func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log)
+		testf func(t *testing.T, clus *it.VarlogCluster, tpid types.TopicID, owner types.LogStreamID, key []byte)
 	}{
 		{
-			name: "CloseBeforeAppendLogs",
-			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
-				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
-				require.NoError(t, err)
-				lsa.Close()
-				err = lsa.AppendBatch([][]byte{[]byte("foo")}, nil)
-				require.Equal(t, varlog.ErrClosed, err)
-			},
-		},
-		{
-			name: "CloseAfterProcessingCallbacks",
-			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
-				var (
-					llsn types.LLSN
-					glsn types.GLSN
-					wg   sync.WaitGroup
-				)
-				cb := func(metas []varlogpb.LogEntryMeta, err error) {
-					defer wg.Done()
-					assert.NoError(t, err)
-					assert.Len(t, metas, batchSize)
-					assert.Less(t, llsn, metas[batchSize-1].LLSN)
-					assert.Less(t, glsn, metas[batchSize-1].GLSN)
-					llsn = metas[batchSize-1].LLSN
-					glsn = metas[batchSize-1].GLSN
-				}
+			name: "Remap",
+			testf: func(t *testing.T, clus *it.VarlogCluster, tpid types.TopicID, owner types.LogStreamID, key []byte) {
+				client := clus.ClientAtIndex(t, 0)
 
-				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
+				_, err := clus.Seal(tpid, owner)
 				require.NoError(t, err)
-				defer func() {
-					wg.Wait()
-					lsa.Close()
-				}()
 
-				wg.Add(calls)
-				for i := 0; i < calls; i++ {
-					data := make([][]byte, batchSize)
-					for j := 0; j < batchSize; j++ {
-						data[j] = []byte(fmt.Sprintf("%d,%d", i, j))
+				var remapped types.LogStreamID
+				for i := 0; i < numLogs; i++ {
+					res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")},
+						varlog.WithSelectionKey(key),
+						varlog.WithAppendSealedLogStreamPolicy(varlog.SealedLogStreamPolicyRemap),
+					)
+					require.NoError(t, res.Err)
+					require.NotEqual(t, owner, res.Metadata[0].LogStreamID)
+					if i == 0 {
+						remapped = res.Metadata[0].LogStreamID
 					}
-					err := lsa.AppendBatch(data, cb)
-					require.NoError(t, err)
+					require.Equal(t, remapped, res.Metadata[0].LogStreamID)
 				}
 			},
 		},
 		{
-			name: "CloseWhileProcessingCallbacks",
-			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
-				var (
-					llsn types.LLSN
-					glsn types.GLSN
-					wg   sync.WaitGroup
-				)
-				cb := func(metas []varlogpb.LogEntryMeta, err error) {
-					defer wg.Done()
-					if err != nil {
-						assert.Equal(t, varlog.ErrClosed, err)
-						return
-					}
-					assert.NoError(t, err)
-					assert.Len(t, metas, batchSize)
-					assert.Less(t, llsn, metas[batchSize-1].LLSN)
-					assert.Less(t, glsn, metas[batchSize-1].GLSN)
-					llsn = metas[batchSize-1].LLSN
-					glsn = metas[batchSize-1].GLSN
-				}
+			name: "Wait",
+			testf: func(t *testing.T, clus *it.VarlogCluster, tpid types.TopicID, owner types.LogStreamID, key []byte) {
+				client := clus.ClientAtIndex(t, 0)
 
-				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
+				_, err := clus.Seal(tpid, owner)
 				require.NoError(t, err)
-				defer func() {
-					lsa.Close()
-					wg.Wait()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+				defer cancel()
+				res := client.Append(ctx, tpid, [][]byte{[]byte("foo")}, varlog.WithSelectionKey(key))
+				require.ErrorIs(t, res.Err, verrors.ErrSealed)
+				require.ErrorIs(t, res.Err, context.DeadlineExceeded)
+
+				var wg sync.WaitGroup
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")}, varlog.WithSelectionKey(key))
+					assert.NoError(t, res.Err)
+					assert.Equal(t, owner, res.Metadata[0].LogStreamID)
 				}()
 
-				wg.Add(calls)
-				for i := 0; i < calls; i++ {
-					data := make([][]byte, batchSize)
-					for j := 0; j < batchSize; j++ {
-						data[j] = []byte(fmt.Sprintf("%d,%d", i, j))
-					}
-					err := lsa.AppendBatch(data, cb)
-					require.NoError(t, err)
-				}
+				require.Eventually(t, func() bool {
+					lsd, err := clus.Unseal(tpid, owner)
+					return err == nil && lsd.Status == varlogpb.LogStreamStatusRunning
+				}, 5*time.Second, 10*time.Millisecond)
+				wg.Wait()
 			},
 		},
-		{
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			clus := it.NewVarlogCluster(t,
+				it.WithNumberOfStorageNodes(1),
+				it.WithNumberOfLogStreams(numLogStreams),
+				it.WithNumberOfClients(1),
+				it.WithVMSOptions(it.NewTestVMSOptions()...),
+				it.WithNumberOfTopics(1),
+			)
+			defer func() {
+				clus.Close(t)
+				testutil.GC()
+			}()
+
+			tpid := clus.TopicIDs()[0]
+			client := clus.ClientAtIndex(t, 0)
+			key := []byte("key")
+
+			var owner types.LogStreamID
+			for i := 0; i < numLogs; i++ {
+				res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")}, varlog.WithSelectionKey(key))
+				require.NoError(t, res.Err)
+				if i == 0 {
+					owner = res.Metadata[0].LogStreamID
+				}
+				require.Equal(t, owner, res.Metadata[0].LogStreamID)
+			}
+
+			tc.testf(t, clus, tpid, owner, key)
+		})
+	}
+}
+
+func TestClientIdempotentProducer(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(), varlog.WithIdempotentProducer())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	for i := 0; i < 3; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo"), []byte("bar")})
+		require.NoError(t, res.Err)
+		require.Len(t, res.Metadata, 2)
+	}
+
+	// A retried batch whose response was lost is rejected.
+	const producerID = 1
+	cli := clus.LogClientOf(t, clus.PrimaryStorageNodeIDOf(t, lsid))
+	res, err := cli.AppendWithProducer(context.Background(), tpid, lsid, producerID, 1, [][]byte{[]byte("foo")})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	_, err = cli.AppendWithProducer(context.Background(), tpid, lsid, producerID, 1, [][]byte{[]byte("foo")})
+	require.ErrorIs(t, verrors.FromStatusError(err), verrors.ErrDuplicate)
+	res, err = cli.AppendWithProducer(context.Background(), tpid, lsid, producerID, 2, [][]byte{[]byte("foo")})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	// Sequence numbers are remembered even after the log stream is sealed
+	// and unsealed.
+	_, err = clus.Seal(tpid, lsid)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		lsd, err := clus.Unseal(tpid, lsid)
+		return err == nil && lsd.Status == varlogpb.LogStreamStatusRunning
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = cli.AppendWithProducer(context.Background(), tpid, lsid, producerID, 2, [][]byte{[]byte("foo")})
+	require.ErrorIs(t, verrors.FromStatusError(err), verrors.ErrDuplicate)
+}
+
+func TestClientTransaction(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(tpid)
+	client := clus.ClientAtIndex(t, 0)
+
+	txn, err := client.BeginTransaction()
+	require.NoError(t, err)
+	require.NoError(t, txn.Append(tpid, lsids[0], [][]byte{[]byte("data1"), []byte("data2")}))
+	require.NoError(t, txn.Append(tpid, lsids[1], [][]byte{[]byte("index1")}))
+	require.NoError(t, txn.Append(tpid, lsids[0], [][]byte{[]byte("data3")}))
+	res := txn.Commit(context.Background())
+	require.NoError(t, res.Err)
+	require.Len(t, res.Metadata, 4)
+	require.Error(t, txn.Append(tpid, lsids[0], [][]byte{[]byte("foo")}))
+
+	expected := []string{"data1", "data2", "data3", "index1"}
+	for i, meta := range res.Metadata {
+		le, err := client.ReadAt(context.Background(), tpid, meta.LogStreamID, meta.GLSN)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], string(le.Data))
+	}
+
+	// The transaction staged without being registered to the metadata
+	// repository is never committed, and aborting it seals the log stream.
+	const transactionID = 1
+	cli := clus.LogClientOf(t, clus.PrimaryStorageNodeIDOf(t, lsids[0]))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := cli.AppendInTransaction(context.Background(), tpid, lsids[0], transactionID, [][]byte{[]byte("foo")})
+		assert.Error(t, err)
+	}()
+	require.Eventually(t, func() bool {
+		require.NoError(t, cli.AbortTransaction(context.Background(), tpid, lsids[0], transactionID))
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = clus.Seal(tpid, lsids[0])
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		lsd, err := clus.Unseal(tpid, lsids[0])
+		return err == nil && lsd.Status == varlogpb.LogStreamStatusRunning
+	}, 5*time.Second, 10*time.Millisecond)
+
+	first, last, err := client.PeekLogStream(context.Background(), tpid, lsids[0])
+	require.NoError(t, err)
+	require.Equal(t, types.LLSN(1), first.LLSN)
+	require.Equal(t, types.LLSN(3), last.LLSN)
+}
+
+func TestClientCompression(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+
+	_, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(), varlog.WithCompression(varlogpb.CompressionCodec(-1)))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// A storage node rejects payloads compressed by unknown codecs.
+	cli := clus.LogClientOf(t, clus.PrimaryStorageNodeIDOf(t, lsid))
+	_, err = cli.Append(context.Background(), tpid, lsid, [][]byte{[]byte("foo")}, varlogpb.LogEntryAttributes{CompressionCodec: varlogpb.CompressionCodec(-1)})
+	require.Error(t, err)
+
+	payload := func(codec varlogpb.CompressionCodec, i int) []byte {
+		return []byte(strings.Repeat(fmt.Sprintf(`{"codec":%q,"index":%d}`, codec, i), 10))
+	}
+	codecs := []varlogpb.CompressionCodec{
+		varlogpb.CompressionCodecZstd,
+		varlogpb.CompressionCodecSnappy,
+		varlogpb.CompressionCodecLZ4,
+	}
+	var expected [][]byte
+	for _, codec := range codecs {
+		client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(), varlog.WithCompression(codec))
+		require.NoError(t, err)
+
+		data := [][]byte{payload(codec, 0), []byte("foo")}
+		res := client.Append(context.Background(), tpid, data, varlog.WithLogEntryAttributes([]varlogpb.LogEntryAttributes{{Key: []byte("key")}, {}}))
+		require.NoError(t, res.Err)
+		expected = append(expected, data...)
+
+		lsa, err := client.NewLogStreamAppender(tpid, lsid)
+		require.NoError(t, err)
+		data = [][]byte{payload(codec, 1)}
+		var wg sync.WaitGroup
+		wg.Add(1)
+		err = lsa.AppendBatch(data, func(_ []varlogpb.LogEntryMeta, err error) {
+			defer wg.Done()
+			assert.NoError(t, err)
+		})
+		require.NoError(t, err)
+		wg.Wait()
+		lsa.Close()
+		expected = append(expected, data...)
+
+		require.NoError(t, client.Close())
+	}
+
+	// The client without compression decompresses log entries transparently.
+	client := clus.ClientAtIndex(t, 0)
+	subscriber := client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1))
+	for i := range expected {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected[i], le.Data)
+		require.Equal(t, varlogpb.CompressionCodecNone, le.CompressionCodec)
+		if i%3 == 0 {
+			require.Equal(t, []byte("key"), le.Key)
+		}
+	}
+	require.NoError(t, subscriber.Close())
+
+	// Backup replicas keep the compressed payloads as they are replicated.
+	cli = clus.LogClientOf(t, clus.BackupStorageNodeIDOf(t, lsid))
+	les, err := cli.ReadRangeWithLLSN(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1), 0)
+	require.NoError(t, err)
+	require.Len(t, les, len(expected))
+	for i, le := range les {
+		require.Equal(t, expected[i], le.Data)
+	}
+}
+
+func TestLogStreamAppender(t *testing.T) {
+	const (
+		pipelineSize = 2
+		calls        = pipelineSize * 5
+		batchSize    = 2
+	)
+
+	tcs := []struct {
+		name  string
+		testf func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log)
+	}{
+		{
+			name: "CloseBeforeAppendLogs",
+			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
+				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
+				require.NoError(t, err)
+				lsa.Close()
+				err = lsa.AppendBatch([][]byte{[]byte("foo")}, nil)
+				require.Equal(t, varlog.ErrClosed, err)
+			},
+		},
+		{
+			name: "CloseAfterProcessingCallbacks",
+			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
+				var (
+					llsn types.LLSN
+					glsn types.GLSN
+					wg   sync.WaitGroup
+				)
+				cb := func(metas []varlogpb.LogEntryMeta, err error) {
+					defer wg.Done()
+					assert.NoError(t, err)
+					assert.Len(t, metas, batchSize)
+					assert.Less(t, llsn, metas[batchSize-1].LLSN)
+					assert.Less(t, glsn, metas[batchSize-1].GLSN)
+					llsn = metas[batchSize-1].LLSN
+					glsn = metas[batchSize-1].GLSN
+				}
+
+				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
+				require.NoError(t, err)
+				defer func() {
+					wg.Wait()
+					lsa.Close()
+				}()
+
+				wg.Add(calls)
+				for i := 0; i < calls; i++ {
+					data := make([][]byte, batchSize)
+					for j := 0; j < batchSize; j++ {
+						data[j] = []byte(fmt.Sprintf("%d,%d", i, j))
+					}
+					err := lsa.AppendBatch(data, cb)
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
+			name: "CloseWhileProcessingCallbacks",
+			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
+				var (
+					llsn types.LLSN
+					glsn types.GLSN
+					wg   sync.WaitGroup
+				)
+				cb := func(metas []varlogpb.LogEntryMeta, err error) {
+					defer wg.Done()
+					if err != nil {
+						assert.Equal(t, varlog.ErrClosed, err)
+						return
+					}
+					assert.NoError(t, err)
+					assert.Len(t, metas, batchSize)
+					assert.Less(t, llsn, metas[batchSize-1].LLSN)
+					assert.Less(t, glsn, metas[batchSize-1].GLSN)
+					llsn = metas[batchSize-1].LLSN
+					glsn = metas[batchSize-1].GLSN
+				}
+
+				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
+				require.NoError(t, err)
+				defer func() {
+					lsa.Close()
+					wg.Wait()
+				}()
+
+				wg.Add(calls)
+				for i := 0; i < calls; i++ {
+					data := make([][]byte, batchSize)
+					for j := 0; j < batchSize; j++ {
+						data[j] = []byte(fmt.Sprintf("%d,%d", i, j))
+					}
+					err := lsa.AppendBatch(data, cb)
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
 			name: "CloseInCallback",
 			testf: func(t *testing.T, tpid types.TopicID, lsid types.LogStreamID, vcli varlog.Log) {
 				lsa, err := vcli.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(pipelineSize))
@@ -1162,3 +1763,999 @@ func testCallbackGen(t *testing.T, lsa varlog.LogStreamAppender, dataBatch [][]b
 		}
 	}
 }
+
+func TestClientLookupGLSNByTime(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	_, err := client.LookupGLSNByTime(context.Background(), tpid, time.Now())
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	const numBatches = 5
+	times := make([]time.Time, 0, numBatches)
+	firsts := make([]types.GLSN, 0, numBatches)
+	var firstLogStream types.LogStreamID
+	for i := 0; i < numBatches; i++ {
+		times = append(times, time.Now())
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo"), []byte("bar")})
+		require.NoError(t, res.Err)
+		firsts = append(firsts, res.Metadata[0].GLSN)
+		if i == 0 {
+			firstLogStream = res.Metadata[0].LogStreamID
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := range times {
+		glsn, err := client.LookupGLSNByTime(context.Background(), tpid, times[i])
+		require.NoError(t, err)
+		require.Equal(t, firsts[i], glsn)
+	}
+	_, err = client.LookupGLSNByTime(context.Background(), tpid, time.Now())
+	require.ErrorIs(t, err, verrors.ErrNoEntry)
+
+	// Every replica keeps the same time index.
+	lsid := firstLogStream
+	for _, rd := range clus.ReplicasOf(t, lsid) {
+		lsn, err := clus.LogClientOf(t, rd.StorageNodeID).LookupGLSNByTime(context.Background(), tpid, lsid, times[0])
+		require.NoError(t, err)
+		require.Equal(t, firsts[0], lsn.GLSN)
+	}
+
+	// Trimmed log entries cannot be found.
+	err = client.Trim(context.Background(), tpid, firsts[1], varlog.TrimOption{})
+	require.NoError(t, err)
+	glsn, err := client.LookupGLSNByTime(context.Background(), tpid, times[0])
+	require.NoError(t, err)
+	require.Equal(t, firsts[1]+1, glsn)
+}
+
+func TestClientSubscribeWithFilter(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	const numLogs = 20
+	var expected []types.GLSN
+	expectedLLSNs := make(map[types.LogStreamID][]types.LLSN)
+	lastLLSNs := make(map[types.LogStreamID]types.LLSN)
+	for i := 0; i < numLogs; i++ {
+		key := fmt.Sprintf("odd-%d", i)
+		if i%2 == 0 {
+			key = fmt.Sprintf("even-%d", i)
+		}
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")},
+			varlog.WithLogEntryAttributes([]varlogpb.LogEntryAttributes{{Key: []byte(key)}}),
+		)
+		require.NoError(t, res.Err)
+		meta := res.Metadata[0]
+		lastLLSNs[meta.LogStreamID] = meta.LLSN
+		if i%2 == 0 {
+			expected = append(expected, meta.GLSN)
+			expectedLLSNs[meta.LogStreamID] = append(expectedLLSNs[meta.LogStreamID], meta.LLSN)
+		}
+	}
+	filter := &varlogpb.LogEntryFilter{KeyPrefix: []byte("even-")}
+
+	// Subscribe advances over filtered log entries across log streams.
+	var (
+		actual []types.GLSN
+		keys   []string
+	)
+	errC := make(chan error, 1)
+	closer, err := client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(numLogs+1), func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			errC <- err
+			return
+		}
+		actual = append(actual, le.GLSN)
+		keys = append(keys, string(le.Key))
+	}, varlog.WithFilter(filter))
+	require.NoError(t, err)
+	require.ErrorIs(t, <-errC, io.EOF)
+	closer()
+	require.Equal(t, expected, actual)
+	for _, key := range keys {
+		require.True(t, strings.HasPrefix(key, "even-"))
+	}
+
+	iter, err := client.SubscribeIterator(context.Background(), tpid, types.MinGLSN, types.GLSN(numLogs+1), varlog.WithFilter(filter))
+	require.NoError(t, err)
+	actual = actual[:0]
+	for {
+		le, err := iter.Next(context.Background())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, []byte("foo"), le.Data)
+		actual = append(actual, le.GLSN)
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, expected, actual)
+
+	// SubscribeTo skips filtered log entries of the log stream.
+	for lsid, lastLLSN := range lastLLSNs {
+		subscriber := client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, lastLLSN+1, varlog.WithFilter(filter))
+		var llsns []types.LLSN
+		for {
+			le, err := subscriber.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			llsns = append(llsns, le.LLSN)
+			if le.LLSN == lastLLSN {
+				break
+			}
+		}
+		require.NoError(t, subscriber.Close())
+		require.Equal(t, expectedLLSNs[lsid], llsns)
+	}
+}
+
+func TestClientTelemetry(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() {
+		require.NoError(t, mp.Shutdown(context.Background()))
+	}()
+
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithTelemetry(mp, trace.NewNoopTracerProvider()),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	const numLogs = 10
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	lsa, err := client.NewLogStreamAppender(tpid, lsid)
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	err = lsa.AppendBatch([][]byte{[]byte("foo"), []byte("bar")}, func(_ []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.NoError(t, err)
+	})
+	require.NoError(t, err)
+	wg.Wait()
+	lsa.Close()
+
+	errC := make(chan error, 1)
+	closer, err := client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(numLogs+1), func(_ varlogpb.LogEntry, err error) {
+		if err != nil {
+			errC <- err
+		}
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, <-errC, io.EOF)
+	closer()
+
+	subscriber := client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(numLogs+1))
+	for i := 0; i < numLogs; i++ {
+		_, err := subscriber.Next()
+		require.NoError(t, err)
+	}
+	require.NoError(t, subscriber.Close())
+
+	err = client.Trim(context.Background(), tpid, types.GLSN(1), varlog.TrimOption{})
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	counters := make(map[string]int64)
+	histograms := make(map[string]uint64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					counters[m.Name] += dp.Value
+				}
+			case metricdata.Histogram[int64]:
+				for _, dp := range data.DataPoints {
+					histograms[m.Name] += dp.Count
+				}
+			}
+		}
+	}
+	require.EqualValues(t, numLogs, counters["client.append.logs"])
+	require.EqualValues(t, numLogs, histograms["client.append.duration"])
+	require.EqualValues(t, 2, counters["client.logstreamappender.append.logs"])
+	require.EqualValues(t, 1, histograms["client.logstreamappender.append.duration"])
+	require.EqualValues(t, 2*numLogs, counters["client.subscribe.logs"])
+	require.EqualValues(t, 2*numLogs, histograms["client.subscribe.lag"])
+	require.EqualValues(t, 1, histograms["client.trim.duration"])
+	require.Positive(t, histograms["client.metadata.refresh.duration"])
+}
+
+func TestClientLoadAwareSelection(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(tpid)
+
+	_, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithLogStreamSelectionPolicy(varlog.LogStreamSelectionPolicy(-1)),
+	)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithLogStreamSelectionPolicy(varlog.LogStreamSelectionPolicyLoadAware),
+		varlog.WithLoadHintInterval(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+
+	const numLogs = 20
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	// The sealed log stream is reported by its load hint, so appends avoid
+	// it without failing.
+	_, err = clus.Seal(tpid, lsids[0])
+	require.NoError(t, err)
+	lsrmd, err := clus.LogClientOf(t, clus.PrimaryStorageNodeIDOf(t, lsids[0])).LogStreamReplicaMetadata(context.Background(), tpid, lsids[0])
+	require.NoError(t, err)
+	require.Equal(t, varlogpb.LogStreamStatusSealed, lsrmd.Status)
+	require.Zero(t, lsrmd.InflightAppends)
+	require.Zero(t, lsrmd.UncommittedLLSNLength)
+	time.Sleep(200 * time.Millisecond)
+
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+		require.Equal(t, lsids[1], res.Metadata[0].LogStreamID)
+	}
+}
+
+func TestClientTopicAppender(t *testing.T) {
+	const (
+		pipelineSize = 2
+		numBatches   = 20
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(tpid)
+	client := clus.ClientAtIndex(t, 0)
+
+	ta, err := client.NewTopicAppender(tpid, varlog.WithPipelineSize(pipelineSize))
+	require.NoError(t, err)
+
+	var (
+		wg     sync.WaitGroup
+		called int
+	)
+	appendBatch := func(i int) {
+		wg.Add(1)
+		err := ta.AppendBatch([][]byte{[]byte(fmt.Sprintf("%d", i))}, func(metas []varlogpb.LogEntryMeta, err error) {
+			defer wg.Done()
+			assert.NoError(t, err)
+			assert.Len(t, metas, 1)
+			// Callbacks are called in the order of AppendBatch calls.
+			assert.Equal(t, called, i)
+			called++
+		})
+		require.NoError(t, err)
+	}
+
+	// The log stream is sealed while batches are in flight. They are moved
+	// to the other log stream.
+	for i := 0; i < numBatches; i++ {
+		if i == numBatches/2 {
+			_, err := clus.Seal(tpid, lsids[0])
+			require.NoError(t, err)
+		}
+		appendBatch(i)
+	}
+	wg.Wait()
+	require.Equal(t, numBatches, called)
+
+	wg.Add(1)
+	err = ta.AppendBatch([][]byte{[]byte("foo")}, func(metas []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.NoError(t, err)
+		assert.Equal(t, lsids[1], metas[0].LogStreamID)
+	})
+	require.NoError(t, err)
+	wg.Wait()
+
+	ta.Close()
+	err = ta.AppendBatch([][]byte{[]byte("foo")}, nil)
+	require.Equal(t, varlog.ErrClosed, err)
+}
+
+func TestClientReadReplicaPolicy(t *testing.T) {
+	const numLogs = 10
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(3),
+		it.WithNumberOfStorageNodes(3),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamID(t, tpid, 0)
+	for i := 0; i < numLogs; i++ {
+		res := clus.ClientAtIndex(t, 0).Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	_, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithReadReplicaPolicy(varlog.ReadReplicaPolicy(-1)),
+	)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	subscribeTo := func(t *testing.T, client varlog.Log, begin, end types.LLSN) {
+		sub := client.SubscribeTo(context.Background(), tpid, lsid, begin, end)
+		defer func() {
+			require.NoError(t, sub.Close())
+		}()
+		for llsn := begin; llsn < end; llsn++ {
+			le, err := sub.Next()
+			require.NoError(t, err)
+			require.Equal(t, llsn, le.LLSN)
+		}
+		_, err := sub.Next()
+		require.ErrorIs(t, err, io.EOF)
+	}
+
+	policies := []varlog.ReadReplicaPolicy{
+		varlog.ReadReplicaPolicyPrimary,
+		varlog.ReadReplicaPolicyAny,
+		varlog.ReadReplicaPolicyNearest,
+		varlog.ReadReplicaPolicyLeastLoaded,
+	}
+	for _, policy := range policies {
+		policy := policy
+		t.Run(policy.String(), func(t *testing.T) {
+			client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+				varlog.WithReadReplicaPolicy(policy),
+			)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, client.Close())
+			}()
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			expected := types.MinGLSN
+			closer, err := client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(numLogs+1), func(le varlogpb.LogEntry, err error) {
+				if err != nil {
+					assert.ErrorIs(t, err, io.EOF)
+					wg.Done()
+					return
+				}
+				assert.Equal(t, expected, le.GLSN)
+				expected++
+			})
+			require.NoError(t, err)
+			wg.Wait()
+			closer()
+			require.Equal(t, types.GLSN(numLogs+1), expected)
+
+			subscribeTo(t, client, types.MinLLSN, types.LLSN(numLogs+1))
+		})
+	}
+
+	// Committed log entries are still readable from backup replicas after
+	// the primary replica fails.
+	client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+		varlog.WithReadReplicaPolicy(varlog.ReadReplicaPolicyPrimary),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	sub := client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(numLogs+1))
+	le, err := sub.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, le.LLSN)
+
+	clus.CloseSN(t, clus.PrimaryStorageNodeIDOf(t, lsid))
+	for llsn := types.MinLLSN + 1; llsn <= numLogs; llsn++ {
+		le, err := sub.Next()
+		require.NoError(t, err)
+		require.Equal(t, llsn, le.LLSN)
+	}
+	_, err = sub.Next()
+	require.ErrorIs(t, err, io.EOF)
+	require.NoError(t, sub.Close())
+
+	subscribeTo(t, client, types.MinLLSN, types.LLSN(numLogs+1))
+}
+
+func TestClientSubscribeStartPosition(t *testing.T) {
+	const (
+		numLogs    = 10
+		numNewLogs = 5
+	)
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamID(t, tpid, 0)
+	client := clus.ClientAtIndex(t, 0)
+	for i := 0; i < numLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")})
+		require.NoError(t, res.Err)
+	}
+
+	subscribe := func(t *testing.T, pos varlog.StartPosition, begin, end types.GLSN) (*sync.WaitGroup, *types.GLSN) {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		expected := begin
+		closer, err := client.Subscribe(context.Background(), tpid, types.InvalidGLSN, end, func(le varlogpb.LogEntry, err error) {
+			if err != nil {
+				assert.ErrorIs(t, err, io.EOF)
+				wg.Done()
+				return
+			}
+			assert.Equal(t, expected, le.GLSN)
+			expected++
+		}, varlog.WithStartPosition(pos))
+		require.NoError(t, err)
+		t.Cleanup(closer)
+		return &wg, &expected
+	}
+
+	// The subscription from the earliest position receives all log entries.
+	wg, expected := subscribe(t, varlog.StartPositionEarliest, types.MinGLSN, types.GLSN(numLogs+1))
+	wg.Wait()
+	require.Equal(t, types.GLSN(numLogs+1), *expected)
+
+	// The subscription from the latest position cannot end before the
+	// global high watermark.
+	_, err := client.Subscribe(context.Background(), tpid, types.InvalidGLSN, types.GLSN(numLogs+1), func(varlogpb.LogEntry, error) {}, varlog.WithStartPosition(varlog.StartPositionLatest))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	_, err = client.Subscribe(context.Background(), tpid, types.InvalidGLSN, types.MaxGLSN, func(varlogpb.LogEntry, error) {}, varlog.WithStartPosition(varlog.StartPosition(-1)))
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+
+	// The subscription from the latest position receives only log entries
+	// appended after it starts.
+	wg, expected = subscribe(t, varlog.StartPositionLatest, types.GLSN(numLogs+1), types.GLSN(numLogs+numNewLogs+1))
+	for i := 0; i < numNewLogs; i++ {
+		res := client.Append(context.Background(), tpid, [][]byte{[]byte("bar")})
+		require.NoError(t, res.Err)
+	}
+	wg.Wait()
+	require.Equal(t, types.GLSN(numLogs+numNewLogs+1), *expected)
+
+	_, last, err := client.PeekLogStream(context.Background(), tpid, lsid)
+	require.NoError(t, err)
+
+	sub := client.SubscribeTo(context.Background(), tpid, lsid, types.InvalidLLSN, types.MaxLLSN, varlog.WithStartPosition(varlog.StartPositionEarliest))
+	le, err := sub.Next()
+	require.NoError(t, err)
+	require.Equal(t, types.MinLLSN, le.LLSN)
+	require.NoError(t, sub.Close())
+
+	sub = client.SubscribeTo(context.Background(), tpid, lsid, types.InvalidLLSN, types.MaxLLSN, varlog.WithStartPosition(varlog.StartPositionLatest))
+	defer func() {
+		require.NoError(t, sub.Close())
+	}()
+	// SubscribeTo returns before the storage node resolves the position;
+	// hence, it keeps appending until the subscriber receives a log entry.
+	ctx, cancel := context.WithCancel(context.Background())
+	var wgAppend sync.WaitGroup
+	wgAppend.Add(1)
+	go func() {
+		defer wgAppend.Done()
+		for ctx.Err() == nil {
+			res := client.AppendTo(ctx, tpid, lsid, [][]byte{[]byte("baz")})
+			assert.True(t, res.Err == nil || ctx.Err() != nil)
+			time.Sleep(100 * time.Millisecond)
+		}
+	}()
+	le, err = sub.Next()
+	cancel()
+	wgAppend.Wait()
+	require.NoError(t, err)
+	require.Greater(t, le.LLSN, last.LLSN)
+	require.Equal(t, "baz", string(le.Data))
+}
+
+func TestClientEncryption(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(2),
+		it.WithNumberOfStorageNodes(2),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+
+	keyPath := filepath.Join(t.TempDir(), "keys")
+	writeKey := func(keyID string) {
+		key, err := encrypt.NewKey()
+		require.NoError(t, err)
+		f, err := os.OpenFile(keyPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(f, "%s %s\n", keyID, base64.StdEncoding.EncodeToString(key))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	openClient := func() varlog.Log {
+		kp, err := varlog.NewFileKeyProvider(keyPath)
+		require.NoError(t, err)
+		client, err := varlog.Open(context.Background(), clus.ClusterID(), clus.MRRPCEndpoints(),
+			varlog.WithEncryption(kp),
+			varlog.WithCompression(varlogpb.CompressionCodecZstd),
+		)
+		require.NoError(t, err)
+		return client
+	}
+
+	payload := func(i int) []byte {
+		return []byte(strings.Repeat(fmt.Sprintf("secret-%d", i), 10))
+	}
+	var expected [][]byte
+
+	// Log entries are encrypted with the key "key-1".
+	writeKey("key-1")
+	client := openClient()
+	data := [][]byte{payload(0), payload(1)}
+	res := client.Append(context.Background(), tpid, data, varlog.WithLogEntryAttributes([]varlogpb.LogEntryAttributes{{Key: []byte("key")}, {}}))
+	require.NoError(t, res.Err)
+	expected = append(expected, data...)
+	require.NoError(t, client.Close())
+
+	// Keys are rotated, and log entries are encrypted with the key "key-2".
+	writeKey("key-2")
+	client = openClient()
+	data = [][]byte{payload(2)}
+	res = client.AppendTo(context.Background(), tpid, lsid, data)
+	require.NoError(t, res.Err)
+	expected = append(expected, data...)
+
+	lsa, err := client.NewLogStreamAppender(tpid, lsid)
+	require.NoError(t, err)
+	data = [][]byte{payload(3)}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	err = lsa.AppendBatch(data, func(_ []varlogpb.LogEntryMeta, err error) {
+		defer wg.Done()
+		assert.NoError(t, err)
+	})
+	require.NoError(t, err)
+	wg.Wait()
+	lsa.Close()
+	expected = append(expected, data...)
+
+	// The client having both keys decrypts log entries transparently.
+	subscriber := client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1))
+	for i := range expected {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected[i], le.Data)
+		require.Nil(t, le.Encryption)
+		require.Equal(t, varlogpb.CompressionCodecNone, le.CompressionCodec)
+		if i == 0 {
+			require.Equal(t, []byte("key"), le.Key)
+		}
+	}
+	require.NoError(t, subscriber.Close())
+
+	var subscribed [][]byte
+	subscribeDone := make(chan struct{})
+	closer, err := client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(len(expected)+1), func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			close(subscribeDone)
+			return
+		}
+		subscribed = append(subscribed, le.Data)
+	})
+	require.NoError(t, err)
+	<-subscribeDone
+	closer()
+	require.Equal(t, expected, subscribed)
+
+	le, err := client.ReadAt(context.Background(), tpid, lsid, types.MinGLSN)
+	require.NoError(t, err)
+	require.Equal(t, expected[0], le.Data)
+	require.NoError(t, client.Close())
+
+	// The client without keys and storage nodes see only encrypted payloads.
+	client = clus.ClientAtIndex(t, 0)
+	subscriber = client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1))
+	for i := range expected {
+		le, err := subscriber.Next()
+		require.NoError(t, err)
+		require.NotEqual(t, expected[i], le.Data)
+		require.NotContains(t, string(le.Data), "secret")
+		require.NotNil(t, le.Encryption)
+		require.Equal(t, varlogpb.CompressionCodecZstd, le.Encryption.CompressionCodec)
+		if i < 2 {
+			require.Equal(t, "key-1", le.Encryption.KeyID)
+		} else {
+			require.Equal(t, "key-2", le.Encryption.KeyID)
+		}
+	}
+	require.NoError(t, subscriber.Close())
+
+	cli := clus.LogClientOf(t, clus.BackupStorageNodeIDOf(t, lsid))
+	les, err := cli.ReadRangeWithLLSN(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1), 0)
+	require.NoError(t, err)
+	require.Len(t, les, len(expected))
+	for _, le := range les {
+		require.NotContains(t, string(le.Data), "secret")
+		require.NotNil(t, le.Encryption)
+	}
+
+	// The client without the key encryption key cannot decrypt log entries.
+	require.NoError(t, os.Remove(keyPath))
+	writeKey("key-3")
+	client = openClient()
+	defer func() {
+		require.NoError(t, client.Close())
+	}()
+	subscriber = client.SubscribeTo(context.Background(), tpid, lsid, types.MinLLSN, types.LLSN(len(expected)+1))
+	_, err = subscriber.Next()
+	require.Error(t, err)
+	require.NoError(t, subscriber.Close())
+	_, err = client.ReadAt(context.Background(), tpid, lsid, types.MinGLSN)
+	require.Error(t, err)
+
+	subscribeDone = make(chan struct{})
+	var subscribeErr error
+	closer, err = client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(len(expected)+1), func(_ varlogpb.LogEntry, err error) {
+		if err != nil {
+			subscribeErr = err
+			close(subscribeDone)
+		}
+	})
+	require.NoError(t, err)
+	<-subscribeDone
+	closer()
+	require.Error(t, subscribeErr)
+	require.NotErrorIs(t, subscribeErr, io.EOF)
+}
+
+func TestClientPeekTopic(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(2),
+		it.WithReplicationFactor(2),
+		it.WithNumberOfTopics(1),
+		it.WithNumberOfLogStreams(2),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+	)
+	defer clus.Close(t)
+
+	tpid := clus.TopicIDs()[0]
+	lsids := clus.LogStreamIDs(tpid)
+	client := clus.ClientAtIndex(t, 0)
+
+	_, err := client.PeekTopic(context.Background(), tpid+1)
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	tb, err := client.PeekTopic(context.Background(), tpid)
+	require.NoError(t, err)
+	require.True(t, tb.First.Invalid())
+	require.True(t, tb.Last.Invalid())
+	require.True(t, tb.GlobalHighWatermark.Invalid())
+	require.Len(t, tb.LogStreams, len(lsids))
+	for _, lsid := range lsids {
+		require.True(t, tb.LogStreams[lsid].First.Invalid())
+		require.True(t, tb.LogStreams[lsid].Last.Invalid())
+	}
+
+	// LSID: 1  1  2
+	// LLSN: 1  2  1
+	// GLSN: 1  2  3
+	res := client.AppendTo(context.Background(), tpid, lsids[0], [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+	res = client.AppendTo(context.Background(), tpid, lsids[1], [][]byte{[]byte("baz")})
+	require.NoError(t, res.Err)
+
+	tb, err = client.PeekTopic(context.Background(), tpid)
+	require.NoError(t, err)
+	require.Equal(t, varlog.TopicBoundary{
+		First:               types.GLSN(1),
+		Last:                types.GLSN(3),
+		GlobalHighWatermark: types.GLSN(3),
+		LogStreams: map[types.LogStreamID]varlog.LogStreamBoundary{
+			lsids[0]: {
+				First: varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 1},
+				Last:  varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 2},
+			},
+			lsids[1]: {
+				First: varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 3},
+				Last:  varlogpb.LogSequenceNumber{LLSN: 1, GLSN: 3},
+			},
+		},
+	}, tb)
+	for _, lsid := range lsids {
+		first, last, err := client.PeekLogStream(context.Background(), tpid, lsid)
+		require.NoError(t, err)
+		require.Equal(t, first, tb.LogStreams[lsid].First)
+		require.Equal(t, last, tb.LogStreams[lsid].Last)
+	}
+
+	err = client.Trim(context.Background(), tpid, types.GLSN(1), varlog.TrimOption{})
+	require.NoError(t, err)
+	tb, err = client.PeekTopic(context.Background(), tpid)
+	require.NoError(t, err)
+	require.Equal(t, types.GLSN(2), tb.First)
+	require.Equal(t, types.GLSN(3), tb.Last)
+	require.Equal(t, varlogpb.LogSequenceNumber{LLSN: 2, GLSN: 2}, tb.LogStreams[lsids[0]].First)
+
+	// A log stream that cannot be peeked fails the topic.
+	for idx := 0; idx < 2; idx++ {
+		clus.CloseSN(t, clus.StorageNodeIDAtIndex(t, idx))
+	}
+	_, err = client.PeekTopic(context.Background(), tpid)
+	require.Error(t, err)
+}
+
+func TestLogStreamAppenderRetryPolicy(t *testing.T) {
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+		it.WithNumberOfTopics(1),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid := clus.LogStreamIDs(tpid)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	invalidPolicy := varlog.WithRetryPolicy(varlog.RetryPolicy{MaxRetries: -1})
+	_, err := client.NewLogStreamAppender(tpid, lsid, invalidPolicy)
+	require.ErrorIs(t, err, verrors.ErrInvalid)
+	res := client.Append(context.Background(), tpid, [][]byte{[]byte("foo")}, invalidPolicy)
+	require.ErrorIs(t, res.Err, verrors.ErrInvalid)
+
+	res = client.AppendTo(context.Background(), tpid, lsid, [][]byte{[]byte("foo")}, varlog.WithRetryPolicy(varlog.RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 10 * time.Millisecond,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}))
+	require.NoError(t, res.Err)
+	lastLLSN := res.Metadata[0].LLSN
+
+	lsa, err := client.NewLogStreamAppender(tpid, lsid,
+		varlog.WithPipelineSize(1),
+		varlog.WithRetryPolicy(varlog.RetryPolicy{
+			MaxRetries:     100,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+			Multiplier:     2,
+		}),
+	)
+	require.NoError(t, err)
+	defer lsa.Close()
+
+	// Without a retry policy, the appender does not reconnect its stream
+	// once the log stream is sealed.
+	lsaNoRetry, err := client.NewLogStreamAppender(tpid, lsid, varlog.WithPipelineSize(1))
+	require.NoError(t, err)
+	defer lsaNoRetry.Close()
+
+	appendBatch := func(lsa varlog.LogStreamAppender) <-chan error {
+		errC := make(chan error, 1)
+		err := lsa.AppendBatch([][]byte{[]byte("foo")}, func(metas []varlogpb.LogEntryMeta, err error) {
+			if err == nil {
+				assert.Len(t, metas, 1)
+				assert.Equal(t, lastLLSN+1, metas[0].LLSN)
+				lastLLSN = metas[0].LLSN
+			}
+			errC <- err
+		})
+		require.NoError(t, err)
+		return errC
+	}
+
+	require.NoError(t, <-appendBatch(lsa))
+	require.NoError(t, <-appendBatch(lsaNoRetry))
+
+	_, err = clus.Seal(tpid, lsid)
+	require.NoError(t, err)
+
+	// The batch sent over the broken stream fails.
+	require.ErrorIs(t, <-appendBatch(lsa), verrors.ErrSealed)
+	require.Error(t, <-appendBatch(lsaNoRetry))
+
+	// The next batch waits for the log stream to be unsealed.
+	errC := appendBatch(lsa)
+	select {
+	case err := <-errC:
+		require.FailNow(t, "unexpected callback while sealed", "err: %v", err)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	_, err = clus.Unseal(tpid, lsid)
+	require.NoError(t, err)
+	select {
+	case err := <-errC:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "timeout waiting for the appender to reconnect")
+	}
+	require.NoError(t, <-appendBatch(lsa))
+	require.Error(t, <-appendBatch(lsaNoRetry))
+}
+
+func TestClientSubscribeLogStreamChanges(t *testing.T) {
+	const numLogs = 6
+
+	clus := it.NewVarlogCluster(t,
+		it.WithNumberOfStorageNodes(1),
+		it.WithReplicationFactor(1),
+		it.WithNumberOfTopics(1),
+		it.WithNumberOfLogStreams(1),
+		it.WithNumberOfClients(1),
+		it.WithVMSOptions(it.NewTestVMSOptions()...),
+	)
+	defer func() {
+		clus.Close(t)
+		testutil.GC()
+	}()
+
+	tpid := clus.TopicIDs()[0]
+	lsid1 := clus.LogStreamIDs(tpid)[0]
+	client := clus.ClientAtIndex(t, 0)
+
+	eventC := make(chan varlog.LogStreamEvent, 3)
+	glsnC := make(chan types.GLSN, numLogs)
+	closer, err := client.Subscribe(context.Background(), tpid, types.MinGLSN, types.GLSN(numLogs+1), func(le varlogpb.LogEntry, err error) {
+		if err != nil {
+			assert.ErrorIs(t, err, io.EOF)
+			close(glsnC)
+			return
+		}
+		glsnC <- le.GLSN
+	}, varlog.WithLogStreamListener(func(event varlog.LogStreamEvent) {
+		eventC <- event
+	}))
+	require.NoError(t, err)
+	defer closer()
+
+	// GLSN 1, 2
+	res := client.AppendTo(context.Background(), tpid, lsid1, [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+	require.Equal(t, types.GLSN(1), <-glsnC)
+	require.Equal(t, types.GLSN(2), <-glsnC)
+
+	// GLSN 3, 4
+	lsid2 := clus.AddLS(t, tpid)
+	res = client.AppendTo(context.Background(), tpid, lsid2, [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+
+	event := <-eventC
+	require.Equal(t, varlog.LogStreamEventAdded, event.Type)
+	require.Equal(t, lsid2, event.LogStreamID)
+	require.Equal(t, types.GLSN(3), event.GLSN)
+
+	_, err = clus.GetVMSClient(t).Seal(context.Background(), tpid, lsid1)
+	require.NoError(t, err)
+	err = clus.GetVMSClient(t).UnregisterLogStream(context.Background(), tpid, lsid1)
+	require.NoError(t, err)
+
+	// The subscription watches the metadata while waiting for GLSN 5.
+	event = <-eventC
+	require.Equal(t, varlog.LogStreamEventRemoved, event.Type)
+	require.Equal(t, lsid1, event.LogStreamID)
+	require.Equal(t, types.GLSN(5), event.GLSN)
+	event = <-eventC
+	require.Equal(t, varlog.LogStreamEventDrained, event.Type)
+	require.Equal(t, lsid1, event.LogStreamID)
+
+	// GLSN 5, 6
+	res = client.AppendTo(context.Background(), tpid, lsid2, [][]byte{[]byte("foo"), []byte("bar")})
+	require.NoError(t, res.Err)
+
+	var glsns []types.GLSN
+	for glsn := range glsnC {
+		glsns = append(glsns, glsn)
+	}
+	require.Equal(t, []types.GLSN{3, 4, 5, 6}, glsns)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/kakao/varlog/internal/storagenode"
 	"github.com/kakao/varlog/pkg/types"
 	"github.com/kakao/varlog/pkg/util/testutil"
+	"github.com/kakao/varlog/pkg/verrors"
 	"github.com/kakao/varlog/pkg/varlog"
 	"github.com/kakao/varlog/proto/varlogpb"
 	"github.com/kakao/varlog/tests/it"
@@ -192,6 +193,51 @@ func TestAddLogStream_LogStreamsCount(t *testing.T) {
 	}
 }
 
+func TestAddNamedTopic(t *testing.T) {
+	const name = "orders-events"
+
+	clus := it.NewVarlogCluster(t,
+		it.WithReplicationFactor(1),
+		it.WithNumberOfStorageNodes(2),
+	)
+	defer clus.Close(t)
+
+	ctx := context.Background()
+	mc := clus.GetVMSClient(t)
+
+	config := varlogpb.TopicConfig{
+		ReplicationFactor: 2,
+		Retention:         time.Hour,
+		MaxLogStreams:     1,
+		Description:       "events of orders",
+	}
+	td, err := mc.AddNamedTopic(ctx, name, config)
+	require.NoError(t, err)
+	require.Equal(t, name, td.Name)
+	require.Equal(t, config, td.Config)
+
+	_, err = mc.AddNamedTopic(ctx, name, varlogpb.TopicConfig{})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = mc.AddNamedTopic(ctx, "orders events", varlogpb.TopicConfig{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = mc.GetTopicByName(ctx, name+"-dlq")
+	require.ErrorIs(t, err, verrors.ErrNotExist)
+
+	require.Eventually(t, func() bool {
+		got, err := mc.GetTopicByName(ctx, name)
+		return err == nil && got.TopicID == td.TopicID && got.Config.Equal(config)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	lsd, err := mc.AddLogStream(ctx, td.TopicID, nil)
+	require.NoError(t, err)
+	require.Len(t, lsd.Replicas, int(config.ReplicationFactor))
+
+	_, err = mc.AddLogStream(ctx, td.TopicID, nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
 func TestAddLogStreamWithNotExistedNode(t *testing.T) {
 	clus := it.NewVarlogCluster(t, it.WithNumberOfTopics(1))
 	defer clus.Close(t)
@@ -1496,6 +1496,16 @@ func (clus *VarlogCluster) storageNodeAddr(t *testing.T, snID types.StorageNodeI
 	return clus.snAddrs[snID]
 }
 
+// LogClientOf returns a client for the log service of the storage node.
+func (clus *VarlogCluster) LogClientOf(t *testing.T, snID types.StorageNodeID) *client.LogClient {
+	clus.muSN.Lock()
+	defer clus.muSN.Unlock()
+
+	cli, err := clus.logClientManager.GetOrConnect(context.Background(), snID, clus.storageNodeAddr(t, snID))
+	require.NoError(t, err)
+	return cli
+}
+
 func (clus *VarlogCluster) AppendUncommittedLog(t *testing.T, topicID types.TopicID, lsID types.LogStreamID, data []byte) {
 	clus.muSN.Lock()
 	defer clus.muSN.Unlock()